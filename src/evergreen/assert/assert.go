@@ -23,6 +23,12 @@ func StringEquals(t *testing.T, actual string, expected string) {
 	}
 }
 
+func BoolEquals(t *testing.T, actual bool, expected bool) {
+	if actual != expected {
+		t.Fatalf("%#v != %#v", actual, expected)
+	}
+}
+
 func IntListEquals(t *testing.T, actualList []int, expectedList []int) {
 	IntEquals(t, len(actualList), len(expectedList))
 	for i, expected := range expectedList {
@@ -115,7 +115,13 @@ func processProgram(status compiler.PassStatus, p compiler.LocationProvider, run
 
 	analyizeProgram(flowProgram)
 
-	goFlowProg, goCoreProg, bypass := golang.GenerateGo(status.Pass("dub_to_go"), flowProgram, coreProg, config.RootPackage, config.GenerateTests)
+	goFlowProg, goCoreProg, bypass := golang.GenerateGo(status.Pass("dub_to_go"), flowProgram, coreProg, config.RootPackage, &golang.GenerateOptions{
+		Tests:    config.GenerateTests,
+		Visitors: config.GenerateVisitors,
+		JSON:     config.GenerateJSON,
+	})
+	goflow.OptimizeProgram(status.Pass("optimize_go"), goFlowProg, config.Optimize)
+
 	if config.Dump {
 		dumpFlowFuncs(status.Pass("dump_go"), runner, goFlowProg, goCoreProg, config.DumpDir)
 	}
@@ -134,6 +140,25 @@ func entryPoint(p compiler.LocationProvider, status compiler.PassStatus, config
 	runner.Kill()
 }
 
+func dumpDeps(config *EGCConfig) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+
+	deps := tree.DubPackageGraph(status.Pass("egc"), p, config.InputDir)
+	if status.ShouldHalt() {
+		fmt.Printf("%d errors\n", status.ErrorCount())
+		os.Exit(1)
+	}
+	switch config.Deps {
+	case "text":
+		fmt.Print(deps.Text())
+	case "dot":
+		fmt.Print(deps.Dot())
+	default:
+		panic(config.Deps)
+	}
+}
+
 func mainLoop(config *EGCConfig, profiling bool) {
 	p := compiler.MakeProvider()
 	status := compiler.MakeStatus(p)
@@ -155,13 +180,17 @@ func mainLoop(config *EGCConfig, profiling bool) {
 }
 
 type EGCConfig struct {
-	Dump          bool
-	InputDir      string
-	OutputDir     string
-	RootPackage   []string
-	DumpDir       []string
-	GenerateTests bool
-	Jobs          int
+	Dump             bool
+	InputDir         string
+	OutputDir        string
+	RootPackage      []string
+	DumpDir          []string
+	GenerateTests    bool
+	GenerateVisitors bool
+	GenerateJSON     bool
+	Optimize         int
+	Deps             string
+	Jobs             int
 }
 
 func flagError(message string) {
@@ -186,6 +215,10 @@ func main() {
 
 	flag.BoolVar(&config.Dump, "dump", false, "Dump flowgraphs to output/... (requires graphviz).")
 	flag.BoolVar(&config.GenerateTests, "gentests", false, "Generate dub tests.")
+	flag.BoolVar(&config.GenerateVisitors, "genvisitors", false, "Generate Walk and Rewrite functions for struct hierarchies.")
+	flag.BoolVar(&config.GenerateJSON, "genjson", false, "Generate JSON encoders and decoders for structs.")
+	flag.IntVar(&config.Optimize, "O", goflow.O0, "Optimization level: 0 translates flowgraphs as they are, 1 propagates copies and removes dead code, 2 also propagates constants.")
+	flag.StringVar(&config.Deps, "deps", "", "Print the package dependency graph as \"text\" or \"dot\" and exit.")
 
 	flag.StringVar(&cpuprofile, "cpuprofile", "", "Write cpu profile to file.")
 	flag.StringVar(&memprofile, "memprofile", "", "Write memory profile to this file.")
@@ -197,6 +230,13 @@ func main() {
 	if config.InputDir == "" {
 		flagError("-indir is required")
 	}
+	if config.Deps != "" {
+		if config.Deps != "text" && config.Deps != "dot" {
+			flagError("-deps must be \"text\" or \"dot\"")
+		}
+		dumpDeps(config)
+		return
+	}
 	if config.OutputDir == "" {
 		flagError("-outdir is required")
 	}
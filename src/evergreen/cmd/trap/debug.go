@@ -0,0 +1,437 @@
+package main
+
+import (
+	"bufio"
+	"evergreen/trap/asm"
+	"evergreen/trap/interpreter"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type debugCommand struct {
+	Names []string
+	Usage string
+	Run   func(s *debugSession, args []string) error
+	Help  string
+}
+
+var debugCommands []*debugCommand
+
+// Returned by commands to print their usage.
+var errUsage = fmt.Errorf("usage")
+
+// Drives a debugger with line-oriented commands.
+type debugSession struct {
+	d   *interpreter.Debugger
+	out io.Writer
+	// The frame inspected by locals and watch, counting outwards from the
+	// innermost.
+	selected int
+	quit     bool
+}
+
+func (s *debugSession) printf(format string, args ...interface{}) {
+	fmt.Fprintf(s.out, format, args...)
+}
+
+// Finds a function by name, or by uid if the name is shared.
+func (s *debugSession) function(arg string) (int, error) {
+	funcs := s.d.I.Funcs
+	if uid, err := strconv.Atoi(arg); err == nil {
+		if uid < 0 || uid >= len(funcs) {
+			return 0, fmt.Errorf("no function %d", uid)
+		}
+		return uid, nil
+	}
+	found := -1
+	for uid, f := range funcs {
+		if f.Name == arg {
+			if found >= 0 {
+				return 0, fmt.Errorf("%s is ambiguous, use its index", arg)
+			}
+			found = uid
+		}
+	}
+	if found < 0 {
+		return 0, fmt.Errorf("no function named %#v", arg)
+	}
+	return found, nil
+}
+
+func (s *debugSession) uid(f *interpreter.Function) int {
+	for uid, other := range s.d.I.Funcs {
+		if other == f {
+			return uid
+		}
+	}
+	panic(f.Name)
+}
+
+func (s *debugSession) frame() (*interpreter.StackFrame, error) {
+	stack := s.d.Stack()
+	if len(stack) == 0 {
+		return nil, fmt.Errorf("nothing is running")
+	}
+	if s.selected >= len(stack) {
+		s.selected = 0
+	}
+	return stack[s.selected], nil
+}
+
+func (s *debugSession) printFrame(depth int, frame *interpreter.StackFrame) {
+	s.printf("#%d %s:%d  %s\n", depth, frame.F.Name, frame.Location, asm.DisassembleOp(s.d.I.Funcs, s.uid(frame.F), frame.Location))
+}
+
+func (s *debugSession) printStop(stop *interpreter.Stop) {
+	i := s.d.I
+	s.selected = 0
+	switch stop.Reason {
+	case interpreter.STOP_DONE:
+		if i.Flow == interpreter.FAIL {
+			s.printf("failed\n")
+			return
+		}
+		s.printf("returned\n")
+		for idx := 0; idx < i.TempLen; idx++ {
+			s.printf("  %s\n", formatObject(i.Temp[idx]))
+		}
+		return
+	case interpreter.STOP_WATCHPOINT:
+		w := stop.Watch
+		s.printf("%s slot %d: %s -> %s\n", w.Struct.Type().Name(), w.Slot, formatObject(stop.Old), formatObject(w.Value))
+	case interpreter.STOP_BREAKPOINT:
+		s.printf("breakpoint\n")
+	}
+	s.printFrame(0, i.Frame)
+}
+
+func (s *debugSession) stopped(stop *interpreter.Stop, err error) error {
+	if err != nil {
+		return err
+	}
+	s.printStop(stop)
+	return nil
+}
+
+func stepCommand(s *debugSession, args []string) error {
+	count := 1
+	if len(args) == 1 {
+		n, err := strconv.Atoi(args[0])
+		if err != nil || n < 1 {
+			return fmt.Errorf("%#v is not a step count", args[0])
+		}
+		count = n
+	} else if len(args) > 1 {
+		return errUsage
+	}
+	for n := 0; n < count; n++ {
+		stop, err := s.d.Step()
+		if err != nil || stop.Reason != interpreter.STOP_STEP || n == count-1 {
+			return s.stopped(stop, err)
+		}
+	}
+	return nil
+}
+
+func continueCommand(s *debugSession, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+	return s.stopped(s.d.Continue())
+}
+
+func (s *debugSession) breakpoint(args []string) (int, int, error) {
+	if len(args) != 2 {
+		return 0, 0, errUsage
+	}
+	uid, err := s.function(args[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	location, err := strconv.Atoi(args[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("%#v is not a location", args[1])
+	}
+	return uid, location, nil
+}
+
+func breakCommand(s *debugSession, args []string) error {
+	if len(args) == 0 {
+		for _, bp := range s.d.Breakpoints() {
+			s.printf("%s:%d\n", s.d.I.Funcs[bp.Func].Name, bp.Location)
+		}
+		return nil
+	}
+	uid, location, err := s.breakpoint(args)
+	if err != nil {
+		return err
+	}
+	return s.d.SetBreakpoint(uid, location)
+}
+
+func clearCommand(s *debugSession, args []string) error {
+	uid, location, err := s.breakpoint(args)
+	if err != nil {
+		return err
+	}
+	if !s.d.ClearBreakpoint(uid, location) {
+		return fmt.Errorf("no breakpoint at %s:%d", s.d.I.Funcs[uid].Name, location)
+	}
+	return nil
+}
+
+// Parses a register of the selected frame, like "r3".
+func (s *debugSession) register(arg string) (interpreter.Object, error) {
+	frame, err := s.frame()
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(arg, "r") {
+		index, err := strconv.Atoi(arg[1:])
+		if err == nil && index >= 0 && index < len(frame.Locals) {
+			return frame.Locals[index], nil
+		}
+	}
+	return nil, fmt.Errorf("%#v is not one of the %d locals", arg, len(frame.Locals))
+}
+
+func watchCommand(s *debugSession, args []string) error {
+	if len(args) == 0 {
+		for idx, w := range s.d.Watchpoints {
+			s.printf("%d: %s slot %d = %s\n", idx, w.Struct.Type().Name(), w.Slot, formatObject(w.Value))
+		}
+		return nil
+	}
+	if len(args) != 2 {
+		return errUsage
+	}
+	o, err := s.register(args[0])
+	if err != nil {
+		return err
+	}
+	slot, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("%#v is not a slot", args[1])
+	}
+	w, err := s.d.Watch(o, slot)
+	if err != nil {
+		return err
+	}
+	s.printf("%d: %s slot %d = %s\n", len(s.d.Watchpoints)-1, w.Struct.Type().Name(), w.Slot, formatObject(w.Value))
+	return nil
+}
+
+func unwatchCommand(s *debugSession, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	index, err := strconv.Atoi(args[0])
+	if err != nil || index < 0 || index >= len(s.d.Watchpoints) {
+		return fmt.Errorf("no watchpoint %s", args[0])
+	}
+	s.d.Unwatch(s.d.Watchpoints[index])
+	return nil
+}
+
+func stackCommand(s *debugSession, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+	for depth, frame := range s.d.Stack() {
+		s.printFrame(depth, frame)
+	}
+	return nil
+}
+
+func frameCommand(s *debugSession, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	depth, err := strconv.Atoi(args[0])
+	stack := s.d.Stack()
+	if err != nil || depth < 0 || depth >= len(stack) {
+		return fmt.Errorf("no frame %s", args[0])
+	}
+	s.selected = depth
+	s.printFrame(depth, stack[depth])
+	return nil
+}
+
+func localsCommand(s *debugSession, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+	frame, err := s.frame()
+	if err != nil {
+		return err
+	}
+	for idx, o := range frame.Locals {
+		s.printf("r%d = %s\n", idx, formatObject(o))
+	}
+	return nil
+}
+
+func listCommand(s *debugSession, args []string) error {
+	if len(args) != 0 {
+		return errUsage
+	}
+	frame, err := s.frame()
+	if err != nil {
+		return err
+	}
+	uid := s.uid(frame.F)
+	// Includes the end of the function, where a missing return stops.
+	for location := 0; location <= len(frame.F.Body); location++ {
+		if location == len(frame.F.Body) && location != frame.Location {
+			break
+		}
+		marker := "  "
+		if location == frame.Location {
+			marker = "=>"
+		}
+		s.printf("%s %3d  %s\n", marker, location, asm.DisassembleOp(s.d.I.Funcs, uid, location))
+	}
+	return nil
+}
+
+func quitCommand(s *debugSession, args []string) error {
+	s.quit = true
+	return nil
+}
+
+func debugHelpCommand(s *debugSession, args []string) error {
+	for _, c := range debugCommands {
+		s.printf("  %s %s\n", strings.Join(c.Names, ", "), c.Usage)
+		s.printf("        %s\n", c.Help)
+	}
+	return nil
+}
+
+func (s *debugSession) execute(line string) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return
+	}
+	for _, c := range debugCommands {
+		for _, name := range c.Names {
+			if name != fields[0] {
+				continue
+			}
+			err := c.Run(s, fields[1:])
+			if err == errUsage {
+				s.printf("Usage: %s %s\n", c.Names[0], c.Usage)
+			} else if err != nil {
+				s.printf("ERROR: %s\n", err)
+			}
+			return
+		}
+	}
+	s.printf("Unrecognized command: %s\n", fields[0])
+}
+
+// Reads commands until the input ends or the user quits.
+func (s *debugSession) loop(in io.Reader) {
+	scanner := bufio.NewScanner(in)
+	for !s.quit {
+		s.printf("(trap) ")
+		if !scanner.Scan() {
+			s.printf("\n")
+			return
+		}
+		s.execute(scanner.Text())
+	}
+}
+
+func Debug(args []string) bool {
+	if len(args) < 2 {
+		return false
+	}
+	i, uid := load(args)
+	if err := i.Invoke(uid); err != nil {
+		fmt.Printf("ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	// Natives run as soon as they are invoked, so there is nothing to step.
+	if i.Frame == nil {
+		report(i, args[1])
+		return true
+	}
+	s := &debugSession{d: interpreter.CreateDebugger(i), out: os.Stdout}
+	s.printFrame(0, i.Frame)
+	s.loop(os.Stdin)
+	return true
+}
+
+func init() {
+	debugCommands = []*debugCommand{
+		&debugCommand{
+			Names: []string{"step", "s"},
+			Usage: "[count]",
+			Run:   stepCommand,
+			Help:  "Executes one op, or count ops.",
+		},
+		&debugCommand{
+			Names: []string{"continue", "c"},
+			Run:   continueCommand,
+			Help:  "Runs until a breakpoint or watchpoint, or until the call finishes.",
+		},
+		&debugCommand{
+			Names: []string{"break", "b"},
+			Usage: "[Func location]",
+			Run:   breakCommand,
+			Help:  "Stops before the op at a location, or lists the breakpoints.",
+		},
+		&debugCommand{
+			Names: []string{"clear"},
+			Usage: "Func location",
+			Run:   clearCommand,
+			Help:  "Removes a breakpoint.",
+		},
+		&debugCommand{
+			Names: []string{"watch", "w"},
+			Usage: "[rN slot]",
+			Run:   watchCommand,
+			Help:  "Stops when a slot of the struct in a register changes, or lists the watchpoints.",
+		},
+		&debugCommand{
+			Names: []string{"unwatch"},
+			Usage: "index",
+			Run:   unwatchCommand,
+			Help:  "Removes a watchpoint.",
+		},
+		&debugCommand{
+			Names: []string{"stack", "bt"},
+			Run:   stackCommand,
+			Help:  "Prints the call stack, innermost first.",
+		},
+		&debugCommand{
+			Names: []string{"frame", "f"},
+			Usage: "depth",
+			Run:   frameCommand,
+			Help:  "Selects the frame that locals, list and watch look at.",
+		},
+		&debugCommand{
+			Names: []string{"locals", "l"},
+			Run:   localsCommand,
+			Help:  "Prints the registers of the selected frame.",
+		},
+		&debugCommand{
+			Names: []string{"list"},
+			Run:   listCommand,
+			Help:  "Prints the ops of the selected frame's function.",
+		},
+		&debugCommand{
+			Names: []string{"quit", "q"},
+			Run:   quitCommand,
+			Help:  "Exits the debugger.",
+		},
+		&debugCommand{
+			Names: []string{"help", "h"},
+			Run:   debugHelpCommand,
+			Help:  "Displays this message.",
+		},
+	}
+}
@@ -0,0 +1,403 @@
+// Tool for running trap programs.
+package main
+
+import (
+	"evergreen/compiler"
+	"evergreen/dub/flow"
+	"evergreen/dub/runtime"
+	dubtransform "evergreen/dub/transform"
+	"evergreen/dub/transform/trap"
+	dubtree "evergreen/dub/tree"
+	"evergreen/trap/asm"
+	"evergreen/trap/interpreter"
+	"evergreen/trap/transform"
+	"evergreen/trap/tree"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type Mode struct {
+	Name  string
+	Usage string
+	Run   func(args []string) bool
+	Help  string
+}
+
+var modes []*Mode
+
+func getMode(requested string) *Mode {
+	for _, mode := range modes {
+		if mode.Name == requested {
+			return mode
+		}
+	}
+	return nil
+}
+
+func formatObject(o interpreter.Object) string {
+	switch o := o.(type) {
+	case *interpreter.I32:
+		return strconv.FormatInt(int64(o.Value), 10)
+	case *interpreter.I64:
+		return strconv.FormatInt(o.Value, 10)
+	case *interpreter.F32:
+		return strconv.FormatFloat(float64(o.Value), 'g', -1, 32)
+	case *interpreter.F64:
+		return strconv.FormatFloat(o.Value, 'g', -1, 64)
+	case *interpreter.Bool:
+		return strconv.FormatBool(o.Value)
+	case *interpreter.String:
+		return strconv.Quote(o.Value)
+	case *interpreter.Struct:
+		slots := make([]string, len(o.Slots))
+		for i, slot := range o.Slots {
+			slots[i] = formatObject(slot)
+		}
+		return o.Type().Name() + "{" + strings.Join(slots, ", ") + "}"
+	case *interpreter.List:
+		items := make([]string, len(o.Items))
+		for i, item := range o.Items {
+			items[i] = formatObject(item)
+		}
+		return o.Type().Name() + "{" + strings.Join(items, ", ") + "}"
+	case nil:
+		return "<nil>"
+	default:
+		panic(o)
+	}
+}
+
+func parseArg(arg string, t tree.Type) (interpreter.Object, error) {
+	switch t {
+	case tree.I32Type:
+		value, err := strconv.ParseInt(arg, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		return &interpreter.I32{Value: int32(value)}, nil
+	case tree.I64Type:
+		value, err := strconv.ParseInt(arg, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &interpreter.I64{Value: value}, nil
+	case tree.F32Type:
+		value, err := strconv.ParseFloat(arg, 32)
+		if err != nil {
+			return nil, err
+		}
+		return &interpreter.F32{Value: float32(value)}, nil
+	case tree.F64Type:
+		value, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, err
+		}
+		return &interpreter.F64{Value: value}, nil
+	case tree.BoolType:
+		value, err := strconv.ParseBool(arg)
+		if err != nil {
+			return nil, err
+		}
+		return &interpreter.Bool{Value: value}, nil
+	case tree.StringType:
+		return &interpreter.String{Value: arg}, nil
+	default:
+		return nil, fmt.Errorf("cannot pass %s from the command line", tree.TypeName(t))
+	}
+}
+
+// The host functions trap programs can call.
+var natives = createNatives()
+
+func createNatives() *transform.Registry {
+	r := transform.CreateRegistry()
+	err := r.Register("print(T)", func(o interpreter.Object) {
+		fmt.Println(formatObject(o))
+	})
+	if err != nil {
+		panic(err)
+	}
+	err = r.Register("len([]T) i32", func(items []interpreter.Object) int32 {
+		return int32(len(items))
+	})
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Assembly does not record parameter types, so arguments are typed by how
+// they are written.
+func guessArg(arg string) interpreter.Object {
+	if value, err := strconv.ParseInt(arg, 10, 32); err == nil {
+		return &interpreter.I32{Value: int32(value)}
+	}
+	if value, err := strconv.ParseFloat(arg, 64); err == nil {
+		return &interpreter.F64{Value: value}
+	}
+	if value, err := strconv.ParseBool(arg); err == nil {
+		return &interpreter.Bool{Value: value}
+	}
+	return &interpreter.String{Value: arg}
+}
+
+// Assembles a trapasm file, or returns nil after printing the errors.
+func assemble(filename string) []*interpreter.Function {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	funcs := asm.AssemblyFrontend(status.Pass("asm"), p, filename, natives)
+	if status.ShouldHalt() {
+		fmt.Printf("%d errors\n", status.ErrorCount())
+		return nil
+	}
+	return funcs
+}
+
+// Compiles a trap file, or returns nil after printing the errors.
+func compile(filename string) (*tree.Program, []*interpreter.Function) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+
+	pass := status.Pass("trap")
+	pass.Begin()
+	var funcs []*interpreter.Function
+	program := tree.TrapProgramFrontend(pass.Pass("frontend"), p, filename, natives.Declarations())
+	if program != nil {
+		funcs = transform.LowerProgram(program, natives, pass.Pass("lower"))
+	}
+	pass.End()
+
+	if status.ShouldHalt() {
+		fmt.Printf("%d errors\n", status.ErrorCount())
+		return nil, nil
+	}
+	return program, funcs
+}
+
+// Loads a trap or trapasm file and prepares to call a function with the
+// arguments, or exits after printing the problem.
+func load(args []string) (*interpreter.Interpreter, int) {
+	var program *tree.Program
+	var funcs []*interpreter.Function
+	if filepath.Ext(args[0]) == ".trapasm" {
+		funcs = assemble(args[0])
+	} else {
+		program, funcs = compile(args[0])
+	}
+	if funcs == nil {
+		os.Exit(1)
+	}
+
+	uid := -1
+	for i, f := range funcs {
+		if f.Name == args[1] {
+			uid = i
+		}
+	}
+	if uid < 0 {
+		fmt.Printf("ERROR: no function named %#v\n", args[1])
+		os.Exit(1)
+	}
+	numParams := funcs[uid].NumParams
+	if len(args)-2 != numParams {
+		fmt.Printf("ERROR: %s expects %d arguments, got %d\n", args[1], numParams, len(args)-2)
+		os.Exit(1)
+	}
+	objects := make([]interpreter.Object, numParams)
+	for i := range objects {
+		if program == nil {
+			objects[i] = guessArg(args[i+2])
+			continue
+		}
+		o, err := parseArg(args[i+2], program.Funcs[uid].Params[i])
+		if err != nil {
+			fmt.Printf("ERROR: argument %d: %s\n", i, err)
+			os.Exit(1)
+		}
+		objects[i] = o
+	}
+
+	i := interpreter.CreateInterpreter(funcs)
+	i.SetTemp(objects)
+	return i, uid
+}
+
+// Prints the results of a finished call, or exits if it failed.
+func report(i *interpreter.Interpreter, name string) {
+	if i.Flow == interpreter.FAIL {
+		fmt.Printf("FAIL: %s\n", name)
+		os.Exit(1)
+	}
+	for idx := 0; idx < i.TempLen; idx++ {
+		fmt.Println(formatObject(i.Temp[idx]))
+	}
+}
+
+func Run(args []string) bool {
+	if len(args) < 2 {
+		return false
+	}
+	i, uid := load(args)
+	if err := i.Call(uid); err != nil {
+		fmt.Printf("ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	report(i, args[1])
+	return true
+}
+
+// Translates a directory of dub sources, or returns nil after printing the
+// errors.
+func translate(dir string) (*flow.DubProgram, *trap.TrapProgram) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+
+	pass := status.Pass("dub")
+	pass.Begin()
+	var flowProgram *flow.DubProgram
+	var trapProgram *trap.TrapProgram
+	program, coreProg := dubtree.DubProgramFrontend(pass.Pass("dub_frontend"), p, dir)
+	if !status.ShouldHalt() {
+		flowProgram = dubtransform.LowerProgram(pass.Pass("lower"), program, coreProg)
+		flow.TrimFlow(pass.Pass("trim_flow"), flowProgram)
+		trapProgram = trap.GenerateTrap(pass.Pass("dub_to_trap"), flowProgram)
+	}
+	pass.End()
+
+	if status.ShouldHalt() {
+		fmt.Printf("%d errors\n", status.ErrorCount())
+		return nil, nil
+	}
+	return flowProgram, trapProgram
+}
+
+func Dub(args []string) bool {
+	if len(args) != 3 {
+		return false
+	}
+	flowProgram, trapProgram := translate(args[0])
+	if trapProgram == nil {
+		os.Exit(1)
+	}
+
+	uid := -1
+	for i, f := range flowProgram.LLFuncs {
+		if f.Name == args[1] && f.CFG != nil {
+			uid = i
+		}
+	}
+	if uid < 0 {
+		fmt.Printf("ERROR: no function named %#v\n", args[1])
+		os.Exit(1)
+	}
+	f := flowProgram.LLFuncs[uid]
+	if len(f.Params) != 0 {
+		fmt.Printf("ERROR: %s expects %d arguments\n", args[1], len(f.Params))
+		os.Exit(1)
+	}
+
+	i := interpreter.CreateInterpreter(trapProgram.Funcs)
+	i.SetStream([]rune(args[2]))
+	i.SetTemp([]interpreter.Object{})
+	if err := i.Call(uid); err != nil {
+		fmt.Printf("ERROR: %s\n", err)
+		os.Exit(1)
+	}
+	if i.Flow == interpreter.FAIL {
+		fmt.Printf("FAIL: %s\n", args[1])
+		os.Exit(1)
+	}
+	for idx, t := range f.ReturnTypes {
+		fmt.Println(runtime.Format(trapProgram.Describe(i.Temp[idx], t)))
+	}
+	if i.Index != len(i.Stream) {
+		fmt.Printf("Consumed %d/%d runes\n", i.Index, len(i.Stream))
+	}
+	return true
+}
+
+func Dis(args []string) bool {
+	if len(args) != 1 {
+		return false
+	}
+	var funcs []*interpreter.Function
+	if info, err := os.Stat(args[0]); err == nil && info.IsDir() {
+		_, trapProgram := translate(args[0])
+		if trapProgram != nil {
+			funcs = trapProgram.Funcs
+		}
+	} else if filepath.Ext(args[0]) == ".trapasm" {
+		funcs = assemble(args[0])
+	} else {
+		_, funcs = compile(args[0])
+	}
+	if funcs == nil {
+		os.Exit(1)
+	}
+	fmt.Print(asm.Disassemble(funcs))
+	return true
+}
+
+func Help(args []string) bool {
+	fmt.Println("Usage:")
+	for _, mode := range modes {
+		fmt.Printf("  trap %s %s\n", mode.Name, mode.Usage)
+		fmt.Printf("        %s\n", mode.Help)
+	}
+	return true
+}
+
+func main() {
+	modes = []*Mode{
+		&Mode{
+			Name:  "run",
+			Usage: "file.trap Func args...",
+			Run:   Run,
+			Help:  "Compiles a trap or trapasm file and calls a function with the arguments.",
+		},
+		&Mode{
+			Name:  "dub",
+			Usage: "dir Func input",
+			Run:   Dub,
+			Help:  "Translates the dub sources in a directory and runs a rule on the input.",
+		},
+		&Mode{
+			Name:  "debug",
+			Usage: "file.trap Func args...",
+			Run:   Debug,
+			Help:  "Like run, but stops before the first op and reads debugger commands from stdin.",
+		},
+		&Mode{
+			Name:  "dis",
+			Usage: "path",
+			Run:   Dis,
+			Help:  "Prints the bytecode for a trap file, a trapasm file, or a directory of dub sources.",
+		},
+		&Mode{
+			Name: "help",
+			Run:  Help,
+			Help: "Displays this message.",
+		},
+	}
+
+	requested := "help"
+	args := []string{}
+	if len(os.Args) >= 2 {
+		requested = os.Args[1]
+		args = os.Args[2:]
+	}
+
+	mode := getMode(requested)
+	if mode == nil {
+		fmt.Printf("Unrecognized mode: %s\n\n", requested)
+		Help(nil)
+		os.Exit(1)
+	}
+	if !mode.Run(args) {
+		fmt.Printf("Usage: trap %s %s\n", mode.Name, mode.Usage)
+		os.Exit(1)
+	}
+}
@@ -136,7 +136,7 @@ func Test(ctx *Context) {
 		if p.Subdir != "" {
 			genout = filepath.Join(genout, p.Subdir)
 		}
-		ctx.SimpleCommand("go", "run", "src/evergreen/cmd/egc/main.go", "-indir="+filepath.Join(dubsrc, p.Name), "-outdir=src", "-gopackage="+genout, "-gentests")
+		ctx.SimpleCommand("go", "run", "src/evergreen/cmd/egc/main.go", "-indir="+filepath.Join(dubsrc, p.Name), "-outdir=src", "-gopackage="+genout, "-gentests", "-genvisitors", "-genjson")
 		if ctx.Errored {
 			return
 		}
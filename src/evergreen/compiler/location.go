@@ -48,9 +48,10 @@ type fileInfo struct {
 	Lines    []int
 }
 
+// The end of the file is included, since parsers report errors there.
 func (info *fileInfo) Contains(pos int) bool {
 	pos -= info.Offset
-	return pos >= 0 && pos < len(info.Stream)
+	return pos >= 0 && pos <= len(info.Stream)
 }
 
 func (info *fileInfo) GetLocationInfo(pos int) (string, int, int, string) {
@@ -70,7 +71,9 @@ func (p *simpleProvider) AddFile(filename string, stream []rune) int {
 		Stream:   stream,
 		Lines:    findLines(stream),
 	}
-	p.maxOffset += len(stream)
+	// Leave room for the end of the file, so it is not confused with the
+	// start of the next file.
+	p.maxOffset += len(stream) + 1
 	p.files = append(p.files, info)
 	return info.Offset
 }
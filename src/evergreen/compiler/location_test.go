@@ -36,3 +36,20 @@ func TestFileLinesEmpty(t *testing.T) {
 	checkLocation(stream, lines, 4, 3, 0, "", t)
 	checkLocation(stream, lines, 5, 3, 1, "", t)
 }
+
+func TestProviderEndOfFile(t *testing.T) {
+	p := MakeProvider()
+	a := p.AddFile("a", []rune("x\ny"))
+	b := p.AddFile("b", []rune("z"))
+
+	filename, line, col, text := p.GetLocationInfo(a + 3)
+	assert.StringEquals(t, filename, "a")
+	assert.IntEquals(t, line, 1)
+	assert.IntEquals(t, col, 1)
+	assert.StringEquals(t, text, "y")
+
+	filename, line, col, _ = p.GetLocationInfo(b)
+	assert.StringEquals(t, filename, "b")
+	assert.IntEquals(t, line, 0)
+	assert.IntEquals(t, col, 0)
+}
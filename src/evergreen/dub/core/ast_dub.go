@@ -65,6 +65,7 @@ type FieldType struct {
 
 type StructType struct {
 	Name       string
+	Exported   bool
 	Implements *StructType
 	Fields     []*FieldType
 	Scoped     bool
@@ -111,10 +112,12 @@ type Function_Scope struct {
 }
 
 type Function struct {
-	Name  string
-	Type  *FunctionType
-	File  *File
-	Index Function_Ref
+	Name     string
+	Exported bool
+	Inline   string
+	Type     *FunctionType
+	File     *File
+	Index    Function_Ref
 }
 
 func (node *Function) isCallable() {
@@ -138,7 +141,8 @@ type CallableTemplate interface {
 }
 
 type FunctionTemplate struct {
-	Name string
+	Name     string
+	Exported bool
 }
 
 func (node *FunctionTemplate) isCallableTemplate() {
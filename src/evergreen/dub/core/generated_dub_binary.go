@@ -0,0 +1,1246 @@
+package core
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func DecodeDubTypeBinary(d *runtime.BinaryDecoder) DubType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/BuiltinType" {
+		return readBuiltinTypeBinary(d, o)
+	}
+	if t == "dub/core/NilType" {
+		return readNilTypeBinary(d, o)
+	}
+	if t == "dub/core/ListType" {
+		return readListTypeBinary(d, o)
+	}
+	if t == "dub/core/TupleType" {
+		return readTupleTypeBinary(d, o)
+	}
+	if t == "dub/core/FunctionType" {
+		return readFunctionTypeBinary(d, o)
+	}
+	if t == "dub/core/UnboundType" {
+		return readUnboundTypeBinary(d, o)
+	}
+	if t == "dub/core/FunctionTemplateType" {
+		return readFunctionTemplateTypeBinary(d, o)
+	}
+	if t == "dub/core/PackageType" {
+		return readPackageTypeBinary(d, o)
+	}
+	if t == "dub/core/StructType" {
+		return readStructTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BuiltinType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/BuiltinType") {
+		return
+	}
+	e.WriteString(node.Name)
+}
+
+func (node *BuiltinType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+}
+
+func readBuiltinTypeBinary(d *runtime.BinaryDecoder, o interface{}) *BuiltinType {
+	var node *BuiltinType
+	if o != nil {
+		return o.(*BuiltinType)
+	}
+	node = &BuiltinType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BuiltinType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "bd3dce755902c74a")
+}
+
+func (node *BuiltinType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "bd3dce755902c74a", "dub/core/BuiltinType", node)
+}
+
+func DecodeBuiltinTypeBinary(d *runtime.BinaryDecoder) *BuiltinType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/BuiltinType" {
+		return readBuiltinTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NilType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/NilType") {
+		return
+	}
+}
+
+func (node *NilType) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readNilTypeBinary(d *runtime.BinaryDecoder, o interface{}) *NilType {
+	var node *NilType
+	if o != nil {
+		return o.(*NilType)
+	}
+	node = &NilType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NilType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "2eaa81067dbcb9ab")
+}
+
+func (node *NilType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "2eaa81067dbcb9ab", "dub/core/NilType", node)
+}
+
+func DecodeNilTypeBinary(d *runtime.BinaryDecoder) *NilType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/NilType" {
+		return readNilTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ListType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/ListType") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *ListType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = DecodeDubTypeBinary(d)
+}
+
+func readListTypeBinary(d *runtime.BinaryDecoder, o interface{}) *ListType {
+	var node *ListType
+	if o != nil {
+		return o.(*ListType)
+	}
+	node = &ListType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ListType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d3ff5e8f5beece17")
+}
+
+func (node *ListType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d3ff5e8f5beece17", "dub/core/ListType", node)
+}
+
+func DecodeListTypeBinary(d *runtime.BinaryDecoder) *ListType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/ListType" {
+		return readListTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TupleType) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x DubType
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/TupleType") {
+		return
+	}
+	if node.Types == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Types))
+		for _, x = range node.Types {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *TupleType) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []DubType
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []DubType{}
+		for range n {
+			s = append(s, DecodeDubTypeBinary(d))
+		}
+	}
+	node.Types = s
+}
+
+func readTupleTypeBinary(d *runtime.BinaryDecoder, o interface{}) *TupleType {
+	var node *TupleType
+	if o != nil {
+		return o.(*TupleType)
+	}
+	node = &TupleType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TupleType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d3ff5e8f5beece17")
+}
+
+func (node *TupleType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d3ff5e8f5beece17", "dub/core/TupleType", node)
+}
+
+func DecodeTupleTypeBinary(d *runtime.BinaryDecoder) *TupleType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/TupleType" {
+		return readTupleTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FunctionType) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x DubType
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/FunctionType") {
+		return
+	}
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x = range node.Params {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+	runtime.EncodeBinary(e, node.Result)
+}
+
+func (node *FunctionType) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []DubType
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []DubType{}
+		for range n {
+			s = append(s, DecodeDubTypeBinary(d))
+		}
+	}
+	node.Params = s
+	node.Result = DecodeDubTypeBinary(d)
+}
+
+func readFunctionTypeBinary(d *runtime.BinaryDecoder, o interface{}) *FunctionType {
+	var node *FunctionType
+	if o != nil {
+		return o.(*FunctionType)
+	}
+	node = &FunctionType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FunctionType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d3ff5e8f5beece17")
+}
+
+func (node *FunctionType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d3ff5e8f5beece17", "dub/core/FunctionType", node)
+}
+
+func DecodeFunctionTypeBinary(d *runtime.BinaryDecoder) *FunctionType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/FunctionType" {
+		return readFunctionTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *UnboundType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/UnboundType") {
+		return
+	}
+	e.WriteInt(node.Index)
+}
+
+func (node *UnboundType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = d.ReadInt()
+}
+
+func readUnboundTypeBinary(d *runtime.BinaryDecoder, o interface{}) *UnboundType {
+	var node *UnboundType
+	if o != nil {
+		return o.(*UnboundType)
+	}
+	node = &UnboundType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *UnboundType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "c582435c0f148343")
+}
+
+func (node *UnboundType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "c582435c0f148343", "dub/core/UnboundType", node)
+}
+
+func DecodeUnboundTypeBinary(d *runtime.BinaryDecoder) *UnboundType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/UnboundType" {
+		return readUnboundTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FunctionTemplateType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/FunctionTemplateType") {
+		return
+	}
+}
+
+func (node *FunctionTemplateType) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readFunctionTemplateTypeBinary(d *runtime.BinaryDecoder, o interface{}) *FunctionTemplateType {
+	var node *FunctionTemplateType
+	if o != nil {
+		return o.(*FunctionTemplateType)
+	}
+	node = &FunctionTemplateType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FunctionTemplateType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d24e672113942162")
+}
+
+func (node *FunctionTemplateType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d24e672113942162", "dub/core/FunctionTemplateType", node)
+}
+
+func DecodeFunctionTemplateTypeBinary(d *runtime.BinaryDecoder) *FunctionTemplateType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/FunctionTemplateType" {
+		return readFunctionTemplateTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *PackageType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/PackageType") {
+		return
+	}
+}
+
+func (node *PackageType) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readPackageTypeBinary(d *runtime.BinaryDecoder, o interface{}) *PackageType {
+	var node *PackageType
+	if o != nil {
+		return o.(*PackageType)
+	}
+	node = &PackageType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *PackageType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6ed8ede54722dc12")
+}
+
+func (node *PackageType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6ed8ede54722dc12", "dub/core/PackageType", node)
+}
+
+func DecodePackageTypeBinary(d *runtime.BinaryDecoder) *PackageType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/PackageType" {
+		return readPackageTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FieldType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/FieldType") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *FieldType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeDubTypeBinary(d)
+}
+
+func readFieldTypeBinary(d *runtime.BinaryDecoder, o interface{}) *FieldType {
+	var node *FieldType
+	if o != nil {
+		return o.(*FieldType)
+	}
+	node = &FieldType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FieldType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d3ff5e8f5beece17")
+}
+
+func (node *FieldType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d3ff5e8f5beece17", "dub/core/FieldType", node)
+}
+
+func DecodeFieldTypeBinary(d *runtime.BinaryDecoder) *FieldType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/FieldType" {
+		return readFieldTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StructType) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *FieldType
+	var x1 *StructType
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/StructType") {
+		return
+	}
+	e.WriteString(node.Name)
+	e.WriteBool(node.Exported)
+	node.Implements.EncodeBinary(e)
+	if node.Fields == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Fields))
+		for _, x0 = range node.Fields {
+			x0.EncodeBinary(e)
+		}
+	}
+	e.WriteBool(node.Scoped)
+	if node.Contains == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Contains))
+		for _, x1 = range node.Contains {
+			x1.EncodeBinary(e)
+		}
+	}
+	e.WriteBool(node.IsParent)
+	node.File.EncodeBinary(e)
+}
+
+func (node *StructType) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*FieldType
+	var n1 int
+	var s1 []*StructType
+	node.Name = d.ReadString()
+	node.Exported = d.ReadBool()
+	node.Implements = DecodeStructTypeBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*FieldType{}
+		for range n0 {
+			s0 = append(s0, DecodeFieldTypeBinary(d))
+		}
+	}
+	node.Fields = s0
+	node.Scoped = d.ReadBool()
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*StructType{}
+		for range n1 {
+			s1 = append(s1, DecodeStructTypeBinary(d))
+		}
+	}
+	node.Contains = s1
+	node.IsParent = d.ReadBool()
+	node.File = DecodeFileBinary(d)
+}
+
+func readStructTypeBinary(d *runtime.BinaryDecoder, o interface{}) *StructType {
+	var node *StructType
+	if o != nil {
+		return o.(*StructType)
+	}
+	node = &StructType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StructType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d3ff5e8f5beece17")
+}
+
+func (node *StructType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d3ff5e8f5beece17", "dub/core/StructType", node)
+}
+
+func DecodeStructTypeBinary(d *runtime.BinaryDecoder) *StructType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/StructType" {
+		return readStructTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Package) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 string
+	var x1 *File
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/Package") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	if node.Path == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Path))
+		for _, x0 = range node.Path {
+			e.WriteString(x0)
+		}
+	}
+	if node.Files == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Files))
+		for _, x1 = range node.Files {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *Package) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []string
+	var n1 int
+	var s1 []*File
+	node.Index = Package_Ref(d.ReadUint32())
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []string{}
+		for range n0 {
+			s0 = append(s0, d.ReadString())
+		}
+	}
+	node.Path = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*File{}
+		for range n1 {
+			s1 = append(s1, DecodeFileBinary(d))
+		}
+	}
+	node.Files = s1
+}
+
+func readPackageBinary(d *runtime.BinaryDecoder, o interface{}) *Package {
+	var node *Package
+	if o != nil {
+		return o.(*Package)
+	}
+	node = &Package{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Package) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6f3f345541e85044")
+}
+
+func (node *Package) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6f3f345541e85044", "dub/core/Package", node)
+}
+
+func DecodePackageBinary(d *runtime.BinaryDecoder) *Package {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/Package" {
+		return readPackageBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *File) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/File") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	node.Package.EncodeBinary(e)
+}
+
+func (node *File) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = File_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.Package = DecodePackageBinary(d)
+}
+
+func readFileBinary(d *runtime.BinaryDecoder, o interface{}) *File {
+	var node *File
+	if o != nil {
+		return o.(*File)
+	}
+	node = &File{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *File) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6f3f345541e85044")
+}
+
+func (node *File) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6f3f345541e85044", "dub/core/File", node)
+}
+
+func DecodeFileBinary(d *runtime.BinaryDecoder) *File {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/File" {
+		return readFileBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeCallableBinary(d *runtime.BinaryDecoder) Callable {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/Function" {
+		return readFunctionBinary(d, o)
+	}
+	if t == "dub/core/IntrinsicFunction" {
+		return readIntrinsicFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Function) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/Function") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	e.WriteBool(node.Exported)
+	e.WriteString(node.Inline)
+	node.Type.EncodeBinary(e)
+	node.File.EncodeBinary(e)
+}
+
+func (node *Function) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = Function_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.Exported = d.ReadBool()
+	node.Inline = d.ReadString()
+	node.Type = DecodeFunctionTypeBinary(d)
+	node.File = DecodeFileBinary(d)
+}
+
+func readFunctionBinary(d *runtime.BinaryDecoder, o interface{}) *Function {
+	var node *Function
+	if o != nil {
+		return o.(*Function)
+	}
+	node = &Function{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Function) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "aa31e85020766c65")
+}
+
+func (node *Function) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "aa31e85020766c65", "dub/core/Function", node)
+}
+
+func DecodeFunctionBinary(d *runtime.BinaryDecoder) *Function {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/Function" {
+		return readFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *IntrinsicFunction) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/IntrinsicFunction") {
+		return
+	}
+	e.WriteString(node.Name)
+	node.Parent.EncodeBinary(e)
+	node.Type.EncodeBinary(e)
+}
+
+func (node *IntrinsicFunction) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Parent = DecodeIntrinsicFunctionTemplateBinary(d)
+	node.Type = DecodeFunctionTypeBinary(d)
+}
+
+func readIntrinsicFunctionBinary(d *runtime.BinaryDecoder, o interface{}) *IntrinsicFunction {
+	var node *IntrinsicFunction
+	if o != nil {
+		return o.(*IntrinsicFunction)
+	}
+	node = &IntrinsicFunction{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *IntrinsicFunction) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "038fa9c881ea616d")
+}
+
+func (node *IntrinsicFunction) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "038fa9c881ea616d", "dub/core/IntrinsicFunction", node)
+}
+
+func DecodeIntrinsicFunctionBinary(d *runtime.BinaryDecoder) *IntrinsicFunction {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/IntrinsicFunction" {
+		return readIntrinsicFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TemplateParam) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/TemplateParam") {
+		return
+	}
+	e.WriteString(node.Name)
+}
+
+func (node *TemplateParam) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+}
+
+func readTemplateParamBinary(d *runtime.BinaryDecoder, o interface{}) *TemplateParam {
+	var node *TemplateParam
+	if o != nil {
+		return o.(*TemplateParam)
+	}
+	node = &TemplateParam{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TemplateParam) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8c835c52a47f0ff3")
+}
+
+func (node *TemplateParam) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8c835c52a47f0ff3", "dub/core/TemplateParam", node)
+}
+
+func DecodeTemplateParamBinary(d *runtime.BinaryDecoder) *TemplateParam {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/TemplateParam" {
+		return readTemplateParamBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeCallableTemplateBinary(d *runtime.BinaryDecoder) CallableTemplate {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/FunctionTemplate" {
+		return readFunctionTemplateBinary(d, o)
+	}
+	if t == "dub/core/IntrinsicFunctionTemplate" {
+		return readIntrinsicFunctionTemplateBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FunctionTemplate) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/FunctionTemplate") {
+		return
+	}
+	e.WriteString(node.Name)
+	e.WriteBool(node.Exported)
+}
+
+func (node *FunctionTemplate) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Exported = d.ReadBool()
+}
+
+func readFunctionTemplateBinary(d *runtime.BinaryDecoder, o interface{}) *FunctionTemplate {
+	var node *FunctionTemplate
+	if o != nil {
+		return o.(*FunctionTemplate)
+	}
+	node = &FunctionTemplate{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FunctionTemplate) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "c24e70d055c120aa")
+}
+
+func (node *FunctionTemplate) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "c24e70d055c120aa", "dub/core/FunctionTemplate", node)
+}
+
+func DecodeFunctionTemplateBinary(d *runtime.BinaryDecoder) *FunctionTemplate {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/FunctionTemplate" {
+		return readFunctionTemplateBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *IntrinsicFunctionTemplate) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *TemplateParam
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/IntrinsicFunctionTemplate") {
+		return
+	}
+	e.WriteString(node.Name)
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x = range node.Params {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Type.EncodeBinary(e)
+}
+
+func (node *IntrinsicFunctionTemplate) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*TemplateParam
+	node.Name = d.ReadString()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*TemplateParam{}
+		for range n {
+			s = append(s, DecodeTemplateParamBinary(d))
+		}
+	}
+	node.Params = s
+	node.Type = DecodeFunctionTypeBinary(d)
+}
+
+func readIntrinsicFunctionTemplateBinary(d *runtime.BinaryDecoder, o interface{}) *IntrinsicFunctionTemplate {
+	var node *IntrinsicFunctionTemplate
+	if o != nil {
+		return o.(*IntrinsicFunctionTemplate)
+	}
+	node = &IntrinsicFunctionTemplate{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *IntrinsicFunctionTemplate) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ebfd087edd84245c")
+}
+
+func (node *IntrinsicFunctionTemplate) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ebfd087edd84245c", "dub/core/IntrinsicFunctionTemplate", node)
+}
+
+func DecodeIntrinsicFunctionTemplateBinary(d *runtime.BinaryDecoder) *IntrinsicFunctionTemplate {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/IntrinsicFunctionTemplate" {
+		return readIntrinsicFunctionTemplateBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BuiltinTypeIndex) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/BuiltinTypeIndex") {
+		return
+	}
+	node.String.EncodeBinary(e)
+	node.Rune.EncodeBinary(e)
+	node.Int.EncodeBinary(e)
+	node.Int64.EncodeBinary(e)
+	node.Float32.EncodeBinary(e)
+	node.Bool.EncodeBinary(e)
+	node.Graph.EncodeBinary(e)
+	node.Nil.EncodeBinary(e)
+	node.Append.EncodeBinary(e)
+	node.Position.EncodeBinary(e)
+	node.Slice.EncodeBinary(e)
+}
+
+func (node *BuiltinTypeIndex) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.String = DecodeBuiltinTypeBinary(d)
+	node.Rune = DecodeBuiltinTypeBinary(d)
+	node.Int = DecodeBuiltinTypeBinary(d)
+	node.Int64 = DecodeBuiltinTypeBinary(d)
+	node.Float32 = DecodeBuiltinTypeBinary(d)
+	node.Bool = DecodeBuiltinTypeBinary(d)
+	node.Graph = DecodeBuiltinTypeBinary(d)
+	node.Nil = DecodeNilTypeBinary(d)
+	node.Append = DecodeIntrinsicFunctionTemplateBinary(d)
+	node.Position = DecodeIntrinsicFunctionBinary(d)
+	node.Slice = DecodeIntrinsicFunctionBinary(d)
+}
+
+func readBuiltinTypeIndexBinary(d *runtime.BinaryDecoder, o interface{}) *BuiltinTypeIndex {
+	var node *BuiltinTypeIndex
+	if o != nil {
+		return o.(*BuiltinTypeIndex)
+	}
+	node = &BuiltinTypeIndex{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BuiltinTypeIndex) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9cf6b392b655108c")
+}
+
+func (node *BuiltinTypeIndex) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9cf6b392b655108c", "dub/core/BuiltinTypeIndex", node)
+}
+
+func DecodeBuiltinTypeIndexBinary(d *runtime.BinaryDecoder) *BuiltinTypeIndex {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/BuiltinTypeIndex" {
+		return readBuiltinTypeIndexBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *CoreProgram) EncodeBinary(e *runtime.BinaryEncoder) {
+	var o0 *Package
+	var o1 *File
+	var o2 *Function
+	var x *StructType
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/core/CoreProgram") {
+		return
+	}
+	if node.Package_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Package_Scope.objects))
+		for _, o0 = range node.Package_Scope.objects {
+			o0.EncodeBinary(e)
+		}
+	}
+	if node.File_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.File_Scope.objects))
+		for _, o1 = range node.File_Scope.objects {
+			o1.EncodeBinary(e)
+		}
+	}
+	if node.Function_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Function_Scope.objects))
+		for _, o2 = range node.Function_Scope.objects {
+			o2.EncodeBinary(e)
+		}
+	}
+	node.Builtins.EncodeBinary(e)
+	if node.Structures == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Structures))
+		for _, x = range node.Structures {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *CoreProgram) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var n1 int
+	var n2 int
+	var n3 int
+	var s []*StructType
+	n0 = d.ReadLength()
+	if n0 >= 0 {
+		node.Package_Scope = &Package_Scope{}
+		for range n0 {
+			node.Package_Scope.objects = append(node.Package_Scope.objects, DecodePackageBinary(d))
+		}
+	}
+	n1 = d.ReadLength()
+	if n1 >= 0 {
+		node.File_Scope = &File_Scope{}
+		for range n1 {
+			node.File_Scope.objects = append(node.File_Scope.objects, DecodeFileBinary(d))
+		}
+	}
+	n2 = d.ReadLength()
+	if n2 >= 0 {
+		node.Function_Scope = &Function_Scope{}
+		for range n2 {
+			node.Function_Scope.objects = append(node.Function_Scope.objects, DecodeFunctionBinary(d))
+		}
+	}
+	node.Builtins = DecodeBuiltinTypeIndexBinary(d)
+	n3 = d.ReadLength()
+	s = nil
+	if n3 >= 0 {
+		s = []*StructType{}
+		for range n3 {
+			s = append(s, DecodeStructTypeBinary(d))
+		}
+	}
+	node.Structures = s
+}
+
+func readCoreProgramBinary(d *runtime.BinaryDecoder, o interface{}) *CoreProgram {
+	var node *CoreProgram
+	if o != nil {
+		return o.(*CoreProgram)
+	}
+	node = &CoreProgram{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *CoreProgram) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "68c9f7c6483a2ac8")
+}
+
+func (node *CoreProgram) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "68c9f7c6483a2ac8", "dub/core/CoreProgram", node)
+}
+
+func DecodeCoreProgramBinary(d *runtime.BinaryDecoder) *CoreProgram {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/core/CoreProgram" {
+		return readCoreProgramBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
@@ -0,0 +1,1496 @@
+package core
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func cloneDubType(c *runtime.Cloner, node DubType) DubType {
+	switch node.(type) {
+	case *BuiltinType:
+		return node.(*BuiltinType).clone(c)
+	case *NilType:
+		return node.(*NilType).clone(c)
+	case *ListType:
+		return node.(*ListType).clone(c)
+	case *TupleType:
+		return node.(*TupleType).clone(c)
+	case *FunctionType:
+		return node.(*FunctionType).clone(c)
+	case *UnboundType:
+		return node.(*UnboundType).clone(c)
+	case *FunctionTemplateType:
+		return node.(*FunctionTemplateType).clone(c)
+	case *PackageType:
+		return node.(*PackageType).clone(c)
+	case *StructType:
+		return node.(*StructType).clone(c)
+	}
+	return node
+}
+
+func equalDubType(c *runtime.Comparer, a DubType, b DubType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *BuiltinType:
+		switch b.(type) {
+		case *BuiltinType:
+			return a.(*BuiltinType).equal(c, b.(*BuiltinType))
+		}
+	case *NilType:
+		switch b.(type) {
+		case *NilType:
+			return a.(*NilType).equal(c, b.(*NilType))
+		}
+	case *ListType:
+		switch b.(type) {
+		case *ListType:
+			return a.(*ListType).equal(c, b.(*ListType))
+		}
+	case *TupleType:
+		switch b.(type) {
+		case *TupleType:
+			return a.(*TupleType).equal(c, b.(*TupleType))
+		}
+	case *FunctionType:
+		switch b.(type) {
+		case *FunctionType:
+			return a.(*FunctionType).equal(c, b.(*FunctionType))
+		}
+	case *UnboundType:
+		switch b.(type) {
+		case *UnboundType:
+			return a.(*UnboundType).equal(c, b.(*UnboundType))
+		}
+	case *FunctionTemplateType:
+		switch b.(type) {
+		case *FunctionTemplateType:
+			return a.(*FunctionTemplateType).equal(c, b.(*FunctionTemplateType))
+		}
+	case *PackageType:
+		switch b.(type) {
+		case *PackageType:
+			return a.(*PackageType).equal(c, b.(*PackageType))
+		}
+	case *StructType:
+		switch b.(type) {
+		case *StructType:
+			return a.(*StructType).equal(c, b.(*StructType))
+		}
+	}
+	return a == b
+}
+
+func (node *BuiltinType) Clone() *BuiltinType {
+	var c *runtime.Cloner
+	var clone *BuiltinType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BuiltinType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BuiltinType) clone(c *runtime.Cloner) *BuiltinType {
+	var o interface{}
+	var clone *BuiltinType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BuiltinType)
+	}
+	clone = &BuiltinType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BuiltinType) cloneFields(c *runtime.Cloner, clone *BuiltinType) {
+	clone.Name = node.Name
+}
+
+func (node *BuiltinType) Equal(other *BuiltinType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BuiltinType) equal(c *runtime.Comparer, other *BuiltinType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+func (node *NilType) Clone() *NilType {
+	var c *runtime.Cloner
+	var clone *NilType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NilType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NilType) clone(c *runtime.Cloner) *NilType {
+	var o interface{}
+	var clone *NilType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NilType)
+	}
+	clone = &NilType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NilType) cloneFields(c *runtime.Cloner, clone *NilType) {
+}
+
+func (node *NilType) Equal(other *NilType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NilType) equal(c *runtime.Comparer, other *NilType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *ListType) Clone() *ListType {
+	var c *runtime.Cloner
+	var clone *ListType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ListType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListType) clone(c *runtime.Cloner) *ListType {
+	var o interface{}
+	var clone *ListType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ListType)
+	}
+	clone = &ListType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListType) cloneFields(c *runtime.Cloner, clone *ListType) {
+	clone.Type = cloneDubType(c, node.Type)
+}
+
+func (node *ListType) Equal(other *ListType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ListType) equal(c *runtime.Comparer, other *ListType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalDubType(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *TupleType) Clone() *TupleType {
+	var c *runtime.Cloner
+	var clone *TupleType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TupleType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TupleType) clone(c *runtime.Cloner) *TupleType {
+	var o interface{}
+	var clone *TupleType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TupleType)
+	}
+	clone = &TupleType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TupleType) cloneFields(c *runtime.Cloner, clone *TupleType) {
+	var s []DubType
+	var e DubType
+	s = nil
+	if node.Types != nil {
+		s = []DubType{}
+		for _, e = range node.Types {
+			s = append(s, cloneDubType(c, e))
+		}
+	}
+	clone.Types = s
+}
+
+func (node *TupleType) Equal(other *TupleType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TupleType) equal(c *runtime.Comparer, other *TupleType) bool {
+	var i int
+	var e DubType
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Types) != len(other.Types) || node.Types == nil != (other.Types == nil) {
+		return false
+	}
+	for i, e = range node.Types {
+		if !equalDubType(c, e, other.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *FunctionType) Clone() *FunctionType {
+	var c *runtime.Cloner
+	var clone *FunctionType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FunctionType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FunctionType) clone(c *runtime.Cloner) *FunctionType {
+	var o interface{}
+	var clone *FunctionType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FunctionType)
+	}
+	clone = &FunctionType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FunctionType) cloneFields(c *runtime.Cloner, clone *FunctionType) {
+	var s []DubType
+	var e DubType
+	s = nil
+	if node.Params != nil {
+		s = []DubType{}
+		for _, e = range node.Params {
+			s = append(s, cloneDubType(c, e))
+		}
+	}
+	clone.Params = s
+	clone.Result = cloneDubType(c, node.Result)
+}
+
+func (node *FunctionType) Equal(other *FunctionType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FunctionType) equal(c *runtime.Comparer, other *FunctionType) bool {
+	var i int
+	var e DubType
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i, e = range node.Params {
+		if !equalDubType(c, e, other.Params[i]) {
+			return false
+		}
+	}
+	if !equalDubType(c, node.Result, other.Result) {
+		return false
+	}
+	return true
+}
+
+func (node *UnboundType) Clone() *UnboundType {
+	var c *runtime.Cloner
+	var clone *UnboundType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &UnboundType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *UnboundType) clone(c *runtime.Cloner) *UnboundType {
+	var o interface{}
+	var clone *UnboundType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*UnboundType)
+	}
+	clone = &UnboundType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *UnboundType) cloneFields(c *runtime.Cloner, clone *UnboundType) {
+	clone.Index = node.Index
+}
+
+func (node *UnboundType) Equal(other *UnboundType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *UnboundType) equal(c *runtime.Comparer, other *UnboundType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+func (node *FunctionTemplateType) Clone() *FunctionTemplateType {
+	var c *runtime.Cloner
+	var clone *FunctionTemplateType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FunctionTemplateType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FunctionTemplateType) clone(c *runtime.Cloner) *FunctionTemplateType {
+	var o interface{}
+	var clone *FunctionTemplateType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FunctionTemplateType)
+	}
+	clone = &FunctionTemplateType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FunctionTemplateType) cloneFields(c *runtime.Cloner, clone *FunctionTemplateType) {
+}
+
+func (node *FunctionTemplateType) Equal(other *FunctionTemplateType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FunctionTemplateType) equal(c *runtime.Comparer, other *FunctionTemplateType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *PackageType) Clone() *PackageType {
+	var c *runtime.Cloner
+	var clone *PackageType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &PackageType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PackageType) clone(c *runtime.Cloner) *PackageType {
+	var o interface{}
+	var clone *PackageType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*PackageType)
+	}
+	clone = &PackageType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PackageType) cloneFields(c *runtime.Cloner, clone *PackageType) {
+}
+
+func (node *PackageType) Equal(other *PackageType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *PackageType) equal(c *runtime.Comparer, other *PackageType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *FieldType) Clone() *FieldType {
+	var c *runtime.Cloner
+	var clone *FieldType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FieldType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldType) clone(c *runtime.Cloner) *FieldType {
+	var o interface{}
+	var clone *FieldType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FieldType)
+	}
+	clone = &FieldType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldType) cloneFields(c *runtime.Cloner, clone *FieldType) {
+	clone.Name = node.Name
+	clone.Type = cloneDubType(c, node.Type)
+}
+
+func (node *FieldType) Equal(other *FieldType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FieldType) equal(c *runtime.Comparer, other *FieldType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalDubType(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *StructType) Clone() *StructType {
+	var c *runtime.Cloner
+	var clone *StructType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StructType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructType) clone(c *runtime.Cloner) *StructType {
+	var o interface{}
+	var clone *StructType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StructType)
+	}
+	clone = &StructType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructType) cloneFields(c *runtime.Cloner, clone *StructType) {
+	var s0 []*FieldType
+	var e0 *FieldType
+	var s1 []*StructType
+	var e1 *StructType
+	clone.Name = node.Name
+	clone.Exported = node.Exported
+	clone.Implements = node.Implements.clone(c)
+	s0 = nil
+	if node.Fields != nil {
+		s0 = []*FieldType{}
+		for _, e0 = range node.Fields {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Fields = s0
+	clone.Scoped = node.Scoped
+	s1 = nil
+	if node.Contains != nil {
+		s1 = []*StructType{}
+		for _, e1 = range node.Contains {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Contains = s1
+	clone.IsParent = node.IsParent
+	clone.File = node.File.clone(c)
+}
+
+func (node *StructType) Equal(other *StructType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StructType) equal(c *runtime.Comparer, other *StructType) bool {
+	var i0 int
+	var e0 *FieldType
+	var i1 int
+	var e1 *StructType
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.Exported != other.Exported {
+		return false
+	}
+	if !node.Implements.equal(c, other.Implements) {
+		return false
+	}
+	if len(node.Fields) != len(other.Fields) || node.Fields == nil != (other.Fields == nil) {
+		return false
+	}
+	for i0, e0 = range node.Fields {
+		if !e0.equal(c, other.Fields[i0]) {
+			return false
+		}
+	}
+	if node.Scoped != other.Scoped {
+		return false
+	}
+	if len(node.Contains) != len(other.Contains) || node.Contains == nil != (other.Contains == nil) {
+		return false
+	}
+	for i1, e1 = range node.Contains {
+		if !e1.equal(c, other.Contains[i1]) {
+			return false
+		}
+	}
+	if node.IsParent != other.IsParent {
+		return false
+	}
+	if !node.File.equalRef(other.File) {
+		return false
+	}
+	return true
+}
+
+func (node *Package) Clone() *Package {
+	var c *runtime.Cloner
+	var clone *Package
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Package{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Package) clone(c *runtime.Cloner) *Package {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Package)
+	}
+	return node
+}
+
+func (node *Package) cloneFields(c *runtime.Cloner, clone *Package) {
+	var s0 []string
+	var e0 string
+	var s1 []*File
+	var e1 *File
+	clone.Index = node.Index
+	s0 = nil
+	if node.Path != nil {
+		s0 = []string{}
+		for _, e0 = range node.Path {
+			s0 = append(s0, e0)
+		}
+	}
+	clone.Path = s0
+	s1 = nil
+	if node.Files != nil {
+		s1 = []*File{}
+		for _, e1 = range node.Files {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Files = s1
+}
+
+func (node *Package) Equal(other *Package) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Package) equal(c *runtime.Comparer, other *Package) bool {
+	var i0 int
+	var e0 string
+	var i1 int
+	var e1 *File
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if len(node.Path) != len(other.Path) || node.Path == nil != (other.Path == nil) {
+		return false
+	}
+	for i0, e0 = range node.Path {
+		if e0 != other.Path[i0] {
+			return false
+		}
+	}
+	if len(node.Files) != len(other.Files) || node.Files == nil != (other.Files == nil) {
+		return false
+	}
+	for i1, e1 = range node.Files {
+		if !e1.equalRef(other.Files[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Package) equalRef(other *Package) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *File) Clone() *File {
+	var c *runtime.Cloner
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) clone(c *runtime.Cloner) *File {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*File)
+	}
+	return node
+}
+
+func (node *File) cloneFields(c *runtime.Cloner, clone *File) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.Package = node.Package.clone(c)
+}
+
+func (node *File) Equal(other *File) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *File) equal(c *runtime.Comparer, other *File) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Package.equalRef(other.Package) {
+		return false
+	}
+	return true
+}
+
+func (node *File) equalRef(other *File) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func cloneCallable(c *runtime.Cloner, node Callable) Callable {
+	switch node.(type) {
+	case *Function:
+		return node.(*Function).clone(c)
+	case *IntrinsicFunction:
+		return node.(*IntrinsicFunction).clone(c)
+	}
+	return node
+}
+
+func equalCallable(c *runtime.Comparer, a Callable, b Callable) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *Function:
+		switch b.(type) {
+		case *Function:
+			return a.(*Function).equalRef(b.(*Function))
+		}
+	case *IntrinsicFunction:
+		switch b.(type) {
+		case *IntrinsicFunction:
+			return a.(*IntrinsicFunction).equal(c, b.(*IntrinsicFunction))
+		}
+	}
+	return a == b
+}
+
+func (node *Function) Clone() *Function {
+	var c *runtime.Cloner
+	var clone *Function
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Function{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Function) clone(c *runtime.Cloner) *Function {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Function)
+	}
+	return node
+}
+
+func (node *Function) cloneFields(c *runtime.Cloner, clone *Function) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.Exported = node.Exported
+	clone.Inline = node.Inline
+	clone.Type = node.Type.clone(c)
+	clone.File = node.File.clone(c)
+}
+
+func (node *Function) Equal(other *Function) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Function) equal(c *runtime.Comparer, other *Function) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.Exported != other.Exported {
+		return false
+	}
+	if node.Inline != other.Inline {
+		return false
+	}
+	if !node.Type.equal(c, other.Type) {
+		return false
+	}
+	if !node.File.equalRef(other.File) {
+		return false
+	}
+	return true
+}
+
+func (node *Function) equalRef(other *Function) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *IntrinsicFunction) Clone() *IntrinsicFunction {
+	var c *runtime.Cloner
+	var clone *IntrinsicFunction
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &IntrinsicFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntrinsicFunction) clone(c *runtime.Cloner) *IntrinsicFunction {
+	var o interface{}
+	var clone *IntrinsicFunction
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*IntrinsicFunction)
+	}
+	clone = &IntrinsicFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntrinsicFunction) cloneFields(c *runtime.Cloner, clone *IntrinsicFunction) {
+	clone.Name = node.Name
+	clone.Parent = node.Parent.clone(c)
+	clone.Type = node.Type.clone(c)
+}
+
+func (node *IntrinsicFunction) Equal(other *IntrinsicFunction) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *IntrinsicFunction) equal(c *runtime.Comparer, other *IntrinsicFunction) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Parent.equal(c, other.Parent) {
+		return false
+	}
+	if !node.Type.equal(c, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *TemplateParam) Clone() *TemplateParam {
+	var c *runtime.Cloner
+	var clone *TemplateParam
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TemplateParam{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TemplateParam) clone(c *runtime.Cloner) *TemplateParam {
+	var o interface{}
+	var clone *TemplateParam
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TemplateParam)
+	}
+	clone = &TemplateParam{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TemplateParam) cloneFields(c *runtime.Cloner, clone *TemplateParam) {
+	clone.Name = node.Name
+}
+
+func (node *TemplateParam) Equal(other *TemplateParam) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TemplateParam) equal(c *runtime.Comparer, other *TemplateParam) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+func cloneCallableTemplate(c *runtime.Cloner, node CallableTemplate) CallableTemplate {
+	switch node.(type) {
+	case *FunctionTemplate:
+		return node.(*FunctionTemplate).clone(c)
+	case *IntrinsicFunctionTemplate:
+		return node.(*IntrinsicFunctionTemplate).clone(c)
+	}
+	return node
+}
+
+func equalCallableTemplate(c *runtime.Comparer, a CallableTemplate, b CallableTemplate) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *FunctionTemplate:
+		switch b.(type) {
+		case *FunctionTemplate:
+			return a.(*FunctionTemplate).equal(c, b.(*FunctionTemplate))
+		}
+	case *IntrinsicFunctionTemplate:
+		switch b.(type) {
+		case *IntrinsicFunctionTemplate:
+			return a.(*IntrinsicFunctionTemplate).equal(c, b.(*IntrinsicFunctionTemplate))
+		}
+	}
+	return a == b
+}
+
+func (node *FunctionTemplate) Clone() *FunctionTemplate {
+	var c *runtime.Cloner
+	var clone *FunctionTemplate
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FunctionTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FunctionTemplate) clone(c *runtime.Cloner) *FunctionTemplate {
+	var o interface{}
+	var clone *FunctionTemplate
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FunctionTemplate)
+	}
+	clone = &FunctionTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FunctionTemplate) cloneFields(c *runtime.Cloner, clone *FunctionTemplate) {
+	clone.Name = node.Name
+	clone.Exported = node.Exported
+}
+
+func (node *FunctionTemplate) Equal(other *FunctionTemplate) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FunctionTemplate) equal(c *runtime.Comparer, other *FunctionTemplate) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.Exported != other.Exported {
+		return false
+	}
+	return true
+}
+
+func (node *IntrinsicFunctionTemplate) Clone() *IntrinsicFunctionTemplate {
+	var c *runtime.Cloner
+	var clone *IntrinsicFunctionTemplate
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &IntrinsicFunctionTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntrinsicFunctionTemplate) clone(c *runtime.Cloner) *IntrinsicFunctionTemplate {
+	var o interface{}
+	var clone *IntrinsicFunctionTemplate
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*IntrinsicFunctionTemplate)
+	}
+	clone = &IntrinsicFunctionTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntrinsicFunctionTemplate) cloneFields(c *runtime.Cloner, clone *IntrinsicFunctionTemplate) {
+	var s []*TemplateParam
+	var e *TemplateParam
+	clone.Name = node.Name
+	s = nil
+	if node.Params != nil {
+		s = []*TemplateParam{}
+		for _, e = range node.Params {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Params = s
+	clone.Type = node.Type.clone(c)
+}
+
+func (node *IntrinsicFunctionTemplate) Equal(other *IntrinsicFunctionTemplate) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *IntrinsicFunctionTemplate) equal(c *runtime.Comparer, other *IntrinsicFunctionTemplate) bool {
+	var i int
+	var e *TemplateParam
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i, e = range node.Params {
+		if !e.equal(c, other.Params[i]) {
+			return false
+		}
+	}
+	if !node.Type.equal(c, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *BuiltinTypeIndex) Clone() *BuiltinTypeIndex {
+	var c *runtime.Cloner
+	var clone *BuiltinTypeIndex
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BuiltinTypeIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BuiltinTypeIndex) clone(c *runtime.Cloner) *BuiltinTypeIndex {
+	var o interface{}
+	var clone *BuiltinTypeIndex
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BuiltinTypeIndex)
+	}
+	clone = &BuiltinTypeIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BuiltinTypeIndex) cloneFields(c *runtime.Cloner, clone *BuiltinTypeIndex) {
+	clone.String = node.String.clone(c)
+	clone.Rune = node.Rune.clone(c)
+	clone.Int = node.Int.clone(c)
+	clone.Int64 = node.Int64.clone(c)
+	clone.Float32 = node.Float32.clone(c)
+	clone.Bool = node.Bool.clone(c)
+	clone.Graph = node.Graph.clone(c)
+	clone.Nil = node.Nil.clone(c)
+	clone.Append = node.Append.clone(c)
+	clone.Position = node.Position.clone(c)
+	clone.Slice = node.Slice.clone(c)
+}
+
+func (node *BuiltinTypeIndex) Equal(other *BuiltinTypeIndex) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BuiltinTypeIndex) equal(c *runtime.Comparer, other *BuiltinTypeIndex) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.String.equal(c, other.String) {
+		return false
+	}
+	if !node.Rune.equal(c, other.Rune) {
+		return false
+	}
+	if !node.Int.equal(c, other.Int) {
+		return false
+	}
+	if !node.Int64.equal(c, other.Int64) {
+		return false
+	}
+	if !node.Float32.equal(c, other.Float32) {
+		return false
+	}
+	if !node.Bool.equal(c, other.Bool) {
+		return false
+	}
+	if !node.Graph.equal(c, other.Graph) {
+		return false
+	}
+	if !node.Nil.equal(c, other.Nil) {
+		return false
+	}
+	if !node.Append.equal(c, other.Append) {
+		return false
+	}
+	if !node.Position.equal(c, other.Position) {
+		return false
+	}
+	if !node.Slice.equal(c, other.Slice) {
+		return false
+	}
+	return true
+}
+
+func (node *CoreProgram) Clone() *CoreProgram {
+	var c *runtime.Cloner
+	var clone *CoreProgram
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &CoreProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CoreProgram) clone(c *runtime.Cloner) *CoreProgram {
+	var o interface{}
+	var clone *CoreProgram
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*CoreProgram)
+	}
+	clone = &CoreProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CoreProgram) cloneFields(c *runtime.Cloner, clone *CoreProgram) {
+	var o0 *Package
+	var x0 *Package
+	var i0 int
+	var o1 *File
+	var x1 *File
+	var i1 int
+	var o2 *Function
+	var x2 *Function
+	var i2 int
+	var s []*StructType
+	var e *StructType
+	if node.Package_Scope != nil {
+		clone.Package_Scope = &Package_Scope{}
+		for _, o0 = range node.Package_Scope.objects {
+			x0 = &Package{}
+			c.Map(o0, x0)
+			clone.Package_Scope.objects = append(clone.Package_Scope.objects, x0)
+		}
+		for i0, o0 = range node.Package_Scope.objects {
+			o0.cloneFields(c, clone.Package_Scope.objects[i0])
+		}
+	}
+	if node.File_Scope != nil {
+		clone.File_Scope = &File_Scope{}
+		for _, o1 = range node.File_Scope.objects {
+			x1 = &File{}
+			c.Map(o1, x1)
+			clone.File_Scope.objects = append(clone.File_Scope.objects, x1)
+		}
+		for i1, o1 = range node.File_Scope.objects {
+			o1.cloneFields(c, clone.File_Scope.objects[i1])
+		}
+	}
+	if node.Function_Scope != nil {
+		clone.Function_Scope = &Function_Scope{}
+		for _, o2 = range node.Function_Scope.objects {
+			x2 = &Function{}
+			c.Map(o2, x2)
+			clone.Function_Scope.objects = append(clone.Function_Scope.objects, x2)
+		}
+		for i2, o2 = range node.Function_Scope.objects {
+			o2.cloneFields(c, clone.Function_Scope.objects[i2])
+		}
+	}
+	clone.Builtins = node.Builtins.clone(c)
+	s = nil
+	if node.Structures != nil {
+		s = []*StructType{}
+		for _, e = range node.Structures {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Structures = s
+}
+
+func (node *CoreProgram) Equal(other *CoreProgram) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *CoreProgram) equal(c *runtime.Comparer, other *CoreProgram) bool {
+	var i0 int
+	var o0 *Package
+	var i1 int
+	var o1 *File
+	var i2 int
+	var o2 *Function
+	var i3 int
+	var e *StructType
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Package_Scope == nil != (other.Package_Scope == nil) {
+		return false
+	}
+	if node.Package_Scope != nil {
+		if len(node.Package_Scope.objects) != len(other.Package_Scope.objects) {
+			return false
+		}
+		for i0, o0 = range node.Package_Scope.objects {
+			if !o0.equal(c, other.Package_Scope.objects[i0]) {
+				return false
+			}
+		}
+	}
+	if node.File_Scope == nil != (other.File_Scope == nil) {
+		return false
+	}
+	if node.File_Scope != nil {
+		if len(node.File_Scope.objects) != len(other.File_Scope.objects) {
+			return false
+		}
+		for i1, o1 = range node.File_Scope.objects {
+			if !o1.equal(c, other.File_Scope.objects[i1]) {
+				return false
+			}
+		}
+	}
+	if node.Function_Scope == nil != (other.Function_Scope == nil) {
+		return false
+	}
+	if node.Function_Scope != nil {
+		if len(node.Function_Scope.objects) != len(other.Function_Scope.objects) {
+			return false
+		}
+		for i2, o2 = range node.Function_Scope.objects {
+			if !o2.equal(c, other.Function_Scope.objects[i2]) {
+				return false
+			}
+		}
+	}
+	if !node.Builtins.equal(c, other.Builtins) {
+		return false
+	}
+	if len(node.Structures) != len(other.Structures) || node.Structures == nil != (other.Structures == nil) {
+		return false
+	}
+	for i3, e = range node.Structures {
+		if !e.equal(c, other.Structures[i3]) {
+			return false
+		}
+	}
+	return true
+}
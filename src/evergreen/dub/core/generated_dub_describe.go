@@ -0,0 +1,358 @@
+package core
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *BuiltinType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BuiltinType")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *BuiltinType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NilType) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("NilType")
+}
+
+func (node *NilType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ListType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ListType")
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *ListType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TupleType) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e DubType
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TupleType")
+	l = runtime.MakeList("[]DubType")
+	for _, e = range node.Types {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Types", l)
+	return d
+}
+
+func (node *TupleType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FunctionType) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e DubType
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FunctionType")
+	l = runtime.MakeList("[]DubType")
+	for _, e = range node.Params {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Params", l)
+	d.AddField("Result", runtime.Describe(node.Result))
+	return d
+}
+
+func (node *FunctionType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *UnboundType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("UnboundType")
+	d.AddField("Index", runtime.Describe(node.Index))
+	return d
+}
+
+func (node *UnboundType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FunctionTemplateType) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("FunctionTemplateType")
+}
+
+func (node *FunctionTemplateType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *PackageType) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("PackageType")
+}
+
+func (node *PackageType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FieldType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FieldType")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *FieldType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StructType) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *FieldType
+	var l1 *runtime.List
+	var e1 *StructType
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StructType")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Exported", runtime.Describe(node.Exported))
+	d.AddField("Implements", runtime.Describe(node.Implements))
+	l0 = runtime.MakeList("[]FieldType")
+	for _, e0 = range node.Fields {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Fields", l0)
+	d.AddField("Scoped", runtime.Describe(node.Scoped))
+	l1 = runtime.MakeList("[]StructType")
+	for _, e1 = range node.Contains {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Contains", l1)
+	d.AddField("IsParent", runtime.Describe(node.IsParent))
+	d.AddField("File", node.File.DescribeRef())
+	return d
+}
+
+func (node *StructType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Package) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 string
+	var l1 *runtime.List
+	var e1 *File
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Package")
+	l0 = runtime.MakeList("[]string")
+	for _, e0 = range node.Path {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Path", l0)
+	l1 = runtime.MakeList("[]File")
+	for _, e1 = range node.Files {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Files", l1)
+	return d
+}
+
+func (node *Package) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("Package", int(node.Index))
+}
+
+func (node *Package) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *File) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("File")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Package", node.Package.DescribeRef())
+	return d
+}
+
+func (node *File) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("File", int(node.Index))
+}
+
+func (node *File) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Function) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Function")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Exported", runtime.Describe(node.Exported))
+	d.AddField("Inline", runtime.Describe(node.Inline))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("File", node.File.DescribeRef())
+	return d
+}
+
+func (node *Function) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("Function", int(node.Index))
+}
+
+func (node *Function) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *IntrinsicFunction) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("IntrinsicFunction")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Parent", runtime.Describe(node.Parent))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *IntrinsicFunction) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TemplateParam) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TemplateParam")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *TemplateParam) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FunctionTemplate) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FunctionTemplate")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Exported", runtime.Describe(node.Exported))
+	return d
+}
+
+func (node *FunctionTemplate) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *IntrinsicFunctionTemplate) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *TemplateParam
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("IntrinsicFunctionTemplate")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l = runtime.MakeList("[]TemplateParam")
+	for _, e = range node.Params {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Params", l)
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *IntrinsicFunctionTemplate) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BuiltinTypeIndex) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BuiltinTypeIndex")
+	d.AddField("String", runtime.Describe(node.String))
+	d.AddField("Rune", runtime.Describe(node.Rune))
+	d.AddField("Int", runtime.Describe(node.Int))
+	d.AddField("Int64", runtime.Describe(node.Int64))
+	d.AddField("Float32", runtime.Describe(node.Float32))
+	d.AddField("Bool", runtime.Describe(node.Bool))
+	d.AddField("Graph", runtime.Describe(node.Graph))
+	d.AddField("Nil", runtime.Describe(node.Nil))
+	d.AddField("Append", runtime.Describe(node.Append))
+	d.AddField("Position", runtime.Describe(node.Position))
+	d.AddField("Slice", runtime.Describe(node.Slice))
+	return d
+}
+
+func (node *CoreProgram) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *StructType
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("CoreProgram")
+	d.AddField("Builtins", runtime.Describe(node.Builtins))
+	l = runtime.MakeList("[]StructType")
+	for _, e = range node.Structures {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Structures", l)
+	return d
+}
+
+func (node *CoreProgram) String() string {
+	return runtime.Format(node.Describe())
+}
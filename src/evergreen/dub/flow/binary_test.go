@@ -0,0 +1,93 @@
+package flow_test
+
+import (
+	"bytes"
+	"evergreen/compiler"
+	"evergreen/dub/flow"
+	"evergreen/dub/transform"
+	"evergreen/dub/transform/golang"
+	"evergreen/dub/tree"
+	gocore "evergreen/go/core"
+	"testing"
+)
+
+// Lowers the compiler's own dub sources.
+func lowerEvergreen(t *testing.T) (*flow.DubProgram, compiler.CompileStatus) {
+	return lowerDir(t, "../../../../dubsrc/evergreen")
+}
+
+func lowerPlayground(t *testing.T) (*flow.DubProgram, compiler.CompileStatus) {
+	return lowerDir(t, "../../../../dubsrc/playground")
+}
+
+func lowerDir(t *testing.T, dir string) (*flow.DubProgram, compiler.CompileStatus) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	program, coreProg := tree.DubProgramFrontend(status.Pass("dub_frontend"), p, dir)
+	if status.ShouldHalt() {
+		t.Fatal("frontend failed")
+	}
+	flowProgram := transform.LowerProgram(status.Pass("lower"), program, coreProg)
+	flow.TrimFlow(status.Pass("trim_flow"), flowProgram)
+	return flowProgram, status
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	flowProgram, status := lowerEvergreen(t)
+
+	data, err := flowProgram.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &flow.DubProgram{}
+	err = decoded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := decoded.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Fatal("snapshot did not survive a round trip")
+	}
+	if len(decoded.LLFuncs) != len(flowProgram.LLFuncs) {
+		t.Fatalf("expected %d functions, got %d", len(flowProgram.LLFuncs), len(decoded.LLFuncs))
+	}
+	for i, f := range decoded.LLFuncs {
+		if f.F != decoded.Core.Function_Scope.Get(f.F.Index) {
+			t.Fatalf("%s is not in its scope", f.Name)
+		}
+		if f.CFG.NumNodes() != flowProgram.LLFuncs[i].CFG.NumNodes() {
+			t.Fatalf("%s has a different graph", f.Name)
+		}
+	}
+
+	// The decoded program can be compiled further.
+	_, goCoreProg, _ := golang.GenerateGo(status.Pass("dub_to_go"), decoded, decoded.Core, []string{"evergreen"}, &golang.GenerateOptions{})
+	data, err = goCoreProg.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	goDecoded := &gocore.CoreProgram{}
+	err = goDecoded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err = goDecoded.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Fatal("go program did not survive a round trip")
+	}
+
+	err = goDecoded.UnmarshalBinary(data[:len(data)/2])
+	if err == nil {
+		t.Fatal("expected a truncated snapshot to fail")
+	}
+	err = decoded.UnmarshalBinary(data)
+	if err == nil {
+		t.Fatal("expected a schema mismatch")
+	}
+}
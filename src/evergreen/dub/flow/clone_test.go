@@ -0,0 +1,55 @@
+package flow_test
+
+import (
+	"evergreen/dub/flow"
+	"testing"
+)
+
+// Finds an op that writes to a register.
+func findCopy(f *flow.LLFunc) *flow.CopyOp {
+	for _, op := range f.Ops {
+		c, ok := op.(*flow.CopyOp)
+		if ok && c.Dst != nil {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestCloneLLFunc(t *testing.T) {
+	program, _ := lowerEvergreen(t)
+	checked := 0
+	for _, f := range program.LLFuncs {
+		c := f.Clone()
+		if c == f || !c.Equal(f) || !f.Equal(c) {
+			t.Fatalf("%s: clone is not equal", f.Name)
+		}
+		if c.F != f.F || c.CFG == f.CFG {
+			t.Fatalf("%s: clone should share the function but copy the graph", f.Name)
+		}
+
+		original := findCopy(f)
+		if original == nil {
+			continue
+		}
+		checked += 1
+		copied := findCopy(c)
+		if copied == original || copied.Dst == original.Dst {
+			t.Fatalf("%s: op was not copied", f.Name)
+		}
+		// Registers are redirected to the copies in the cloned scope.
+		if copied.Dst.Index != original.Dst.Index || copied.Dst.T != original.Dst.T {
+			t.Fatalf("%s: register was not remapped", f.Name)
+		}
+		copied.Dst.Name += "_renamed"
+		if c.Equal(f) {
+			t.Fatalf("%s: modified clone should differ", f.Name)
+		}
+		if original.Dst.Name == copied.Dst.Name {
+			t.Fatalf("%s: modifying the clone changed the original", f.Name)
+		}
+	}
+	if checked == 0 {
+		t.Fatal("no copies were found")
+	}
+}
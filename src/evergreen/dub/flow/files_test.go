@@ -0,0 +1,48 @@
+package flow_test
+
+import (
+	"evergreen/dub/transform/golang"
+	gocore "evergreen/go/core"
+	"evergreen/go/transform"
+	"strings"
+	"testing"
+)
+
+func TestGoFilePerSourceFile(t *testing.T) {
+	flowProgram, status := lowerEvergreen(t)
+	goFlowProg, goCoreProg, bypass := golang.GenerateGo(status.Pass("dub_to_go"), flowProgram, flowProgram.Core, []string{"evergreen"}, &golang.GenerateOptions{Tests: true})
+
+	for i := 0; i < goCoreProg.Function_Scope.Len(); i++ {
+		f := goCoreProg.Function_Scope.Get(gocore.Function_Ref(i))
+		if f.File == nil {
+			t.Fatalf("%s has no file", f.Name)
+		}
+		if f.File.Package != f.Package {
+			t.Fatalf("%s is in %s but its file is in %s", f.Name, strings.Join(f.Package.Path, "/"), strings.Join(f.File.Package.Path, "/"))
+		}
+	}
+
+	prog := transform.FlowToTree(status.Pass("flow_to_tree"), goFlowProg, goCoreProg, bypass)
+	for _, pkg := range prog.Packages {
+		if strings.Join(pkg.P.Path, "/") != "evergreen/dub/tree" {
+			continue
+		}
+		names := map[string]int{}
+		for _, file := range pkg.Files {
+			names[file.Name] = len(file.Decls)
+		}
+		for _, name := range []string{"ast_dub.go", "parser_dub.go", "tests_dub_test.go"} {
+			if names[name] == 0 {
+				t.Errorf("expected declarations in %s, got %v", name, names)
+			}
+		}
+		// tests.dub only contains tests.
+		for _, name := range []string{"generated_dub.go", "tests_dub.go", "parser_dub_test.go"} {
+			if _, ok := names[name]; ok {
+				t.Errorf("did not expect %s", name)
+			}
+		}
+		return
+	}
+	t.Fatal("package not found")
+}
@@ -0,0 +1,1873 @@
+package flow
+
+import (
+	"evergreen/dub/core"
+	"evergreen/dub/runtime"
+	"evergreen/dub/tree"
+	"evergreen/graph"
+)
+
+func (node *RegisterInfo) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/RegisterInfo") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *RegisterInfo) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = RegisterInfo_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.T = core.DecodeDubTypeBinary(d)
+}
+
+func readRegisterInfoBinary(d *runtime.BinaryDecoder, o interface{}) *RegisterInfo {
+	var node *RegisterInfo
+	if o != nil {
+		return o.(*RegisterInfo)
+	}
+	node = &RegisterInfo{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *RegisterInfo) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e4cefd0bf4bc379e")
+}
+
+func (node *RegisterInfo) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e4cefd0bf4bc379e", "dub/flow/RegisterInfo", node)
+}
+
+func DecodeRegisterInfoBinary(d *runtime.BinaryDecoder) *RegisterInfo {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/RegisterInfo" {
+		return readRegisterInfoBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *LLFunc) EncodeBinary(e *runtime.BinaryEncoder) {
+	var o *RegisterInfo
+	var x0 *RegisterInfo
+	var x1 core.DubType
+	var x2 DubOp
+	var x3 int
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/LLFunc") {
+		return
+	}
+	if node.RegisterInfo_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.RegisterInfo_Scope.objects))
+		for _, o = range node.RegisterInfo_Scope.objects {
+			o.EncodeBinary(e)
+		}
+	}
+	e.WriteString(node.Name)
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x0 = range node.Params {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.ReturnTypes == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.ReturnTypes))
+		for _, x1 = range node.ReturnTypes {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+	if node.CFG == nil {
+		e.WriteBool(false)
+	} else {
+		e.WriteBool(true)
+		e.WriteOpaque(node.CFG)
+	}
+	if node.Ops == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Ops))
+		for _, x2 = range node.Ops {
+			runtime.EncodeBinary(e, x2)
+		}
+	}
+	if node.Edges == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Edges))
+		for _, x3 = range node.Edges {
+			e.WriteInt(x3)
+		}
+	}
+	node.F.EncodeBinary(e)
+}
+
+func (node *LLFunc) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var n1 int
+	var s0 []*RegisterInfo
+	var n2 int
+	var s1 []core.DubType
+	var n3 int
+	var s2 []DubOp
+	var n4 int
+	var s3 []int
+	n0 = d.ReadLength()
+	if n0 >= 0 {
+		node.RegisterInfo_Scope = &RegisterInfo_Scope{}
+		for range n0 {
+			node.RegisterInfo_Scope.objects = append(node.RegisterInfo_Scope.objects, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Name = d.ReadString()
+	n1 = d.ReadLength()
+	s0 = nil
+	if n1 >= 0 {
+		s0 = []*RegisterInfo{}
+		for range n1 {
+			s0 = append(s0, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Params = s0
+	n2 = d.ReadLength()
+	s1 = nil
+	if n2 >= 0 {
+		s1 = []core.DubType{}
+		for range n2 {
+			s1 = append(s1, core.DecodeDubTypeBinary(d))
+		}
+	}
+	node.ReturnTypes = s1
+	node.CFG = nil
+	if d.ReadBool() {
+		node.CFG = &graph.Graph{}
+		d.ReadOpaque(node.CFG)
+	}
+	n3 = d.ReadLength()
+	s2 = nil
+	if n3 >= 0 {
+		s2 = []DubOp{}
+		for range n3 {
+			s2 = append(s2, DecodeDubOpBinary(d))
+		}
+	}
+	node.Ops = s2
+	n4 = d.ReadLength()
+	s3 = nil
+	if n4 >= 0 {
+		s3 = []int{}
+		for range n4 {
+			s3 = append(s3, d.ReadInt())
+		}
+	}
+	node.Edges = s3
+	node.F = core.DecodeFunctionBinary(d)
+}
+
+func readLLFuncBinary(d *runtime.BinaryDecoder, o interface{}) *LLFunc {
+	var node *LLFunc
+	if o != nil {
+		return o.(*LLFunc)
+	}
+	node = &LLFunc{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *LLFunc) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "60870b8941849c65")
+}
+
+func (node *LLFunc) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "60870b8941849c65", "dub/flow/LLFunc", node)
+}
+
+func DecodeLLFuncBinary(d *runtime.BinaryDecoder) *LLFunc {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/LLFunc" {
+		return readLLFuncBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeDubOpBinary(d *runtime.BinaryDecoder) DubOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/CoerceOp" {
+		return readCoerceOpBinary(d, o)
+	}
+	if t == "dub/flow/CopyOp" {
+		return readCopyOpBinary(d, o)
+	}
+	if t == "dub/flow/ConstantNilOp" {
+		return readConstantNilOpBinary(d, o)
+	}
+	if t == "dub/flow/ConstantIntOp" {
+		return readConstantIntOpBinary(d, o)
+	}
+	if t == "dub/flow/ConstantFloat32Op" {
+		return readConstantFloat32OpBinary(d, o)
+	}
+	if t == "dub/flow/ConstantBoolOp" {
+		return readConstantBoolOpBinary(d, o)
+	}
+	if t == "dub/flow/ConstantRuneOp" {
+		return readConstantRuneOpBinary(d, o)
+	}
+	if t == "dub/flow/ConstantStringOp" {
+		return readConstantStringOpBinary(d, o)
+	}
+	if t == "dub/flow/BinaryOp" {
+		return readBinaryOpBinary(d, o)
+	}
+	if t == "dub/flow/CallOp" {
+		return readCallOpBinary(d, o)
+	}
+	if t == "dub/flow/ConstructOp" {
+		return readConstructOpBinary(d, o)
+	}
+	if t == "dub/flow/ConstructListOp" {
+		return readConstructListOpBinary(d, o)
+	}
+	if t == "dub/flow/Checkpoint" {
+		return readCheckpointBinary(d, o)
+	}
+	if t == "dub/flow/Recover" {
+		return readRecoverBinary(d, o)
+	}
+	if t == "dub/flow/LookaheadBegin" {
+		return readLookaheadBeginBinary(d, o)
+	}
+	if t == "dub/flow/LookaheadEnd" {
+		return readLookaheadEndBinary(d, o)
+	}
+	if t == "dub/flow/ReturnOp" {
+		return readReturnOpBinary(d, o)
+	}
+	if t == "dub/flow/Fail" {
+		return readFailBinary(d, o)
+	}
+	if t == "dub/flow/Peek" {
+		return readPeekBinary(d, o)
+	}
+	if t == "dub/flow/Consume" {
+		return readConsumeBinary(d, o)
+	}
+	if t == "dub/flow/TransferOp" {
+		return readTransferOpBinary(d, o)
+	}
+	if t == "dub/flow/EntryOp" {
+		return readEntryOpBinary(d, o)
+	}
+	if t == "dub/flow/SwitchOp" {
+		return readSwitchOpBinary(d, o)
+	}
+	if t == "dub/flow/ExitOp" {
+		return readExitOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *CoerceOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/CoerceOp") {
+		return
+	}
+	node.Src.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.T)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *CoerceOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Src = DecodeRegisterInfoBinary(d)
+	node.T = core.DecodeDubTypeBinary(d)
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readCoerceOpBinary(d *runtime.BinaryDecoder, o interface{}) *CoerceOp {
+	var node *CoerceOp
+	if o != nil {
+		return o.(*CoerceOp)
+	}
+	node = &CoerceOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *CoerceOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7874d2dd4f40931f")
+}
+
+func (node *CoerceOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7874d2dd4f40931f", "dub/flow/CoerceOp", node)
+}
+
+func DecodeCoerceOpBinary(d *runtime.BinaryDecoder) *CoerceOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/CoerceOp" {
+		return readCoerceOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *CopyOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/CopyOp") {
+		return
+	}
+	node.Src.EncodeBinary(e)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *CopyOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Src = DecodeRegisterInfoBinary(d)
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readCopyOpBinary(d *runtime.BinaryDecoder, o interface{}) *CopyOp {
+	var node *CopyOp
+	if o != nil {
+		return o.(*CopyOp)
+	}
+	node = &CopyOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *CopyOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e7a37d3ab9561253")
+}
+
+func (node *CopyOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e7a37d3ab9561253", "dub/flow/CopyOp", node)
+}
+
+func DecodeCopyOpBinary(d *runtime.BinaryDecoder) *CopyOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/CopyOp" {
+		return readCopyOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantNilOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstantNilOp") {
+		return
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantNilOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstantNilOpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantNilOp {
+	var node *ConstantNilOp
+	if o != nil {
+		return o.(*ConstantNilOp)
+	}
+	node = &ConstantNilOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantNilOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "90601d091cfee73c")
+}
+
+func (node *ConstantNilOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "90601d091cfee73c", "dub/flow/ConstantNilOp", node)
+}
+
+func DecodeConstantNilOpBinary(d *runtime.BinaryDecoder) *ConstantNilOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstantNilOp" {
+		return readConstantNilOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantIntOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstantIntOp") {
+		return
+	}
+	e.WriteInt64(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantIntOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadInt64()
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstantIntOpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantIntOp {
+	var node *ConstantIntOp
+	if o != nil {
+		return o.(*ConstantIntOp)
+	}
+	node = &ConstantIntOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantIntOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "4d5fcf77703241cf")
+}
+
+func (node *ConstantIntOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "4d5fcf77703241cf", "dub/flow/ConstantIntOp", node)
+}
+
+func DecodeConstantIntOpBinary(d *runtime.BinaryDecoder) *ConstantIntOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstantIntOp" {
+		return readConstantIntOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantFloat32Op) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstantFloat32Op") {
+		return
+	}
+	e.WriteFloat32(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantFloat32Op) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadFloat32()
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstantFloat32OpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantFloat32Op {
+	var node *ConstantFloat32Op
+	if o != nil {
+		return o.(*ConstantFloat32Op)
+	}
+	node = &ConstantFloat32Op{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantFloat32Op) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "558ce594779861f9")
+}
+
+func (node *ConstantFloat32Op) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "558ce594779861f9", "dub/flow/ConstantFloat32Op", node)
+}
+
+func DecodeConstantFloat32OpBinary(d *runtime.BinaryDecoder) *ConstantFloat32Op {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstantFloat32Op" {
+		return readConstantFloat32OpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantBoolOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstantBoolOp") {
+		return
+	}
+	e.WriteBool(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantBoolOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadBool()
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstantBoolOpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantBoolOp {
+	var node *ConstantBoolOp
+	if o != nil {
+		return o.(*ConstantBoolOp)
+	}
+	node = &ConstantBoolOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantBoolOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8cef6f36654c98e3")
+}
+
+func (node *ConstantBoolOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8cef6f36654c98e3", "dub/flow/ConstantBoolOp", node)
+}
+
+func DecodeConstantBoolOpBinary(d *runtime.BinaryDecoder) *ConstantBoolOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstantBoolOp" {
+		return readConstantBoolOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantRuneOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstantRuneOp") {
+		return
+	}
+	e.WriteRuneValue(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantRuneOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadRuneValue()
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstantRuneOpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantRuneOp {
+	var node *ConstantRuneOp
+	if o != nil {
+		return o.(*ConstantRuneOp)
+	}
+	node = &ConstantRuneOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantRuneOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "bca1c73f19ba46b7")
+}
+
+func (node *ConstantRuneOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "bca1c73f19ba46b7", "dub/flow/ConstantRuneOp", node)
+}
+
+func DecodeConstantRuneOpBinary(d *runtime.BinaryDecoder) *ConstantRuneOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstantRuneOp" {
+		return readConstantRuneOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantStringOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstantStringOp") {
+		return
+	}
+	e.WriteString(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantStringOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadString()
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstantStringOpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantStringOp {
+	var node *ConstantStringOp
+	if o != nil {
+		return o.(*ConstantStringOp)
+	}
+	node = &ConstantStringOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantStringOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8c0dddf67e3393cf")
+}
+
+func (node *ConstantStringOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8c0dddf67e3393cf", "dub/flow/ConstantStringOp", node)
+}
+
+func DecodeConstantStringOpBinary(d *runtime.BinaryDecoder) *ConstantStringOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstantStringOp" {
+		return readConstantStringOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BinaryOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/BinaryOp") {
+		return
+	}
+	node.Left.EncodeBinary(e)
+	e.WriteString(node.Op)
+	node.Right.EncodeBinary(e)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *BinaryOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Left = DecodeRegisterInfoBinary(d)
+	node.Op = d.ReadString()
+	node.Right = DecodeRegisterInfoBinary(d)
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readBinaryOpBinary(d *runtime.BinaryDecoder, o interface{}) *BinaryOp {
+	var node *BinaryOp
+	if o != nil {
+		return o.(*BinaryOp)
+	}
+	node = &BinaryOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BinaryOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "4b37dd64aa3ab5c0")
+}
+
+func (node *BinaryOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "4b37dd64aa3ab5c0", "dub/flow/BinaryOp", node)
+}
+
+func DecodeBinaryOpBinary(d *runtime.BinaryDecoder) *BinaryOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/BinaryOp" {
+		return readBinaryOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *CallOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *RegisterInfo
+	var x1 *RegisterInfo
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/CallOp") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Target)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x0 = range node.Args {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Dsts == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Dsts))
+		for _, x1 = range node.Dsts {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *CallOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*RegisterInfo
+	var n1 int
+	var s1 []*RegisterInfo
+	node.Target = core.DecodeCallableBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*RegisterInfo{}
+		for range n0 {
+			s0 = append(s0, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Args = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*RegisterInfo{}
+		for range n1 {
+			s1 = append(s1, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Dsts = s1
+}
+
+func readCallOpBinary(d *runtime.BinaryDecoder, o interface{}) *CallOp {
+	var node *CallOp
+	if o != nil {
+		return o.(*CallOp)
+	}
+	node = &CallOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *CallOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "989231e2ca058aee")
+}
+
+func (node *CallOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "989231e2ca058aee", "dub/flow/CallOp", node)
+}
+
+func DecodeCallOpBinary(d *runtime.BinaryDecoder) *CallOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/CallOp" {
+		return readCallOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *KeyValue) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/KeyValue") {
+		return
+	}
+	e.WriteString(node.Key)
+	node.Value.EncodeBinary(e)
+}
+
+func (node *KeyValue) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Key = d.ReadString()
+	node.Value = DecodeRegisterInfoBinary(d)
+}
+
+func readKeyValueBinary(d *runtime.BinaryDecoder, o interface{}) *KeyValue {
+	var node *KeyValue
+	if o != nil {
+		return o.(*KeyValue)
+	}
+	node = &KeyValue{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *KeyValue) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "09149ef706ffe488")
+}
+
+func (node *KeyValue) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "09149ef706ffe488", "dub/flow/KeyValue", node)
+}
+
+func DecodeKeyValueBinary(d *runtime.BinaryDecoder) *KeyValue {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/KeyValue" {
+		return readKeyValueBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstructOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *KeyValue
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstructOp") {
+		return
+	}
+	node.Type.EncodeBinary(e)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstructOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*KeyValue
+	node.Type = core.DecodeStructTypeBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*KeyValue{}
+		for range n {
+			s = append(s, DecodeKeyValueBinary(d))
+		}
+	}
+	node.Args = s
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstructOpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstructOp {
+	var node *ConstructOp
+	if o != nil {
+		return o.(*ConstructOp)
+	}
+	node = &ConstructOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstructOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "609c097461bcf43b")
+}
+
+func (node *ConstructOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "609c097461bcf43b", "dub/flow/ConstructOp", node)
+}
+
+func DecodeConstructOpBinary(d *runtime.BinaryDecoder) *ConstructOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstructOp" {
+		return readConstructOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstructListOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *RegisterInfo
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ConstructListOp") {
+		return
+	}
+	node.Type.EncodeBinary(e)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstructListOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*RegisterInfo
+	node.Type = core.DecodeListTypeBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*RegisterInfo{}
+		for range n {
+			s = append(s, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Args = s
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readConstructListOpBinary(d *runtime.BinaryDecoder, o interface{}) *ConstructListOp {
+	var node *ConstructListOp
+	if o != nil {
+		return o.(*ConstructListOp)
+	}
+	node = &ConstructListOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstructListOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "763cfd06dd05012b")
+}
+
+func (node *ConstructListOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "763cfd06dd05012b", "dub/flow/ConstructListOp", node)
+}
+
+func DecodeConstructListOpBinary(d *runtime.BinaryDecoder) *ConstructListOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ConstructListOp" {
+		return readConstructListOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Checkpoint) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/Checkpoint") {
+		return
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *Checkpoint) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readCheckpointBinary(d *runtime.BinaryDecoder, o interface{}) *Checkpoint {
+	var node *Checkpoint
+	if o != nil {
+		return o.(*Checkpoint)
+	}
+	node = &Checkpoint{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Checkpoint) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "4032a8d0f054c2a2")
+}
+
+func (node *Checkpoint) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "4032a8d0f054c2a2", "dub/flow/Checkpoint", node)
+}
+
+func DecodeCheckpointBinary(d *runtime.BinaryDecoder) *Checkpoint {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/Checkpoint" {
+		return readCheckpointBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Recover) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/Recover") {
+		return
+	}
+	node.Src.EncodeBinary(e)
+}
+
+func (node *Recover) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Src = DecodeRegisterInfoBinary(d)
+}
+
+func readRecoverBinary(d *runtime.BinaryDecoder, o interface{}) *Recover {
+	var node *Recover
+	if o != nil {
+		return o.(*Recover)
+	}
+	node = &Recover{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Recover) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "b2e1fd3d9d50f45f")
+}
+
+func (node *Recover) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "b2e1fd3d9d50f45f", "dub/flow/Recover", node)
+}
+
+func DecodeRecoverBinary(d *runtime.BinaryDecoder) *Recover {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/Recover" {
+		return readRecoverBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *LookaheadBegin) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/LookaheadBegin") {
+		return
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *LookaheadBegin) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readLookaheadBeginBinary(d *runtime.BinaryDecoder, o interface{}) *LookaheadBegin {
+	var node *LookaheadBegin
+	if o != nil {
+		return o.(*LookaheadBegin)
+	}
+	node = &LookaheadBegin{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *LookaheadBegin) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7f644c3459cfd52d")
+}
+
+func (node *LookaheadBegin) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7f644c3459cfd52d", "dub/flow/LookaheadBegin", node)
+}
+
+func DecodeLookaheadBeginBinary(d *runtime.BinaryDecoder) *LookaheadBegin {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/LookaheadBegin" {
+		return readLookaheadBeginBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *LookaheadEnd) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/LookaheadEnd") {
+		return
+	}
+	e.WriteBool(node.Failed)
+	node.Src.EncodeBinary(e)
+}
+
+func (node *LookaheadEnd) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Failed = d.ReadBool()
+	node.Src = DecodeRegisterInfoBinary(d)
+}
+
+func readLookaheadEndBinary(d *runtime.BinaryDecoder, o interface{}) *LookaheadEnd {
+	var node *LookaheadEnd
+	if o != nil {
+		return o.(*LookaheadEnd)
+	}
+	node = &LookaheadEnd{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *LookaheadEnd) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ed57ad4bc3152474")
+}
+
+func (node *LookaheadEnd) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ed57ad4bc3152474", "dub/flow/LookaheadEnd", node)
+}
+
+func DecodeLookaheadEndBinary(d *runtime.BinaryDecoder) *LookaheadEnd {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/LookaheadEnd" {
+		return readLookaheadEndBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ReturnOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *RegisterInfo
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ReturnOp") {
+		return
+	}
+	if node.Exprs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Exprs))
+		for _, x = range node.Exprs {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *ReturnOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*RegisterInfo
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*RegisterInfo{}
+		for range n {
+			s = append(s, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Exprs = s
+}
+
+func readReturnOpBinary(d *runtime.BinaryDecoder, o interface{}) *ReturnOp {
+	var node *ReturnOp
+	if o != nil {
+		return o.(*ReturnOp)
+	}
+	node = &ReturnOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ReturnOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f4032ec219162ade")
+}
+
+func (node *ReturnOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f4032ec219162ade", "dub/flow/ReturnOp", node)
+}
+
+func DecodeReturnOpBinary(d *runtime.BinaryDecoder) *ReturnOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ReturnOp" {
+		return readReturnOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Fail) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/Fail") {
+		return
+	}
+}
+
+func (node *Fail) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readFailBinary(d *runtime.BinaryDecoder, o interface{}) *Fail {
+	var node *Fail
+	if o != nil {
+		return o.(*Fail)
+	}
+	node = &Fail{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Fail) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "0be7732f5984829b")
+}
+
+func (node *Fail) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "0be7732f5984829b", "dub/flow/Fail", node)
+}
+
+func DecodeFailBinary(d *runtime.BinaryDecoder) *Fail {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/Fail" {
+		return readFailBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Peek) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/Peek") {
+		return
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *Peek) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Dst = DecodeRegisterInfoBinary(d)
+}
+
+func readPeekBinary(d *runtime.BinaryDecoder, o interface{}) *Peek {
+	var node *Peek
+	if o != nil {
+		return o.(*Peek)
+	}
+	node = &Peek{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Peek) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "c35f81d2f53e0923")
+}
+
+func (node *Peek) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "c35f81d2f53e0923", "dub/flow/Peek", node)
+}
+
+func DecodePeekBinary(d *runtime.BinaryDecoder) *Peek {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/Peek" {
+		return readPeekBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Consume) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/Consume") {
+		return
+	}
+}
+
+func (node *Consume) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readConsumeBinary(d *runtime.BinaryDecoder, o interface{}) *Consume {
+	var node *Consume
+	if o != nil {
+		return o.(*Consume)
+	}
+	node = &Consume{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Consume) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "1a2880e10d3d1cdd")
+}
+
+func (node *Consume) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "1a2880e10d3d1cdd", "dub/flow/Consume", node)
+}
+
+func DecodeConsumeBinary(d *runtime.BinaryDecoder) *Consume {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/Consume" {
+		return readConsumeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TransferOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *RegisterInfo
+	var x1 *RegisterInfo
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/TransferOp") {
+		return
+	}
+	if node.Srcs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Srcs))
+		for _, x0 = range node.Srcs {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Dsts == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Dsts))
+		for _, x1 = range node.Dsts {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *TransferOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*RegisterInfo
+	var n1 int
+	var s1 []*RegisterInfo
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*RegisterInfo{}
+		for range n0 {
+			s0 = append(s0, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Srcs = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*RegisterInfo{}
+		for range n1 {
+			s1 = append(s1, DecodeRegisterInfoBinary(d))
+		}
+	}
+	node.Dsts = s1
+}
+
+func readTransferOpBinary(d *runtime.BinaryDecoder, o interface{}) *TransferOp {
+	var node *TransferOp
+	if o != nil {
+		return o.(*TransferOp)
+	}
+	node = &TransferOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TransferOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6698b481c61edccd")
+}
+
+func (node *TransferOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6698b481c61edccd", "dub/flow/TransferOp", node)
+}
+
+func DecodeTransferOpBinary(d *runtime.BinaryDecoder) *TransferOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/TransferOp" {
+		return readTransferOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *EntryOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/EntryOp") {
+		return
+	}
+}
+
+func (node *EntryOp) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readEntryOpBinary(d *runtime.BinaryDecoder, o interface{}) *EntryOp {
+	var node *EntryOp
+	if o != nil {
+		return o.(*EntryOp)
+	}
+	node = &EntryOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *EntryOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8bb9dd727c5b86e6")
+}
+
+func (node *EntryOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8bb9dd727c5b86e6", "dub/flow/EntryOp", node)
+}
+
+func DecodeEntryOpBinary(d *runtime.BinaryDecoder) *EntryOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/EntryOp" {
+		return readEntryOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SwitchOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/SwitchOp") {
+		return
+	}
+	node.Cond.EncodeBinary(e)
+}
+
+func (node *SwitchOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Cond = DecodeRegisterInfoBinary(d)
+}
+
+func readSwitchOpBinary(d *runtime.BinaryDecoder, o interface{}) *SwitchOp {
+	var node *SwitchOp
+	if o != nil {
+		return o.(*SwitchOp)
+	}
+	node = &SwitchOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SwitchOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ba7435194ae78590")
+}
+
+func (node *SwitchOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ba7435194ae78590", "dub/flow/SwitchOp", node)
+}
+
+func DecodeSwitchOpBinary(d *runtime.BinaryDecoder) *SwitchOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/SwitchOp" {
+		return readSwitchOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ExitOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/ExitOp") {
+		return
+	}
+}
+
+func (node *ExitOp) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readExitOpBinary(d *runtime.BinaryDecoder, o interface{}) *ExitOp {
+	var node *ExitOp
+	if o != nil {
+		return o.(*ExitOp)
+	}
+	node = &ExitOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ExitOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d83abd21c30a73d6")
+}
+
+func (node *ExitOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d83abd21c30a73d6", "dub/flow/ExitOp", node)
+}
+
+func DecodeExitOpBinary(d *runtime.BinaryDecoder) *ExitOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/ExitOp" {
+		return readExitOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *DubPackage) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 string
+	var x1 *core.StructType
+	var x2 *LLFunc
+	var x3 *tree.Test
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/DubPackage") {
+		return
+	}
+	if node.Path == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Path))
+		for _, x0 = range node.Path {
+			e.WriteString(x0)
+		}
+	}
+	if node.Structs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Structs))
+		for _, x1 = range node.Structs {
+			x1.EncodeBinary(e)
+		}
+	}
+	if node.Funcs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Funcs))
+		for _, x2 = range node.Funcs {
+			x2.EncodeBinary(e)
+		}
+	}
+	if node.Tests == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Tests))
+		for _, x3 = range node.Tests {
+			x3.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *DubPackage) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []string
+	var n1 int
+	var s1 []*core.StructType
+	var n2 int
+	var s2 []*LLFunc
+	var n3 int
+	var s3 []*tree.Test
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []string{}
+		for range n0 {
+			s0 = append(s0, d.ReadString())
+		}
+	}
+	node.Path = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*core.StructType{}
+		for range n1 {
+			s1 = append(s1, core.DecodeStructTypeBinary(d))
+		}
+	}
+	node.Structs = s1
+	n2 = d.ReadLength()
+	s2 = nil
+	if n2 >= 0 {
+		s2 = []*LLFunc{}
+		for range n2 {
+			s2 = append(s2, DecodeLLFuncBinary(d))
+		}
+	}
+	node.Funcs = s2
+	n3 = d.ReadLength()
+	s3 = nil
+	if n3 >= 0 {
+		s3 = []*tree.Test{}
+		for range n3 {
+			s3 = append(s3, tree.DecodeTestBinary(d))
+		}
+	}
+	node.Tests = s3
+}
+
+func readDubPackageBinary(d *runtime.BinaryDecoder, o interface{}) *DubPackage {
+	var node *DubPackage
+	if o != nil {
+		return o.(*DubPackage)
+	}
+	node = &DubPackage{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *DubPackage) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "c99809f5eb0d2087")
+}
+
+func (node *DubPackage) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "c99809f5eb0d2087", "dub/flow/DubPackage", node)
+}
+
+func DecodeDubPackageBinary(d *runtime.BinaryDecoder) *DubPackage {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/DubPackage" {
+		return readDubPackageBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *DubProgram) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *DubPackage
+	var x1 *LLFunc
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/flow/DubProgram") {
+		return
+	}
+	node.Core.EncodeBinary(e)
+	if node.Packages == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Packages))
+		for _, x0 = range node.Packages {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.LLFuncs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.LLFuncs))
+		for _, x1 = range node.LLFuncs {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *DubProgram) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*DubPackage
+	var n1 int
+	var s1 []*LLFunc
+	node.Core = core.DecodeCoreProgramBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*DubPackage{}
+		for range n0 {
+			s0 = append(s0, DecodeDubPackageBinary(d))
+		}
+	}
+	node.Packages = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*LLFunc{}
+		for range n1 {
+			s1 = append(s1, DecodeLLFuncBinary(d))
+		}
+	}
+	node.LLFuncs = s1
+}
+
+func readDubProgramBinary(d *runtime.BinaryDecoder, o interface{}) *DubProgram {
+	var node *DubProgram
+	if o != nil {
+		return o.(*DubProgram)
+	}
+	node = &DubProgram{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *DubProgram) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3c3f6bd33e411074")
+}
+
+func (node *DubProgram) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3c3f6bd33e411074", "dub/flow/DubProgram", node)
+}
+
+func DecodeDubProgramBinary(d *runtime.BinaryDecoder) *DubProgram {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/flow/DubProgram" {
+		return readDubProgramBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
@@ -0,0 +1,2153 @@
+package flow
+
+import (
+	"evergreen/dub/core"
+	"evergreen/dub/runtime"
+	"evergreen/dub/tree"
+	"evergreen/graph"
+)
+
+func (node *RegisterInfo) Clone() *RegisterInfo {
+	var c *runtime.Cloner
+	var clone *RegisterInfo
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &RegisterInfo{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RegisterInfo) clone(c *runtime.Cloner) *RegisterInfo {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*RegisterInfo)
+	}
+	return node
+}
+
+func (node *RegisterInfo) cloneFields(c *runtime.Cloner, clone *RegisterInfo) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.T = node.T
+}
+
+func (node *RegisterInfo) Equal(other *RegisterInfo) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *RegisterInfo) equal(c *runtime.Comparer, other *RegisterInfo) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *RegisterInfo) equalRef(other *RegisterInfo) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *LLFunc) Clone() *LLFunc {
+	var c *runtime.Cloner
+	var clone *LLFunc
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &LLFunc{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LLFunc) clone(c *runtime.Cloner) *LLFunc {
+	var o interface{}
+	var clone *LLFunc
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*LLFunc)
+	}
+	clone = &LLFunc{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LLFunc) cloneFields(c *runtime.Cloner, clone *LLFunc) {
+	var o *RegisterInfo
+	var x *RegisterInfo
+	var i int
+	var s0 []*RegisterInfo
+	var e0 *RegisterInfo
+	var s1 []core.DubType
+	var e1 core.DubType
+	var g *graph.Graph
+	var s2 []DubOp
+	var e2 DubOp
+	var s3 []int
+	var e3 int
+	if node.RegisterInfo_Scope != nil {
+		clone.RegisterInfo_Scope = &RegisterInfo_Scope{}
+		for _, o = range node.RegisterInfo_Scope.objects {
+			x = &RegisterInfo{}
+			c.Map(o, x)
+			clone.RegisterInfo_Scope.objects = append(clone.RegisterInfo_Scope.objects, x)
+		}
+		for i, o = range node.RegisterInfo_Scope.objects {
+			o.cloneFields(c, clone.RegisterInfo_Scope.objects[i])
+		}
+	}
+	clone.Name = node.Name
+	s0 = nil
+	if node.Params != nil {
+		s0 = []*RegisterInfo{}
+		for _, e0 = range node.Params {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Params = s0
+	s1 = nil
+	if node.ReturnTypes != nil {
+		s1 = []core.DubType{}
+		for _, e1 = range node.ReturnTypes {
+			s1 = append(s1, e1)
+		}
+	}
+	clone.ReturnTypes = s1
+	g = nil
+	if node.CFG != nil {
+		g = node.CFG.Copy()
+	}
+	clone.CFG = g
+	s2 = nil
+	if node.Ops != nil {
+		s2 = []DubOp{}
+		for _, e2 = range node.Ops {
+			s2 = append(s2, cloneDubOp(c, e2))
+		}
+	}
+	clone.Ops = s2
+	s3 = nil
+	if node.Edges != nil {
+		s3 = []int{}
+		for _, e3 = range node.Edges {
+			s3 = append(s3, e3)
+		}
+	}
+	clone.Edges = s3
+	clone.F = node.F
+}
+
+func (node *LLFunc) Equal(other *LLFunc) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *LLFunc) equal(c *runtime.Comparer, other *LLFunc) bool {
+	var i0 int
+	var o *RegisterInfo
+	var i1 int
+	var e0 *RegisterInfo
+	var i2 int
+	var e1 core.DubType
+	var i3 int
+	var e2 DubOp
+	var i4 int
+	var e3 int
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.RegisterInfo_Scope == nil != (other.RegisterInfo_Scope == nil) {
+		return false
+	}
+	if node.RegisterInfo_Scope != nil {
+		if len(node.RegisterInfo_Scope.objects) != len(other.RegisterInfo_Scope.objects) {
+			return false
+		}
+		for i0, o = range node.RegisterInfo_Scope.objects {
+			if !o.equal(c, other.RegisterInfo_Scope.objects[i0]) {
+				return false
+			}
+		}
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i1, e0 = range node.Params {
+		if !e0.equalRef(other.Params[i1]) {
+			return false
+		}
+	}
+	if len(node.ReturnTypes) != len(other.ReturnTypes) || node.ReturnTypes == nil != (other.ReturnTypes == nil) {
+		return false
+	}
+	for i2, e1 = range node.ReturnTypes {
+		if e1 != other.ReturnTypes[i2] {
+			return false
+		}
+	}
+	if !node.CFG.Equal(other.CFG) {
+		return false
+	}
+	if len(node.Ops) != len(other.Ops) || node.Ops == nil != (other.Ops == nil) {
+		return false
+	}
+	for i3, e2 = range node.Ops {
+		if !equalDubOp(c, e2, other.Ops[i3]) {
+			return false
+		}
+	}
+	if len(node.Edges) != len(other.Edges) || node.Edges == nil != (other.Edges == nil) {
+		return false
+	}
+	for i4, e3 = range node.Edges {
+		if e3 != other.Edges[i4] {
+			return false
+		}
+	}
+	if node.F != other.F {
+		return false
+	}
+	return true
+}
+
+func cloneDubOp(c *runtime.Cloner, node DubOp) DubOp {
+	switch node.(type) {
+	case *CoerceOp:
+		return node.(*CoerceOp).clone(c)
+	case *CopyOp:
+		return node.(*CopyOp).clone(c)
+	case *ConstantNilOp:
+		return node.(*ConstantNilOp).clone(c)
+	case *ConstantIntOp:
+		return node.(*ConstantIntOp).clone(c)
+	case *ConstantFloat32Op:
+		return node.(*ConstantFloat32Op).clone(c)
+	case *ConstantBoolOp:
+		return node.(*ConstantBoolOp).clone(c)
+	case *ConstantRuneOp:
+		return node.(*ConstantRuneOp).clone(c)
+	case *ConstantStringOp:
+		return node.(*ConstantStringOp).clone(c)
+	case *BinaryOp:
+		return node.(*BinaryOp).clone(c)
+	case *CallOp:
+		return node.(*CallOp).clone(c)
+	case *ConstructOp:
+		return node.(*ConstructOp).clone(c)
+	case *ConstructListOp:
+		return node.(*ConstructListOp).clone(c)
+	case *Checkpoint:
+		return node.(*Checkpoint).clone(c)
+	case *Recover:
+		return node.(*Recover).clone(c)
+	case *LookaheadBegin:
+		return node.(*LookaheadBegin).clone(c)
+	case *LookaheadEnd:
+		return node.(*LookaheadEnd).clone(c)
+	case *ReturnOp:
+		return node.(*ReturnOp).clone(c)
+	case *Fail:
+		return node.(*Fail).clone(c)
+	case *Peek:
+		return node.(*Peek).clone(c)
+	case *Consume:
+		return node.(*Consume).clone(c)
+	case *TransferOp:
+		return node.(*TransferOp).clone(c)
+	case *EntryOp:
+		return node.(*EntryOp).clone(c)
+	case *SwitchOp:
+		return node.(*SwitchOp).clone(c)
+	case *ExitOp:
+		return node.(*ExitOp).clone(c)
+	}
+	return node
+}
+
+func equalDubOp(c *runtime.Comparer, a DubOp, b DubOp) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *CoerceOp:
+		switch b.(type) {
+		case *CoerceOp:
+			return a.(*CoerceOp).equal(c, b.(*CoerceOp))
+		}
+	case *CopyOp:
+		switch b.(type) {
+		case *CopyOp:
+			return a.(*CopyOp).equal(c, b.(*CopyOp))
+		}
+	case *ConstantNilOp:
+		switch b.(type) {
+		case *ConstantNilOp:
+			return a.(*ConstantNilOp).equal(c, b.(*ConstantNilOp))
+		}
+	case *ConstantIntOp:
+		switch b.(type) {
+		case *ConstantIntOp:
+			return a.(*ConstantIntOp).equal(c, b.(*ConstantIntOp))
+		}
+	case *ConstantFloat32Op:
+		switch b.(type) {
+		case *ConstantFloat32Op:
+			return a.(*ConstantFloat32Op).equal(c, b.(*ConstantFloat32Op))
+		}
+	case *ConstantBoolOp:
+		switch b.(type) {
+		case *ConstantBoolOp:
+			return a.(*ConstantBoolOp).equal(c, b.(*ConstantBoolOp))
+		}
+	case *ConstantRuneOp:
+		switch b.(type) {
+		case *ConstantRuneOp:
+			return a.(*ConstantRuneOp).equal(c, b.(*ConstantRuneOp))
+		}
+	case *ConstantStringOp:
+		switch b.(type) {
+		case *ConstantStringOp:
+			return a.(*ConstantStringOp).equal(c, b.(*ConstantStringOp))
+		}
+	case *BinaryOp:
+		switch b.(type) {
+		case *BinaryOp:
+			return a.(*BinaryOp).equal(c, b.(*BinaryOp))
+		}
+	case *CallOp:
+		switch b.(type) {
+		case *CallOp:
+			return a.(*CallOp).equal(c, b.(*CallOp))
+		}
+	case *ConstructOp:
+		switch b.(type) {
+		case *ConstructOp:
+			return a.(*ConstructOp).equal(c, b.(*ConstructOp))
+		}
+	case *ConstructListOp:
+		switch b.(type) {
+		case *ConstructListOp:
+			return a.(*ConstructListOp).equal(c, b.(*ConstructListOp))
+		}
+	case *Checkpoint:
+		switch b.(type) {
+		case *Checkpoint:
+			return a.(*Checkpoint).equal(c, b.(*Checkpoint))
+		}
+	case *Recover:
+		switch b.(type) {
+		case *Recover:
+			return a.(*Recover).equal(c, b.(*Recover))
+		}
+	case *LookaheadBegin:
+		switch b.(type) {
+		case *LookaheadBegin:
+			return a.(*LookaheadBegin).equal(c, b.(*LookaheadBegin))
+		}
+	case *LookaheadEnd:
+		switch b.(type) {
+		case *LookaheadEnd:
+			return a.(*LookaheadEnd).equal(c, b.(*LookaheadEnd))
+		}
+	case *ReturnOp:
+		switch b.(type) {
+		case *ReturnOp:
+			return a.(*ReturnOp).equal(c, b.(*ReturnOp))
+		}
+	case *Fail:
+		switch b.(type) {
+		case *Fail:
+			return a.(*Fail).equal(c, b.(*Fail))
+		}
+	case *Peek:
+		switch b.(type) {
+		case *Peek:
+			return a.(*Peek).equal(c, b.(*Peek))
+		}
+	case *Consume:
+		switch b.(type) {
+		case *Consume:
+			return a.(*Consume).equal(c, b.(*Consume))
+		}
+	case *TransferOp:
+		switch b.(type) {
+		case *TransferOp:
+			return a.(*TransferOp).equal(c, b.(*TransferOp))
+		}
+	case *EntryOp:
+		switch b.(type) {
+		case *EntryOp:
+			return a.(*EntryOp).equal(c, b.(*EntryOp))
+		}
+	case *SwitchOp:
+		switch b.(type) {
+		case *SwitchOp:
+			return a.(*SwitchOp).equal(c, b.(*SwitchOp))
+		}
+	case *ExitOp:
+		switch b.(type) {
+		case *ExitOp:
+			return a.(*ExitOp).equal(c, b.(*ExitOp))
+		}
+	}
+	return a == b
+}
+
+func (node *CoerceOp) Clone() *CoerceOp {
+	var c *runtime.Cloner
+	var clone *CoerceOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &CoerceOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CoerceOp) clone(c *runtime.Cloner) *CoerceOp {
+	var o interface{}
+	var clone *CoerceOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*CoerceOp)
+	}
+	clone = &CoerceOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CoerceOp) cloneFields(c *runtime.Cloner, clone *CoerceOp) {
+	clone.Src = node.Src.clone(c)
+	clone.T = node.T
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *CoerceOp) Equal(other *CoerceOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *CoerceOp) equal(c *runtime.Comparer, other *CoerceOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Src.equalRef(other.Src) {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *CopyOp) Clone() *CopyOp {
+	var c *runtime.Cloner
+	var clone *CopyOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &CopyOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CopyOp) clone(c *runtime.Cloner) *CopyOp {
+	var o interface{}
+	var clone *CopyOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*CopyOp)
+	}
+	clone = &CopyOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CopyOp) cloneFields(c *runtime.Cloner, clone *CopyOp) {
+	clone.Src = node.Src.clone(c)
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *CopyOp) Equal(other *CopyOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *CopyOp) equal(c *runtime.Comparer, other *CopyOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Src.equalRef(other.Src) {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantNilOp) Clone() *ConstantNilOp {
+	var c *runtime.Cloner
+	var clone *ConstantNilOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantNilOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantNilOp) clone(c *runtime.Cloner) *ConstantNilOp {
+	var o interface{}
+	var clone *ConstantNilOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantNilOp)
+	}
+	clone = &ConstantNilOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantNilOp) cloneFields(c *runtime.Cloner, clone *ConstantNilOp) {
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantNilOp) Equal(other *ConstantNilOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantNilOp) equal(c *runtime.Comparer, other *ConstantNilOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantIntOp) Clone() *ConstantIntOp {
+	var c *runtime.Cloner
+	var clone *ConstantIntOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantIntOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantIntOp) clone(c *runtime.Cloner) *ConstantIntOp {
+	var o interface{}
+	var clone *ConstantIntOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantIntOp)
+	}
+	clone = &ConstantIntOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantIntOp) cloneFields(c *runtime.Cloner, clone *ConstantIntOp) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantIntOp) Equal(other *ConstantIntOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantIntOp) equal(c *runtime.Comparer, other *ConstantIntOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantFloat32Op) Clone() *ConstantFloat32Op {
+	var c *runtime.Cloner
+	var clone *ConstantFloat32Op
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantFloat32Op{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantFloat32Op) clone(c *runtime.Cloner) *ConstantFloat32Op {
+	var o interface{}
+	var clone *ConstantFloat32Op
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantFloat32Op)
+	}
+	clone = &ConstantFloat32Op{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantFloat32Op) cloneFields(c *runtime.Cloner, clone *ConstantFloat32Op) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantFloat32Op) Equal(other *ConstantFloat32Op) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantFloat32Op) equal(c *runtime.Comparer, other *ConstantFloat32Op) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantBoolOp) Clone() *ConstantBoolOp {
+	var c *runtime.Cloner
+	var clone *ConstantBoolOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantBoolOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantBoolOp) clone(c *runtime.Cloner) *ConstantBoolOp {
+	var o interface{}
+	var clone *ConstantBoolOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantBoolOp)
+	}
+	clone = &ConstantBoolOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantBoolOp) cloneFields(c *runtime.Cloner, clone *ConstantBoolOp) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantBoolOp) Equal(other *ConstantBoolOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantBoolOp) equal(c *runtime.Comparer, other *ConstantBoolOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantRuneOp) Clone() *ConstantRuneOp {
+	var c *runtime.Cloner
+	var clone *ConstantRuneOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantRuneOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantRuneOp) clone(c *runtime.Cloner) *ConstantRuneOp {
+	var o interface{}
+	var clone *ConstantRuneOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantRuneOp)
+	}
+	clone = &ConstantRuneOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantRuneOp) cloneFields(c *runtime.Cloner, clone *ConstantRuneOp) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantRuneOp) Equal(other *ConstantRuneOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantRuneOp) equal(c *runtime.Comparer, other *ConstantRuneOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantStringOp) Clone() *ConstantStringOp {
+	var c *runtime.Cloner
+	var clone *ConstantStringOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantStringOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantStringOp) clone(c *runtime.Cloner) *ConstantStringOp {
+	var o interface{}
+	var clone *ConstantStringOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantStringOp)
+	}
+	clone = &ConstantStringOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantStringOp) cloneFields(c *runtime.Cloner, clone *ConstantStringOp) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantStringOp) Equal(other *ConstantStringOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantStringOp) equal(c *runtime.Comparer, other *ConstantStringOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *BinaryOp) Clone() *BinaryOp {
+	var c *runtime.Cloner
+	var clone *BinaryOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BinaryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryOp) clone(c *runtime.Cloner) *BinaryOp {
+	var o interface{}
+	var clone *BinaryOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BinaryOp)
+	}
+	clone = &BinaryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryOp) cloneFields(c *runtime.Cloner, clone *BinaryOp) {
+	clone.Left = node.Left.clone(c)
+	clone.Op = node.Op
+	clone.Right = node.Right.clone(c)
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *BinaryOp) Equal(other *BinaryOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BinaryOp) equal(c *runtime.Comparer, other *BinaryOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Left.equalRef(other.Left) {
+		return false
+	}
+	if node.Op != other.Op {
+		return false
+	}
+	if !node.Right.equalRef(other.Right) {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *CallOp) Clone() *CallOp {
+	var c *runtime.Cloner
+	var clone *CallOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &CallOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CallOp) clone(c *runtime.Cloner) *CallOp {
+	var o interface{}
+	var clone *CallOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*CallOp)
+	}
+	clone = &CallOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CallOp) cloneFields(c *runtime.Cloner, clone *CallOp) {
+	var s0 []*RegisterInfo
+	var e0 *RegisterInfo
+	var s1 []*RegisterInfo
+	var e1 *RegisterInfo
+	clone.Target = node.Target
+	s0 = nil
+	if node.Args != nil {
+		s0 = []*RegisterInfo{}
+		for _, e0 = range node.Args {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Args = s0
+	s1 = nil
+	if node.Dsts != nil {
+		s1 = []*RegisterInfo{}
+		for _, e1 = range node.Dsts {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Dsts = s1
+}
+
+func (node *CallOp) Equal(other *CallOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *CallOp) equal(c *runtime.Comparer, other *CallOp) bool {
+	var i0 int
+	var e0 *RegisterInfo
+	var i1 int
+	var e1 *RegisterInfo
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Target != other.Target {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i0, e0 = range node.Args {
+		if !e0.equalRef(other.Args[i0]) {
+			return false
+		}
+	}
+	if len(node.Dsts) != len(other.Dsts) || node.Dsts == nil != (other.Dsts == nil) {
+		return false
+	}
+	for i1, e1 = range node.Dsts {
+		if !e1.equalRef(other.Dsts[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *KeyValue) Clone() *KeyValue {
+	var c *runtime.Cloner
+	var clone *KeyValue
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &KeyValue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *KeyValue) clone(c *runtime.Cloner) *KeyValue {
+	var o interface{}
+	var clone *KeyValue
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*KeyValue)
+	}
+	clone = &KeyValue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *KeyValue) cloneFields(c *runtime.Cloner, clone *KeyValue) {
+	clone.Key = node.Key
+	clone.Value = node.Value.clone(c)
+}
+
+func (node *KeyValue) Equal(other *KeyValue) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *KeyValue) equal(c *runtime.Comparer, other *KeyValue) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Key != other.Key {
+		return false
+	}
+	if !node.Value.equalRef(other.Value) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstructOp) Clone() *ConstructOp {
+	var c *runtime.Cloner
+	var clone *ConstructOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstructOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructOp) clone(c *runtime.Cloner) *ConstructOp {
+	var o interface{}
+	var clone *ConstructOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstructOp)
+	}
+	clone = &ConstructOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructOp) cloneFields(c *runtime.Cloner, clone *ConstructOp) {
+	var s []*KeyValue
+	var e *KeyValue
+	clone.Type = node.Type
+	s = nil
+	if node.Args != nil {
+		s = []*KeyValue{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstructOp) Equal(other *ConstructOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstructOp) equal(c *runtime.Comparer, other *ConstructOp) bool {
+	var i int
+	var e *KeyValue
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Type != other.Type {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equal(c, other.Args[i]) {
+			return false
+		}
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstructListOp) Clone() *ConstructListOp {
+	var c *runtime.Cloner
+	var clone *ConstructListOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstructListOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructListOp) clone(c *runtime.Cloner) *ConstructListOp {
+	var o interface{}
+	var clone *ConstructListOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstructListOp)
+	}
+	clone = &ConstructListOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructListOp) cloneFields(c *runtime.Cloner, clone *ConstructListOp) {
+	var s []*RegisterInfo
+	var e *RegisterInfo
+	clone.Type = node.Type
+	s = nil
+	if node.Args != nil {
+		s = []*RegisterInfo{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstructListOp) Equal(other *ConstructListOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstructListOp) equal(c *runtime.Comparer, other *ConstructListOp) bool {
+	var i int
+	var e *RegisterInfo
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Type != other.Type {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equalRef(other.Args[i]) {
+			return false
+		}
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *Checkpoint) Clone() *Checkpoint {
+	var c *runtime.Cloner
+	var clone *Checkpoint
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Checkpoint{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Checkpoint) clone(c *runtime.Cloner) *Checkpoint {
+	var o interface{}
+	var clone *Checkpoint
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Checkpoint)
+	}
+	clone = &Checkpoint{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Checkpoint) cloneFields(c *runtime.Cloner, clone *Checkpoint) {
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *Checkpoint) Equal(other *Checkpoint) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Checkpoint) equal(c *runtime.Comparer, other *Checkpoint) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *Recover) Clone() *Recover {
+	var c *runtime.Cloner
+	var clone *Recover
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Recover{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Recover) clone(c *runtime.Cloner) *Recover {
+	var o interface{}
+	var clone *Recover
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Recover)
+	}
+	clone = &Recover{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Recover) cloneFields(c *runtime.Cloner, clone *Recover) {
+	clone.Src = node.Src.clone(c)
+}
+
+func (node *Recover) Equal(other *Recover) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Recover) equal(c *runtime.Comparer, other *Recover) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Src.equalRef(other.Src) {
+		return false
+	}
+	return true
+}
+
+func (node *LookaheadBegin) Clone() *LookaheadBegin {
+	var c *runtime.Cloner
+	var clone *LookaheadBegin
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &LookaheadBegin{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LookaheadBegin) clone(c *runtime.Cloner) *LookaheadBegin {
+	var o interface{}
+	var clone *LookaheadBegin
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*LookaheadBegin)
+	}
+	clone = &LookaheadBegin{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LookaheadBegin) cloneFields(c *runtime.Cloner, clone *LookaheadBegin) {
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *LookaheadBegin) Equal(other *LookaheadBegin) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *LookaheadBegin) equal(c *runtime.Comparer, other *LookaheadBegin) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *LookaheadEnd) Clone() *LookaheadEnd {
+	var c *runtime.Cloner
+	var clone *LookaheadEnd
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &LookaheadEnd{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LookaheadEnd) clone(c *runtime.Cloner) *LookaheadEnd {
+	var o interface{}
+	var clone *LookaheadEnd
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*LookaheadEnd)
+	}
+	clone = &LookaheadEnd{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LookaheadEnd) cloneFields(c *runtime.Cloner, clone *LookaheadEnd) {
+	clone.Failed = node.Failed
+	clone.Src = node.Src.clone(c)
+}
+
+func (node *LookaheadEnd) Equal(other *LookaheadEnd) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *LookaheadEnd) equal(c *runtime.Comparer, other *LookaheadEnd) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Failed != other.Failed {
+		return false
+	}
+	if !node.Src.equalRef(other.Src) {
+		return false
+	}
+	return true
+}
+
+func (node *ReturnOp) Clone() *ReturnOp {
+	var c *runtime.Cloner
+	var clone *ReturnOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ReturnOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ReturnOp) clone(c *runtime.Cloner) *ReturnOp {
+	var o interface{}
+	var clone *ReturnOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ReturnOp)
+	}
+	clone = &ReturnOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ReturnOp) cloneFields(c *runtime.Cloner, clone *ReturnOp) {
+	var s []*RegisterInfo
+	var e *RegisterInfo
+	s = nil
+	if node.Exprs != nil {
+		s = []*RegisterInfo{}
+		for _, e = range node.Exprs {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Exprs = s
+}
+
+func (node *ReturnOp) Equal(other *ReturnOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ReturnOp) equal(c *runtime.Comparer, other *ReturnOp) bool {
+	var i int
+	var e *RegisterInfo
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Exprs) != len(other.Exprs) || node.Exprs == nil != (other.Exprs == nil) {
+		return false
+	}
+	for i, e = range node.Exprs {
+		if !e.equalRef(other.Exprs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Fail) Clone() *Fail {
+	var c *runtime.Cloner
+	var clone *Fail
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Fail{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Fail) clone(c *runtime.Cloner) *Fail {
+	var o interface{}
+	var clone *Fail
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Fail)
+	}
+	clone = &Fail{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Fail) cloneFields(c *runtime.Cloner, clone *Fail) {
+}
+
+func (node *Fail) Equal(other *Fail) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Fail) equal(c *runtime.Comparer, other *Fail) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *Peek) Clone() *Peek {
+	var c *runtime.Cloner
+	var clone *Peek
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Peek{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Peek) clone(c *runtime.Cloner) *Peek {
+	var o interface{}
+	var clone *Peek
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Peek)
+	}
+	clone = &Peek{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Peek) cloneFields(c *runtime.Cloner, clone *Peek) {
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *Peek) Equal(other *Peek) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Peek) equal(c *runtime.Comparer, other *Peek) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *Consume) Clone() *Consume {
+	var c *runtime.Cloner
+	var clone *Consume
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Consume{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Consume) clone(c *runtime.Cloner) *Consume {
+	var o interface{}
+	var clone *Consume
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Consume)
+	}
+	clone = &Consume{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Consume) cloneFields(c *runtime.Cloner, clone *Consume) {
+}
+
+func (node *Consume) Equal(other *Consume) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Consume) equal(c *runtime.Comparer, other *Consume) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *TransferOp) Clone() *TransferOp {
+	var c *runtime.Cloner
+	var clone *TransferOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TransferOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TransferOp) clone(c *runtime.Cloner) *TransferOp {
+	var o interface{}
+	var clone *TransferOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TransferOp)
+	}
+	clone = &TransferOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TransferOp) cloneFields(c *runtime.Cloner, clone *TransferOp) {
+	var s0 []*RegisterInfo
+	var e0 *RegisterInfo
+	var s1 []*RegisterInfo
+	var e1 *RegisterInfo
+	s0 = nil
+	if node.Srcs != nil {
+		s0 = []*RegisterInfo{}
+		for _, e0 = range node.Srcs {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Srcs = s0
+	s1 = nil
+	if node.Dsts != nil {
+		s1 = []*RegisterInfo{}
+		for _, e1 = range node.Dsts {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Dsts = s1
+}
+
+func (node *TransferOp) Equal(other *TransferOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TransferOp) equal(c *runtime.Comparer, other *TransferOp) bool {
+	var i0 int
+	var e0 *RegisterInfo
+	var i1 int
+	var e1 *RegisterInfo
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Srcs) != len(other.Srcs) || node.Srcs == nil != (other.Srcs == nil) {
+		return false
+	}
+	for i0, e0 = range node.Srcs {
+		if !e0.equalRef(other.Srcs[i0]) {
+			return false
+		}
+	}
+	if len(node.Dsts) != len(other.Dsts) || node.Dsts == nil != (other.Dsts == nil) {
+		return false
+	}
+	for i1, e1 = range node.Dsts {
+		if !e1.equalRef(other.Dsts[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *EntryOp) Clone() *EntryOp {
+	var c *runtime.Cloner
+	var clone *EntryOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &EntryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *EntryOp) clone(c *runtime.Cloner) *EntryOp {
+	var o interface{}
+	var clone *EntryOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*EntryOp)
+	}
+	clone = &EntryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *EntryOp) cloneFields(c *runtime.Cloner, clone *EntryOp) {
+}
+
+func (node *EntryOp) Equal(other *EntryOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *EntryOp) equal(c *runtime.Comparer, other *EntryOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *SwitchOp) Clone() *SwitchOp {
+	var c *runtime.Cloner
+	var clone *SwitchOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SwitchOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SwitchOp) clone(c *runtime.Cloner) *SwitchOp {
+	var o interface{}
+	var clone *SwitchOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SwitchOp)
+	}
+	clone = &SwitchOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SwitchOp) cloneFields(c *runtime.Cloner, clone *SwitchOp) {
+	clone.Cond = node.Cond.clone(c)
+}
+
+func (node *SwitchOp) Equal(other *SwitchOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SwitchOp) equal(c *runtime.Comparer, other *SwitchOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Cond.equalRef(other.Cond) {
+		return false
+	}
+	return true
+}
+
+func (node *ExitOp) Clone() *ExitOp {
+	var c *runtime.Cloner
+	var clone *ExitOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ExitOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ExitOp) clone(c *runtime.Cloner) *ExitOp {
+	var o interface{}
+	var clone *ExitOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ExitOp)
+	}
+	clone = &ExitOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ExitOp) cloneFields(c *runtime.Cloner, clone *ExitOp) {
+}
+
+func (node *ExitOp) Equal(other *ExitOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ExitOp) equal(c *runtime.Comparer, other *ExitOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *DubPackage) Clone() *DubPackage {
+	var c *runtime.Cloner
+	var clone *DubPackage
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &DubPackage{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DubPackage) clone(c *runtime.Cloner) *DubPackage {
+	var o interface{}
+	var clone *DubPackage
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*DubPackage)
+	}
+	clone = &DubPackage{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DubPackage) cloneFields(c *runtime.Cloner, clone *DubPackage) {
+	var s0 []string
+	var e0 string
+	var s1 []*core.StructType
+	var e1 *core.StructType
+	var s2 []*LLFunc
+	var e2 *LLFunc
+	var s3 []*tree.Test
+	var e3 *tree.Test
+	s0 = nil
+	if node.Path != nil {
+		s0 = []string{}
+		for _, e0 = range node.Path {
+			s0 = append(s0, e0)
+		}
+	}
+	clone.Path = s0
+	s1 = nil
+	if node.Structs != nil {
+		s1 = []*core.StructType{}
+		for _, e1 = range node.Structs {
+			s1 = append(s1, e1)
+		}
+	}
+	clone.Structs = s1
+	s2 = nil
+	if node.Funcs != nil {
+		s2 = []*LLFunc{}
+		for _, e2 = range node.Funcs {
+			s2 = append(s2, e2.clone(c))
+		}
+	}
+	clone.Funcs = s2
+	s3 = nil
+	if node.Tests != nil {
+		s3 = []*tree.Test{}
+		for _, e3 = range node.Tests {
+			s3 = append(s3, e3)
+		}
+	}
+	clone.Tests = s3
+}
+
+func (node *DubPackage) Equal(other *DubPackage) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *DubPackage) equal(c *runtime.Comparer, other *DubPackage) bool {
+	var i0 int
+	var e0 string
+	var i1 int
+	var e1 *core.StructType
+	var i2 int
+	var e2 *LLFunc
+	var i3 int
+	var e3 *tree.Test
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Path) != len(other.Path) || node.Path == nil != (other.Path == nil) {
+		return false
+	}
+	for i0, e0 = range node.Path {
+		if e0 != other.Path[i0] {
+			return false
+		}
+	}
+	if len(node.Structs) != len(other.Structs) || node.Structs == nil != (other.Structs == nil) {
+		return false
+	}
+	for i1, e1 = range node.Structs {
+		if e1 != other.Structs[i1] {
+			return false
+		}
+	}
+	if len(node.Funcs) != len(other.Funcs) || node.Funcs == nil != (other.Funcs == nil) {
+		return false
+	}
+	for i2, e2 = range node.Funcs {
+		if !e2.equal(c, other.Funcs[i2]) {
+			return false
+		}
+	}
+	if len(node.Tests) != len(other.Tests) || node.Tests == nil != (other.Tests == nil) {
+		return false
+	}
+	for i3, e3 = range node.Tests {
+		if e3 != other.Tests[i3] {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *DubProgram) Clone() *DubProgram {
+	var c *runtime.Cloner
+	var clone *DubProgram
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &DubProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DubProgram) clone(c *runtime.Cloner) *DubProgram {
+	var o interface{}
+	var clone *DubProgram
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*DubProgram)
+	}
+	clone = &DubProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DubProgram) cloneFields(c *runtime.Cloner, clone *DubProgram) {
+	var s0 []*DubPackage
+	var e0 *DubPackage
+	var s1 []*LLFunc
+	var e1 *LLFunc
+	clone.Core = node.Core
+	s0 = nil
+	if node.Packages != nil {
+		s0 = []*DubPackage{}
+		for _, e0 = range node.Packages {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Packages = s0
+	s1 = nil
+	if node.LLFuncs != nil {
+		s1 = []*LLFunc{}
+		for _, e1 = range node.LLFuncs {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.LLFuncs = s1
+}
+
+func (node *DubProgram) Equal(other *DubProgram) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *DubProgram) equal(c *runtime.Comparer, other *DubProgram) bool {
+	var i0 int
+	var e0 *DubPackage
+	var i1 int
+	var e1 *LLFunc
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Core != other.Core {
+		return false
+	}
+	if len(node.Packages) != len(other.Packages) || node.Packages == nil != (other.Packages == nil) {
+		return false
+	}
+	for i0, e0 = range node.Packages {
+		if !e0.equal(c, other.Packages[i0]) {
+			return false
+		}
+	}
+	if len(node.LLFuncs) != len(other.LLFuncs) || node.LLFuncs == nil != (other.LLFuncs == nil) {
+		return false
+	}
+	for i1, e1 = range node.LLFuncs {
+		if !e1.equal(c, other.LLFuncs[i1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,542 @@
+package flow
+
+import (
+	"evergreen/dub/core"
+	"evergreen/dub/runtime"
+	"evergreen/dub/tree"
+)
+
+func (node *RegisterInfo) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("RegisterInfo")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *RegisterInfo) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("RegisterInfo", int(node.Index))
+}
+
+func (node *RegisterInfo) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *LLFunc) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *RegisterInfo
+	var l1 *runtime.List
+	var e1 core.DubType
+	var l2 *runtime.List
+	var e2 DubOp
+	var l3 *runtime.List
+	var e3 int
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("LLFunc")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l0 = runtime.MakeList("[]RegisterInfo")
+	for _, e0 = range node.Params {
+		l0.Append(e0.DescribeRef())
+	}
+	d.AddField("Params", l0)
+	l1 = runtime.MakeList("[]DubType")
+	for _, e1 = range node.ReturnTypes {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("ReturnTypes", l1)
+	d.AddField("CFG", runtime.Describe(node.CFG))
+	l2 = runtime.MakeList("[]DubOp")
+	for _, e2 = range node.Ops {
+		l2.Append(runtime.Describe(e2))
+	}
+	d.AddField("Ops", l2)
+	l3 = runtime.MakeList("[]int")
+	for _, e3 = range node.Edges {
+		l3.Append(runtime.Describe(e3))
+	}
+	d.AddField("Edges", l3)
+	d.AddField("F", node.F.DescribeRef())
+	return d
+}
+
+func (node *LLFunc) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *CoerceOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("CoerceOp")
+	d.AddField("Src", node.Src.DescribeRef())
+	d.AddField("T", runtime.Describe(node.T))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *CoerceOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *CopyOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("CopyOp")
+	d.AddField("Src", node.Src.DescribeRef())
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *CopyOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantNilOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantNilOp")
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantNilOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantIntOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantIntOp")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantIntOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantFloat32Op) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantFloat32Op")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantFloat32Op) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantBoolOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantBoolOp")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantBoolOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantRuneOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantRuneOp")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantRuneOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantStringOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantStringOp")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantStringOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BinaryOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BinaryOp")
+	d.AddField("Left", node.Left.DescribeRef())
+	d.AddField("Op", runtime.Describe(node.Op))
+	d.AddField("Right", node.Right.DescribeRef())
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *BinaryOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *CallOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *RegisterInfo
+	var l1 *runtime.List
+	var e1 *RegisterInfo
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("CallOp")
+	d.AddField("Target", runtime.Describe(node.Target))
+	l0 = runtime.MakeList("[]RegisterInfo")
+	for _, e0 = range node.Args {
+		l0.Append(e0.DescribeRef())
+	}
+	d.AddField("Args", l0)
+	l1 = runtime.MakeList("[]RegisterInfo")
+	for _, e1 = range node.Dsts {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Dsts", l1)
+	return d
+}
+
+func (node *CallOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *KeyValue) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("KeyValue")
+	d.AddField("Key", runtime.Describe(node.Key))
+	d.AddField("Value", node.Value.DescribeRef())
+	return d
+}
+
+func (node *KeyValue) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstructOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *KeyValue
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstructOp")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]KeyValue")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstructOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstructListOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *RegisterInfo
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstructListOp")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]RegisterInfo")
+	for _, e = range node.Args {
+		l.Append(e.DescribeRef())
+	}
+	d.AddField("Args", l)
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstructListOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Checkpoint) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Checkpoint")
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *Checkpoint) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Recover) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Recover")
+	d.AddField("Src", node.Src.DescribeRef())
+	return d
+}
+
+func (node *Recover) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *LookaheadBegin) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("LookaheadBegin")
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *LookaheadBegin) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *LookaheadEnd) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("LookaheadEnd")
+	d.AddField("Failed", runtime.Describe(node.Failed))
+	d.AddField("Src", node.Src.DescribeRef())
+	return d
+}
+
+func (node *LookaheadEnd) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ReturnOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *RegisterInfo
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ReturnOp")
+	l = runtime.MakeList("[]RegisterInfo")
+	for _, e = range node.Exprs {
+		l.Append(e.DescribeRef())
+	}
+	d.AddField("Exprs", l)
+	return d
+}
+
+func (node *ReturnOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Fail) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("Fail")
+}
+
+func (node *Fail) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Peek) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Peek")
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *Peek) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Consume) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("Consume")
+}
+
+func (node *Consume) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TransferOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *RegisterInfo
+	var l1 *runtime.List
+	var e1 *RegisterInfo
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TransferOp")
+	l0 = runtime.MakeList("[]RegisterInfo")
+	for _, e0 = range node.Srcs {
+		l0.Append(e0.DescribeRef())
+	}
+	d.AddField("Srcs", l0)
+	l1 = runtime.MakeList("[]RegisterInfo")
+	for _, e1 = range node.Dsts {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Dsts", l1)
+	return d
+}
+
+func (node *TransferOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *EntryOp) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("EntryOp")
+}
+
+func (node *EntryOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SwitchOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SwitchOp")
+	d.AddField("Cond", node.Cond.DescribeRef())
+	return d
+}
+
+func (node *SwitchOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ExitOp) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("ExitOp")
+}
+
+func (node *ExitOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *DubPackage) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 string
+	var l1 *runtime.List
+	var e1 *core.StructType
+	var l2 *runtime.List
+	var e2 *LLFunc
+	var l3 *runtime.List
+	var e3 *tree.Test
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("DubPackage")
+	l0 = runtime.MakeList("[]string")
+	for _, e0 = range node.Path {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Path", l0)
+	l1 = runtime.MakeList("[]StructType")
+	for _, e1 = range node.Structs {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Structs", l1)
+	l2 = runtime.MakeList("[]LLFunc")
+	for _, e2 = range node.Funcs {
+		l2.Append(runtime.Describe(e2))
+	}
+	d.AddField("Funcs", l2)
+	l3 = runtime.MakeList("[]Test")
+	for _, e3 = range node.Tests {
+		l3.Append(runtime.Describe(e3))
+	}
+	d.AddField("Tests", l3)
+	return d
+}
+
+func (node *DubPackage) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *DubProgram) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *DubPackage
+	var l1 *runtime.List
+	var e1 *LLFunc
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("DubProgram")
+	d.AddField("Core", runtime.Describe(node.Core))
+	l0 = runtime.MakeList("[]DubPackage")
+	for _, e0 = range node.Packages {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Packages", l0)
+	l1 = runtime.MakeList("[]LLFunc")
+	for _, e1 = range node.LLFuncs {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("LLFuncs", l1)
+	return d
+}
+
+func (node *DubProgram) String() string {
+	return runtime.Format(node.Describe())
+}
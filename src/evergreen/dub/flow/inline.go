@@ -0,0 +1,250 @@
+package flow
+
+import (
+	"evergreen/compiler"
+	"evergreen/dub/core"
+	"evergreen/dub/tree"
+	"evergreen/graph"
+)
+
+// Functions with at most this many ops are inlined unless marked noinline.
+const InlineThreshold = 8
+
+const (
+	inlineUnvisited = iota
+	inlineVisiting
+	inlineDone
+)
+
+type inliner struct {
+	program *DubProgram
+	state   []int
+}
+
+// The number of ops in the body of a function, not counting the entry and
+// exit.
+func bodySize(f *LLFunc) int {
+	order, _ := graph.ReversePostorder(f.CFG)
+	size := 0
+	for _, n := range order {
+		switch f.Ops[n].(type) {
+		case *EntryOp, *ExitOp:
+		default:
+			size += 1
+		}
+	}
+	return size
+}
+
+func shouldInline(f *LLFunc) bool {
+	switch f.F.Inline {
+	case "inline":
+		return true
+	case "noinline":
+		return false
+	default:
+		return bodySize(f) <= InlineThreshold
+	}
+}
+
+func isPrivateType(t core.DubType) bool {
+	switch t := t.(type) {
+	case *core.StructType:
+		return !t.Exported
+	case *core.ListType:
+		return isPrivateType(t.Type)
+	case *core.TupleType:
+		for _, inner := range t.Types {
+			if isPrivateType(inner) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Reports whether the body of a function refers to functions, types or fields
+// its package does not export, in which case it cannot be inlined into another
+// package.
+func usesPrivateNames(f *LLFunc) bool {
+	for _, info := range f.RegisterInfo_Scope.objects {
+		if isPrivateType(info.T) {
+			return true
+		}
+	}
+	order, _ := graph.ReversePostorder(f.CFG)
+	for _, n := range order {
+		switch op := f.Ops[n].(type) {
+		case *CallOp:
+			if target, ok := op.Target.(*core.Function); ok && !target.Exported {
+				return true
+			}
+		case *ConstructOp:
+			if isPrivateType(op.Type) {
+				return true
+			}
+			for _, arg := range op.Args {
+				if !tree.IsExportedName(arg.Key) {
+					return true
+				}
+			}
+		case *ConstructListOp:
+			if isPrivateType(op.Type) {
+				return true
+			}
+		case *CoerceOp:
+			if isPrivateType(op.T) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Appends a sequence of ops after a node, returning the last node.
+func appendOps(decl *LLFunc, n graph.NodeID, ops []DubOp) graph.NodeID {
+	for _, op := range ops {
+		next := AllocNode(decl, op)
+		decl.CFG.ConnectEdge(n, AllocEdge(decl, NORMAL), next)
+		n = next
+	}
+	return n
+}
+
+// Replaces a call with a copy of the body of the function being called.
+// Arguments and return values are passed with copies, which SSI will
+// propagate.  Empty transfers are left behind where nothing needs to be
+// copied, and are removed by SSI like any other nop.
+func inlineCall(decl *LLFunc, n graph.NodeID, callee *LLFunc) bool {
+	g := decl.CFG
+	call := decl.Ops[n].(*CallOp)
+
+	// Where each flow leaving the callee continues in the caller.
+	targets := make([]graph.NodeID, NUM_FLOWS)
+	for i := range targets {
+		targets[i] = graph.NoNode
+	}
+	exits := []graph.EdgeID{}
+	eit := g.ExitIterator(n)
+	for eit.HasNext() {
+		e, dst := eit.GetNext()
+		targets[decl.Edges[e]] = dst
+		exits = append(exits, e)
+	}
+	it := callee.CFG.EntryIterator(callee.CFG.Exit())
+	for it.HasNext() {
+		_, e := it.GetNext()
+		if targets[EdgeTypeInfo[callee.Edges[e]].AsInlinedFlow] == graph.NoNode {
+			return false
+		}
+	}
+
+	body := callee.Clone()
+	for _, reg := range body.RegisterInfo_Scope.objects {
+		decl.RegisterInfo_Scope.Register(reg)
+	}
+	for _, e := range exits {
+		g.KillEdge(e)
+	}
+
+	stitcher := graph.MakeEdgeStitcher(body.CFG, g)
+	order, _ := graph.ReversePostorder(body.CFG)
+	for _, src := range order {
+		var tail graph.NodeID
+		switch op := body.Ops[src].(type) {
+		case *EntryOp:
+			decl.Ops[n] = &TransferOp{}
+			copies := make([]DubOp, len(body.Params))
+			for i, p := range body.Params {
+				copies[i] = &CopyOp{Src: call.Args[i], Dst: p}
+			}
+			tail = appendOps(decl, n, copies)
+		case *ExitOp:
+			continue
+		case *ReturnOp:
+			head := AllocNode(decl, &TransferOp{})
+			stitcher.MapIncomingEdges(src, head)
+			copies := []DubOp{}
+			for i, dst := range call.Dsts {
+				if dst != nil {
+					copies = append(copies, &CopyOp{Src: op.Exprs[i], Dst: dst})
+				}
+			}
+			tail = appendOps(decl, head, copies)
+		default:
+			tail = AllocNode(decl, op)
+			stitcher.MapIncomingEdges(src, tail)
+		}
+
+		eit := body.CFG.ExitIterator(src)
+		for eit.HasNext() {
+			e, dst := eit.GetNext()
+			flow := body.Edges[e]
+			if dst == body.CFG.Exit() {
+				flow = EdgeTypeInfo[flow].AsInlinedFlow
+				g.ConnectEdge(tail, AllocEdge(decl, flow), targets[flow])
+			} else {
+				translated := AllocEdge(decl, flow)
+				g.ConnectEdgeEntry(tail, translated)
+				stitcher.MapEdge(e, translated)
+			}
+		}
+	}
+	return true
+}
+
+func (inl *inliner) process(index int) {
+	inl.state[index] = inlineVisiting
+	decl := inl.program.LLFuncs[index]
+
+	// Inline into callees first, so their final size is known.
+	order, _ := graph.ReversePostorder(decl.CFG)
+	sites := []graph.NodeID{}
+	for _, n := range order {
+		call, ok := decl.Ops[n].(*CallOp)
+		if !ok {
+			continue
+		}
+		f, ok := call.Target.(*core.Function)
+		if !ok {
+			continue
+		}
+		if inl.state[f.Index] == inlineUnvisited {
+			inl.process(int(f.Index))
+		}
+		sites = append(sites, n)
+	}
+
+	for _, n := range sites {
+		f := decl.Ops[n].(*CallOp).Target.(*core.Function)
+		// Recursive calls are left alone.
+		if inl.state[f.Index] != inlineDone {
+			continue
+		}
+		callee := inl.program.LLFuncs[f.Index]
+		if f.File.Package != decl.F.File.Package && usesPrivateNames(callee) {
+			continue
+		}
+		if shouldInline(callee) {
+			inlineCall(decl, n, callee)
+		}
+	}
+	inl.state[index] = inlineDone
+}
+
+// Splices the bodies of small functions, and functions marked inline, into
+// their callers.  Runs before SSI.
+func InlineProgram(status compiler.PassStatus, program *DubProgram) {
+	status.Begin()
+	defer status.End()
+
+	inl := &inliner{
+		program: program,
+		state:   make([]int, len(program.LLFuncs)),
+	}
+	for i := range program.LLFuncs {
+		if inl.state[i] == inlineUnvisited {
+			inl.process(i)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package flow_test
+
+import (
+	"evergreen/compiler"
+	"evergreen/dub/core"
+	"evergreen/dub/flow"
+	"evergreen/dub/transform"
+	"evergreen/dub/transform/golang"
+	"evergreen/dub/tree"
+	gocore "evergreen/go/core"
+	goflow "evergreen/go/flow"
+	gotransform "evergreen/go/transform"
+	gotree "evergreen/go/tree"
+	"evergreen/graph"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func funcsByName(program *flow.DubProgram, status compiler.CompileStatus) map[string]*flow.LLFunc {
+	funcs := map[string]*flow.LLFunc{}
+	for _, f := range program.LLFuncs {
+		funcs[f.Name] = f
+	}
+	return funcs
+}
+
+// Counts the reachable calls to each function.
+func countCalls(f *flow.LLFunc) map[string]int {
+	calls := map[string]int{}
+	order, _ := graph.ReversePostorder(f.CFG)
+	for _, n := range order {
+		call, ok := f.Ops[n].(*flow.CallOp)
+		if !ok {
+			continue
+		}
+		if target, ok := call.Target.(*core.Function); ok {
+			calls[target.Name] += 1
+		}
+	}
+	return calls
+}
+
+func TestInlineHints(t *testing.T) {
+	funcs := funcsByName(lowerPlayground(t))
+	if hint := funcs["Digit"].F.Inline; hint != "inline" {
+		t.Errorf("expected Digit to be inline, got %#v", hint)
+	}
+	if hint := funcs["Twice"].F.Inline; hint != "noinline" {
+		t.Errorf("expected Twice to be noinline, got %#v", hint)
+	}
+	if hint := funcs["Add"].F.Inline; hint != "" {
+		t.Errorf("expected Add to have no hint, got %#v", hint)
+	}
+
+	calls := countCalls(funcs["TwoDigits"])
+	if calls["Digit"] != 0 {
+		t.Errorf("Digit was not inlined: %v", calls)
+	}
+	if calls["Twice"] != 1 {
+		t.Errorf("Twice should not be inlined: %v", calls)
+	}
+	// Small functions are inlined without a hint.
+	if calls := countCalls(funcs["FooProxy"]); calls["Foo"] != 0 {
+		t.Errorf("Foo was not inlined: %v", calls)
+	}
+}
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	for name, src := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// Writes the Go translation of a program into a GOPATH.
+func writeGo(t *testing.T, status compiler.CompileStatus, goFlowProg *goflow.FlowProgram, goCoreProg *gocore.CoreProgram, bypass *gotransform.TreeBypass, gopath string) {
+	goTreeProg := gotransform.FlowToTree(status.Pass("flow_to_tree"), goFlowProg, goCoreProg, bypass)
+	runner := compiler.CreateTaskRunner(1)
+	gotree.GoProgramBackend(status.Pass("go_backend"), goTreeProg, goCoreProg, filepath.Join(gopath, "src"), runner)
+	runner.Kill()
+	if status.ShouldHalt() {
+		t.Fatal("generating Go failed")
+	}
+}
+
+// Runs the go command with the generated code ahead of the real GOPATH.
+func runGo(t *testing.T, gopath string, args ...string) {
+	cmd := exec.Command("go", args...)
+	cmd.Env = append(os.Environ(), "GOPATH="+gopath+string(filepath.ListSeparator)+build.Default.GOPATH, "GO111MODULE=off")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%s\n%s", err, out)
+	}
+}
+
+// Generates Go from a dub program and checks that it builds.
+func buildGenerated(t *testing.T, program *flow.DubProgram, coreProg *core.CoreProgram, status compiler.CompileStatus, gopath string) {
+	goFlowProg, goCoreProg, bypass := golang.GenerateGo(status.Pass("dub_to_go"), program, coreProg, []string{"inlined"}, &golang.GenerateOptions{})
+	goflow.OptimizeProgram(status.Pass("optimize_go"), goFlowProg, goflow.O0)
+	writeGo(t, status, goFlowProg, goCoreProg, bypass, gopath)
+	runGo(t, gopath, "build", "inlined/...")
+}
+
+func TestInlineAcrossPackages(t *testing.T) {
+	dir, err := ioutil.TempDir("", "inline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFiles(t, dir, map[string]string{
+		"dub/proxy.dub": `import (
+  "sub"
+)
+
+func Proxy() int {
+  return sub.bar()
+}
+
+func SmallProxy() int {
+  return sub.Small()
+}
+
+func BoxProxy() sub.Box {
+  return sub.Make()
+}
+
+func PairProxy() sub.Pair {
+  return sub.MakePair()
+}
+`,
+		"dub/sub/sub.dub": `noinline func helper() int {
+  return 4
+}
+
+export func bar() int {
+  return helper() + 1
+}
+
+func Small() int {
+  return 3
+}
+
+struct Box {
+  value int
+}
+
+func Make() Box {
+  return Box{value: 7}
+}
+
+struct Pair {
+  Left int
+  Right int
+}
+
+func MakePair() Pair {
+  return Pair{Left: 1, Right: 2}
+}
+`,
+	})
+
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	program, coreProg := tree.DubProgramFrontend(status.Pass("dub_frontend"), p, filepath.Join(dir, "dub"))
+	if status.ShouldHalt() {
+		t.Fatal("frontend failed")
+	}
+	flowProgram := transform.LowerProgram(status.Pass("lower"), program, coreProg)
+	flow.TrimFlow(status.Pass("trim_flow"), flowProgram)
+
+	// bar calls a function sub does not export, so it stays in sub.
+	funcs := funcsByName(flowProgram, status)
+	if calls := countCalls(funcs["Proxy"]); calls["bar"] != 1 || calls["helper"] != 0 {
+		t.Errorf("bar should not be inlined: %v", calls)
+	}
+	if calls := countCalls(funcs["SmallProxy"]); calls["Small"] != 0 {
+		t.Errorf("Small was not inlined: %v", calls)
+	}
+	// Box has a field sub does not export.
+	if calls := countCalls(funcs["BoxProxy"]); calls["Make"] != 1 {
+		t.Errorf("Make should not be inlined: %v", calls)
+	}
+	if calls := countCalls(funcs["PairProxy"]); calls["MakePair"] != 0 {
+		t.Errorf("MakePair was not inlined: %v", calls)
+	}
+
+	buildGenerated(t, flowProgram, coreProg, status, filepath.Join(dir, "go"))
+}
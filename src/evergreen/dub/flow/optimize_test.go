@@ -0,0 +1,57 @@
+package flow_test
+
+import (
+	"evergreen/dub/flow"
+	"evergreen/dub/transform/golang"
+	goflow "evergreen/go/flow"
+	"evergreen/graph"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func countReachableOps(prog *goflow.FlowProgram) int {
+	count := 0
+	iter := prog.FlowFunc_Scope.Iter()
+	for iter.Next() {
+		_, decl := iter.Value()
+		order, _ := graph.ReversePostorder(decl.CFG)
+		count += len(order)
+	}
+	return count
+}
+
+// Marks the structs other structs implement, as egc does before translating.
+func markParents(program *flow.DubProgram) {
+	for _, dubPkg := range program.Packages {
+		for _, s := range dubPkg.Structs {
+			if s.Implements != nil {
+				s.Implements.IsParent = true
+			}
+		}
+	}
+}
+
+// Optimizes the Go translation of the evergreen sources and runs the dub tests
+// against it.
+func TestOptimizeEvergreen(t *testing.T) {
+	dir, err := ioutil.TempDir("", "optimize")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	flowProgram, status := lowerEvergreen(t)
+	markParents(flowProgram)
+	goFlowProg, goCoreProg, bypass := golang.GenerateGo(status.Pass("dub_to_go"), flowProgram, flowProgram.Core, []string{"optimized"}, &golang.GenerateOptions{Tests: true})
+
+	before := countReachableOps(goFlowProg)
+	goflow.OptimizeProgram(status.Pass("optimize_go"), goFlowProg, goflow.O2)
+	after := countReachableOps(goFlowProg)
+	if after >= before {
+		t.Errorf("expected fewer ops, got %d before and %d after", before, after)
+	}
+
+	writeGo(t, status, goFlowProg, goCoreProg, bypass, dir)
+	runGo(t, dir, "test", "optimized/...")
+}
@@ -81,6 +81,13 @@ func collectDefUse(decl *LLFunc, node graph.NodeID, op DubOp, defuse *ssi.DefUse
 			addUse(arg, node, defuse)
 		}
 		addDef(op.Dst, node, defuse)
+	case *TransferOp:
+		for _, src := range op.Srcs {
+			addUse(src, node, defuse)
+		}
+		for _, dst := range op.Dsts {
+			addDef(dst, node, defuse)
+		}
 	default:
 		panic(op)
 	}
@@ -349,6 +356,7 @@ func killUnusedOutputs(n graph.NodeID, op DubOp, live ssi.LivenessOracle) {
 		if deadAtExit(live, n, op.Dst) {
 			op.Dst = nil
 		}
+	case *TransferOp:
 	default:
 		panic(op)
 	}
@@ -0,0 +1,60 @@
+package flow_test
+
+import (
+	"evergreen/dub/transform/golang"
+	"evergreen/go/transform"
+	"evergreen/go/tree"
+	"testing"
+)
+
+func countGotos(block *tree.Block) int {
+	if block == nil {
+		return 0
+	}
+	count := 0
+	for _, stmt := range block.Body {
+		switch stmt := stmt.(type) {
+		case *tree.Goto:
+			count += 1
+		case *tree.If:
+			count += countGotos(stmt.T) + countGotos(stmt.F)
+		case *tree.For:
+			count += countGotos(stmt.Block)
+		case *tree.Range:
+			count += countGotos(stmt.Block)
+		case *tree.BlockStmt:
+			count += countGotos(stmt.Block)
+		case *tree.TypeSwitch:
+			for _, c := range stmt.Cases {
+				count += countGotos(c.Block)
+			}
+			count += countGotos(stmt.Default)
+		case *tree.Switch:
+			for _, c := range stmt.Cases {
+				count += countGotos(c.Block)
+			}
+			count += countGotos(stmt.Default)
+		}
+	}
+	return count
+}
+
+func TestNoGoto(t *testing.T) {
+	flowProgram, status := lowerEvergreen(t)
+	goFlowProg, goCoreProg, bypass := golang.GenerateGo(status.Pass("dub_to_go"), flowProgram, flowProgram.Core, []string{"evergreen"}, &golang.GenerateOptions{Tests: true})
+	prog := transform.FlowToTree(status.Pass("flow_to_tree"), goFlowProg, goCoreProg, bypass)
+
+	for _, pkg := range prog.Packages {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				f, ok := decl.(*tree.FuncDecl)
+				if !ok {
+					continue
+				}
+				if n := countGotos(f.Block); n != 0 {
+					t.Errorf("%s: %d gotos", f.Name, n)
+				}
+			}
+		}
+	}
+}
@@ -6,56 +6,94 @@ import (
 	"evergreen/graph"
 )
 
-func TrimFlow(status compiler.PassStatus, program *DubProgram) {
-	status.Begin()
-	defer status.End()
-
-	// TODO use whole-program analysis to agressively find dead flows.
-	lut := map[core.Function_Ref]int{}
+// Can a call produce the given flow, based on what is currently known about
+// the functions it may call?
+func callHasFlow(op *CallOp, flow int, exitFlows [][]bool) bool {
+	switch c := op.Target.(type) {
+	case *core.Function:
+		return exitFlows[c.Index][flow]
+	case *core.IntrinsicFunction:
+		// Intrinsics never fail.
+		return flow == NORMAL
+	default:
+		return true
+	}
+}
 
-	flows := make([][]bool, NUM_FLOWS)
-	for i := 0; i < NUM_FLOWS; i++ {
-		flows[i] = make([]bool, len(program.LLFuncs))
+// Walks the edges of a function that can be taken, and records the flows that
+// can leave it.  Returns true if new flows were found.
+func updateExitFlows(f *LLFunc, flows []bool, exitFlows [][]bool) bool {
+	g := f.CFG
+	changed := false
+	visited := make([]bool, g.NumNodes())
+	pending := []graph.NodeID{g.Entry()}
+	visited[g.Entry()] = true
+	for len(pending) > 0 {
+		n := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		call, isCall := f.Ops[n].(*CallOp)
+		eit := g.ExitIterator(n)
+		for eit.HasNext() {
+			e, dst := eit.GetNext()
+			flow := f.Edges[e]
+			if isCall && !callHasFlow(call, flow, exitFlows) {
+				continue
+			}
+			if dst == g.Exit() {
+				exitFlow := EdgeTypeInfo[flow].AsInlinedFlow
+				if !flows[exitFlow] {
+					flows[exitFlow] = true
+					changed = true
+				}
+			} else if !visited[dst] {
+				visited[dst] = true
+				pending = append(pending, dst)
+			}
+		}
 	}
+	return changed
+}
 
-	// Find the exit flows of every function.
-	for i, f := range program.LLFuncs {
-		lut[f.F.Index] = i
-		it := f.CFG.EntryIterator(f.CFG.Exit())
-		for it.HasNext() {
-			_, e := it.GetNext()
-			exitFlow := f.Edges[e]
-			localFlow := EdgeTypeInfo[exitFlow].AsInlinedFlow
-			flows[localFlow][i] = true
+// Finds the flows that can leave each function.  Every function starts out
+// assumed to produce no flows, and the assumptions are relaxed until they hold
+// for every function, so a set of mutually recursive functions is not assumed
+// to fail just because it calls itself.
+func findExitFlows(program *DubProgram) [][]bool {
+	exitFlows := make([][]bool, len(program.LLFuncs))
+	for i := range exitFlows {
+		exitFlows[i] = make([]bool, NUM_FLOWS)
+	}
+	changed := true
+	for changed {
+		changed = false
+		for i, f := range program.LLFuncs {
+			if updateExitFlows(f, exitFlows[i], exitFlows) {
+				changed = true
+			}
 		}
 	}
+	return exitFlows
+}
 
-	// For each call site, kill edges that will not be taken in practice.
+// Kills the edges leaving calls for flows the callee cannot produce.
+func TrimFlow(status compiler.PassStatus, program *DubProgram) {
+	status.Begin()
+	defer status.End()
+
+	exitFlows := findExitFlows(program)
 	for _, f := range program.LLFuncs {
 		g := f.CFG
 		for node, op := range f.Ops {
-			switch op := op.(type) {
-			case *CallOp:
-				var tgt int
-				switch c := op.Target.(type) {
-				case *core.Function:
-					var ok bool
-					tgt, ok = lut[c.Index]
-					if !ok {
-						panic(c)
-					}
-					iter := g.ExitIterator(graph.NodeID(node))
-					for iter.HasNext() {
-						e, _ := iter.GetNext()
-						possible := flows[f.Edges[e]][tgt]
-						if !possible {
-							g.KillEdge(e)
-						}
-					}
-				default:
-					// TODO trim other callable types?
+			call, ok := op.(*CallOp)
+			if !ok {
+				continue
+			}
+			iter := g.ExitIterator(graph.NodeID(node))
+			for iter.HasNext() {
+				e, _ := iter.GetNext()
+				if !callHasFlow(call, f.Edges[e], exitFlows) {
+					g.KillEdge(e)
 				}
-			default:
 			}
 		}
 	}
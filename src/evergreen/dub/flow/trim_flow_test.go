@@ -0,0 +1,33 @@
+package flow_test
+
+import (
+	"evergreen/dub/flow"
+	"testing"
+)
+
+// Can the function exit with a failure?
+func exitsWithFail(f *flow.LLFunc) bool {
+	it := f.CFG.EntryIterator(f.CFG.Exit())
+	for it.HasNext() {
+		_, e := it.GetNext()
+		if f.Edges[e] == flow.FAIL {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTrimFlow(t *testing.T) {
+	funcs := funcsByName(lowerPlayground(t))
+
+	// Calls to functions that cannot fail are assumed to succeed, even
+	// when the functions are mutually recursive.
+	for _, name := range []string{"Twice", "IsEven", "IsOdd"} {
+		if exitsWithFail(funcs[name]) {
+			t.Errorf("%s should not fail", name)
+		}
+	}
+	if !exitsWithFail(funcs["TwoDigits"]) {
+		t.Error("TwoDigits should fail")
+	}
+}
@@ -0,0 +1,364 @@
+package runtime
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Support for the binary encoders and decoders generated for dub structures.
+//
+// A snapshot starts with a header naming the format version and the schema
+// the snapshot was written with.  The schema is a fingerprint of the layout
+// of every structure reachable from the root, so a decoder generated from
+// different dub sources rejects the snapshot instead of misreading it.
+//
+// Structures are encoded by identity.  The first time an object is written
+// it is given an ID and its fields follow.  Later references to the same
+// object, including references to scoped objects and cycles, are written as
+// the ID.  Scopes are written as lists of their objects, which keep their
+// indexes.
+
+const BinaryFormatVersion = 1
+
+const binaryMagic = "dub\x00"
+
+// Object tags.
+const (
+	binaryNil = iota
+	binaryRef
+	binaryObject
+)
+
+type BinaryEncoder struct {
+	data    []byte
+	objects map[interface{}]uint64
+	types   map[string]uint64
+}
+
+func (e *BinaryEncoder) WriteUvarint(v uint64) {
+	e.data = binary.AppendUvarint(e.data, v)
+}
+
+func (e *BinaryEncoder) WriteVarint(v int64) {
+	e.data = binary.AppendVarint(e.data, v)
+}
+
+func (e *BinaryEncoder) WriteString(v string) {
+	e.WriteUvarint(uint64(len(v)))
+	e.data = append(e.data, v...)
+}
+
+func (e *BinaryEncoder) WriteBool(v bool) {
+	if v {
+		e.data = append(e.data, 1)
+	} else {
+		e.data = append(e.data, 0)
+	}
+}
+
+func (e *BinaryEncoder) WriteInt(v int) {
+	e.WriteVarint(int64(v))
+}
+
+func (e *BinaryEncoder) WriteInt64(v int64) {
+	e.WriteVarint(v)
+}
+
+// Not named WriteRune, which conventionally has a different signature.
+func (e *BinaryEncoder) WriteRuneValue(v rune) {
+	e.WriteVarint(int64(v))
+}
+
+func (e *BinaryEncoder) WriteUint32(v uint32) {
+	e.WriteUvarint(uint64(v))
+}
+
+func (e *BinaryEncoder) WriteFloat32(v float32) {
+	e.data = binary.LittleEndian.AppendUint32(e.data, math.Float32bits(v))
+}
+
+// WriteNil writes a missing object or list.
+func (e *BinaryEncoder) WriteNil() {
+	e.WriteUvarint(binaryNil)
+}
+
+// WriteLength starts a list with n elements.
+func (e *BinaryEncoder) WriteLength(n int) {
+	e.WriteUvarint(uint64(n) + 1)
+}
+
+// WriteObject starts an object.  It returns true if the object has not been
+// written before, in which case the caller must write its fields.
+func (e *BinaryEncoder) WriteObject(object interface{}, typeName string) bool {
+	id, ok := e.objects[object]
+	if ok {
+		e.WriteUvarint(binaryRef)
+		e.WriteUvarint(id)
+		return false
+	}
+	e.objects[object] = uint64(len(e.objects))
+	e.WriteUvarint(binaryObject)
+	// Type names are written once, and referred to by number afterwards.
+	t, ok := e.types[typeName]
+	if ok {
+		e.WriteUvarint(t)
+	} else {
+		t = uint64(len(e.types))
+		e.types[typeName] = t
+		e.WriteUvarint(t)
+		e.WriteString(typeName)
+	}
+	return true
+}
+
+// WriteOpaque writes a value dub does not look inside of, such as a graph.
+func (e *BinaryEncoder) WriteOpaque(v encoding.BinaryMarshaler) {
+	data, err := v.MarshalBinary()
+	if err != nil {
+		panic(err)
+	}
+	e.WriteUvarint(uint64(len(data)))
+	e.data = append(e.data, data...)
+}
+
+// BinaryEncodable is implemented by every generated dub structure.  The
+// method may be called on a nil pointer.
+type BinaryEncodable interface {
+	EncodeBinary(e *BinaryEncoder)
+}
+
+func EncodeBinary(e *BinaryEncoder, v interface{}) {
+	if v == nil {
+		e.WriteNil()
+		return
+	}
+	v.(BinaryEncodable).EncodeBinary(e)
+}
+
+func MarshalBinary(root BinaryEncodable, schema string) ([]byte, error) {
+	e := &BinaryEncoder{
+		objects: map[interface{}]uint64{},
+		types:   map[string]uint64{},
+	}
+	e.data = append(e.data, binaryMagic...)
+	e.WriteUvarint(BinaryFormatVersion)
+	e.WriteString(schema)
+	root.EncodeBinary(e)
+	return e.data, nil
+}
+
+type BinaryDecoder struct {
+	data    []byte
+	offset  int
+	objects []interface{}
+	names   []string
+	types   []string
+	pending string
+	err     error
+}
+
+// Fail records the first error found while decoding.  Once decoding fails
+// every read returns a zero value.
+func (d *BinaryDecoder) Fail(message string) {
+	if d.err == nil {
+		d.err = fmt.Errorf("offset %d: %s", d.offset, message)
+	}
+}
+
+func (d *BinaryDecoder) Err() error {
+	return d.err
+}
+
+func (d *BinaryDecoder) ReadUvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data[d.offset:])
+	if n <= 0 {
+		d.Fail("truncated snapshot")
+		return 0
+	}
+	d.offset += n
+	return v
+}
+
+func (d *BinaryDecoder) ReadVarint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data[d.offset:])
+	if n <= 0 {
+		d.Fail("truncated snapshot")
+		return 0
+	}
+	d.offset += n
+	return v
+}
+
+func (d *BinaryDecoder) readBytes(n uint64) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.data)-d.offset) {
+		d.Fail("truncated snapshot")
+		return nil
+	}
+	b := d.data[d.offset : d.offset+int(n)]
+	d.offset += int(n)
+	return b
+}
+
+func (d *BinaryDecoder) ReadString() string {
+	return string(d.readBytes(d.ReadUvarint()))
+}
+
+func (d *BinaryDecoder) ReadBool() bool {
+	b := d.readBytes(1)
+	if b == nil {
+		return false
+	}
+	if b[0] > 1 {
+		d.Fail(fmt.Sprintf("bad bool %d", b[0]))
+	}
+	return b[0] == 1
+}
+
+func (d *BinaryDecoder) signed(bits uint) int64 {
+	v := d.ReadVarint()
+	if v < -1<<(bits-1) || v > 1<<(bits-1)-1 {
+		d.Fail(fmt.Sprintf("%d does not fit in %d bits", v, bits))
+		return 0
+	}
+	return v
+}
+
+func (d *BinaryDecoder) ReadInt() int {
+	return int(d.signed(strconv.IntSize))
+}
+
+func (d *BinaryDecoder) ReadInt64() int64 {
+	return d.ReadVarint()
+}
+
+func (d *BinaryDecoder) ReadRuneValue() rune {
+	return rune(d.signed(32))
+}
+
+func (d *BinaryDecoder) ReadUint32() uint32 {
+	v := d.ReadUvarint()
+	if v > math.MaxUint32 {
+		d.Fail(fmt.Sprintf("%d does not fit in a uint32", v))
+		return 0
+	}
+	return uint32(v)
+}
+
+func (d *BinaryDecoder) ReadFloat32() float32 {
+	b := d.readBytes(4)
+	if b == nil {
+		return 0
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(b))
+}
+
+// ReadLength starts a list and returns the number of elements, or -1 if the
+// list is nil.
+func (d *BinaryDecoder) ReadLength() int {
+	v := d.ReadUvarint()
+	// Every element takes at least one byte.
+	if v > uint64(len(d.data)-d.offset)+1 {
+		d.Fail(fmt.Sprintf("list of %d elements is too long", v-1))
+		return -1
+	}
+	return int(v) - 1
+}
+
+// ReadObject starts an object and returns the name of its type.  If the
+// object was decoded before it is also returned.  Otherwise the caller must
+// allocate the object, Register it, and decode its fields.  Nil objects have
+// no type.
+func (d *BinaryDecoder) ReadObject() (interface{}, string) {
+	switch tag := d.ReadUvarint(); tag {
+	case binaryNil:
+		return nil, ""
+	case binaryRef:
+		id := d.ReadUvarint()
+		if id >= uint64(len(d.objects)) {
+			d.Fail(fmt.Sprintf("bad object reference %d", id))
+			return nil, ""
+		}
+		return d.objects[id], d.names[id]
+	case binaryObject:
+		t := d.ReadUvarint()
+		if t == uint64(len(d.types)) {
+			d.types = append(d.types, d.ReadString())
+		} else if t > uint64(len(d.types)) {
+			d.Fail(fmt.Sprintf("bad type reference %d", t))
+			return nil, ""
+		}
+		if d.err != nil {
+			return nil, ""
+		}
+		d.pending = d.types[t]
+		return nil, d.pending
+	default:
+		d.Fail(fmt.Sprintf("bad object tag %d", tag))
+		return nil, ""
+	}
+}
+
+// Register gives the object announced by ReadObject its ID, so the fields of
+// the object can refer back to it.
+func (d *BinaryDecoder) Register(object interface{}) {
+	d.objects = append(d.objects, object)
+	d.names = append(d.names, d.pending)
+	d.pending = ""
+}
+
+func (d *BinaryDecoder) ReadOpaque(v encoding.BinaryUnmarshaler) {
+	data := d.readBytes(d.ReadUvarint())
+	if d.err != nil {
+		return
+	}
+	err := v.UnmarshalBinary(data)
+	if err != nil {
+		d.Fail(err.Error())
+	}
+}
+
+// BinaryDecodable is implemented by every generated dub structure.
+type BinaryDecodable interface {
+	DecodeBinary(d *BinaryDecoder)
+}
+
+func UnmarshalBinary(data []byte, schema string, typeName string, root BinaryDecodable) error {
+	if len(data) < len(binaryMagic) || string(data[:len(binaryMagic)]) != binaryMagic {
+		return errors.New("not a dub snapshot")
+	}
+	d := &BinaryDecoder{data: data, offset: len(binaryMagic)}
+	version := d.ReadUvarint()
+	if d.err == nil && version != BinaryFormatVersion {
+		return fmt.Errorf("unsupported snapshot format version %d", version)
+	}
+	actual := d.ReadString()
+	if d.err == nil && actual != schema {
+		return fmt.Errorf("snapshot schema %s does not match %s", actual, schema)
+	}
+	_, t := d.ReadObject()
+	if d.err == nil && t != typeName {
+		if t == "" {
+			return errors.New("snapshot is empty")
+		}
+		return fmt.Errorf("expected %s but got %s", typeName, t)
+	}
+	d.Register(root)
+	root.DecodeBinary(d)
+	if d.err == nil && d.offset != len(d.data) {
+		d.Fail("trailing data")
+	}
+	return d.err
+}
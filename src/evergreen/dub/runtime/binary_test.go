@@ -0,0 +1,90 @@
+package runtime
+
+import (
+	"evergreen/assert"
+	"testing"
+)
+
+// A hand written equivalent of a generated structure.
+type pair struct {
+	Name  string
+	Value int64
+	Other *pair
+}
+
+func (node *pair) EncodeBinary(e *BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "pair") {
+		return
+	}
+	e.WriteString(node.Name)
+	e.WriteInt64(node.Value)
+	node.Other.EncodeBinary(e)
+}
+
+func (node *pair) DecodeBinary(d *BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Value = d.ReadInt64()
+	o, t := d.ReadObject()
+	switch t {
+	case "":
+	case "pair":
+		if o != nil {
+			node.Other = o.(*pair)
+		} else {
+			node.Other = &pair{}
+			d.Register(node.Other)
+			node.Other.DecodeBinary(d)
+		}
+	default:
+		d.Fail("unexpected " + t)
+	}
+}
+
+func TestBinaryCycle(t *testing.T) {
+	a := &pair{Name: "a", Value: -1 << 40}
+	a.Other = &pair{Name: "b", Other: a}
+	data, err := MarshalBinary(a, "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &pair{}
+	err = UnmarshalBinary(data, "schema", "pair", decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, decoded.Other.Name, "b")
+	if decoded.Value != a.Value || decoded.Other.Other != decoded {
+		t.Fatalf("bad decode %#v", decoded)
+	}
+}
+
+func TestBinaryHeader(t *testing.T) {
+	data, err := MarshalBinary(&pair{}, "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &pair{}
+	err = UnmarshalBinary(data, "other", "pair", decoded)
+	assert.StringEquals(t, err.Error(), "snapshot schema schema does not match other")
+	err = UnmarshalBinary(data, "schema", "thing", decoded)
+	assert.StringEquals(t, err.Error(), "expected thing but got pair")
+	err = UnmarshalBinary([]byte("{}"), "schema", "pair", decoded)
+	assert.StringEquals(t, err.Error(), "not a dub snapshot")
+	err = UnmarshalBinary(data[:len(data)-1], "schema", "pair", decoded)
+	assert.StringEquals(t, err.Error(), "offset 21: truncated snapshot")
+
+	data[len(binaryMagic)] = BinaryFormatVersion + 1
+	err = UnmarshalBinary(data, "schema", "pair", decoded)
+	assert.StringEquals(t, err.Error(), "unsupported snapshot format version 2")
+
+	data, err = MarshalBinary((*pair)(nil), "schema")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = UnmarshalBinary(data, "schema", "pair", decoded)
+	assert.StringEquals(t, err.Error(), "snapshot is empty")
+}
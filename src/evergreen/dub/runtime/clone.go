@@ -0,0 +1,45 @@
+package runtime
+
+// Support for the Clone and Equal methods generated for dub structures.
+
+// Cloner remembers the copy made of every object, so objects that are shared
+// or form cycles are copied once.  Scoped objects are only copied along with
+// their scope, references to scoped objects that were not copied are left
+// pointing at the original.
+type Cloner struct {
+	copies map[interface{}]interface{}
+}
+
+func MakeCloner() *Cloner {
+	return &Cloner{copies: map[interface{}]interface{}{}}
+}
+
+func (c *Cloner) Map(original interface{}, clone interface{}) {
+	c.copies[original] = clone
+}
+
+// Lookup returns the copy of an object, or nil if it has not been copied.
+func (c *Cloner) Lookup(original interface{}) interface{} {
+	return c.copies[original]
+}
+
+// Comparer remembers the pairs of objects that are being compared.
+type Comparer struct {
+	pairs map[[2]interface{}]bool
+}
+
+func MakeComparer() *Comparer {
+	return &Comparer{pairs: map[[2]interface{}]bool{}}
+}
+
+// Assume records that a and b are being compared, and reports if they already
+// were.  A pair that is already being compared is assumed to be equal, so
+// comparing cycles terminates.
+func (c *Comparer) Assume(a interface{}, b interface{}) bool {
+	key := [2]interface{}{a, b}
+	if c.pairs[key] {
+		return true
+	}
+	c.pairs[key] = true
+	return false
+}
@@ -0,0 +1,230 @@
+package runtime
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Generated code describes every dub structure in terms of Values so that
+// structures can be printed in the same syntax dub tests use to destructure
+// them, and so that they can be compared structurally.
+
+type Value interface {
+	isValue()
+}
+
+// Atom is a literal, nil, or a reference to a scoped object.
+type Atom struct {
+	Text string
+}
+
+func (v *Atom) isValue() {
+}
+
+type Field struct {
+	Name  string
+	Value Value
+}
+
+type Struct struct {
+	Name   string
+	Fields []*Field
+}
+
+func (v *Struct) isValue() {
+}
+
+func (v *Struct) AddField(name string, value Value) {
+	v.Fields = append(v.Fields, MakeField(name, value))
+}
+
+func (v *Struct) GetField(name string) Value {
+	for _, f := range v.Fields {
+		if f.Name == name {
+			return f.Value
+		}
+	}
+	return nil
+}
+
+type List struct {
+	Type     string
+	Elements []Value
+}
+
+func (v *List) isValue() {
+}
+
+func (v *List) Append(value Value) {
+	v.Elements = append(v.Elements, value)
+}
+
+func MakeField(name string, value Value) *Field {
+	return &Field{Name: name, Value: value}
+}
+
+func MakeStruct(name string, fields ...*Field) *Struct {
+	return &Struct{Name: name, Fields: fields}
+}
+
+func MakeList(t string, elements ...Value) *List {
+	return &List{Type: t, Elements: elements}
+}
+
+// Ref describes a reference to a scoped object.  Scoped objects are not
+// described in place because they are shared, and may refer back to the
+// object that refers to them.
+func Ref(name string, index int) Value {
+	return &Atom{Text: fmt.Sprintf("%s#%d", name, index)}
+}
+
+// Describable is implemented by every generated dub structure.
+type Describable interface {
+	Describe() Value
+}
+
+// Describe converts a dub value into its description.
+func Describe(v interface{}) Value {
+	switch v := v.(type) {
+	case nil:
+		return &Atom{Text: "nil"}
+	case Describable:
+		return v.Describe()
+	case string:
+		return &Atom{Text: strconv.Quote(v)}
+	case rune:
+		return &Atom{Text: strconv.QuoteRune(v)}
+	case bool:
+		return &Atom{Text: strconv.FormatBool(v)}
+	case int:
+		return &Atom{Text: strconv.Itoa(v)}
+	case int64:
+		return &Atom{Text: strconv.FormatInt(v, 10)}
+	case uint32:
+		return &Atom{Text: strconv.FormatUint(uint64(v), 10)}
+	case float32:
+		return &Atom{Text: strconv.FormatFloat(float64(v), 'g', -1, 32)}
+	default:
+		// Opaque values, such as graphs.
+		return &Atom{Text: fmt.Sprintf("%T", v)}
+	}
+}
+
+func formatValue(v Value, indent int, buf *bytes.Buffer) {
+	nested := strings.Repeat("  ", indent+1)
+	switch v := v.(type) {
+	case nil:
+		// A field the other description does not have.
+		buf.WriteString("<missing>")
+	case *Atom:
+		buf.WriteString(v.Text)
+	case *Struct:
+		buf.WriteString(v.Name)
+		buf.WriteString("{")
+		if len(v.Fields) == 0 {
+			buf.WriteString("}")
+			return
+		}
+		buf.WriteString("\n")
+		for _, f := range v.Fields {
+			buf.WriteString(nested)
+			buf.WriteString(f.Name)
+			buf.WriteString(": ")
+			formatValue(f.Value, indent+1, buf)
+			buf.WriteString("\n")
+		}
+		buf.WriteString(nested[:len(nested)-2])
+		buf.WriteString("}")
+	case *List:
+		buf.WriteString(v.Type)
+		buf.WriteString("{")
+		if len(v.Elements) == 0 {
+			buf.WriteString("}")
+			return
+		}
+		buf.WriteString("\n")
+		for _, e := range v.Elements {
+			buf.WriteString(nested)
+			formatValue(e, indent+1, buf)
+			buf.WriteString("\n")
+		}
+		buf.WriteString(nested[:len(nested)-2])
+		buf.WriteString("}")
+	default:
+		panic(v)
+	}
+}
+
+// Format renders a description with one field or list element per line.
+func Format(v Value) string {
+	var buf bytes.Buffer
+	formatValue(v, 0, &buf)
+	return buf.String()
+}
+
+// Difference is the first place where two descriptions disagree.
+type Difference struct {
+	Path     string
+	Expected Value
+	Actual   Value
+}
+
+func (d *Difference) String() string {
+	text := fmt.Sprintf("expected %s, got %s", Format(d.Expected), Format(d.Actual))
+	if d.Path != "" {
+		text += " at path " + d.Path
+	}
+	return text
+}
+
+func fieldPath(path string, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+func diffValue(path string, expected Value, actual Value) *Difference {
+	mismatch := &Difference{Path: path, Expected: expected, Actual: actual}
+	switch expected := expected.(type) {
+	case *Atom:
+		other, ok := actual.(*Atom)
+		if !ok || other.Text != expected.Text {
+			return mismatch
+		}
+	case *Struct:
+		other, ok := actual.(*Struct)
+		if !ok || other.Name != expected.Name {
+			return mismatch
+		}
+		for _, f := range expected.Fields {
+			d := diffValue(fieldPath(path, f.Name), f.Value, other.GetField(f.Name))
+			if d != nil {
+				return d
+			}
+		}
+	case *List:
+		other, ok := actual.(*List)
+		if !ok || len(other.Elements) != len(expected.Elements) {
+			return mismatch
+		}
+		for i, e := range expected.Elements {
+			d := diffValue(fmt.Sprintf("%s[%d]", path, i), e, other.Elements[i])
+			if d != nil {
+				return d
+			}
+		}
+	default:
+		panic(expected)
+	}
+	return nil
+}
+
+// Diff compares actual against expected and returns nil if they match.
+// Fields missing from an expected struct are not compared, so expected may be
+// a partial description such as the destructure in a dub test.
+func Diff(expected Value, actual Value) *Difference {
+	return diffValue("", expected, actual)
+}
@@ -0,0 +1,67 @@
+package runtime
+
+import (
+	"evergreen/assert"
+	"testing"
+)
+
+func describeDecl(name string) Value {
+	return MakeStruct("Decl",
+		MakeField("Name", MakeStruct("Id", MakeField("Text", Describe(name)))),
+		MakeField("Pos", Describe(7)),
+	)
+}
+
+func TestFormat(t *testing.T) {
+	v := MakeStruct("File",
+		MakeField("Decls", MakeList("[]Decl", describeDecl("foo"), Describe(nil))),
+		MakeField("Imports", MakeList("[]Import")),
+		MakeField("Rune", Describe('x')),
+		MakeField("Package", Ref("Package", 2)),
+	)
+	assert.StringEquals(t, Format(v), `File{
+  Decls: []Decl{
+    Decl{
+      Name: Id{
+        Text: "foo"
+      }
+      Pos: 7
+    }
+    nil
+  }
+  Imports: []Import{}
+  Rune: 'x'
+  Package: Package#2
+}`)
+}
+
+func TestDiffMatch(t *testing.T) {
+	actual := MakeStruct("File", MakeField("Decls", MakeList("[]Decl", describeDecl("foo"), describeDecl("bar"))))
+	// Fields that are not mentioned are not compared.
+	expected := MakeStruct("File", MakeField("Decls", MakeList("[]Decl",
+		MakeStruct("Decl"),
+		MakeStruct("Decl", MakeField("Name", MakeStruct("Id", MakeField("Text", Describe("bar"))))),
+	)))
+	if d := Diff(expected, actual); d != nil {
+		t.Fatal(d)
+	}
+}
+
+func TestDiffMismatch(t *testing.T) {
+	actual := MakeStruct("File", MakeField("Decls", MakeList("[]Decl", describeDecl("foo"), describeDecl("bar"))))
+	expected := MakeStruct("File", MakeField("Decls", MakeList("[]Decl",
+		MakeStruct("Decl"),
+		MakeStruct("Decl", MakeField("Name", MakeStruct("Id", MakeField("Text", Describe("baz"))))),
+	)))
+	d := Diff(expected, actual)
+	if d == nil {
+		t.Fatal("expected a difference")
+	}
+	assert.StringEquals(t, d.String(), `expected "baz", got "bar" at path Decls[1].Name.Text`)
+
+	d = Diff(MakeStruct("Id", MakeField("Missing", Describe(1))), MakeStruct("Id"))
+	assert.StringEquals(t, d.String(), `expected 1, got <missing> at path Missing`)
+
+	d = Diff(MakeList("[]int", Describe(1)), MakeList("[]int"))
+	assert.StringEquals(t, d.String(), "expected []int{\n  1\n}, got []int{}")
+}
@@ -0,0 +1,347 @@
+package runtime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Support for the JSON encoders and decoders generated for dub structures.
+//
+// Every structure is encoded as an object whose JSONTypeKey field names the
+// structure, so members of an implements hierarchy can be told apart when
+// they are decoded.  References to scoped objects are encoded as the index of
+// the object in its scope.  The container of the scope encodes the objects
+// themselves, and binds the scope while the rest of the container is decoded
+// so the references can be resolved.
+
+const JSONTypeKey = "$type"
+
+// JSONObject is a JSON object that keeps its fields in order.
+type JSONObject struct {
+	names  []string
+	values []interface{}
+}
+
+func MakeJSONObject(typeName string) *JSONObject {
+	o := &JSONObject{}
+	o.Set(JSONTypeKey, typeName)
+	return o
+}
+
+func (o *JSONObject) Set(name string, value interface{}) {
+	o.names = append(o.names, name)
+	o.values = append(o.values, value)
+}
+
+func (o *JSONObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i, name := range o.names {
+		if i != 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(strconv.Quote(name))
+		buf.WriteString(":")
+		data, err := json.Marshal(o.values[i])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(data)
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
+// JSONList is an encoded list.  A nil JSONList encodes as null.
+type JSONList struct {
+	elements []interface{}
+}
+
+func MakeJSONList() *JSONList {
+	return &JSONList{elements: []interface{}{}}
+}
+
+func (l *JSONList) Append(value interface{}) {
+	l.elements = append(l.elements, value)
+}
+
+func (l *JSONList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.elements)
+}
+
+// JSONEncoder is implemented by every generated dub structure.
+type JSONEncoder interface {
+	EncodeJSON() interface{}
+}
+
+// EncodeJSON converts a dub value into something encoding/json can marshal.
+func EncodeJSON(v interface{}) interface{} {
+	e, ok := v.(JSONEncoder)
+	if ok {
+		return e.EncodeJSON()
+	}
+	return v
+}
+
+func MarshalJSON(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// JSONScope holds the objects a reference can resolve to.
+type JSONScope struct {
+	Name    string
+	objects []interface{}
+}
+
+func MakeJSONScope(name string) *JSONScope {
+	return &JSONScope{Name: name}
+}
+
+func (s *JSONScope) Add(object interface{}) {
+	s.objects = append(s.objects, object)
+}
+
+// JSONDecoder tracks the scopes that are bound and the first error found
+// while decoding.
+type JSONDecoder struct {
+	scopes []*JSONScope
+	err    error
+}
+
+// Parse reads a JSON document.  Numbers are kept exact so that 64 bit
+// integers survive the round trip.
+func (d *JSONDecoder) Parse(data []byte) (*JSONValue, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONValue{decoder: d, value: value}, nil
+}
+
+// Bind makes the objects in a scope available to references.  Scopes that are
+// not encoded in the document, such as the functions of a program an AST
+// refers to, may be bound before decoding.
+func (d *JSONDecoder) Bind(scope *JSONScope) {
+	d.scopes = append(d.scopes, scope)
+}
+
+func (d *JSONDecoder) Unbind(scope *JSONScope) {
+	last := len(d.scopes) - 1
+	if last < 0 || d.scopes[last] != scope {
+		panic(scope.Name)
+	}
+	d.scopes = d.scopes[:last]
+}
+
+func (d *JSONDecoder) lookup(name string) *JSONScope {
+	for i := len(d.scopes) - 1; i >= 0; i-- {
+		if d.scopes[i].Name == name {
+			return d.scopes[i]
+		}
+	}
+	return nil
+}
+
+func (d *JSONDecoder) Err() error {
+	return d.err
+}
+
+// JSONValue is a decoded value, and where it was found in the document.
+type JSONValue struct {
+	decoder *JSONDecoder
+	path    string
+	value   interface{}
+}
+
+func (v *JSONValue) child(path string, value interface{}) *JSONValue {
+	return &JSONValue{decoder: v.decoder, path: path, value: value}
+}
+
+func (v *JSONValue) Fail(message string) {
+	if v.decoder.err == nil {
+		path := v.path
+		if path == "" {
+			path = "root"
+		}
+		v.decoder.err = fmt.Errorf("%s: %s", path, message)
+	}
+}
+
+func (v *JSONValue) IsNull() bool {
+	return v.value == nil
+}
+
+func (v *JSONValue) object() map[string]interface{} {
+	if v.value == nil {
+		return nil
+	}
+	o, ok := v.value.(map[string]interface{})
+	if !ok {
+		v.Fail(fmt.Sprintf("expected an object but got %#v", v.value))
+	}
+	return o
+}
+
+// Type returns the name of the structure an object encodes.
+func (v *JSONValue) Type() string {
+	t, ok := v.object()[JSONTypeKey].(string)
+	if !ok {
+		v.Fail("missing " + JSONTypeKey)
+	}
+	return t
+}
+
+// Expect fails unless the value is an object encoding the named structure.
+func (v *JSONValue) Expect(typeName string) {
+	t := v.Type()
+	if t != typeName {
+		v.Fail(fmt.Sprintf("expected %s but got %s", typeName, t))
+	}
+}
+
+// Field returns a field of an object.  Missing fields are null.
+func (v *JSONValue) Field(name string) *JSONValue {
+	return v.child(fieldPath(v.path, name), v.object()[name])
+}
+
+func (v *JSONValue) Elements() []*JSONValue {
+	if v.value == nil {
+		return nil
+	}
+	l, ok := v.value.([]interface{})
+	if !ok {
+		v.Fail(fmt.Sprintf("expected a list but got %#v", v.value))
+		return nil
+	}
+	elements := make([]*JSONValue, len(l))
+	for i, e := range l {
+		elements[i] = v.child(fmt.Sprintf("%s[%d]", v.path, i), e)
+	}
+	return elements
+}
+
+func (v *JSONValue) AsString() string {
+	if v.value == nil {
+		return ""
+	}
+	s, ok := v.value.(string)
+	if !ok {
+		v.Fail(fmt.Sprintf("expected a string but got %#v", v.value))
+	}
+	return s
+}
+
+func (v *JSONValue) AsBool() bool {
+	if v.value == nil {
+		return false
+	}
+	b, ok := v.value.(bool)
+	if !ok {
+		v.Fail(fmt.Sprintf("expected a bool but got %#v", v.value))
+	}
+	return b
+}
+
+func (v *JSONValue) integer(bits int) int64 {
+	if v.value == nil {
+		return 0
+	}
+	n, ok := v.value.(json.Number)
+	if !ok {
+		v.Fail(fmt.Sprintf("expected a number but got %#v", v.value))
+		return 0
+	}
+	i, err := strconv.ParseInt(string(n), 10, bits)
+	if err != nil {
+		v.Fail(err.Error())
+	}
+	return i
+}
+
+func (v *JSONValue) AsInt() int {
+	return int(v.integer(strconv.IntSize))
+}
+
+func (v *JSONValue) AsInt64() int64 {
+	return v.integer(64)
+}
+
+func (v *JSONValue) AsRune() rune {
+	return rune(v.integer(32))
+}
+
+func (v *JSONValue) AsUint32() uint32 {
+	i := v.integer(64)
+	if i < 0 || i > 1<<32-1 {
+		v.Fail(fmt.Sprintf("%d does not fit in a uint32", i))
+	}
+	return uint32(i)
+}
+
+func (v *JSONValue) AsFloat32() float32 {
+	if v.value == nil {
+		return 0
+	}
+	n, ok := v.value.(json.Number)
+	if !ok {
+		v.Fail(fmt.Sprintf("expected a number but got %#v", v.value))
+		return 0
+	}
+	f, err := strconv.ParseFloat(string(n), 32)
+	if err != nil {
+		v.Fail(err.Error())
+	}
+	return float32(f)
+}
+
+func (v *JSONValue) Bind(scope *JSONScope) {
+	v.decoder.Bind(scope)
+}
+
+func (v *JSONValue) Unbind(scope *JSONScope) {
+	v.decoder.Unbind(scope)
+}
+
+// Resolves reports if the value is a reference into the named scope that can
+// be resolved.  Null references do not resolve, but are not an error.
+func (v *JSONValue) Resolves(name string) bool {
+	if v.value == nil {
+		return false
+	}
+	index := v.integer(strconv.IntSize)
+	scope := v.decoder.lookup(name)
+	if scope == nil {
+		v.Fail(fmt.Sprintf("no scope for %s#%d", name, index))
+		return false
+	}
+	if index < 0 || int(index) >= len(scope.objects) {
+		v.Fail(fmt.Sprintf("cannot resolve %s#%d", name, index))
+		return false
+	}
+	return true
+}
+
+// Ref returns the object a reference resolves to.  Check Resolves first.
+func (v *JSONValue) Ref(name string) interface{} {
+	return v.decoder.lookup(name).objects[v.integer(strconv.IntSize)]
+}
+
+// JSONDecodable is implemented by every generated dub structure.
+type JSONDecodable interface {
+	DecodeJSON(v *JSONValue)
+}
+
+func UnmarshalJSON(data []byte, node JSONDecodable) error {
+	d := &JSONDecoder{}
+	v, err := d.Parse(data)
+	if err != nil {
+		return err
+	}
+	node.DecodeJSON(v)
+	return d.Err()
+}
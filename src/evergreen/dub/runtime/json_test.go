@@ -0,0 +1,79 @@
+package runtime
+
+import (
+	"evergreen/assert"
+	"testing"
+)
+
+func TestJSONObjectOrder(t *testing.T) {
+	o := MakeJSONObject("Decl")
+	o.Set("Name", "foo")
+	o.Set("Args", MakeJSONList())
+	var missing *JSONList
+	o.Set("Body", missing)
+	data, err := MarshalJSON(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, string(data), `{"$type":"Decl","Name":"foo","Args":[],"Body":null}`)
+}
+
+func TestJSONValues(t *testing.T) {
+	d := &JSONDecoder{}
+	v, err := d.Parse([]byte(`{"$type":"T","Big":9007199254740993,"Neg":-1,"Items":[true,"x"]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v.Expect("T")
+	if v.Field("Big").AsInt64() != 9007199254740993 {
+		t.Fatal("lost precision")
+	}
+	if !v.Field("Missing").IsNull() {
+		t.Fatal("missing fields should be null")
+	}
+	items := v.Field("Items").Elements()
+	if !items[0].AsBool() {
+		t.Fatal("expected true")
+	}
+	if d.Err() != nil {
+		t.Fatal(d.Err())
+	}
+
+	v.Field("Neg").AsUint32()
+	items[1].AsInt()
+	assert.StringEquals(t, d.Err().Error(), "Neg: -1 does not fit in a uint32")
+}
+
+func TestJSONScopes(t *testing.T) {
+	d := &JSONDecoder{}
+	v, err := d.Parse([]byte(`[1, null, 3]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	outer := MakeJSONScope("X")
+	outer.Add("outer")
+	outer.Add("outer")
+	inner := MakeJSONScope("X")
+	inner.Add("a")
+	inner.Add("b")
+	refs := v.Elements()
+
+	v.Bind(outer)
+	v.Bind(inner)
+	if !refs[0].Resolves("X") || refs[0].Ref("X") != "b" {
+		t.Fatal("expected the innermost scope")
+	}
+	if refs[1].Resolves("X") {
+		t.Fatal("null should not resolve")
+	}
+	v.Unbind(inner)
+	v.Unbind(outer)
+	if d.Err() != nil {
+		t.Fatal(d.Err())
+	}
+
+	if refs[2].Resolves("X") {
+		t.Fatal("nothing is bound")
+	}
+	assert.StringEquals(t, d.Err().Error(), "[2]: no scope for X#3")
+}
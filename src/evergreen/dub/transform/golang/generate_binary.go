@@ -0,0 +1,486 @@
+package golang
+
+import (
+	"bytes"
+	"evergreen/dub/core"
+	dstcore "evergreen/go/core"
+	dst "evergreen/go/tree"
+	"fmt"
+	"hash/fnv"
+	"sort"
+)
+
+// Generates binary encoders and decoders for every structure in a package,
+// so that programs built from scoped structures can be snapshotted.
+// Concrete structures get EncodeBinary and DecodeBinary methods, which are
+// also exposed through the standard MarshalBinary and UnmarshalBinary
+// methods.  Every structure gets a Decode<Name>Binary function.  See
+// evergreen/dub/runtime for the encoding.
+
+var binaryBuiltinMethods = map[string]string{
+	"string":  "String",
+	"rune":    "RuneValue",
+	"int":     "Int",
+	"int64":   "Int64",
+	"uint32":  "Uint32",
+	"float32": "Float32",
+	"bool":    "Bool",
+}
+
+func schemaTypeName(t core.DubType) string {
+	switch t := t.(type) {
+	case *core.StructType:
+		return qualifiedName(t)
+	case *core.ListType:
+		return "[]" + schemaTypeName(t.Type)
+	case *core.BuiltinType:
+		return t.Name
+	default:
+		panic(t)
+	}
+}
+
+// Fingerprints the layout of every structure that can be reached from root.
+func binarySchema(coreProg *core.CoreProgram, root *core.StructType) string {
+	seen := map[*core.StructType]bool{}
+	var visit func(t core.DubType)
+	visit = func(t core.DubType) {
+		switch t := t.(type) {
+		case *core.StructType:
+			if seen[t] {
+				return
+			}
+			seen[t] = true
+			for _, f := range t.Fields {
+				visit(f.Type)
+			}
+			for _, c := range t.Contains {
+				visit(c)
+			}
+			if t.IsParent {
+				for _, m := range concreteMembers(coreProg, t) {
+					visit(m)
+				}
+			}
+		case *core.ListType:
+			visit(t.Type)
+		}
+	}
+	visit(root)
+
+	layouts := []string{}
+	for s := range seen {
+		var buf bytes.Buffer
+		buf.WriteString(qualifiedName(s))
+		if s.Implements != nil {
+			fmt.Fprintf(&buf, " implements %s", qualifiedName(s.Implements))
+		}
+		if s.Scoped {
+			buf.WriteString(" scoped")
+		}
+		for _, c := range s.Contains {
+			fmt.Fprintf(&buf, " contains %s", qualifiedName(c))
+		}
+		for _, f := range s.Fields {
+			fmt.Fprintf(&buf, "; %s %s", f.Name, schemaTypeName(f.Type))
+		}
+		layouts = append(layouts, buf.String())
+	}
+	sort.Strings(layouts)
+
+	h := fnv.New64a()
+	for _, layout := range layouts {
+		h.Write([]byte(layout))
+		h.Write([]byte("\n"))
+	}
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+func binaryDecoderName(s *core.StructType) string {
+	prefix := "Decode"
+	if !s.Exported {
+		prefix = "decode"
+	}
+	return prefix + structName(s) + "Binary"
+}
+
+func binaryReaderName(s *core.StructType) string {
+	return "read" + structName(s) + "Binary"
+}
+
+type binaryGenerator struct {
+	ctx  *DubToGoContext
+	decl *dst.FuncDecl
+	e    *dst.LocalInfo
+	d    *dst.LocalInfo
+}
+
+func (g *binaryGenerator) encoderRef() dst.TypeRef {
+	return &dst.PointerRef{Element: g.ctx.runtimeType("BinaryEncoder")}
+}
+
+func (g *binaryGenerator) decoderRef() dst.TypeRef {
+	return &dst.PointerRef{Element: g.ctx.runtimeType("BinaryDecoder")}
+}
+
+func (g *binaryGenerator) goPackage(s *core.StructType) *dstcore.Package {
+	switch t := g.ctx.link.GetType(s, STRUCT).(type) {
+	case *dstcore.StructType:
+		return t.Package
+	case *dstcore.InterfaceType:
+		return t.Package
+	default:
+		panic(t)
+	}
+}
+
+func (g *binaryGenerator) decodeCall(s *core.StructType) dst.Expr {
+	return &dst.Call{
+		Expr: &dst.GetFunction{
+			Func: &dstcore.Function{
+				Name:    binaryDecoderName(s),
+				Package: g.goPackage(s),
+			},
+		},
+		Args: []dst.Expr{getLocal(g.d)},
+	}
+}
+
+func (g *binaryGenerator) write(method string, args ...dst.Expr) dst.Stmt {
+	return methodCall(getLocal(g.e), "Write"+method, args...)
+}
+
+func (g *binaryGenerator) read(method string, args ...dst.Expr) *dst.Call {
+	return methodCall(getLocal(g.d), "Read"+method, args...)
+}
+
+// Lists are written as their length followed by their elements.
+func (g *binaryGenerator) encodeList(value dst.Expr, elem *dst.LocalInfo, body []dst.Stmt) dst.Stmt {
+	return ifElse(
+		checkEQ(value, nilLiteral()),
+		[]dst.Stmt{g.write("Nil")},
+		[]dst.Stmt{
+			g.write("Length", call("len", value)),
+			rangeStmt(&dst.SetDiscard{}, setLocal(elem), value, body),
+		},
+	)
+}
+
+func (g *binaryGenerator) encodeValue(value func() dst.Expr, t core.DubType) []dst.Stmt {
+	switch t := t.(type) {
+	case *core.BuiltinType:
+		if t.Name == "graph" {
+			return []dst.Stmt{
+				ifElse(
+					checkEQ(value(), nilLiteral()),
+					[]dst.Stmt{g.write("Bool", boolLiteral(false))},
+					[]dst.Stmt{g.write("Bool", boolLiteral(true)), g.write("Opaque", value())},
+				),
+			}
+		}
+		method, ok := binaryBuiltinMethods[t.Name]
+		if !ok {
+			panic(t.Name)
+		}
+		return []dst.Stmt{g.write(method, value())}
+	case *core.StructType:
+		if t.IsParent {
+			return []dst.Stmt{runtimeCall("EncodeBinary", getLocal(g.e), value())}
+		}
+		return []dst.Stmt{methodCall(value(), "EncodeBinary", getLocal(g.e))}
+	case *core.ListType:
+		elem := g.decl.CreateLocalInfo("x", typeRef(t.Type, g.ctx))
+		body := g.encodeValue(func() dst.Expr { return getLocal(elem) }, t.Type)
+		return []dst.Stmt{g.encodeList(value(), elem, body)}
+	default:
+		panic(t)
+	}
+}
+
+func (g *binaryGenerator) decodeValue(target func() dst.Target, t core.DubType) []dst.Stmt {
+	switch t := t.(type) {
+	case *core.BuiltinType:
+		if t.Name == "graph" {
+			return []dst.Stmt{
+				assign(target(), nilLiteral()),
+				ifStmt(
+					g.read("Bool"),
+					assign(target(), &dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: dst.RefForType(g.ctx.graph)}}),
+					methodCall(getLocal(g.d), "ReadOpaque", g.targetValue(target())),
+				),
+			}
+		}
+		method, ok := binaryBuiltinMethods[t.Name]
+		if !ok {
+			panic(t.Name)
+		}
+		return []dst.Stmt{assign(target(), g.read(method))}
+	case *core.StructType:
+		return []dst.Stmt{assign(target(), g.decodeCall(t))}
+	case *core.ListType:
+		n := g.decl.CreateLocalInfo("n", dst.RefForType(g.ctx.index.Int))
+		list := g.decl.CreateLocalInfo("s", typeRef(t, g.ctx))
+		item := g.decl.CreateLocalInfo("x", typeRef(t.Type, g.ctx))
+		body := g.decodeValue(func() dst.Target { return setLocal(item) }, t.Type)
+		body = append(body, assign(setLocal(list), call("append", getLocal(list), getLocal(item))))
+		return []dst.Stmt{
+			assign(setLocal(n), g.read("Length")),
+			assign(setLocal(list), nilLiteral()),
+			ifStmt(
+				checkGE(getLocal(n), intLiteral(0)),
+				assign(setLocal(list), &dst.ListLiteral{Type: typeRef(t, g.ctx)}),
+				rangeStmt(nil, nil, getLocal(n), body),
+			),
+			assign(target(), getLocal(list)),
+		}
+	default:
+		panic(t)
+	}
+}
+
+// The value a target was just assigned.
+func (g *binaryGenerator) targetValue(target dst.Target) dst.Expr {
+	switch target := target.(type) {
+	case *dst.SetLocal:
+		return getLocal(target.Info)
+	case *dst.SetSelector:
+		return attr(target.Expr, target.Text)
+	default:
+		panic(target)
+	}
+}
+
+func checkGE(x dst.Expr, y dst.Expr) dst.Expr {
+	return &dst.BinaryExpr{
+		Left:  x,
+		Op:    ">=",
+		Right: y,
+	}
+}
+
+func (g *binaryGenerator) method(name string, s *core.StructType) *dst.LocalInfo {
+	g.decl = funcDecl(name)
+	node := g.decl.CreateLocalInfo("node", typeRef(s, g.ctx))
+	g.decl.Recv = param(node)
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{},
+		Results: []*dst.Param{},
+	}
+	return node
+}
+
+func (g *binaryGenerator) scopeObjects(node *dst.LocalInfo, c *core.StructType) dst.Expr {
+	return attr(attr(getLocal(node), subtypeName(c, SCOPE)), "objects")
+}
+
+func (g *binaryGenerator) generateEncode(s *core.StructType) *dst.FuncDecl {
+	node := g.method("EncodeBinary", s)
+	g.e = g.decl.CreateLocalInfo("e", g.encoderRef())
+	g.decl.Type.Params = []*dst.Param{param(g.e)}
+
+	stmts := []dst.Stmt{
+		ifStmt(checkEQ(getLocal(node), nilLiteral()), g.write("Nil"), returnStmt()),
+		ifStmt(
+			&dst.UnaryExpr{Op: "!", Expr: methodCall(getLocal(g.e), "WriteObject", getLocal(node), strLiteral(qualifiedName(s)))},
+			returnStmt(),
+		),
+	}
+	if s.Scoped {
+		stmts = append(stmts, g.write("Uint32", &dst.TypeCoerce{
+			Type: dst.RefForType(g.ctx.index.UInt32),
+			Expr: attr(getLocal(node), "Index"),
+		}))
+	}
+	for _, c := range s.Contains {
+		elem := g.decl.CreateLocalInfo("o", typeRef(c, g.ctx))
+		stmts = append(stmts, ifElse(
+			checkEQ(attr(getLocal(node), subtypeName(c, SCOPE)), nilLiteral()),
+			[]dst.Stmt{g.write("Nil")},
+			[]dst.Stmt{
+				g.write("Length", call("len", g.scopeObjects(node, c))),
+				rangeStmt(&dst.SetDiscard{}, setLocal(elem), g.scopeObjects(node, c), []dst.Stmt{
+					methodCall(getLocal(elem), "EncodeBinary", getLocal(g.e)),
+				}),
+			},
+		))
+	}
+	for _, f := range s.Fields {
+		name := f.Name
+		stmts = append(stmts, g.encodeValue(func() dst.Expr { return attr(getLocal(node), name) }, f.Type)...)
+	}
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func (g *binaryGenerator) generateDecode(s *core.StructType) *dst.FuncDecl {
+	node := g.method("DecodeBinary", s)
+	g.d = g.decl.CreateLocalInfo("d", g.decoderRef())
+	g.decl.Type.Params = []*dst.Param{param(g.d)}
+
+	stmts := []dst.Stmt{}
+	if s.Scoped {
+		stmts = append(stmts, assign(
+			&dst.SetSelector{Expr: getLocal(node), Text: "Index"},
+			&dst.TypeCoerce{Type: g.ctx.link.TypeRef(s, REF), Expr: g.read("Uint32")},
+		))
+	}
+	for _, c := range s.Contains {
+		scopeName := subtypeName(c, SCOPE)
+		n := g.decl.CreateLocalInfo("n", dst.RefForType(g.ctx.index.Int))
+		stmts = append(stmts,
+			assign(setLocal(n), g.read("Length")),
+			ifStmt(
+				checkGE(getLocal(n), intLiteral(0)),
+				assign(
+					&dst.SetSelector{Expr: getLocal(node), Text: scopeName},
+					&dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: g.ctx.link.TypeRef(c, SCOPE)}},
+				),
+				rangeStmt(nil, nil, getLocal(n), []dst.Stmt{
+					assign(
+						&dst.SetSelector{Expr: attr(getLocal(node), scopeName), Text: "objects"},
+						call("append", g.scopeObjects(node, c), g.decodeCall(c)),
+					),
+				}),
+			),
+		)
+	}
+	for _, f := range s.Fields {
+		name := f.Name
+		stmts = append(stmts, g.decodeValue(
+			func() dst.Target { return &dst.SetSelector{Expr: getLocal(node), Text: name} },
+			f.Type,
+		)...)
+	}
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+// Reads a structure that has been announced by ReadObject.
+func (g *binaryGenerator) generateReader(s *core.StructType) *dst.FuncDecl {
+	g.decl = funcDecl(binaryReaderName(s))
+	g.d = g.decl.CreateLocalInfo("d", g.decoderRef())
+	o := g.decl.CreateLocalInfo("o", &dst.NameRef{T: &dstcore.ExternalType{Name: "interface{}"}})
+	result := typeRef(s, g.ctx)
+	node := g.decl.CreateLocalInfo("node", result)
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(g.d), param(o)},
+		Results: []*dst.Param{&dst.Param{Type: result}},
+	}
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			ifStmt(
+				checkNE(getLocal(o), nilLiteral()),
+				returnStmt(&dst.TypeAssert{Expr: getLocal(o), Type: result}),
+			),
+			assign(setLocal(node), &dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: g.ctx.link.TypeRef(s, STRUCT)}}),
+			methodCall(getLocal(g.d), "Register", getLocal(node)),
+			methodCall(getLocal(node), "DecodeBinary", getLocal(g.d)),
+			returnStmt(getLocal(node)),
+		},
+	}
+	return g.decl
+}
+
+// Decoders dispatch on the type of the object that was written.
+func (g *binaryGenerator) generateDecoder(coreProg *core.CoreProgram, s *core.StructType) *dst.FuncDecl {
+	g.decl = funcDecl(binaryDecoderName(s))
+	g.d = g.decl.CreateLocalInfo("d", g.decoderRef())
+	o := g.decl.CreateLocalInfo("o", &dst.NameRef{T: &dstcore.ExternalType{Name: "interface{}"}})
+	t := g.decl.CreateLocalInfo("t", dst.RefForType(g.ctx.index.String))
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(g.d)},
+		Results: []*dst.Param{&dst.Param{Type: typeRef(s, g.ctx)}},
+	}
+
+	members := []*core.StructType{s}
+	if s.IsParent {
+		members = concreteMembers(coreProg, s)
+	}
+	stmts := []dst.Stmt{
+		&dst.Assign{
+			Targets: []dst.Target{setLocal(o), setLocal(t)},
+			Op:      "=",
+			Sources: []dst.Expr{methodCall(getLocal(g.d), "ReadObject")},
+		},
+		ifStmt(checkEQ(getLocal(t), strLiteral("")), returnStmt(nilLiteral())),
+	}
+	for _, m := range members {
+		stmts = append(stmts, ifStmt(
+			checkEQ(getLocal(t), strLiteral(qualifiedName(m))),
+			returnStmt(&dst.Call{
+				Expr: &dst.GetFunction{
+					Func: &dstcore.Function{
+						Name:    binaryReaderName(m),
+						Package: g.goPackage(m),
+					},
+				},
+				Args: []dst.Expr{getLocal(g.d), getLocal(o)},
+			}),
+		))
+	}
+	stmts = append(stmts,
+		methodCall(getLocal(g.d), "Fail", &dst.BinaryExpr{
+			Left:  strLiteral("unexpected "),
+			Op:    "+",
+			Right: getLocal(t),
+		}),
+		returnStmt(nilLiteral()),
+	)
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func (g *binaryGenerator) generateMarshal(s *core.StructType, schema string) *dst.FuncDecl {
+	node := g.method("MarshalBinary", s)
+	g.decl.Type.Results = []*dst.Param{
+		&dst.Param{Type: &dst.SliceRef{Element: &dst.NameRef{T: &dstcore.ExternalType{Name: "byte"}}}},
+		&dst.Param{Type: &dst.NameRef{T: &dstcore.ExternalType{Name: "error"}}},
+	}
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			returnStmt(runtimeCall("MarshalBinary", getLocal(node), strLiteral(schema))),
+		},
+	}
+	return g.decl
+}
+
+func (g *binaryGenerator) generateUnmarshal(s *core.StructType, schema string) *dst.FuncDecl {
+	node := g.method("UnmarshalBinary", s)
+	data := g.decl.CreateLocalInfo("data", &dst.SliceRef{Element: &dst.NameRef{T: &dstcore.ExternalType{Name: "byte"}}})
+	g.decl.Type.Params = []*dst.Param{param(data)}
+	g.decl.Type.Results = []*dst.Param{
+		&dst.Param{Type: &dst.NameRef{T: &dstcore.ExternalType{Name: "error"}}},
+	}
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			returnStmt(runtimeCall("UnmarshalBinary", getLocal(data), strLiteral(schema), strLiteral(qualifiedName(s)), getLocal(node))),
+		},
+	}
+	return g.decl
+}
+
+func GenerateBinary(leaf string, coreProg *core.CoreProgram, p *core.Package, ctx *DubToGoContext) *dst.FileAST {
+	g := &binaryGenerator{ctx: ctx}
+	decls := []dst.Decl{}
+	for _, s := range packageStructures(coreProg, p) {
+		if !s.IsParent {
+			schema := binarySchema(coreProg, s)
+			decls = append(decls,
+				g.generateEncode(s),
+				g.generateDecode(s),
+				g.generateReader(s),
+				g.generateMarshal(s, schema),
+				g.generateUnmarshal(s, schema),
+			)
+		}
+		decls = append(decls, g.generateDecoder(coreProg, s))
+	}
+	if len(decls) == 0 {
+		return nil
+	}
+	return &dst.FileAST{
+		Name:    "generated_dub_binary.go",
+		Package: leaf,
+		Decls:   decls,
+	}
+}
@@ -0,0 +1,476 @@
+package golang
+
+import (
+	"evergreen/dub/core"
+	dstcore "evergreen/go/core"
+	dst "evergreen/go/tree"
+)
+
+// Generates Clone and Equal methods for every concrete structure.
+//
+// A clone copies the structures of the package that are reachable from the
+// original.  Structures in other packages, such as the types an IR refers
+// to, are shared rather than copied.  Scoped objects are copied along with
+// the scope that contains them and keep their indexes, so references to them
+// are redirected to the copies.  References to scoped objects outside of the
+// clone still point at the original.
+//
+// Equal follows the same boundaries.  Structures in other packages are
+// compared by identity, and references to scoped objects are compared by
+// index.
+
+type cloneGenerator struct {
+	ctx  *DubToGoContext
+	p    *core.Package
+	decl *dst.FuncDecl
+	c    *dst.LocalInfo
+}
+
+func (g *cloneGenerator) local(t core.DubType) bool {
+	s, ok := t.(*core.StructType)
+	return ok && s.File.Package == g.p
+}
+
+func (g *cloneGenerator) clonerRef() dst.TypeRef {
+	return &dst.PointerRef{Element: g.ctx.runtimeType("Cloner")}
+}
+
+func (g *cloneGenerator) comparerRef() dst.TypeRef {
+	return &dst.PointerRef{Element: g.ctx.runtimeType("Comparer")}
+}
+
+func (g *cloneGenerator) anyRef() dst.TypeRef {
+	return &dst.NameRef{T: &dstcore.ExternalType{Name: "interface{}"}}
+}
+
+func (g *cloneGenerator) boolRef() dst.TypeRef {
+	return dst.RefForType(g.ctx.index.Bool)
+}
+
+func (g *cloneGenerator) method(name string, s *core.StructType, params []*dst.LocalInfo, result dst.TypeRef) *dst.LocalInfo {
+	g.decl = funcDecl(name)
+	node := g.decl.CreateLocalInfo("node", typeRef(s, g.ctx))
+	g.decl.Recv = param(node)
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{},
+		Results: []*dst.Param{},
+	}
+	if result != nil {
+		g.decl.Type.Results = append(g.decl.Type.Results, &dst.Param{Type: result})
+	}
+	return node
+}
+
+func (g *cloneGenerator) addParam(name string, t dst.TypeRef) *dst.LocalInfo {
+	info := g.decl.CreateLocalInfo(name, t)
+	g.decl.Type.Params = append(g.decl.Type.Params, param(info))
+	return info
+}
+
+func cloneParentName(s *core.StructType) string {
+	return "clone" + structName(s)
+}
+
+func equalParentName(s *core.StructType) string {
+	return "equal" + structName(s)
+}
+
+func (g *cloneGenerator) newStruct(s *core.StructType) dst.Expr {
+	return &dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: g.ctx.link.TypeRef(s, STRUCT)}}
+}
+
+func (g *cloneGenerator) scopeObjects(node dst.Expr, c *core.StructType) dst.Expr {
+	return attr(attr(node, subtypeName(c, SCOPE)), "objects")
+}
+
+// Returns the statements needed to clone a value and an expression for the
+// copy.
+func (g *cloneGenerator) cloneValue(value dst.Expr, t core.DubType) ([]dst.Stmt, dst.Expr) {
+	switch t := t.(type) {
+	case *core.BuiltinType:
+		if t.Name == "graph" {
+			copied := g.decl.CreateLocalInfo("g", typeRef(t, g.ctx))
+			return []dst.Stmt{
+				assign(setLocal(copied), nilLiteral()),
+				ifStmt(
+					checkNE(value, nilLiteral()),
+					assign(setLocal(copied), methodCall(value, "Copy")),
+				),
+			}, getLocal(copied)
+		}
+		return nil, value
+	case *core.StructType:
+		if !g.local(t) {
+			return nil, value
+		}
+		if t.IsParent {
+			return nil, &dst.Call{Expr: glbl(cloneParentName(t)), Args: []dst.Expr{getLocal(g.c), value}}
+		}
+		return nil, methodCall(value, "clone", getLocal(g.c))
+	case *core.ListType:
+		list := g.decl.CreateLocalInfo("s", typeRef(t, g.ctx))
+		elem := g.decl.CreateLocalInfo("e", typeRef(t.Type, g.ctx))
+		body, expr := g.cloneValue(getLocal(elem), t.Type)
+		body = append(body, assign(setLocal(list), call("append", getLocal(list), expr)))
+		return []dst.Stmt{
+			assign(setLocal(list), nilLiteral()),
+			ifStmt(
+				checkNE(value, nilLiteral()),
+				assign(setLocal(list), &dst.ListLiteral{Type: typeRef(t, g.ctx)}),
+				rangeStmt(&dst.SetDiscard{}, setLocal(elem), value, body),
+			),
+		}, getLocal(list)
+	default:
+		panic(t)
+	}
+}
+
+func (g *cloneGenerator) generateClone(s *core.StructType) *dst.FuncDecl {
+	node := g.method("Clone", s, nil, typeRef(s, g.ctx))
+	g.c = g.decl.CreateLocalInfo("c", g.clonerRef())
+	clone := g.decl.CreateLocalInfo("clone", typeRef(s, g.ctx))
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			ifStmt(checkEQ(getLocal(node), nilLiteral()), returnStmt(nilLiteral())),
+			assign(setLocal(g.c), runtimeCall("MakeCloner")),
+			assign(setLocal(clone), g.newStruct(s)),
+			methodCall(getLocal(g.c), "Map", getLocal(node), getLocal(clone)),
+			methodCall(getLocal(node), "cloneFields", getLocal(g.c), getLocal(clone)),
+			returnStmt(getLocal(clone)),
+		},
+	}
+	return g.decl
+}
+
+func (g *cloneGenerator) generateCloneShared(s *core.StructType) *dst.FuncDecl {
+	node := g.method("clone", s, nil, typeRef(s, g.ctx))
+	g.c = g.addParam("c", g.clonerRef())
+	o := g.decl.CreateLocalInfo("o", g.anyRef())
+	stmts := []dst.Stmt{
+		ifStmt(checkEQ(getLocal(node), nilLiteral()), returnStmt(nilLiteral())),
+		assign(setLocal(o), methodCall(getLocal(g.c), "Lookup", getLocal(node))),
+		ifStmt(
+			checkNE(getLocal(o), nilLiteral()),
+			returnStmt(&dst.TypeAssert{Expr: getLocal(o), Type: typeRef(s, g.ctx)}),
+		),
+	}
+	if s.Scoped {
+		// The scope of the object is not being cloned.
+		stmts = append(stmts, returnStmt(getLocal(node)))
+	} else {
+		clone := g.decl.CreateLocalInfo("clone", typeRef(s, g.ctx))
+		stmts = append(stmts,
+			assign(setLocal(clone), g.newStruct(s)),
+			methodCall(getLocal(g.c), "Map", getLocal(node), getLocal(clone)),
+			methodCall(getLocal(node), "cloneFields", getLocal(g.c), getLocal(clone)),
+			returnStmt(getLocal(clone)),
+		)
+	}
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func (g *cloneGenerator) generateCloneFields(s *core.StructType) *dst.FuncDecl {
+	node := g.method("cloneFields", s, nil, nil)
+	g.c = g.addParam("c", g.clonerRef())
+	clone := g.addParam("clone", typeRef(s, g.ctx))
+
+	stmts := []dst.Stmt{}
+	if s.Scoped {
+		stmts = append(stmts, assign(
+			&dst.SetSelector{Expr: getLocal(clone), Text: "Index"},
+			attr(getLocal(node), "Index"),
+		))
+	}
+	// Every object in a scope is allocated before any are filled in, so
+	// references between them can be redirected.
+	for _, c := range s.Contains {
+		scopeName := subtypeName(c, SCOPE)
+		o := g.decl.CreateLocalInfo("o", typeRef(c, g.ctx))
+		copied := g.decl.CreateLocalInfo("x", typeRef(c, g.ctx))
+		index := g.decl.CreateLocalInfo("i", dst.RefForType(g.ctx.index.Int))
+		stmts = append(stmts, ifStmt(
+			checkNE(attr(getLocal(node), scopeName), nilLiteral()),
+			assign(
+				&dst.SetSelector{Expr: getLocal(clone), Text: scopeName},
+				&dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: g.ctx.link.TypeRef(c, SCOPE)}},
+			),
+			rangeStmt(&dst.SetDiscard{}, setLocal(o), g.scopeObjects(getLocal(node), c), []dst.Stmt{
+				assign(setLocal(copied), g.newStruct(c)),
+				methodCall(getLocal(g.c), "Map", getLocal(o), getLocal(copied)),
+				assign(
+					&dst.SetSelector{Expr: attr(getLocal(clone), scopeName), Text: "objects"},
+					call("append", g.scopeObjects(getLocal(clone), c), getLocal(copied)),
+				),
+			}),
+			rangeStmt(setLocal(index), setLocal(o), g.scopeObjects(getLocal(node), c), []dst.Stmt{
+				methodCall(getLocal(o), "cloneFields", getLocal(g.c), &dst.Index{
+					Expr:  g.scopeObjects(getLocal(clone), c),
+					Index: getLocal(index),
+				}),
+			}),
+		))
+	}
+	for _, f := range s.Fields {
+		fieldStmts, expr := g.cloneValue(attr(getLocal(node), f.Name), f.Type)
+		stmts = append(stmts, fieldStmts...)
+		stmts = append(stmts, assign(&dst.SetSelector{Expr: getLocal(clone), Text: f.Name}, expr))
+	}
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+// Parents dispatch on the type of the value.
+func (g *cloneGenerator) generateCloneParent(coreProg *core.CoreProgram, s *core.StructType) *dst.FuncDecl {
+	g.decl = funcDecl(cloneParentName(s))
+	g.c = g.decl.CreateLocalInfo("c", g.clonerRef())
+	node := g.decl.CreateLocalInfo("node", typeRef(s, g.ctx))
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(g.c), param(node)},
+		Results: []*dst.Param{&dst.Param{Type: typeRef(s, g.ctx)}},
+	}
+	cases := []*dst.TypeSwitchCase{}
+	for _, m := range concreteMembers(coreProg, s) {
+		if !g.local(m) {
+			continue
+		}
+		cases = append(cases, &dst.TypeSwitchCase{
+			Types: []dst.TypeRef{typeRef(m, g.ctx)},
+			Block: &dst.Block{
+				Body: []dst.Stmt{
+					returnStmt(methodCall(&dst.TypeAssert{Expr: getLocal(node), Type: typeRef(m, g.ctx)}, "clone", getLocal(g.c))),
+				},
+			},
+		})
+	}
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			&dst.TypeSwitch{Expr: getLocal(node), Cases: cases},
+			returnStmt(getLocal(node)),
+		},
+	}
+	return g.decl
+}
+
+func (g *cloneGenerator) returnFalseUnless(cond dst.Expr) dst.Stmt {
+	return ifStmt(&dst.UnaryExpr{Op: "!", Expr: cond}, returnStmt(boolLiteral(false)))
+}
+
+// Returns the statements needed to compare two values.
+func (g *cloneGenerator) compareValue(a dst.Expr, b dst.Expr, t core.DubType) []dst.Stmt {
+	switch t := t.(type) {
+	case *core.BuiltinType:
+		if t.Name == "graph" {
+			return []dst.Stmt{g.returnFalseUnless(methodCall(a, "Equal", b))}
+		}
+		return []dst.Stmt{ifStmt(checkNE(a, b), returnStmt(boolLiteral(false)))}
+	case *core.StructType:
+		if !g.local(t) {
+			return []dst.Stmt{ifStmt(checkNE(a, b), returnStmt(boolLiteral(false)))}
+		}
+		if t.IsParent {
+			return []dst.Stmt{g.returnFalseUnless(&dst.Call{Expr: glbl(equalParentName(t)), Args: []dst.Expr{getLocal(g.c), a, b}})}
+		}
+		if t.Scoped {
+			return []dst.Stmt{g.returnFalseUnless(methodCall(a, "equalRef", b))}
+		}
+		return []dst.Stmt{g.returnFalseUnless(methodCall(a, "equal", getLocal(g.c), b))}
+	case *core.ListType:
+		index := g.decl.CreateLocalInfo("i", dst.RefForType(g.ctx.index.Int))
+		elem := g.decl.CreateLocalInfo("e", typeRef(t.Type, g.ctx))
+		body := g.compareValue(getLocal(elem), &dst.Index{Expr: b, Index: getLocal(index)}, t.Type)
+		return []dst.Stmt{
+			ifStmt(
+				&dst.BinaryExpr{
+					Left:  checkNE(call("len", a), call("len", b)),
+					Op:    "||",
+					Right: checkNE(checkEQ(a, nilLiteral()), checkEQ(b, nilLiteral())),
+				},
+				returnStmt(boolLiteral(false)),
+			),
+			rangeStmt(setLocal(index), setLocal(elem), a, body),
+		}
+	default:
+		panic(t)
+	}
+}
+
+func (g *cloneGenerator) generateEqual(s *core.StructType) *dst.FuncDecl {
+	node := g.method("Equal", s, nil, g.boolRef())
+	other := g.addParam("other", typeRef(s, g.ctx))
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			returnStmt(methodCall(getLocal(node), "equal", runtimeCall("MakeComparer"), getLocal(other))),
+		},
+	}
+	return g.decl
+}
+
+func (g *cloneGenerator) generateEqualShared(s *core.StructType) *dst.FuncDecl {
+	node := g.method("equal", s, nil, g.boolRef())
+	g.c = g.addParam("c", g.comparerRef())
+	other := g.addParam("other", typeRef(s, g.ctx))
+
+	stmts := []dst.Stmt{
+		ifStmt(checkEQ(getLocal(node), getLocal(other)), returnStmt(boolLiteral(true))),
+		ifStmt(
+			&dst.BinaryExpr{
+				Left:  checkEQ(getLocal(node), nilLiteral()),
+				Op:    "||",
+				Right: checkEQ(getLocal(other), nilLiteral()),
+			},
+			returnStmt(boolLiteral(false)),
+		),
+		ifStmt(methodCall(getLocal(g.c), "Assume", getLocal(node), getLocal(other)), returnStmt(boolLiteral(true))),
+	}
+	if s.Scoped {
+		stmts = append(stmts, ifStmt(
+			checkNE(attr(getLocal(node), "Index"), attr(getLocal(other), "Index")),
+			returnStmt(boolLiteral(false)),
+		))
+	}
+	for _, c := range s.Contains {
+		scopeName := subtypeName(c, SCOPE)
+		a := attr(getLocal(node), scopeName)
+		b := attr(getLocal(other), scopeName)
+		index := g.decl.CreateLocalInfo("i", dst.RefForType(g.ctx.index.Int))
+		o := g.decl.CreateLocalInfo("o", typeRef(c, g.ctx))
+		stmts = append(stmts,
+			ifStmt(
+				checkNE(checkEQ(a, nilLiteral()), checkEQ(b, nilLiteral())),
+				returnStmt(boolLiteral(false)),
+			),
+			ifStmt(
+				checkNE(attr(getLocal(node), scopeName), nilLiteral()),
+				ifStmt(
+					checkNE(call("len", g.scopeObjects(getLocal(node), c)), call("len", g.scopeObjects(getLocal(other), c))),
+					returnStmt(boolLiteral(false)),
+				),
+				rangeStmt(setLocal(index), setLocal(o), g.scopeObjects(getLocal(node), c), []dst.Stmt{
+					g.returnFalseUnless(methodCall(getLocal(o), "equal", getLocal(g.c), &dst.Index{
+						Expr:  g.scopeObjects(getLocal(other), c),
+						Index: getLocal(index),
+					})),
+				}),
+			),
+		)
+	}
+	for _, f := range s.Fields {
+		stmts = append(stmts, g.compareValue(attr(getLocal(node), f.Name), attr(getLocal(other), f.Name), f.Type)...)
+	}
+	stmts = append(stmts, returnStmt(boolLiteral(true)))
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func (g *cloneGenerator) generateEqualRef(s *core.StructType) *dst.FuncDecl {
+	node := g.method("equalRef", s, nil, g.boolRef())
+	other := g.addParam("other", typeRef(s, g.ctx))
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			ifStmt(
+				&dst.BinaryExpr{
+					Left:  checkEQ(getLocal(node), nilLiteral()),
+					Op:    "||",
+					Right: checkEQ(getLocal(other), nilLiteral()),
+				},
+				returnStmt(checkEQ(getLocal(node), getLocal(other))),
+			),
+			returnStmt(checkEQ(attr(getLocal(node), "Index"), attr(getLocal(other), "Index"))),
+		},
+	}
+	return g.decl
+}
+
+func (g *cloneGenerator) generateEqualParent(coreProg *core.CoreProgram, s *core.StructType) *dst.FuncDecl {
+	g.decl = funcDecl(equalParentName(s))
+	g.c = g.decl.CreateLocalInfo("c", g.comparerRef())
+	a := g.decl.CreateLocalInfo("a", typeRef(s, g.ctx))
+	b := g.decl.CreateLocalInfo("b", typeRef(s, g.ctx))
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(g.c), param(a), param(b)},
+		Results: []*dst.Param{&dst.Param{Type: g.boolRef()}},
+	}
+	cases := []*dst.TypeSwitchCase{}
+	for _, m := range concreteMembers(coreProg, s) {
+		if !g.local(m) {
+			continue
+		}
+		mt := typeRef(m, g.ctx)
+		var compare dst.Expr
+		if m.Scoped {
+			compare = methodCall(&dst.TypeAssert{Expr: getLocal(a), Type: mt}, "equalRef", &dst.TypeAssert{Expr: getLocal(b), Type: mt})
+		} else {
+			compare = methodCall(&dst.TypeAssert{Expr: getLocal(a), Type: mt}, "equal", getLocal(g.c), &dst.TypeAssert{Expr: getLocal(b), Type: mt})
+		}
+		cases = append(cases, &dst.TypeSwitchCase{
+			Types: []dst.TypeRef{mt},
+			Block: &dst.Block{
+				Body: []dst.Stmt{
+					&dst.TypeSwitch{
+						Expr: getLocal(b),
+						Cases: []*dst.TypeSwitchCase{
+							&dst.TypeSwitchCase{
+								Types: []dst.TypeRef{typeRef(m, g.ctx)},
+								Block: &dst.Block{Body: []dst.Stmt{returnStmt(compare)}},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			ifStmt(
+				&dst.BinaryExpr{
+					Left:  checkEQ(getLocal(a), nilLiteral()),
+					Op:    "||",
+					Right: checkEQ(getLocal(b), nilLiteral()),
+				},
+				returnStmt(checkEQ(getLocal(a), getLocal(b))),
+			),
+			&dst.TypeSwitch{Expr: getLocal(a), Cases: cases},
+			// Members from other packages.
+			returnStmt(checkEQ(getLocal(a), getLocal(b))),
+		},
+	}
+	return g.decl
+}
+
+func GenerateClone(leaf string, coreProg *core.CoreProgram, p *core.Package, ctx *DubToGoContext) *dst.FileAST {
+	g := &cloneGenerator{ctx: ctx, p: p}
+	decls := []dst.Decl{}
+	for _, s := range packageStructures(coreProg, p) {
+		if s.IsParent {
+			decls = append(decls,
+				g.generateCloneParent(coreProg, s),
+				g.generateEqualParent(coreProg, s),
+			)
+			continue
+		}
+		// A field cannot share its name with a method.
+		if !hasField(s, "Clone") {
+			decls = append(decls, g.generateClone(s))
+		}
+		decls = append(decls,
+			g.generateCloneShared(s),
+			g.generateCloneFields(s),
+		)
+		if !hasField(s, "Equal") {
+			decls = append(decls, g.generateEqual(s))
+		}
+		decls = append(decls, g.generateEqualShared(s))
+		if s.Scoped {
+			decls = append(decls, g.generateEqualRef(s))
+		}
+	}
+	if len(decls) == 0 {
+		return nil
+	}
+	return &dst.FileAST{
+		Name:    "generated_dub_clone.go",
+		Package: leaf,
+		Decls:   decls,
+	}
+}
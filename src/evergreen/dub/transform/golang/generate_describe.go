@@ -0,0 +1,155 @@
+package golang
+
+import (
+	"evergreen/dub/core"
+	dst "evergreen/go/tree"
+)
+
+// Generates a Describe method for every concrete structure, which converts
+// the structure into a runtime.Value, and a String method that formats the
+// description.  Scoped structures also get a DescribeRef method which is used
+// wherever they are referred to.
+
+func runtimeCall(name string, args ...dst.Expr) *dst.Call {
+	return &dst.Call{
+		Expr: attr(glbl("runtime"), name),
+		Args: args,
+	}
+}
+
+func methodCall(recv dst.Expr, name string, args ...dst.Expr) *dst.Call {
+	return &dst.Call{
+		Expr: attr(recv, name),
+		Args: args,
+	}
+}
+
+func hasField(s *core.StructType, name string) bool {
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+type describeGenerator struct {
+	ctx  *DubToGoContext
+	decl *dst.FuncDecl
+}
+
+func (g *describeGenerator) valueRef() dst.TypeRef {
+	return g.ctx.runtimeType("Value")
+}
+
+// Returns the statements needed to build the description and an expression
+// that evaluates to it.
+func (g *describeGenerator) describeValue(value dst.Expr, t core.DubType) ([]dst.Stmt, dst.Expr) {
+	switch t := t.(type) {
+	case *core.ListType:
+		list := g.decl.CreateLocalInfo("l", &dst.PointerRef{Element: g.ctx.runtimeType("List")})
+		elem := g.decl.CreateLocalInfo("e", typeRef(t.Type, g.ctx))
+		body, expr := g.describeValue(getLocal(elem), t.Type)
+		body = append(body, methodCall(getLocal(list), "Append", expr))
+		return []dst.Stmt{
+			assign(setLocal(list), runtimeCall("MakeList", strLiteral(core.TypeName(t)))),
+			rangeStmt(&dst.SetDiscard{}, setLocal(elem), value, body),
+		}, getLocal(list)
+	case *core.StructType:
+		if t.Scoped {
+			return nil, methodCall(value, "DescribeRef")
+		}
+	}
+	return nil, runtimeCall("Describe", value)
+}
+
+func (g *describeGenerator) method(name string, s *core.StructType, result dst.TypeRef) *dst.LocalInfo {
+	g.decl = funcDecl(name)
+	node := g.decl.CreateLocalInfo("node", typeRef(s, g.ctx))
+	g.decl.Recv = param(node)
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{},
+		Results: []*dst.Param{&dst.Param{Type: result}},
+	}
+	return node
+}
+
+func (g *describeGenerator) returnNilIfNil(node *dst.LocalInfo) dst.Stmt {
+	return ifStmt(
+		checkEQ(getLocal(node), nilLiteral()),
+		&dst.Return{Args: []dst.Expr{runtimeCall("Describe", nilLiteral())}},
+	)
+}
+
+func (g *describeGenerator) generateDescribe(s *core.StructType) *dst.FuncDecl {
+	node := g.method("Describe", s, g.valueRef())
+	d := g.decl.CreateLocalInfo("d", &dst.PointerRef{Element: g.ctx.runtimeType("Struct")})
+
+	stmts := []dst.Stmt{
+		g.returnNilIfNil(node),
+		assign(setLocal(d), runtimeCall("MakeStruct", strLiteral(s.Name))),
+	}
+	for _, f := range s.Fields {
+		fieldStmts, expr := g.describeValue(attr(getLocal(node), f.Name), f.Type)
+		stmts = append(stmts, fieldStmts...)
+		stmts = append(stmts, methodCall(getLocal(d), "AddField", strLiteral(f.Name), expr))
+	}
+	stmts = append(stmts, &dst.Return{Args: []dst.Expr{getLocal(d)}})
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func (g *describeGenerator) generateDescribeRef(s *core.StructType) *dst.FuncDecl {
+	node := g.method("DescribeRef", s, g.valueRef())
+	index := &dst.TypeCoerce{
+		Type: dst.RefForType(g.ctx.index.Int),
+		Expr: attr(getLocal(node), "Index"),
+	}
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			g.returnNilIfNil(node),
+			&dst.Return{Args: []dst.Expr{runtimeCall("Ref", strLiteral(s.Name), index)}},
+		},
+	}
+	return g.decl
+}
+
+func (g *describeGenerator) generateString(s *core.StructType) *dst.FuncDecl {
+	node := g.method("String", s, dst.RefForType(g.ctx.index.String))
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			&dst.Return{
+				Args: []dst.Expr{
+					runtimeCall("Format", methodCall(getLocal(node), "Describe")),
+				},
+			},
+		},
+	}
+	return g.decl
+}
+
+func GenerateDescribers(leaf string, coreProg *core.CoreProgram, p *core.Package, ctx *DubToGoContext) *dst.FileAST {
+	g := &describeGenerator{ctx: ctx}
+	decls := []dst.Decl{}
+	for _, s := range packageStructures(coreProg, p) {
+		if s.IsParent {
+			continue
+		}
+		decls = append(decls, g.generateDescribe(s))
+		if s.Scoped {
+			decls = append(decls, g.generateDescribeRef(s))
+		}
+		// A field cannot share its name with a method.
+		if !hasField(s, "String") {
+			decls = append(decls, g.generateString(s))
+		}
+	}
+	if len(decls) == 0 {
+		return nil
+	}
+	return &dst.FileAST{
+		Name:    "generated_dub_describe.go",
+		Package: leaf,
+		Decls:   decls,
+	}
+}
@@ -4,14 +4,17 @@ import (
 	"evergreen/compiler"
 	"evergreen/dub/core"
 	"evergreen/dub/flow"
+	"evergreen/dub/tree"
 	dstcore "evergreen/go/core"
 	dstflow "evergreen/go/flow"
 	"evergreen/go/transform"
 	ast "evergreen/go/tree"
+	"strings"
 )
 
 type DubToGoContext struct {
 	index       *dstcore.BuiltinTypeIndex
+	runtime     *dstcore.Package
 	state       *dstcore.StructType
 	graph       *dstcore.StructType
 	t           *dstcore.StructType
@@ -19,6 +22,7 @@ type DubToGoContext struct {
 	core        *core.CoreProgram
 	dstCore     *dstcore.CoreProgram
 	functionMap []*dstcore.Function
+	fileMap     []*dstcore.File
 }
 
 func makeExterns(goCoreProg *dstcore.CoreProgram, ctx *DubToGoContext) {
@@ -30,6 +34,7 @@ func makeExterns(goCoreProg *dstcore.CoreProgram, ctx *DubToGoContext) {
 		Name:    "State",
 		Package: runtimePkg,
 	}
+	ctx.runtime = runtimePkg
 
 	graphPkg := goCoreProg.Package_Scope.Register(&dstcore.Package{
 		Extern: true,
@@ -50,14 +55,25 @@ func makeExterns(goCoreProg *dstcore.CoreProgram, ctx *DubToGoContext) {
 	}
 }
 
+// A reference to a type declared in the dub runtime package.
+func (ctx *DubToGoContext) runtimeType(name string) ast.TypeRef {
+	return &ast.NameRef{
+		T: &dstcore.ExternalType{
+			Name:    name,
+			Package: ctx.runtime,
+		},
+	}
+}
+
 func createFuncs(dubCoreProg *core.CoreProgram, dubFlowProg *flow.DubProgram, goCoreProg *dstcore.CoreProgram, goFlowProg *dstflow.FlowProgram, packages []*dstcore.Package, ctx *DubToGoContext) []*dstflow.FlowFunc {
 	n := dubCoreProg.Function_Scope.Len()
 	coreMap := make([]*dstcore.Function, n)
 	for i := 0; i < n; i++ {
 		f := dubCoreProg.Function_Scope.Get(core.Function_Ref(i))
 		coreMap[i] = goCoreProg.Function_Scope.Register(&dstcore.Function{
-			Name:    f.Name,
+			Name:    functionName(f),
 			Package: nil,
+			File:    ctx.fileMap[f.File.Index],
 		})
 	}
 	ctx.functionMap = coreMap
@@ -80,12 +96,39 @@ func pathLeaf(path []string) string {
 	return path[len(path)-1]
 }
 
-func GenerateGo(status compiler.PassStatus, program *flow.DubProgram, coreProg *core.CoreProgram, rootPackage []string, generate_tests bool) (*dstflow.FlowProgram, *dstcore.CoreProgram, *transform.TreeBypass) {
+// Each dub source file is translated into a Go source file of the same name.
+func goFileName(f *core.File) string {
+	return strings.TrimSuffix(f.Name, ".dub") + "_dub.go"
+}
+
+func createFiles(coreProg *core.CoreProgram, goCoreProg *dstcore.CoreProgram, packages []*dstcore.Package, ctx *DubToGoContext) {
+	n := coreProg.File_Scope.Len()
+	ctx.fileMap = make([]*dstcore.File, n)
+	for i := 0; i < n; i++ {
+		f := coreProg.File_Scope.Get(core.File_Ref(i))
+		goFile := goCoreProg.File_Scope.Register(&dstcore.File{
+			Name: goFileName(f),
+		})
+		dstcore.InsertFileIntoPackage(goCoreProg, packages[f.Package.Index], goFile)
+		ctx.fileMap[i] = goFile
+	}
+}
+
+// GenerateOptions selects the optional code emitted alongside the translated
+// program.
+type GenerateOptions struct {
+	Tests    bool
+	Visitors bool
+	JSON     bool
+}
+
+func GenerateGo(status compiler.PassStatus, program *flow.DubProgram, coreProg *core.CoreProgram, rootPackage []string, options *GenerateOptions) (*dstflow.FlowProgram, *dstcore.CoreProgram, *transform.TreeBypass) {
 	status.Begin()
 	defer status.End()
 
 	dstCoreProg := &dstcore.CoreProgram{
 		Package_Scope:  &dstcore.Package_Scope{},
+		File_Scope:     &dstcore.File_Scope{},
 		Function_Scope: &dstcore.Function_Scope{},
 	}
 
@@ -105,9 +148,10 @@ func GenerateGo(status compiler.PassStatus, program *flow.DubProgram, coreProg *
 		dstCore: dstCoreProg,
 	}
 	makeExterns(dstCoreProg, ctx)
+	createFiles(coreProg, dstCoreProg, packages, ctx)
 
 	// Translate types.
-	types := createTypeMapping(program, coreProg, packages, ctx.link)
+	types := createTypeMapping(program, coreProg, packages, ctx.fileMap, ctx.link)
 	createTypes(program, coreProg, ctx)
 
 	flowProg := &dstflow.FlowProgram{
@@ -120,21 +164,68 @@ func GenerateGo(status compiler.PassStatus, program *flow.DubProgram, coreProg *
 	createFuncs(coreProg, program, dstCoreProg, flowProg, packages, ctx)
 	createTags(coreProg, program, dstCoreProg, flowProg, packages, ctx)
 
-	bypass := generateTreeBypass(program, coreProg, generate_tests, ctx)
+	bypass := generateTreeBypass(program, coreProg, packages, options, ctx)
 
 	return flowProg, dstCoreProg, bypass
 }
 
-func generateTreeBypass(program *flow.DubProgram, coreProg *core.CoreProgram, generate_tests bool, ctx *DubToGoContext) *transform.TreeBypass {
+func generateTreeBypass(program *flow.DubProgram, coreProg *core.CoreProgram, packages []*dstcore.Package, options *GenerateOptions, ctx *DubToGoContext) *transform.TreeBypass {
 	bypass := &transform.TreeBypass{
-		Tests: make([]*ast.FileAST, len(program.Packages)),
+		Tests: make([][]*ast.FileAST, len(program.Packages)),
+		Extra: make([][]*ast.FileAST, len(program.Packages)),
 	}
 
-	// For each package, generate tests that cannot be derived from the flow IR
-	if generate_tests {
+	// For each source file, generate tests that cannot be derived from the flow IR
+	if options.Tests {
 		for i, dubPkg := range program.Packages {
-			if len(dubPkg.Tests) != 0 {
-				bypass.Tests[i] = GenerateTests(pathLeaf(dubPkg.Path), dubPkg.Tests, ctx)
+			fileTests := map[*core.File][]*tree.Test{}
+			for _, tst := range dubPkg.Tests {
+				fileTests[tst.File] = append(fileTests[tst.File], tst)
+			}
+			for _, f := range coreProg.Package_Scope.Get(core.Package_Ref(i)).Files {
+				tests := fileTests[f]
+				if len(tests) != 0 {
+					bypass.Tests[i] = append(bypass.Tests[i], GenerateTests(pathLeaf(dubPkg.Path), ctx.fileMap[f.Index], tests, ctx))
+				}
+			}
+		}
+	}
+
+	for i := range program.Packages {
+		file := GenerateDescribers(pathLeaf(packages[i].Path), coreProg, coreProg.Package_Scope.Get(core.Package_Ref(i)), ctx)
+		if file != nil {
+			bypass.Extra[i] = append(bypass.Extra[i], file)
+		}
+	}
+
+	for i := range program.Packages {
+		file := GenerateBinary(pathLeaf(packages[i].Path), coreProg, coreProg.Package_Scope.Get(core.Package_Ref(i)), ctx)
+		if file != nil {
+			bypass.Extra[i] = append(bypass.Extra[i], file)
+		}
+	}
+
+	for i := range program.Packages {
+		file := GenerateClone(pathLeaf(packages[i].Path), coreProg, coreProg.Package_Scope.Get(core.Package_Ref(i)), ctx)
+		if file != nil {
+			bypass.Extra[i] = append(bypass.Extra[i], file)
+		}
+	}
+
+	if options.Visitors {
+		for i := range program.Packages {
+			file := GenerateVisitors(pathLeaf(packages[i].Path), coreProg, coreProg.Package_Scope.Get(core.Package_Ref(i)), ctx)
+			if file != nil {
+				bypass.Extra[i] = append(bypass.Extra[i], file)
+			}
+		}
+	}
+
+	if options.JSON {
+		for i := range program.Packages {
+			file := GenerateJSON(pathLeaf(packages[i].Path), coreProg, coreProg.Package_Scope.Get(core.Package_Ref(i)), ctx)
+			if file != nil {
+				bypass.Extra[i] = append(bypass.Extra[i], file)
 			}
 		}
 	}
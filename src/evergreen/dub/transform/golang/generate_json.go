@@ -0,0 +1,414 @@
+package golang
+
+import (
+	"evergreen/dub/core"
+	dstcore "evergreen/go/core"
+	dst "evergreen/go/tree"
+)
+
+// Generates JSON encoders and decoders for every structure in a package.
+// Concrete structures get EncodeJSON and DecodeJSON methods, which are also
+// exposed through the standard MarshalJSON and UnmarshalJSON methods.  Every
+// structure, including the parents of implements hierarchies, gets a
+// Decode<Name>JSON function which allocates the structure and decodes it.
+// See evergreen/dub/runtime for the encoding.
+//
+// Graphs are not encoded.
+
+type jsonGenerator struct {
+	ctx  *DubToGoContext
+	decl *dst.FuncDecl
+}
+
+func jsonDecoderName(s *core.StructType) string {
+	prefix := "Decode"
+	if !s.Exported {
+		prefix = "decode"
+	}
+	return prefix + structName(s) + "JSON"
+}
+
+func isGraph(t core.DubType) bool {
+	bt, ok := t.(*core.BuiltinType)
+	return ok && bt.Name == "graph"
+}
+
+// Lists of builtins can be handed to encoding/json as is.
+func isPlainList(t core.DubType) bool {
+	lt, ok := t.(*core.ListType)
+	if !ok {
+		return false
+	}
+	_, ok = lt.Type.(*core.BuiltinType)
+	return ok
+}
+
+var jsonBuiltinDecoders = map[string]string{
+	"string":  "AsString",
+	"rune":    "AsRune",
+	"int":     "AsInt",
+	"int64":   "AsInt64",
+	"uint32":  "AsUint32",
+	"float32": "AsFloat32",
+	"bool":    "AsBool",
+}
+
+func (g *jsonGenerator) goPackage(s *core.StructType) *dstcore.Package {
+	switch t := g.ctx.link.GetType(s, STRUCT).(type) {
+	case *dstcore.StructType:
+		return t.Package
+	case *dstcore.InterfaceType:
+		return t.Package
+	default:
+		panic(t)
+	}
+}
+
+func (g *jsonGenerator) decoderFunc(s *core.StructType) dst.Expr {
+	return &dst.GetFunction{
+		Func: &dstcore.Function{
+			Name:    jsonDecoderName(s),
+			Package: g.goPackage(s),
+		},
+	}
+}
+
+func (g *jsonGenerator) valueRef() dst.TypeRef {
+	return &dst.PointerRef{Element: g.ctx.runtimeType("JSONValue")}
+}
+
+func (g *jsonGenerator) anyRef() dst.TypeRef {
+	return &dst.NameRef{T: &dstcore.ExternalType{Name: "interface{}"}}
+}
+
+// Returns the statements needed to encode a value and an expression for the
+// encoded value.
+func (g *jsonGenerator) encodeValue(value func() dst.Expr, t core.DubType) ([]dst.Stmt, dst.Expr) {
+	switch t := t.(type) {
+	case *core.BuiltinType:
+		return nil, value()
+	case *core.StructType:
+		if t.Scoped {
+			return nil, methodCall(value(), "EncodeJSONRef")
+		}
+		return nil, runtimeCall("EncodeJSON", value())
+	case *core.ListType:
+		if isPlainList(t) {
+			return nil, value()
+		}
+		list := g.decl.CreateLocalInfo("l", &dst.PointerRef{Element: g.ctx.runtimeType("JSONList")})
+		elem := g.decl.CreateLocalInfo("e", typeRef(t.Type, g.ctx))
+		body, expr := g.encodeValue(func() dst.Expr { return getLocal(elem) }, t.Type)
+		body = append(body, methodCall(getLocal(list), "Append", expr))
+		return []dst.Stmt{
+			assign(setLocal(list), nilLiteral()),
+			ifStmt(
+				checkNE(value(), nilLiteral()),
+				assign(setLocal(list), runtimeCall("MakeJSONList")),
+				rangeStmt(&dst.SetDiscard{}, setLocal(elem), value(), body),
+			),
+		}, getLocal(list)
+	default:
+		panic(t)
+	}
+}
+
+// Decode value into target.
+func (g *jsonGenerator) decodeValue(value func() dst.Expr, target func() dst.Target, t core.DubType) []dst.Stmt {
+	switch t := t.(type) {
+	case *core.BuiltinType:
+		method, ok := jsonBuiltinDecoders[t.Name]
+		if !ok {
+			panic(t.Name)
+		}
+		return []dst.Stmt{assign(target(), methodCall(value(), method))}
+	case *core.StructType:
+		if t.Scoped {
+			name := strLiteral(qualifiedName(t))
+			return []dst.Stmt{
+				&dst.If{
+					Cond: methodCall(value(), "Resolves", name),
+					T: &dst.Block{
+						Body: []dst.Stmt{
+							assign(target(), &dst.TypeAssert{
+								Expr: methodCall(value(), "Ref", strLiteral(qualifiedName(t))),
+								Type: typeRef(t, g.ctx),
+							}),
+						},
+					},
+					F: &dst.Block{
+						Body: []dst.Stmt{
+							assign(target(), nilLiteral()),
+						},
+					},
+				},
+			}
+		}
+		return []dst.Stmt{assign(target(), &dst.Call{Expr: g.decoderFunc(t), Args: []dst.Expr{value()}})}
+	case *core.ListType:
+		list := g.decl.CreateLocalInfo("s", typeRef(t, g.ctx))
+		elem := g.decl.CreateLocalInfo("e", g.valueRef())
+		item := g.decl.CreateLocalInfo("x", typeRef(t.Type, g.ctx))
+		body := g.decodeValue(func() dst.Expr { return getLocal(elem) }, func() dst.Target { return setLocal(item) }, t.Type)
+		body = append(body, assign(setLocal(list), call("append", getLocal(list), getLocal(item))))
+		return []dst.Stmt{
+			assign(setLocal(list), nilLiteral()),
+			ifStmt(
+				&dst.UnaryExpr{Op: "!", Expr: methodCall(value(), "IsNull")},
+				assign(setLocal(list), &dst.ListLiteral{Type: typeRef(t, g.ctx)}),
+				rangeStmt(&dst.SetDiscard{}, setLocal(elem), methodCall(value(), "Elements"), body),
+			),
+			assign(target(), getLocal(list)),
+		}
+	default:
+		panic(t)
+	}
+}
+
+func (g *jsonGenerator) method(name string, s *core.StructType, params []*dst.Param, results []dst.TypeRef) *dst.LocalInfo {
+	g.decl = funcDecl(name)
+	node := g.decl.CreateLocalInfo("node", typeRef(s, g.ctx))
+	g.decl.Recv = param(node)
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  params,
+		Results: []*dst.Param{},
+	}
+	for _, r := range results {
+		g.decl.Type.Results = append(g.decl.Type.Results, &dst.Param{Type: r})
+	}
+	return node
+}
+
+func (g *jsonGenerator) scopeObjects(node *dst.LocalInfo, c *core.StructType) dst.Expr {
+	return attr(attr(getLocal(node), subtypeName(c, SCOPE)), "objects")
+}
+
+func (g *jsonGenerator) generateEncode(s *core.StructType) *dst.FuncDecl {
+	node := g.method("EncodeJSON", s, []*dst.Param{}, []dst.TypeRef{g.anyRef()})
+	o := g.decl.CreateLocalInfo("o", &dst.PointerRef{Element: g.ctx.runtimeType("JSONObject")})
+
+	stmts := []dst.Stmt{
+		ifStmt(checkEQ(getLocal(node), nilLiteral()), &dst.Return{Args: []dst.Expr{nilLiteral()}}),
+		assign(setLocal(o), runtimeCall("MakeJSONObject", strLiteral(s.Name))),
+	}
+	for _, f := range s.Fields {
+		if isGraph(f.Type) {
+			continue
+		}
+		name := f.Name
+		fieldStmts, expr := g.encodeValue(func() dst.Expr { return attr(getLocal(node), name) }, f.Type)
+		stmts = append(stmts, fieldStmts...)
+		stmts = append(stmts, methodCall(getLocal(o), "Set", strLiteral(f.Name), expr))
+	}
+	for _, c := range s.Contains {
+		scope := subtypeName(c, SCOPE)
+		list := g.decl.CreateLocalInfo("l", &dst.PointerRef{Element: g.ctx.runtimeType("JSONList")})
+		elem := g.decl.CreateLocalInfo("e", typeRef(c, g.ctx))
+		stmts = append(stmts,
+			assign(setLocal(list), nilLiteral()),
+			ifStmt(
+				checkNE(attr(getLocal(node), scope), nilLiteral()),
+				assign(setLocal(list), runtimeCall("MakeJSONList")),
+				rangeStmt(&dst.SetDiscard{}, setLocal(elem), g.scopeObjects(node, c), []dst.Stmt{
+					methodCall(getLocal(list), "Append", methodCall(getLocal(elem), "EncodeJSON")),
+				}),
+			),
+			methodCall(getLocal(o), "Set", strLiteral(scope), getLocal(list)),
+		)
+	}
+	stmts = append(stmts, &dst.Return{Args: []dst.Expr{getLocal(o)}})
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func (g *jsonGenerator) generateEncodeRef(s *core.StructType) *dst.FuncDecl {
+	node := g.method("EncodeJSONRef", s, []*dst.Param{}, []dst.TypeRef{g.anyRef()})
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			ifStmt(checkEQ(getLocal(node), nilLiteral()), &dst.Return{Args: []dst.Expr{nilLiteral()}}),
+			&dst.Return{Args: []dst.Expr{attr(getLocal(node), "Index")}},
+		},
+	}
+	return g.decl
+}
+
+func (g *jsonGenerator) generateDecode(s *core.StructType) *dst.FuncDecl {
+	node := g.method("DecodeJSON", s, []*dst.Param{}, nil)
+	v := g.decl.CreateLocalInfo("v", g.valueRef())
+	g.decl.Type.Params = []*dst.Param{param(v)}
+
+	stmts := []dst.Stmt{
+		methodCall(getLocal(v), "Expect", strLiteral(s.Name)),
+	}
+
+	// Create the objects in each scope before decoding anything, so
+	// references to them can be resolved.
+	scopes := make([]*dst.LocalInfo, len(s.Contains))
+	fields := make([]*dst.LocalInfo, len(s.Contains))
+	for i, c := range s.Contains {
+		scopeName := subtypeName(c, SCOPE)
+		scopes[i] = g.decl.CreateLocalInfo("scope", &dst.PointerRef{Element: g.ctx.runtimeType("JSONScope")})
+		fields[i] = g.decl.CreateLocalInfo("f", g.valueRef())
+		obj := g.decl.CreateLocalInfo("o", typeRef(c, g.ctx))
+		stmts = append(stmts,
+			assign(setLocal(fields[i]), methodCall(getLocal(v), "Field", strLiteral(scopeName))),
+			assign(setLocal(scopes[i]), runtimeCall("MakeJSONScope", strLiteral(qualifiedName(c)))),
+			ifStmt(
+				&dst.UnaryExpr{Op: "!", Expr: methodCall(getLocal(fields[i]), "IsNull")},
+				assign(
+					&dst.SetSelector{Expr: getLocal(node), Text: scopeName},
+					&dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: g.ctx.link.TypeRef(c, SCOPE)}},
+				),
+				rangeStmt(nil, nil, methodCall(getLocal(fields[i]), "Elements"), []dst.Stmt{
+					assign(setLocal(obj), &dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: g.ctx.link.TypeRef(c, STRUCT)}}),
+					assign(
+						&dst.SetSelector{Expr: attr(getLocal(node), scopeName), Text: "objects"},
+						call("append", g.scopeObjects(node, c), getLocal(obj)),
+					),
+					methodCall(getLocal(scopes[i]), "Add", getLocal(obj)),
+				}),
+			),
+			methodCall(getLocal(v), "Bind", getLocal(scopes[i])),
+		)
+	}
+	for i, c := range s.Contains {
+		index := g.decl.CreateLocalInfo("i", dst.RefForType(g.ctx.index.Int))
+		elem := g.decl.CreateLocalInfo("e", g.valueRef())
+		obj := g.decl.CreateLocalInfo("o", typeRef(c, g.ctx))
+		stmts = append(stmts,
+			rangeStmt(setLocal(index), setLocal(elem), methodCall(getLocal(fields[i]), "Elements"), []dst.Stmt{
+				assign(setLocal(obj), &dst.Index{Expr: g.scopeObjects(node, c), Index: getLocal(index)}),
+				assign(
+					&dst.SetSelector{Expr: getLocal(obj), Text: "Index"},
+					&dst.TypeCoerce{Type: g.ctx.link.TypeRef(c, REF), Expr: getLocal(index)},
+				),
+				methodCall(getLocal(obj), "DecodeJSON", getLocal(elem)),
+			}),
+		)
+	}
+
+	for _, f := range s.Fields {
+		if isGraph(f.Type) {
+			continue
+		}
+		name := f.Name
+		stmts = append(stmts, g.decodeValue(
+			func() dst.Expr { return methodCall(getLocal(v), "Field", strLiteral(name)) },
+			func() dst.Target { return &dst.SetSelector{Expr: getLocal(node), Text: name} },
+			f.Type,
+		)...)
+	}
+
+	for i := len(scopes) - 1; i >= 0; i-- {
+		stmts = append(stmts, methodCall(getLocal(v), "Unbind", getLocal(scopes[i])))
+	}
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func (g *jsonGenerator) generateMarshal(s *core.StructType) *dst.FuncDecl {
+	node := g.method("MarshalJSON", s, []*dst.Param{}, []dst.TypeRef{
+		&dst.SliceRef{Element: &dst.NameRef{T: &dstcore.ExternalType{Name: "byte"}}},
+		&dst.NameRef{T: &dstcore.ExternalType{Name: "error"}},
+	})
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			&dst.Return{Args: []dst.Expr{runtimeCall("MarshalJSON", methodCall(getLocal(node), "EncodeJSON"))}},
+		},
+	}
+	return g.decl
+}
+
+func (g *jsonGenerator) generateUnmarshal(s *core.StructType) *dst.FuncDecl {
+	node := g.method("UnmarshalJSON", s, []*dst.Param{}, []dst.TypeRef{
+		&dst.NameRef{T: &dstcore.ExternalType{Name: "error"}},
+	})
+	data := g.decl.CreateLocalInfo("data", &dst.SliceRef{Element: &dst.NameRef{T: &dstcore.ExternalType{Name: "byte"}}})
+	g.decl.Type.Params = []*dst.Param{param(data)}
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			&dst.Return{Args: []dst.Expr{runtimeCall("UnmarshalJSON", getLocal(data), getLocal(node))}},
+		},
+	}
+	return g.decl
+}
+
+func (g *jsonGenerator) decoderDecl(s *core.StructType) (*dst.LocalInfo, dst.TypeRef) {
+	g.decl = funcDecl(jsonDecoderName(s))
+	v := g.decl.CreateLocalInfo("v", g.valueRef())
+	result := typeRef(s, g.ctx)
+	g.decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(v)},
+		Results: []*dst.Param{&dst.Param{Type: result}},
+	}
+	return v, result
+}
+
+func (g *jsonGenerator) generateDecoder(s *core.StructType) *dst.FuncDecl {
+	v, result := g.decoderDecl(s)
+	node := g.decl.CreateLocalInfo("node", result)
+	g.decl.Block = &dst.Block{
+		Body: []dst.Stmt{
+			ifStmt(methodCall(getLocal(v), "IsNull"), &dst.Return{Args: []dst.Expr{nilLiteral()}}),
+			assign(setLocal(node), &dst.UnaryExpr{Op: "&", Expr: &dst.StructLiteral{Type: g.ctx.link.TypeRef(s, STRUCT)}}),
+			methodCall(getLocal(node), "DecodeJSON", getLocal(v)),
+			&dst.Return{Args: []dst.Expr{getLocal(node)}},
+		},
+	}
+	return g.decl
+}
+
+// Parents dispatch on the type recorded in the object.
+func (g *jsonGenerator) generateParentDecoder(coreProg *core.CoreProgram, s *core.StructType) *dst.FuncDecl {
+	v, _ := g.decoderDecl(s)
+	t := g.decl.CreateLocalInfo("t", dst.RefForType(g.ctx.index.String))
+	stmts := []dst.Stmt{
+		ifStmt(methodCall(getLocal(v), "IsNull"), &dst.Return{Args: []dst.Expr{nilLiteral()}}),
+		assign(setLocal(t), methodCall(getLocal(v), "Type")),
+	}
+	for _, m := range concreteMembers(coreProg, s) {
+		stmts = append(stmts, ifStmt(
+			checkEQ(getLocal(t), strLiteral(m.Name)),
+			&dst.Return{Args: []dst.Expr{&dst.Call{Expr: g.decoderFunc(m), Args: []dst.Expr{getLocal(v)}}}},
+		))
+	}
+	stmts = append(stmts,
+		methodCall(getLocal(v), "Fail", &dst.BinaryExpr{
+			Left:  strLiteral("unexpected "),
+			Op:    "+",
+			Right: getLocal(t),
+		}),
+		&dst.Return{Args: []dst.Expr{nilLiteral()}},
+	)
+	g.decl.Block = &dst.Block{Body: stmts}
+	return g.decl
+}
+
+func GenerateJSON(leaf string, coreProg *core.CoreProgram, p *core.Package, ctx *DubToGoContext) *dst.FileAST {
+	g := &jsonGenerator{ctx: ctx}
+	decls := []dst.Decl{}
+	for _, s := range packageStructures(coreProg, p) {
+		if s.IsParent {
+			decls = append(decls, g.generateParentDecoder(coreProg, s))
+			continue
+		}
+		decls = append(decls, g.generateEncode(s))
+		if s.Scoped {
+			decls = append(decls, g.generateEncodeRef(s))
+		}
+		decls = append(decls,
+			g.generateDecode(s),
+			g.generateDecoder(s),
+			g.generateMarshal(s),
+			g.generateUnmarshal(s),
+		)
+	}
+	if len(decls) == 0 {
+		return nil
+	}
+	return &dst.FileAST{
+		Name:    "generated_dub_json.go",
+		Package: leaf,
+		Decls:   decls,
+	}
+}
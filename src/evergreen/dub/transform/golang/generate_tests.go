@@ -3,16 +3,16 @@ package golang
 import (
 	"evergreen/dub/core"
 	"evergreen/dub/tree"
+	dstcore "evergreen/go/core"
 	dst "evergreen/go/tree"
 	"fmt"
+	"strings"
 )
 
 type testingContext struct {
-	glbl     *DubToGoContext
-	funcDecl *dst.FuncDecl
-	state    *dst.LocalInfo
-	tInfo    *dst.LocalInfo
-	okInfo   *dst.LocalInfo
+	glbl  *DubToGoContext
+	state *dst.LocalInfo
+	tInfo *dst.LocalInfo
 }
 
 func (ctx *testingContext) GetState() dst.Expr {
@@ -71,15 +71,6 @@ func (ctx *testingContext) makeFatalTest(cond dst.Expr, f string, args ...dst.Ex
 	}
 }
 
-func makeLen(expr dst.Expr) dst.Expr {
-	return &dst.Call{
-		Expr: glbl("len"),
-		Args: []dst.Expr{
-			expr,
-		},
-	}
-}
-
 func checkEQ(x dst.Expr, y dst.Expr) dst.Expr {
 	return &dst.BinaryExpr{
 		Left:  x,
@@ -114,134 +105,47 @@ func translateType(ctx *testingContext, at core.DubType) dst.TypeRef {
 	}
 }
 
-func generateDestructure(value *dst.LocalInfo, nameX string, path string, d tree.Destructure, generalType core.DubType, ctx *testingContext, stmts []dst.Stmt) []dst.Stmt {
+// Build the description a destructure expects.  Fields the destructure does
+// not mention are left out, so runtime.Diff will not compare them.
+func generateExpected(d tree.Destructure) dst.Expr {
 	switch d := d.(type) {
 	case *tree.DestructureStruct:
-		actual_value := value
-
-		actualType := tree.ResolveType(d.Type)
-		structType, ok := actualType.(*core.StructType)
+		t := tree.ResolveType(d.Type)
+		structType, ok := t.(*core.StructType)
 		if !ok {
-			panic(actualType)
-		}
-
-		if generalType != actualType {
-			actual_name := fmt.Sprintf("typed_%s", nameX)
-
-			lref := translateType(ctx, actualType)
-			actual_value = ctx.funcDecl.LocalInfo_Scope.Register(&dst.LocalInfo{
-				Name: actual_name,
-				T:    lref,
-			})
-
-			ref := translateType(ctx, actualType)
-			stmts = append(stmts, &dst.Assign{
-				Targets: []dst.Target{
-					// HACK
-					&dst.SetLocal{Info: actual_value},
-					&dst.SetLocal{Info: ctx.okInfo},
-				},
-				Op: "=",
-				Sources: []dst.Expr{
-					// TODO typecast tree.
-					&dst.TypeAssert{
-						Expr: &dst.GetLocal{Info: value},
-						Type: ref,
-					},
-				},
-			})
-			stmts = append(stmts, ctx.makeFatalTest(
-				&dst.UnaryExpr{Op: "!", Expr: &dst.GetLocal{Info: ctx.okInfo}},
-				fmt.Sprintf("%s: expected a *%s but got a %%#v", path, structType.Name),
-				&dst.GetLocal{Info: value},
-			))
+			panic(t)
 		}
-		cond := checkEQ(&dst.GetLocal{Info: actual_value}, &dst.NilLiteral{})
-		stmts = append(stmts, ctx.makeFatalTest(cond, fmt.Sprintf("%s: nil", path)))
-
+		args := []dst.Expr{strLiteral(structType.Name)}
 		for _, arg := range d.Args {
-			fn := arg.Name.Text
-			childstmts := []dst.Stmt{}
-			child_name := fmt.Sprintf("%s_%s", nameX, fn)
-			child_path := fmt.Sprintf("%s.%s", path, fn)
-
-			f := tree.GetField(structType, fn)
-			t := f.Type
-
-			child_value := ctx.funcDecl.CreateLocalInfo(child_name, translateType(ctx, t))
-			childstmts = append(childstmts, &dst.Assign{
-				Targets: []dst.Target{
-					&dst.SetLocal{Info: child_value},
-				},
-				Op: "=",
-				Sources: []dst.Expr{
-					attr(&dst.GetLocal{Info: actual_value}, fn),
-				},
-			})
-			childstmts = generateDestructure(
-				child_value,
-				child_name,
-				child_path,
-				arg.Destructure,
-				t,
-				ctx,
-				childstmts,
-			)
-			//stmts = append(stmts, &dst.BlockStmt{Body: childstmts})
-			stmts = append(stmts, childstmts...)
+			args = append(args, runtimeCall("MakeField", strLiteral(arg.Name.Text), generateExpected(arg.Destructure)))
 		}
+		return runtimeCall("MakeStruct", args...)
 	case *tree.DestructureList:
-		stmts = append(stmts, ctx.makeFatalTest(
-			checkNE(makeLen(&dst.GetLocal{Info: value}), intLiteral(len(d.Args))),
-			fmt.Sprintf("%s: expected length %d but got %%d", path, len(d.Args)),
-			makeLen(&dst.GetLocal{Info: value}),
-		))
-		t := tree.ResolveType(d.Type)
-		dt, ok := t.(*core.ListType)
-		if !ok {
-			panic(t)
-		}
-		for i, arg := range d.Args {
-			childstmts := []dst.Stmt{}
-			child_name := fmt.Sprintf("%s_%d", nameX, i)
-			child_path := fmt.Sprintf("%s[%d]", path, i)
-			child_value := ctx.funcDecl.CreateLocalInfo(child_name, translateType(ctx, dt.Type))
-			childstmts = append(childstmts, &dst.Assign{
-				Targets: []dst.Target{
-					&dst.SetLocal{Info: child_value},
-				},
-				Op: "=",
-				Sources: []dst.Expr{
-					&dst.Index{
-						Expr:  &dst.GetLocal{Info: value},
-						Index: intLiteral(i),
-					},
-				},
-			})
-			childstmts = generateDestructure(child_value, child_name, child_path, arg, dt.Type, ctx, childstmts)
-			//stmts = append(stmts, &dst.BlockStmt{Body: childstmts})
-			stmts = append(stmts, childstmts...)
+		args := []dst.Expr{strLiteral(core.TypeName(tree.ResolveType(d.Type)))}
+		for _, arg := range d.Args {
+			args = append(args, generateExpected(arg))
 		}
+		return runtimeCall("MakeList", args...)
 	case *tree.DestructureValue:
+		var value dst.Expr
 		switch expr := d.Expr.(type) {
 		case *tree.StringLiteral:
-			stmts = append(stmts, ctx.makeFatalTest(checkNE(&dst.GetLocal{Info: value}, strLiteral(expr.Value)), fmt.Sprintf("%s: expected %%#v but got %%#v", path), strLiteral(expr.Value), &dst.GetLocal{Info: value}))
+			value = strLiteral(expr.Value)
 		case *tree.RuneLiteral:
-			stmts = append(stmts, ctx.makeFatalTest(checkNE(&dst.GetLocal{Info: value}, runeLiteral(expr.Value)), fmt.Sprintf("%s: expected %%#U but got %%#U", path), runeLiteral(expr.Value), &dst.GetLocal{Info: value}))
+			value = runeLiteral(expr.Value)
 		case *tree.IntLiteral:
-			stmts = append(stmts, ctx.makeFatalTest(checkNE(&dst.GetLocal{Info: value}, intLiteral(expr.Value)), fmt.Sprintf("%s: expected %%#v but got %%#v", path), intLiteral(expr.Value), &dst.GetLocal{Info: value}))
+			value = intLiteral(expr.Value)
 		case *tree.BoolLiteral:
-			stmts = append(stmts, ctx.makeFatalTest(checkNE(&dst.GetLocal{Info: value}, boolLiteral(expr.Value)), fmt.Sprintf("%s: expected %%#v but got %%#v", path), boolLiteral(expr.Value), &dst.GetLocal{Info: value}))
+			value = boolLiteral(expr.Value)
 		case *tree.NilLiteral:
-			stmts = append(stmts, ctx.makeFatalTest(checkNE(&dst.GetLocal{Info: value}, nilLiteral()), fmt.Sprintf("%s: expected nil but got %%#v", path), &dst.GetLocal{Info: value}))
+			value = nilLiteral()
 		default:
 			panic(expr)
 		}
+		return runtimeCall("Describe", value)
 	default:
 		panic(d)
 	}
-
-	return stmts
 }
 
 func generateExpr(ctx *testingContext, expr tree.ASTExpr) dst.Expr {
@@ -260,7 +164,7 @@ func generateExpr(ctx *testingContext, expr tree.ASTExpr) dst.Expr {
 			c = e.Func
 			switch f := c.(type) {
 			case *core.Function:
-				fname = f.Name
+				fname = functionName(f)
 			case *core.IntrinsicFunction:
 				fname = f.Name
 			default:
@@ -294,8 +198,7 @@ func generateGoTest(tst *tree.Test, gctx *DubToGoContext) *dst.FuncDecl {
 	}
 
 	ctx := &testingContext{
-		glbl:     gctx,
-		funcDecl: decl,
+		glbl: gctx,
 	}
 	ctx.tInfo = decl.CreateLocalInfo("t", &dst.PointerRef{
 		Element: &dst.NameRef{
@@ -307,10 +210,6 @@ func generateGoTest(tst *tree.Test, gctx *DubToGoContext) *dst.FuncDecl {
 			T: ctx.glbl.state,
 		},
 	})
-	ctx.okInfo = decl.CreateLocalInfo("ok", &dst.NameRef{
-		Name: "bool",
-		T:    gctx.index.Bool,
-	})
 
 	stmts := []dst.Stmt{}
 	stmts = append(stmts, &dst.Assign{
@@ -365,7 +264,24 @@ func generateGoTest(tst *tree.Test, gctx *DubToGoContext) *dst.FuncDecl {
 		attr(glbl("runtime"), flowName), attr(ctx.GetState(), "Flow"),
 	))
 
-	stmts = generateDestructure(root_value, root_name, root_name, tst.Destructure, tst.Type, ctx, stmts)
+	// Compare the result against the destructure.
+	diff := decl.CreateLocalInfo("diff", &dst.PointerRef{
+		Element: ctx.glbl.runtimeType("Difference"),
+	})
+	stmts = append(stmts, &dst.Assign{
+		Targets: []dst.Target{
+			&dst.SetLocal{Info: diff},
+		},
+		Op: "=",
+		Sources: []dst.Expr{
+			runtimeCall("Diff", generateExpected(tst.Destructure), runtimeCall("Describe", &dst.GetLocal{Info: root_value})),
+		},
+	})
+	stmts = append(stmts, ctx.makeFatalTest(
+		checkNE(&dst.GetLocal{Info: diff}, nilLiteral()),
+		"%s",
+		&dst.GetLocal{Info: diff},
+	))
 
 	decl.Type = &dst.FuncTypeRef{
 		Params: []*dst.Param{
@@ -379,7 +295,7 @@ func generateGoTest(tst *tree.Test, gctx *DubToGoContext) *dst.FuncDecl {
 	return decl
 }
 
-func GenerateTests(leaf string, tests []*tree.Test, gctx *DubToGoContext) *dst.FileAST {
+func GenerateTests(leaf string, f *dstcore.File, tests []*tree.Test, gctx *DubToGoContext) *dst.FileAST {
 	decls := []dst.Decl{}
 
 	for _, tst := range tests {
@@ -387,7 +303,7 @@ func GenerateTests(leaf string, tests []*tree.Test, gctx *DubToGoContext) *dst.F
 	}
 
 	file := &dst.FileAST{
-		Name:    "generated_dub_test.go",
+		Name:    strings.TrimSuffix(f.Name, ".go") + "_test.go",
 		Package: leaf,
 		Decls:   decls,
 	}
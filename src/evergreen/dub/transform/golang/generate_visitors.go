@@ -0,0 +1,347 @@
+package golang
+
+import (
+	"evergreen/dub/core"
+	dst "evergreen/go/tree"
+	"strings"
+)
+
+// Generates Walk and Rewrite functions for each struct hierarchy declared in
+// a package.  Walk visits the nodes of a tree in pre-order and descends into
+// a node's children only if the visitor returns true.  Rewrite replaces each
+// node, in post-order, with the result of the rewrite callback.  The result is
+// asserted back to the type of the field it replaces, so a callback that
+// returns a node of another subtype panics.  Fields of the root type accept
+// any node.
+//
+// A node's children are found by following its fields, through lists and
+// plain structures, until a member of the hierarchy is reached.  Scoped
+// structures are references rather than children and are not followed, nor
+// are structures declared in other packages.
+type visitorGenerator struct {
+	ctx      *DubToGoContext
+	coreProg *core.CoreProgram
+	pkg      *core.Package
+	root     *core.StructType
+
+	// Concrete structures with at least one field that reaches the hierarchy.
+	hasChildren map[*core.StructType]bool
+
+	requested map[*core.StructType]bool
+	pending   []*core.StructType
+}
+
+func (g *visitorGenerator) followed(s *core.StructType) bool {
+	return s.File.Package == g.pkg && !s.Scoped
+}
+
+func (g *visitorGenerator) isNode(s *core.StructType) bool {
+	return implementsStruct(s, g.root)
+}
+
+func (g *visitorGenerator) reaches(t core.DubType) bool {
+	switch t := t.(type) {
+	case *core.ListType:
+		return g.reaches(t.Type)
+	case *core.StructType:
+		if !g.followed(t) {
+			return false
+		}
+		if g.isNode(t) {
+			return true
+		}
+		if t.IsParent {
+			for _, m := range concreteMembers(g.coreProg, t) {
+				if g.hasChildren[m] {
+					return true
+				}
+			}
+			return false
+		}
+		return g.hasChildren[t]
+	default:
+		return false
+	}
+}
+
+func (g *visitorGenerator) findChildren() {
+	structs := packageStructures(g.coreProg, g.pkg)
+	changed := true
+	for changed {
+		changed = false
+		for _, s := range structs {
+			if s.IsParent || s.Scoped || g.hasChildren[s] {
+				continue
+			}
+			for _, f := range s.Fields {
+				if g.reaches(f.Type) {
+					g.hasChildren[s] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+}
+
+func (g *visitorGenerator) ref(t core.DubType) dst.TypeRef {
+	return typeRef(t, g.ctx)
+}
+
+func (g *visitorGenerator) rootName(prefix string) string {
+	if !g.root.Exported {
+		prefix = strings.ToLower(prefix)
+	}
+	return prefix + structName(g.root)
+}
+
+func (g *visitorGenerator) helperName(prefix string, s *core.StructType) string {
+	return strings.ToLower(prefix) + structName(g.root) + "_" + structName(s)
+}
+
+func (g *visitorGenerator) request(s *core.StructType) {
+	if !g.requested[s] {
+		g.requested[s] = true
+		g.pending = append(g.pending, s)
+	}
+}
+
+// func(R) bool
+func (g *visitorGenerator) visitType() dst.TypeRef {
+	return &dst.FuncTypeRef{
+		Params:  []*dst.Param{&dst.Param{Type: g.ref(g.root)}},
+		Results: []*dst.Param{&dst.Param{Type: dst.RefForType(g.ctx.index.Bool)}},
+	}
+}
+
+// func(R) R
+func (g *visitorGenerator) rewriteType() dst.TypeRef {
+	return &dst.FuncTypeRef{
+		Params:  []*dst.Param{&dst.Param{Type: g.ref(g.root)}},
+		Results: []*dst.Param{&dst.Param{Type: g.ref(g.root)}},
+	}
+}
+
+type fieldVisitor func(decl *dst.FuncDecl, fn *dst.LocalInfo, value func() dst.Expr, target func() dst.Target, t core.DubType) []dst.Stmt
+
+// Dispatch on the concrete type of node and visit the fields of each member
+// of parent that has children.
+func (g *visitorGenerator) memberSwitch(decl *dst.FuncDecl, node *dst.LocalInfo, fn *dst.LocalInfo, parent *core.StructType, visitor fieldVisitor) []dst.Stmt {
+	cases := []*dst.TypeSwitchCase{}
+	for _, m := range concreteMembers(g.coreProg, parent) {
+		if !g.followed(m) || !g.hasChildren[m] {
+			continue
+		}
+		n := decl.CreateLocalInfo("n", g.ref(m))
+		body := []dst.Stmt{
+			assign(setLocal(n), &dst.TypeAssert{Expr: getLocal(node), Type: g.ref(m)}),
+		}
+		body = append(body, g.visitFields(decl, n, fn, m, visitor)...)
+		cases = append(cases, &dst.TypeSwitchCase{
+			Types: []dst.TypeRef{g.ref(m)},
+			Block: &dst.Block{Body: body},
+		})
+	}
+	if len(cases) == 0 {
+		return nil
+	}
+	return []dst.Stmt{
+		&dst.TypeSwitch{
+			Expr:  getLocal(node),
+			Cases: cases,
+		},
+	}
+}
+
+func (g *visitorGenerator) visitFields(decl *dst.FuncDecl, node *dst.LocalInfo, fn *dst.LocalInfo, s *core.StructType, visitor fieldVisitor) []dst.Stmt {
+	stmts := []dst.Stmt{}
+	for _, f := range s.Fields {
+		name := f.Name
+		value := func() dst.Expr {
+			return attr(getLocal(node), name)
+		}
+		target := func() dst.Target {
+			return &dst.SetSelector{Expr: getLocal(node), Text: name}
+		}
+		stmts = append(stmts, visitor(decl, fn, value, target, f.Type)...)
+	}
+	return stmts
+}
+
+func (g *visitorGenerator) walkValue(decl *dst.FuncDecl, visit *dst.LocalInfo, value func() dst.Expr, target func() dst.Target, t core.DubType) []dst.Stmt {
+	if !g.reaches(t) {
+		return nil
+	}
+	switch t := t.(type) {
+	case *core.ListType:
+		elem := decl.CreateLocalInfo("e", g.ref(t.Type))
+		body := g.walkValue(decl, visit, func() dst.Expr { return getLocal(elem) }, nil, t.Type)
+		return []dst.Stmt{rangeStmt(&dst.SetDiscard{}, setLocal(elem), value(), body)}
+	case *core.StructType:
+		var stmt dst.Stmt
+		if g.isNode(t) {
+			stmt = call(g.rootName("Walk"), value(), getLocal(visit))
+		} else {
+			g.request(t)
+			stmt = call(g.helperName("walk", t), value(), getLocal(visit))
+		}
+		if !t.IsParent {
+			stmt = ifStmt(checkNE(value(), nilLiteral()), stmt)
+		}
+		return []dst.Stmt{stmt}
+	default:
+		panic(t)
+	}
+}
+
+func (g *visitorGenerator) rewriteValue(decl *dst.FuncDecl, rewrite *dst.LocalInfo, value func() dst.Expr, target func() dst.Target, t core.DubType) []dst.Stmt {
+	if !g.reaches(t) {
+		return nil
+	}
+	switch t := t.(type) {
+	case *core.ListType:
+		elem := decl.CreateLocalInfo("e", g.ref(t.Type))
+		var key dst.Target = &dst.SetDiscard{}
+		var elemTarget func() dst.Target
+		// Only replaced nodes need to be stored back into the list, everything
+		// else is rewritten in place.
+		et, ok := t.Type.(*core.StructType)
+		if ok && g.isNode(et) {
+			index := decl.CreateLocalInfo("i", dst.RefForType(g.ctx.index.Int))
+			key = setLocal(index)
+			elemTarget = func() dst.Target {
+				return &dst.SetIndex{Expr: value(), Index: getLocal(index)}
+			}
+		}
+		body := g.rewriteValue(decl, rewrite, func() dst.Expr { return getLocal(elem) }, elemTarget, t.Type)
+		return []dst.Stmt{rangeStmt(key, setLocal(elem), value(), body)}
+	case *core.StructType:
+		if g.isNode(t) {
+			var expr dst.Expr = call(g.rootName("Rewrite"), value(), getLocal(rewrite))
+			if t == g.root {
+				return []dst.Stmt{assign(target(), expr)}
+			}
+			expr = &dst.TypeAssert{Expr: expr, Type: g.ref(t)}
+			return []dst.Stmt{ifStmt(checkNE(value(), nilLiteral()), assign(target(), expr))}
+		}
+		g.request(t)
+		var stmt dst.Stmt = call(g.helperName("rewrite", t), value(), getLocal(rewrite))
+		if !t.IsParent {
+			stmt = ifStmt(checkNE(value(), nilLiteral()), stmt)
+		}
+		return []dst.Stmt{stmt}
+	default:
+		panic(t)
+	}
+}
+
+func (g *visitorGenerator) generateWalk() *dst.FuncDecl {
+	decl := funcDecl(g.rootName("Walk"))
+	node := decl.CreateLocalInfo("node", g.ref(g.root))
+	visit := decl.CreateLocalInfo("visit", g.visitType())
+	decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(node), param(visit)},
+		Results: []*dst.Param{},
+	}
+
+	stmts := []dst.Stmt{
+		ifStmt(
+			&dst.BinaryExpr{
+				Left: checkEQ(getLocal(node), nilLiteral()),
+				Op:   "||",
+				Right: &dst.UnaryExpr{
+					Op:   "!",
+					Expr: &dst.Call{Expr: getLocal(visit), Args: []dst.Expr{getLocal(node)}},
+				},
+			},
+			&dst.Return{},
+		),
+	}
+	stmts = append(stmts, g.memberSwitch(decl, node, visit, g.root, g.walkValue)...)
+	decl.Block = &dst.Block{Body: stmts}
+	return decl
+}
+
+func (g *visitorGenerator) generateRewrite() *dst.FuncDecl {
+	decl := funcDecl(g.rootName("Rewrite"))
+	node := decl.CreateLocalInfo("node", g.ref(g.root))
+	rewrite := decl.CreateLocalInfo("rewrite", g.rewriteType())
+	decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(node), param(rewrite)},
+		Results: []*dst.Param{&dst.Param{Type: g.ref(g.root)}},
+	}
+
+	stmts := []dst.Stmt{
+		ifStmt(
+			checkEQ(getLocal(node), nilLiteral()),
+			&dst.Return{Args: []dst.Expr{nilLiteral()}},
+		),
+	}
+	stmts = append(stmts, g.memberSwitch(decl, node, rewrite, g.root, g.rewriteValue)...)
+	stmts = append(stmts, &dst.Return{
+		Args: []dst.Expr{
+			&dst.Call{Expr: getLocal(rewrite), Args: []dst.Expr{getLocal(node)}},
+		},
+	})
+	decl.Block = &dst.Block{Body: stmts}
+	return decl
+}
+
+// Helpers descend through structures that are not part of the hierarchy.
+func (g *visitorGenerator) generateHelper(prefix string, s *core.StructType, fnName string, fnType dst.TypeRef, visitor fieldVisitor) *dst.FuncDecl {
+	decl := funcDecl(g.helperName(prefix, s))
+	node := decl.CreateLocalInfo("node", g.ref(s))
+	fn := decl.CreateLocalInfo(fnName, fnType)
+	decl.Type = &dst.FuncTypeRef{
+		Params:  []*dst.Param{param(node), param(fn)},
+		Results: []*dst.Param{},
+	}
+	var stmts []dst.Stmt
+	if s.IsParent {
+		stmts = g.memberSwitch(decl, node, fn, s, visitor)
+	} else {
+		stmts = g.visitFields(decl, node, fn, s, visitor)
+	}
+	decl.Block = &dst.Block{Body: stmts}
+	return decl
+}
+
+func (g *visitorGenerator) generate() []dst.Decl {
+	g.findChildren()
+	decls := []dst.Decl{
+		g.generateWalk(),
+		g.generateRewrite(),
+	}
+	for len(g.pending) > 0 {
+		s := g.pending[0]
+		g.pending = g.pending[1:]
+		decls = append(decls,
+			g.generateHelper("Walk", s, "visit", g.visitType(), g.walkValue),
+			g.generateHelper("Rewrite", s, "rewrite", g.rewriteType(), g.rewriteValue),
+		)
+	}
+	return decls
+}
+
+func GenerateVisitors(leaf string, coreProg *core.CoreProgram, p *core.Package, ctx *DubToGoContext) *dst.FileAST {
+	decls := []dst.Decl{}
+	for _, root := range hierarchyRoots(coreProg, p) {
+		g := &visitorGenerator{
+			ctx:         ctx,
+			coreProg:    coreProg,
+			pkg:         p,
+			root:        root,
+			hasChildren: map[*core.StructType]bool{},
+			requested:   map[*core.StructType]bool{},
+		}
+		decls = append(decls, g.generate()...)
+	}
+	if len(decls) == 0 {
+		return nil
+	}
+	return &dst.FileAST{
+		Name:    "generated_dub_visitors.go",
+		Package: leaf,
+		Decls:   decls,
+	}
+}
@@ -0,0 +1,126 @@
+package golang
+
+import (
+	"evergreen/dub/core"
+	dst "evergreen/go/tree"
+	"strings"
+)
+
+// Helpers shared by the generators that emit code for traversing dub
+// structures.
+
+// Does s implement parent, directly or indirectly?  A struct implements
+// itself.
+func implementsStruct(s *core.StructType, parent *core.StructType) bool {
+	for current := s; current != nil; current = current.Implements {
+		if current == parent {
+			return true
+		}
+	}
+	return false
+}
+
+// The roots of the implements hierarchies declared in a package.
+func hierarchyRoots(coreProg *core.CoreProgram, p *core.Package) []*core.StructType {
+	roots := []*core.StructType{}
+	for _, s := range coreProg.Structures {
+		if s.File.Package == p && s.IsParent && s.Implements == nil {
+			roots = append(roots, s)
+		}
+	}
+	return roots
+}
+
+// The concrete structures that implement parent, in declaration order.
+func concreteMembers(coreProg *core.CoreProgram, parent *core.StructType) []*core.StructType {
+	members := []*core.StructType{}
+	for _, s := range coreProg.Structures {
+		if !s.IsParent && implementsStruct(s, parent) {
+			members = append(members, s)
+		}
+	}
+	return members
+}
+
+// The structures declared in a package, in declaration order.
+func packageStructures(coreProg *core.CoreProgram, p *core.Package) []*core.StructType {
+	structs := []*core.StructType{}
+	for _, s := range coreProg.Structures {
+		if s.File.Package == p {
+			structs = append(structs, s)
+		}
+	}
+	return structs
+}
+
+// Names a structure uniquely within a program, for serialized data.
+func qualifiedName(s *core.StructType) string {
+	path := append([]string{}, s.File.Package.Path...)
+	return strings.Join(append(path, s.Name), "/")
+}
+
+func typeRef(t core.DubType, ctx *DubToGoContext) dst.TypeRef {
+	return dst.RefForType(goFieldType(t, ctx))
+}
+
+func getLocal(info *dst.LocalInfo) dst.Expr {
+	return &dst.GetLocal{Info: info}
+}
+
+func setLocal(info *dst.LocalInfo) dst.Target {
+	return &dst.SetLocal{Info: info}
+}
+
+func call(name string, args ...dst.Expr) *dst.Call {
+	return &dst.Call{
+		Expr: glbl(name),
+		Args: args,
+	}
+}
+
+func assign(target dst.Target, expr dst.Expr) dst.Stmt {
+	return &dst.Assign{
+		Sources: []dst.Expr{expr},
+		Op:      "=",
+		Targets: []dst.Target{target},
+	}
+}
+
+func ifStmt(cond dst.Expr, body ...dst.Stmt) dst.Stmt {
+	return &dst.If{
+		Cond: cond,
+		T:    &dst.Block{Body: body},
+	}
+}
+
+func ifElse(cond dst.Expr, t []dst.Stmt, f []dst.Stmt) dst.Stmt {
+	return &dst.If{
+		Cond: cond,
+		T:    &dst.Block{Body: t},
+		F:    &dst.Block{Body: f},
+	}
+}
+
+func returnStmt(args ...dst.Expr) dst.Stmt {
+	return &dst.Return{Args: args}
+}
+
+func rangeStmt(key dst.Target, value dst.Target, expr dst.Expr, body []dst.Stmt) dst.Stmt {
+	return &dst.Range{
+		Key:   key,
+		Value: value,
+		Expr:  expr,
+		Block: &dst.Block{Body: body},
+	}
+}
+
+func param(info *dst.LocalInfo) *dst.Param {
+	return &dst.Param{Info: info}
+}
+
+func funcDecl(name string) *dst.FuncDecl {
+	return &dst.FuncDecl{
+		Name:            name,
+		LocalInfo_Scope: &dst.LocalInfo_Scope{},
+	}
+}
@@ -469,8 +469,9 @@ func createTagInternal(base *srccore.StructType, parent *srccore.StructType, goC
 	createTagInternal(base, parent.Implements, goCoreProg, goFlowProg, p, selfType)
 
 	goCoreFunc := &dstcore.Function{
-		Name:    "is" + parent.Name,
+		Name:    tagName(parent),
 		Package: nil,
+		File:    selfType.File,
 	}
 
 	goFlowFunc := &dst.FlowFunc{
@@ -5,6 +5,8 @@ import (
 	"evergreen/dub/flow"
 	dstcore "evergreen/go/core"
 	ast "evergreen/go/tree"
+	"unicode"
+	"unicode/utf8"
 )
 
 const (
@@ -57,8 +59,26 @@ func makeLinker() DubToGoLinker {
 	}
 }
 
+// Exported dub declarations are capitalized so they are visible outside the
+// generated Go package.  Everything else keeps its dub spelling.
+func goName(name string, exported bool) string {
+	if exported {
+		r, size := utf8.DecodeRuneInString(name)
+		return string(unicode.ToUpper(r)) + name[size:]
+	}
+	return name
+}
+
+func structName(s *core.StructType) string {
+	return goName(s.Name, s.Exported)
+}
+
+func functionName(f *core.Function) string {
+	return goName(f.Name, f.Exported)
+}
+
 func subtypeName(s *core.StructType, subtype int) string {
-	name := s.Name
+	name := structName(s)
 	switch subtype {
 	case STRUCT:
 		// Nothing
@@ -73,7 +93,7 @@ func subtypeName(s *core.StructType, subtype int) string {
 }
 
 func tagName(s *core.StructType) string {
-	return "is" + s.Name
+	return "is" + structName(s)
 }
 
 func builtinType(t *core.BuiltinType, ctx *DubToGoContext) dstcore.GoType {
@@ -129,10 +149,11 @@ func goFieldType(t core.DubType, ctx *DubToGoContext) dstcore.GoType {
 	return goType(t, ctx)
 }
 
-func createTypeMapping(program *flow.DubProgram, coreProg *core.CoreProgram, packages []*dstcore.Package, link DubToGoLinker) []dstcore.GoType {
+func createTypeMapping(program *flow.DubProgram, coreProg *core.CoreProgram, packages []*dstcore.Package, files []*dstcore.File, link DubToGoLinker) []dstcore.GoType {
 	types := []dstcore.GoType{}
 	for _, s := range coreProg.Structures {
 		p := packages[s.File.Package.Index]
+		f := files[s.File.Index]
 
 		if s.IsParent {
 			if s.Scoped {
@@ -141,13 +162,13 @@ func createTypeMapping(program *flow.DubProgram, coreProg *core.CoreProgram, pac
 			if len(s.Fields) != 0 {
 				panic(s.Name)
 			}
-			types = append(types, link.SetType(s, STRUCT, &dstcore.InterfaceType{Package: p}))
+			types = append(types, link.SetType(s, STRUCT, &dstcore.InterfaceType{Package: p, File: f}))
 		} else {
 			if s.Scoped {
-				types = append(types, link.SetType(s, REF, &dstcore.TypeDefType{Package: p}))
-				types = append(types, link.SetType(s, SCOPE, &dstcore.StructType{Package: p}))
+				types = append(types, link.SetType(s, REF, &dstcore.TypeDefType{Package: p, File: f}))
+				types = append(types, link.SetType(s, SCOPE, &dstcore.StructType{Package: p, File: f}))
 			}
-			types = append(types, link.SetType(s, STRUCT, &dstcore.StructType{Package: p}))
+			types = append(types, link.SetType(s, STRUCT, &dstcore.StructType{Package: p, File: f}))
 		}
 	}
 	return types
@@ -157,7 +178,7 @@ func createTypes(program *flow.DubProgram, coreProg *core.CoreProgram, ctx *DubT
 	for _, s := range coreProg.Structures {
 		if s.IsParent {
 			impl, _ := ctx.link.GetType(s, STRUCT).(*dstcore.InterfaceType)
-			impl.Name = s.Name
+			impl.Name = structName(s)
 			impl.Fields = []*dstcore.Field{}
 			for tag := s; tag != nil; tag = tag.Implements {
 				impl.Fields = append(impl.Fields, &dstcore.Field{
@@ -168,7 +189,7 @@ func createTypes(program *flow.DubProgram, coreProg *core.CoreProgram, ctx *DubT
 
 		} else {
 			impl, _ := ctx.link.GetType(s, STRUCT).(*dstcore.StructType)
-			impl.Name = s.Name
+			impl.Name = structName(s)
 
 			fields := []*dstcore.Field{}
 			for _, f := range s.Fields {
@@ -750,6 +750,7 @@ func LowerProgram(status compiler.PassStatus, program *tree.Program, coreProg *c
 		LLFuncs:  dubFuncs,
 	}
 
+	flow.InlineProgram(status.Pass("inline"), dubProg)
 	ssiProgram(status.Pass("ssi"), dubProg)
 
 	return dubProg
@@ -0,0 +1,134 @@
+// Package trap translates dub programs into trap interpreter functions, so
+// grammars can be loaded and run without the Go toolchain.
+package trap
+
+import (
+	"evergreen/compiler"
+	"evergreen/dub/core"
+	"evergreen/dub/flow"
+	"evergreen/dub/runtime"
+	"evergreen/trap/interpreter"
+)
+
+type DubToTrapContext struct {
+	core    *core.CoreProgram
+	structs map[*core.StructType]*interpreter.StructType
+	// The reverse of structs, for describing results.
+	dubStructs map[*interpreter.StructType]*core.StructType
+}
+
+func (ctx *DubToTrapContext) structType(st *core.StructType) *interpreter.StructType {
+	t, ok := ctx.structs[st]
+	if !ok {
+		t = &interpreter.StructType{StructName: st.Name}
+		ctx.structs[st] = t
+		ctx.dubStructs[t] = st
+	}
+	return t
+}
+
+// Runes and ints are both i32, so the interpreter can compare them and use
+// either to index the input stream.
+func (ctx *DubToTrapContext) trapType(t core.DubType) interpreter.Type {
+	builtins := ctx.core.Builtins
+	switch t := t.(type) {
+	case *core.StructType:
+		return ctx.structType(t)
+	case *core.ListType:
+		return &interpreter.ListType{Element: ctx.trapType(t.Type)}
+	case *core.BuiltinType:
+		switch t {
+		case builtins.String:
+			return interpreter.StringType
+		case builtins.Rune, builtins.Int:
+			return interpreter.I32Type
+		case builtins.Int64:
+			return interpreter.I64Type
+		case builtins.Float32:
+			return interpreter.F32Type
+		case builtins.Bool:
+			return interpreter.BoolType
+		default:
+			panic(t.Name)
+		}
+	default:
+		panic(t)
+	}
+}
+
+// A dub program translated for the interpreter.  Funcs are in the same order
+// as the core program's functions, so a function's index is its uid.
+type TrapProgram struct {
+	Funcs []*interpreter.Function
+	ctx   *DubToTrapContext
+}
+
+// Converts a value created by the program into the description the Go
+// backend would give the same value, so results can be compared against dub
+// tests.  Scoped structures are described in place because the interpreter
+// does not keep their indexes.
+func (p *TrapProgram) Describe(o interpreter.Object, t core.DubType) runtime.Value {
+	builtins := p.ctx.core.Builtins
+	switch o := o.(type) {
+	case nil:
+		if _, ok := t.(*core.ListType); ok {
+			return runtime.MakeList(core.TypeName(t))
+		}
+		return runtime.Describe(nil)
+	case *interpreter.Struct:
+		st, ok := p.ctx.dubStructs[o.T]
+		if !ok {
+			panic(o.T)
+		}
+		d := runtime.MakeStruct(st.Name)
+		for i, f := range st.Fields {
+			d.AddField(f.Name, p.Describe(o.Slots[i], f.Type))
+		}
+		return d
+	case *interpreter.List:
+		lt, ok := t.(*core.ListType)
+		if !ok {
+			panic(t)
+		}
+		d := runtime.MakeList(core.TypeName(t))
+		for _, item := range o.Items {
+			d.Append(p.Describe(item, lt.Type))
+		}
+		return d
+	case *interpreter.I32:
+		if t == builtins.Rune {
+			return runtime.Describe(rune(o.Value))
+		}
+		return runtime.Describe(int(o.Value))
+	case *interpreter.I64:
+		return runtime.Describe(o.Value)
+	case *interpreter.F32:
+		return runtime.Describe(o.Value)
+	case *interpreter.Bool:
+		return runtime.Describe(o.Value)
+	case *interpreter.String:
+		return runtime.Describe(o.Value)
+	default:
+		panic(o)
+	}
+}
+
+func GenerateTrap(status compiler.PassStatus, program *flow.DubProgram) *TrapProgram {
+	status.Begin()
+	defer status.End()
+
+	ctx := &DubToTrapContext{
+		core:       program.Core,
+		structs:    map[*core.StructType]*interpreter.StructType{},
+		dubStructs: map[*interpreter.StructType]*core.StructType{},
+	}
+	funcs := make([]*interpreter.Function, len(program.LLFuncs))
+	for i, f := range program.LLFuncs {
+		t := &flowTranslator{
+			ctx:      ctx,
+			original: f,
+		}
+		funcs[i] = t.translate()
+	}
+	return &TrapProgram{Funcs: funcs, ctx: ctx}
+}
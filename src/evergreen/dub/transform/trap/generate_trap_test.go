@@ -0,0 +1,185 @@
+package trap
+
+import (
+	"evergreen/compiler"
+	"evergreen/dub/core"
+	"evergreen/dub/flow"
+	"evergreen/dub/runtime"
+	"evergreen/dub/transform"
+	"evergreen/dub/tree"
+	"evergreen/trap/interpreter"
+	"testing"
+)
+
+func translateDir(t *testing.T, dir string) (*flow.DubProgram, *TrapProgram) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	program, coreProg := tree.DubProgramFrontend(status.Pass("dub_frontend"), p, dir)
+	if status.ShouldHalt() {
+		t.Fatal("frontend failed")
+	}
+	flowProgram := transform.LowerProgram(status.Pass("lower"), program, coreProg)
+	flow.TrimFlow(status.Pass("trim_flow"), flowProgram)
+	trapProgram := GenerateTrap(status.Pass("dub_to_trap"), flowProgram)
+	if status.ShouldHalt() {
+		t.Fatal("translation failed")
+	}
+	return flowProgram, trapProgram
+}
+
+func findFunc(program *flow.DubProgram, name string, t *testing.T) int {
+	for i, f := range program.LLFuncs {
+		if f.Name == name {
+			return i
+		}
+	}
+	t.Fatalf("no function named %s", name)
+	return -1
+}
+
+func call(i *interpreter.Interpreter, uid int, input string, args []interpreter.Object, t *testing.T) {
+	i.SetStream([]rune(input))
+	i.SetTemp(args)
+	if err := i.Call(uid); err != nil {
+		t.Fatalf("%s: %s", i.Funcs[uid].Name, err)
+	}
+}
+
+func TestPlayground(t *testing.T) {
+	flowProgram, trapProgram := translateDir(t, "../../../../../dubsrc/playground")
+	builtins := flowProgram.Core.Builtins
+	i := interpreter.CreateInterpreter(trapProgram.Funcs)
+
+	tests := []struct {
+		Name     string
+		Input    string
+		Args     []interpreter.Object
+		T        core.DubType
+		Expected string
+	}{
+		{"Add", "", []interpreter.Object{&interpreter.I32{Value: 2}, &interpreter.I32{Value: 3}}, builtins.Int, "5"},
+		{"CoerceFraction", "", []interpreter.Object{}, builtins.Float32, "0.625"},
+		{"StringAddition", "", []interpreter.Object{}, builtins.String, `"foobar"`},
+		{"FooProxy", "", []interpreter.Object{}, builtins.Int, "37"},
+		{"ExportedProxy", "", []interpreter.Object{}, builtins.Int, "5"},
+		{"TwoDigits", "42", []interpreter.Object{}, builtins.Int, "42"},
+		{"IsEven", "", []interpreter.Object{&interpreter.I32{Value: 7}}, builtins.Bool, "false"},
+		{"ExplicitSpecialization", "", []interpreter.Object{}, &core.ListType{Type: builtins.Int}, "[]int{\n  1\n}"},
+	}
+	for _, test := range tests {
+		call(i, findFunc(flowProgram, test.Name, t), test.Input, test.Args, t)
+		if i.Flow != interpreter.NORMAL || i.TempLen != 1 {
+			t.Errorf("%s: expected one result, got flow %d and %d results", test.Name, i.Flow, i.TempLen)
+			continue
+		}
+		actual := runtime.Format(trapProgram.Describe(i.Temp[0], test.T))
+		if actual != test.Expected {
+			t.Errorf("%s: expected %s, got %s", test.Name, test.Expected, actual)
+		}
+	}
+
+	// Digit fails if there is no digit.
+	call(i, findFunc(flowProgram, "TwoDigits", t), "4x", []interpreter.Object{}, t)
+	if i.Flow != interpreter.FAIL {
+		t.Errorf("expected TwoDigits to fail, got flow %d", i.Flow)
+	}
+}
+
+func testArg(expr tree.ASTExpr) interpreter.Object {
+	switch expr := expr.(type) {
+	case *tree.StringLiteral:
+		return &interpreter.String{Value: expr.Value}
+	case *tree.RuneLiteral:
+		return &interpreter.I32{Value: expr.Value}
+	case *tree.IntLiteral:
+		return &interpreter.I32{Value: int32(expr.Value)}
+	case *tree.BoolLiteral:
+		return &interpreter.Bool{Value: expr.Value}
+	default:
+		panic(expr)
+	}
+}
+
+// Builds the description a destructure expects, like the generated Go tests.
+func expected(d tree.Destructure) runtime.Value {
+	switch d := d.(type) {
+	case *tree.DestructureStruct:
+		st := tree.ResolveType(d.Type).(*core.StructType)
+		fields := []*runtime.Field{}
+		for _, arg := range d.Args {
+			fields = append(fields, runtime.MakeField(arg.Name.Text, expected(arg.Destructure)))
+		}
+		return runtime.MakeStruct(st.Name, fields...)
+	case *tree.DestructureList:
+		elements := []runtime.Value{}
+		for _, arg := range d.Args {
+			elements = append(elements, expected(arg))
+		}
+		return runtime.MakeList(core.TypeName(tree.ResolveType(d.Type)), elements...)
+	case *tree.DestructureValue:
+		switch expr := d.Expr.(type) {
+		case *tree.StringLiteral:
+			return runtime.Describe(expr.Value)
+		case *tree.RuneLiteral:
+			return runtime.Describe(expr.Value)
+		case *tree.IntLiteral:
+			return runtime.Describe(expr.Value)
+		case *tree.BoolLiteral:
+			return runtime.Describe(expr.Value)
+		case *tree.NilLiteral:
+			return runtime.Describe(nil)
+		default:
+			panic(expr)
+		}
+	default:
+		panic(d)
+	}
+}
+
+// Runs the dub tests for the compiler's own grammars on the interpreter.
+func TestGrammars(t *testing.T) {
+	flowProgram, trapProgram := translateDir(t, "../../../../../dubsrc/evergreen")
+	i := interpreter.CreateInterpreter(trapProgram.Funcs)
+
+	count := 0
+	for _, pkg := range flowProgram.Packages {
+		for _, test := range pkg.Tests {
+			rule := test.Rule.(*tree.Call)
+			f := rule.Expr.(*tree.GetFunction).Func.(*core.Function)
+			args := []interpreter.Object{}
+			for _, arg := range rule.Args {
+				args = append(args, testArg(arg))
+			}
+			call(i, int(f.Index), test.Input, args, t)
+			count += 1
+
+			if test.Flow == "NORMAL" {
+				if i.Flow != interpreter.NORMAL {
+					t.Errorf("%s: expected normal flow", test.Name.Text)
+					continue
+				}
+				if i.Index != len(i.Stream) {
+					t.Errorf("%s: only consumed %d/%d runes", test.Name.Text, i.Index, len(i.Stream))
+					continue
+				}
+			} else if i.Flow != interpreter.FAIL {
+				t.Errorf("%s: expected fail flow", test.Name.Text)
+				continue
+			}
+			if test.Destructure == nil {
+				continue
+			}
+			var actual interpreter.Object
+			if i.TempLen > 0 {
+				actual = i.Temp[0]
+			}
+			diff := runtime.Diff(expected(test.Destructure), trapProgram.Describe(actual, test.Type))
+			if diff != nil {
+				t.Errorf("%s: %s", test.Name.Text, diff)
+			}
+		}
+	}
+	if count == 0 {
+		t.Fatal("no tests found")
+	}
+}
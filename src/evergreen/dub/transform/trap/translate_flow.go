@@ -0,0 +1,391 @@
+package trap
+
+import (
+	"evergreen/dub/core"
+	"evergreen/dub/flow"
+	"evergreen/graph"
+	"evergreen/trap/interpreter"
+)
+
+var binOps = map[string]interpreter.BinOp{
+	"+":  interpreter.ADD,
+	"-":  interpreter.SUB,
+	"*":  interpreter.MUL,
+	"/":  interpreter.DIV,
+	"%":  interpreter.REM,
+	"==": interpreter.EQ,
+	"!=": interpreter.NE,
+	"<":  interpreter.LT,
+	"<=": interpreter.LE,
+	">":  interpreter.GT,
+	">=": interpreter.GE,
+}
+
+// A jump whose location is filled in once every node has been placed.
+type jumpPatch struct {
+	location *int
+	node     graph.NodeID
+}
+
+type flowTranslator struct {
+	ctx       *DubToTrapContext
+	original  *flow.LLFunc
+	f         *interpreter.Function
+	regMap    []int
+	constants map[interface{}]int
+	// The location of each node's first op.
+	starts  []int
+	patches []jumpPatch
+	// Failure handlers, by the node they branch to.
+	handlers map[int]graph.NodeID
+}
+
+func (t *flowTranslator) emit(op interpreter.Op) {
+	t.f.Body = append(t.f.Body, op)
+}
+
+func (t *flowTranslator) allocTemp() int {
+	temp := t.f.NumLocals
+	t.f.NumLocals += 1
+	return temp
+}
+
+func (t *flowTranslator) reg(reg *flow.RegisterInfo) int {
+	return t.regMap[reg.Index]
+}
+
+// Ops that do not use their result still need somewhere to put it.
+func (t *flowTranslator) dst(reg *flow.RegisterInfo) int {
+	if reg == nil {
+		return t.allocTemp()
+	}
+	return t.reg(reg)
+}
+
+func (t *flowTranslator) regList(regs []*flow.RegisterInfo) interpreter.Locals {
+	out := make(interpreter.Locals, len(regs))
+	for i, reg := range regs {
+		out[i] = t.reg(reg)
+	}
+	return out
+}
+
+// Adds a constant to the pool, unless an equal constant is already there.
+func (t *flowTranslator) constant(key interface{}, o interpreter.Object) int {
+	index, ok := t.constants[key]
+	if !ok {
+		index = len(t.f.Constants)
+		t.f.Constants = append(t.f.Constants, o)
+		t.constants[key] = index
+	}
+	return index
+}
+
+func (t *flowTranslator) storeConst(key interface{}, o interpreter.Object, target int) {
+	t.emit(&interpreter.StoreConst{Const: t.constant(key, o), Target: target})
+}
+
+// Stores the value an unset field of the given type would have in Go.  Nil
+// lists are empty lists, so they can be appended to.
+func (t *flowTranslator) storeZero(dt core.DubType, target int) {
+	builtins := t.ctx.core.Builtins
+	if lt, ok := dt.(*core.ListType); ok {
+		t.emit(&interpreter.CreateList{
+			Type:   t.ctx.trapType(lt).(*interpreter.ListType),
+			Args:   interpreter.Locals{},
+			Target: target,
+		})
+		return
+	}
+	switch dt {
+	case builtins.String:
+		t.storeConst("", &interpreter.String{}, target)
+	case builtins.Rune, builtins.Int:
+		t.storeConst(int32(0), &interpreter.I32{}, target)
+	case builtins.Int64:
+		t.storeConst(int64(0), &interpreter.I64{}, target)
+	case builtins.Float32:
+		t.storeConst(float32(0), &interpreter.F32{}, target)
+	case builtins.Bool:
+		t.storeConst(false, &interpreter.Bool{}, target)
+	default:
+		t.storeConst(nil, nil, target)
+	}
+}
+
+func (t *flowTranslator) jump(location *int, n graph.NodeID) {
+	t.patches = append(t.patches, jumpPatch{location: location, node: n})
+}
+
+// Copies all the sources before any of the destinations are written.
+func (t *flowTranslator) transfer(srcs interpreter.Locals, dsts interpreter.Locals) {
+	read := map[int]bool{}
+	for _, src := range srcs {
+		read[src] = true
+	}
+	clobbers := false
+	for i, dst := range dsts {
+		if read[dst] && srcs[i] != dst {
+			clobbers = true
+		}
+	}
+	if clobbers {
+		temps := make(interpreter.Locals, len(srcs))
+		for i, src := range srcs {
+			temps[i] = t.allocTemp()
+			t.emit(&interpreter.Copy{Src: src, Target: temps[i]})
+		}
+		srcs = temps
+	}
+	for i, dst := range dsts {
+		if srcs[i] != dst {
+			t.emit(&interpreter.Copy{Src: srcs[i], Target: dst})
+		}
+	}
+}
+
+func (t *flowTranslator) translateCall(op *flow.CallOp) {
+	args := t.regList(op.Args)
+	switch c := op.Target.(type) {
+	case *core.Function:
+		t.emit(&interpreter.Call{Func: int(c.Index), Args: args, Targets: t.regList(op.Dsts)})
+	case *core.IntrinsicFunction:
+		builtins := t.ctx.core.Builtins
+		if c.Parent == builtins.Append {
+			if len(args) != 2 || len(op.Dsts) > 1 {
+				panic(op)
+			}
+			// Appending happens in place, so the result is the original list.
+			t.emit(&interpreter.Append{List: args[0], Value: args[1]})
+			if len(op.Dsts) == 1 {
+				t.transfer(interpreter.Locals{args[0]}, t.regList(op.Dsts))
+			}
+			return
+		}
+		switch c {
+		case builtins.Position:
+			if len(op.Dsts) == 1 {
+				t.emit(&interpreter.Checkpoint{Target: t.reg(op.Dsts[0])})
+			}
+		case builtins.Slice:
+			if len(op.Dsts) == 1 {
+				t.emit(&interpreter.Slice{Begin: args[0], End: args[1], Target: t.reg(op.Dsts[0])})
+			}
+		default:
+			panic(c)
+		}
+	default:
+		panic(op.Target)
+	}
+}
+
+func (t *flowTranslator) translateConstruct(op *flow.ConstructOp) {
+	args := make(interpreter.Locals, len(op.Type.Fields))
+	for i, f := range op.Type.Fields {
+		args[i] = -1
+		for _, arg := range op.Args {
+			if arg.Key == f.Name {
+				args[i] = t.reg(arg.Value)
+			}
+		}
+		if args[i] < 0 {
+			args[i] = t.allocTemp()
+			t.storeZero(f.Type, args[i])
+		}
+	}
+	t.emit(&interpreter.CreateStruct{
+		Type:   t.ctx.structType(op.Type),
+		Args:   args,
+		Target: t.dst(op.Dst),
+	})
+}
+
+func (t *flowTranslator) translateCoerce(op *flow.CoerceOp) {
+	src := t.ctx.trapType(op.Src.T)
+	dst := t.ctx.trapType(op.T)
+	_, srcList := src.(*interpreter.ListType)
+	_, srcBuiltin := src.(*interpreter.BuiltinType)
+	_, dstBuiltin := dst.(*interpreter.BuiltinType)
+	if (srcBuiltin || srcList) && dstBuiltin && src != dst {
+		t.emit(&interpreter.Convert{Src: t.reg(op.Src), Type: dst, Target: t.reg(op.Dst)})
+	} else {
+		t.transfer(interpreter.Locals{t.reg(op.Src)}, interpreter.Locals{t.reg(op.Dst)})
+	}
+}
+
+func (t *flowTranslator) translateOp(op flow.DubOp) {
+	switch op := op.(type) {
+	case *flow.EntryOp, *flow.ExitOp:
+		// Nothing to do.
+	case *flow.SwitchOp:
+		// Branching is handled with the other exits.
+	case *flow.CallOp:
+		t.translateCall(op)
+	case *flow.ConstructOp:
+		t.translateConstruct(op)
+	case *flow.ConstructListOp:
+		t.emit(&interpreter.CreateList{
+			Type:   t.ctx.trapType(op.Type).(*interpreter.ListType),
+			Args:   t.regList(op.Args),
+			Target: t.dst(op.Dst),
+		})
+	case *flow.TransferOp:
+		t.transfer(t.regList(op.Srcs), t.regList(op.Dsts))
+	case *flow.CopyOp:
+		t.transfer(interpreter.Locals{t.reg(op.Src)}, interpreter.Locals{t.dst(op.Dst)})
+	case *flow.CoerceOp:
+		t.translateCoerce(op)
+	case *flow.ConstantRuneOp:
+		t.storeConst(int32(op.Value), &interpreter.I32{Value: int32(op.Value)}, t.dst(op.Dst))
+	case *flow.ConstantStringOp:
+		t.storeConst(op.Value, &interpreter.String{Value: op.Value}, t.dst(op.Dst))
+	case *flow.ConstantIntOp:
+		if op.Dst != nil && op.Dst.T == t.ctx.core.Builtins.Int64 {
+			t.storeConst(op.Value, &interpreter.I64{Value: op.Value}, t.dst(op.Dst))
+		} else {
+			t.storeConst(int32(op.Value), &interpreter.I32{Value: int32(op.Value)}, t.dst(op.Dst))
+		}
+	case *flow.ConstantFloat32Op:
+		t.storeConst(op.Value, &interpreter.F32{Value: op.Value}, t.dst(op.Dst))
+	case *flow.ConstantBoolOp:
+		t.storeConst(op.Value, &interpreter.Bool{Value: op.Value}, t.dst(op.Dst))
+	case *flow.ConstantNilOp:
+		if op.Dst != nil {
+			t.storeZero(op.Dst.T, t.reg(op.Dst))
+		}
+	case *flow.BinaryOp:
+		binop, ok := binOps[op.Op]
+		if !ok {
+			panic(op.Op)
+		}
+		t.emit(&interpreter.BinaryOp{
+			Op:     binop,
+			Left:   t.reg(op.Left),
+			Right:  t.reg(op.Right),
+			Target: t.dst(op.Dst),
+		})
+	case *flow.Checkpoint:
+		t.emit(&interpreter.Checkpoint{Target: t.dst(op.Dst)})
+	case *flow.Recover:
+		t.emit(&interpreter.Recover{Checkpoint: t.reg(op.Src)})
+	case *flow.Fail:
+		t.emit(&interpreter.Fail{})
+	case *flow.Peek:
+		t.emit(&interpreter.Peek{Target: t.dst(op.Dst)})
+	case *flow.Consume:
+		t.emit(&interpreter.Consume{})
+	case *flow.LookaheadBegin:
+		// The VM does not track the deepest failure, so lookahead is plain
+		// backtracking.
+		t.emit(&interpreter.Checkpoint{Target: t.dst(op.Dst)})
+	case *flow.LookaheadEnd:
+		t.emit(&interpreter.Recover{Checkpoint: t.reg(op.Src)})
+		if op.Failed {
+			t.emit(&interpreter.Fail{})
+		}
+	case *flow.ReturnOp:
+		t.emit(&interpreter.Return{Args: t.regList(op.Exprs)})
+	default:
+		panic(op)
+	}
+}
+
+// Emits the branches out of a node.  The next node in the order is reached by
+// falling through.
+func (t *flowTranslator) translateExits(n graph.NodeID, next graph.NodeID) {
+	g := t.original.CFG
+	normal := graph.NoNode
+	condTrue := graph.NoNode
+	fail := graph.NoNode
+	eit := g.ExitIterator(n)
+	for eit.HasNext() {
+		e, dst := eit.GetNext()
+		switch t.original.Edges[e] {
+		case flow.NORMAL, flow.COND_FALSE:
+			normal = dst
+		case flow.COND_TRUE:
+			condTrue = dst
+		case flow.FAIL:
+			fail = dst
+		case flow.RETURN:
+			// Handled by the return op.
+		default:
+			panic(t.original.Edges[e])
+		}
+	}
+
+	if fail != graph.NoNode {
+		// A failure that reaches the exit fails the function, which needs no
+		// handler.
+		_, exits := t.original.Ops[fail].(*flow.ExitOp)
+		if !exits && len(t.f.Body) > t.starts[n] {
+			t.handlers[len(t.f.Body)-1] = fail
+		}
+	}
+	if condTrue != graph.NoNode {
+		op := t.original.Ops[n].(*flow.SwitchOp)
+		jump := &interpreter.ConditionalJump{Arg: t.reg(op.Cond)}
+		t.emit(jump)
+		t.jump(&jump.Location, condTrue)
+	}
+	if normal != graph.NoNode && normal != next {
+		jump := &interpreter.Jump{}
+		t.emit(jump)
+		t.jump(&jump.Location, normal)
+	}
+}
+
+func (t *flowTranslator) translate() *interpreter.Function {
+	src := t.original
+	t.f = &interpreter.Function{
+		Name:      src.Name,
+		NumParams: len(src.Params),
+		Constants: []interpreter.Object{},
+		Body:      []interpreter.Op{},
+	}
+	t.constants = map[interface{}]int{}
+	if src.CFG == nil {
+		// Templates are only called after being specialized.
+		return t.f
+	}
+
+	// Parameters come first, so the interpreter can pass arguments in place.
+	num := src.RegisterInfo_Scope.Len()
+	t.regMap = make([]int, num)
+	for i := range t.regMap {
+		t.regMap[i] = -1
+	}
+	for i, p := range src.Params {
+		t.regMap[p.Index] = i
+	}
+	t.f.NumLocals = len(src.Params)
+	for i := range t.regMap {
+		if t.regMap[i] < 0 {
+			t.regMap[i] = t.allocTemp()
+		}
+	}
+
+	order, _ := graph.ReversePostorder(src.CFG)
+	t.starts = make([]int, src.CFG.NumNodes())
+	t.handlers = map[int]graph.NodeID{}
+	for i, n := range order {
+		next := graph.NoNode
+		if i+1 < len(order) {
+			next = order[i+1]
+		}
+		t.starts[n] = len(t.f.Body)
+		t.translateOp(src.Ops[n])
+		t.translateExits(n, next)
+	}
+
+	for _, patch := range t.patches {
+		*patch.location = t.starts[patch.node]
+	}
+	if len(t.handlers) > 0 {
+		t.f.FailHandlers = map[int]int{}
+		for location, n := range t.handlers {
+			t.f.FailHandlers[location] = t.starts[n]
+		}
+	}
+	return t.f
+}
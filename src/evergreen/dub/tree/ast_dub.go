@@ -0,0 +1,459 @@
+package tree
+
+import (
+	"evergreen/dub/core"
+)
+
+type TextMatch interface {
+	isTextMatch()
+}
+
+type RuneFilter struct {
+	Min rune
+	Max rune
+}
+
+type RuneRangeMatch struct {
+	Invert  bool
+	Filters []*RuneFilter
+}
+
+func (node *RuneRangeMatch) isTextMatch() {
+}
+
+type StringLiteralMatch struct {
+	Value string
+}
+
+func (node *StringLiteralMatch) isTextMatch() {
+}
+
+type MatchSequence struct {
+	Matches []TextMatch
+}
+
+func (node *MatchSequence) isTextMatch() {
+}
+
+type MatchChoice struct {
+	Matches []TextMatch
+}
+
+func (node *MatchChoice) isTextMatch() {
+}
+
+type MatchRepeat struct {
+	Match TextMatch
+	Min   int
+}
+
+func (node *MatchRepeat) isTextMatch() {
+}
+
+type MatchLookahead struct {
+	Invert bool
+	Match  TextMatch
+}
+
+func (node *MatchLookahead) isTextMatch() {
+}
+
+type Id struct {
+	Pos  int
+	Text string
+}
+
+type ASTExpr interface {
+	isASTExpr()
+}
+
+type RuneLiteral struct {
+	Text  string
+	Value rune
+}
+
+func (node *RuneLiteral) isASTExpr() {
+}
+
+type StringLiteral struct {
+	Pos   int
+	Text  string
+	Value string
+}
+
+func (node *StringLiteral) isASTExpr() {
+}
+
+type IntLiteral struct {
+	Text  string
+	Value int
+}
+
+func (node *IntLiteral) isASTExpr() {
+}
+
+type Float32Literal struct {
+	Text  string
+	Value float32
+}
+
+func (node *Float32Literal) isASTExpr() {
+}
+
+type BoolLiteral struct {
+	Text  string
+	Value bool
+}
+
+func (node *BoolLiteral) isASTExpr() {
+}
+
+type NilLiteral struct {
+}
+
+func (node *NilLiteral) isASTExpr() {
+}
+
+type StringMatch struct {
+	Match TextMatch
+}
+
+func (node *StringMatch) isASTExpr() {
+}
+
+type RuneMatch struct {
+	Match *RuneRangeMatch
+}
+
+func (node *RuneMatch) isASTExpr() {
+}
+
+type ASTDecl interface {
+	isASTDecl()
+}
+
+type ASTTypeRef interface {
+	isASTTypeRef()
+}
+
+type TypeRef struct {
+	Name *Id
+}
+
+func (node *TypeRef) isASTTypeRef() {
+}
+
+type ListTypeRef struct {
+	Type ASTTypeRef
+}
+
+func (node *ListTypeRef) isASTTypeRef() {
+}
+
+type QualifiedTypeRef struct {
+	Package *Id
+	Name    *Id
+}
+
+func (node *QualifiedTypeRef) isASTTypeRef() {
+}
+
+type GetType struct {
+	Type core.DubType
+}
+
+func (node *GetType) isASTTypeRef() {
+}
+
+type Destructure interface {
+	isDestructure()
+}
+
+type DestructureValue struct {
+	Expr ASTExpr
+}
+
+func (node *DestructureValue) isDestructure() {
+}
+
+type DestructureField struct {
+	Name        *Id
+	Destructure Destructure
+}
+
+type DestructureStruct struct {
+	Type ASTTypeRef
+	Args []*DestructureField
+}
+
+func (node *DestructureStruct) isDestructure() {
+}
+
+type DestructureList struct {
+	Type ASTTypeRef
+	Args []Destructure
+}
+
+func (node *DestructureList) isDestructure() {
+}
+
+type If struct {
+	Expr  ASTExpr
+	Block []ASTExpr
+	Else  []ASTExpr
+}
+
+func (node *If) isASTExpr() {
+}
+
+type Repeat struct {
+	Block []ASTExpr
+	Min   int
+}
+
+func (node *Repeat) isASTExpr() {
+}
+
+type Choice struct {
+	Blocks [][]ASTExpr
+}
+
+func (node *Choice) isASTExpr() {
+}
+
+type Optional struct {
+	Block []ASTExpr
+}
+
+func (node *Optional) isASTExpr() {
+}
+
+type Assign struct {
+	Expr    ASTExpr
+	Pos     int
+	Targets []ASTExpr
+	Type    ASTTypeRef
+	Define  bool
+}
+
+func (node *Assign) isASTExpr() {
+}
+
+type NameRef struct {
+	Name *Id
+}
+
+func (node *NameRef) isASTExpr() {
+}
+
+type GetLocal struct {
+	Info *LocalInfo
+}
+
+func (node *GetLocal) isASTExpr() {
+}
+
+type SetLocal struct {
+	Info *LocalInfo
+}
+
+func (node *SetLocal) isASTExpr() {
+}
+
+type Discard struct {
+}
+
+func (node *Discard) isASTExpr() {
+}
+
+type GetFunction struct {
+	Func core.Callable
+}
+
+func (node *GetFunction) isASTExpr() {
+}
+
+type GetFunctionTemplate struct {
+	Template core.CallableTemplate
+}
+
+func (node *GetFunctionTemplate) isASTExpr() {
+}
+
+type GetPackage struct {
+	Package *core.Package
+}
+
+func (node *GetPackage) isASTExpr() {
+}
+
+type NamedExpr struct {
+	Name *Id
+	Expr ASTExpr
+}
+
+type Construct struct {
+	Type ASTTypeRef
+	Args []*NamedExpr
+}
+
+func (node *Construct) isASTExpr() {
+}
+
+type ConstructList struct {
+	Type ASTTypeRef
+	Args []ASTExpr
+}
+
+func (node *ConstructList) isASTExpr() {
+}
+
+type Coerce struct {
+	Type ASTTypeRef
+	Expr ASTExpr
+}
+
+func (node *Coerce) isASTExpr() {
+}
+
+type Call struct {
+	Expr   ASTExpr
+	Pos    int
+	Args   []ASTExpr
+	Target core.Callable
+	T      core.DubType
+}
+
+func (node *Call) isASTExpr() {
+}
+
+type Selector struct {
+	Expr ASTExpr
+	Pos  int
+	Name *Id
+}
+
+func (node *Selector) isASTExpr() {
+}
+
+type SpecializeTemplate struct {
+	Expr  ASTExpr
+	Pos   int
+	Types []ASTTypeRef
+}
+
+func (node *SpecializeTemplate) isASTExpr() {
+}
+
+type Fail struct {
+}
+
+func (node *Fail) isASTExpr() {
+}
+
+type Return struct {
+	Pos   int
+	Exprs []ASTExpr
+}
+
+func (node *Return) isASTExpr() {
+}
+
+type BinaryOp struct {
+	Left  ASTExpr
+	Op    string
+	OpPos int
+	Right ASTExpr
+	T     core.DubType
+}
+
+func (node *BinaryOp) isASTExpr() {
+}
+
+type TemplateParam struct {
+	Name *Id
+}
+
+type FieldDecl struct {
+	Name *Id
+	Type ASTTypeRef
+}
+
+type StructDecl struct {
+	Name       *Id
+	Export     bool
+	Implements ASTTypeRef
+	Fields     []*FieldDecl
+	Scoped     bool
+	Contains   []ASTTypeRef
+	T          *core.StructType
+}
+
+func (node *StructDecl) isASTDecl() {
+}
+
+type LocalInfo_Ref uint32
+
+type LocalInfo_Scope struct {
+	objects []*LocalInfo
+}
+
+type LocalInfo struct {
+	Name  string
+	T     core.DubType
+	Index LocalInfo_Ref
+}
+
+type Param struct {
+	Name *Id
+	Type ASTTypeRef
+	Info *LocalInfo
+}
+
+type FuncDecl struct {
+	Name            *Id
+	Export          bool
+	Inline          string
+	TemplateParams  []*TemplateParam
+	Params          []*Param
+	ReturnTypes     []ASTTypeRef
+	Block           []ASTExpr
+	F               *core.Function
+	LocalInfo_Scope *LocalInfo_Scope
+}
+
+func (node *FuncDecl) isASTDecl() {
+}
+
+type Test struct {
+	Name        *Id
+	Rule        ASTExpr
+	Type        core.DubType
+	Input       string
+	Flow        string
+	Destructure Destructure
+	File        *core.File
+}
+
+type ImportDecl struct {
+	Path *StringLiteral
+}
+
+type File struct {
+	Name    string
+	Imports []*ImportDecl
+	Decls   []ASTDecl
+	Tests   []*Test
+	F       *core.File
+}
+
+type Package struct {
+	Path  []string
+	Files []*File
+	P     *core.Package
+}
+
+type Program struct {
+	Builtins *core.BuiltinTypeIndex
+	Packages []*Package
+}
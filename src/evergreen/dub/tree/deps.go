@@ -0,0 +1,243 @@
+package tree
+
+import (
+	"bytes"
+	"evergreen/compiler"
+	"fmt"
+	"strings"
+)
+
+// PackageGraph records the import relationships between the packages of a
+// program.  Packages are identified by their index in Program.Packages.
+type PackageGraph struct {
+	Packages []*Package
+	Imports  [][]int
+	// Order lists every package after all the packages it depends on.
+	Order []int
+
+	importPos [][]int
+	lut       map[string]int
+}
+
+func packagePath(pkg *Package) string {
+	return strings.Join(pkg.Path, "/")
+}
+
+// Lookup finds the index of the package with the given import path.
+func (g *PackageGraph) Lookup(path string) (int, bool) {
+	index, ok := g.lut[path]
+	return index, ok
+}
+
+func (g *PackageGraph) addImport(src int, dst int, pos int) {
+	for _, existing := range g.Imports[src] {
+		if existing == dst {
+			return
+		}
+	}
+	g.Imports[src] = append(g.Imports[src], dst)
+	g.importPos[src] = append(g.importPos[src], pos)
+}
+
+func (g *PackageGraph) importLocation(src int, dst int) int {
+	for i, existing := range g.Imports[src] {
+		if existing == dst {
+			return g.importPos[src][i]
+		}
+	}
+	panic(dst)
+}
+
+// Tarjan's algorithm.  Strongly connected components are emitted after every
+// component they can reach, which is exactly the order the semantic pass
+// wants.
+type sccFinder struct {
+	g          *PackageGraph
+	index      []int
+	lowlink    []int
+	onStack    []bool
+	stack      []int
+	uid        int
+	components [][]int
+}
+
+func (f *sccFinder) visit(n int) {
+	f.index[n] = f.uid
+	f.lowlink[n] = f.uid
+	f.uid += 1
+	f.stack = append(f.stack, n)
+	f.onStack[n] = true
+
+	for _, dst := range f.g.Imports[n] {
+		if f.index[dst] < 0 {
+			f.visit(dst)
+			if f.lowlink[dst] < f.lowlink[n] {
+				f.lowlink[n] = f.lowlink[dst]
+			}
+		} else if f.onStack[dst] && f.index[dst] < f.lowlink[n] {
+			f.lowlink[n] = f.index[dst]
+		}
+	}
+
+	if f.lowlink[n] == f.index[n] {
+		component := []int{}
+		for {
+			top := f.stack[len(f.stack)-1]
+			f.stack = f.stack[:len(f.stack)-1]
+			f.onStack[top] = false
+			component = append(component, top)
+			if top == n {
+				break
+			}
+		}
+		f.components = append(f.components, component)
+	}
+}
+
+func findComponents(g *PackageGraph) [][]int {
+	n := len(g.Packages)
+	f := &sccFinder{
+		g:       g,
+		index:   make([]int, n),
+		lowlink: make([]int, n),
+		onStack: make([]bool, n),
+	}
+	for i := 0; i < n; i++ {
+		f.index[i] = -1
+	}
+	for i := 0; i < n; i++ {
+		if f.index[i] < 0 {
+			f.visit(i)
+		}
+	}
+	return f.components
+}
+
+// Find the shortest import path from the head of a component back to itself.
+func findCycle(g *PackageGraph, component []int) []int {
+	member := map[int]bool{}
+	for _, n := range component {
+		member[n] = true
+	}
+	head := component[len(component)-1]
+
+	prev := map[int]int{}
+	queue := []int{head}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dst := range g.Imports[current] {
+			if !member[dst] {
+				continue
+			}
+			if dst == head {
+				cycle := []int{current}
+				for current != head {
+					current = prev[current]
+					cycle = append(cycle, current)
+				}
+				// Reverse so the cycle reads in import order.
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			}
+			_, seen := prev[dst]
+			if !seen {
+				prev[dst] = current
+				queue = append(queue, dst)
+			}
+		}
+	}
+	return nil
+}
+
+func reportCycle(status compiler.PassStatus, g *PackageGraph, cycle []int) {
+	names := make([]string, len(cycle)+1)
+	for i, n := range cycle {
+		names[i] = packagePath(g.Packages[n])
+	}
+	names[len(cycle)] = names[0]
+	last := cycle[len(cycle)-1]
+	pos := g.importLocation(last, cycle[0])
+	status.LocationError(pos, fmt.Sprintf("import cycle: %s", strings.Join(names, " -> ")))
+}
+
+// BuildPackageGraph resolves the imports of every package and rejects import
+// cycles.
+func BuildPackageGraph(status compiler.PassStatus, program *Program) *PackageGraph {
+	status.Begin()
+	defer status.End()
+
+	n := len(program.Packages)
+	g := &PackageGraph{
+		Packages:  program.Packages,
+		Imports:   make([][]int, n),
+		importPos: make([][]int, n),
+		lut:       map[string]int{},
+	}
+	for i, pkg := range program.Packages {
+		g.lut[packagePath(pkg)] = i
+	}
+
+	for i, pkg := range program.Packages {
+		for _, file := range pkg.Files {
+			for _, imp := range file.Imports {
+				other, ok := g.Lookup(imp.Path.Value)
+				if !ok {
+					status.LocationError(imp.Path.Pos, fmt.Sprintf("cannot find module %#v", imp.Path.Value))
+					continue
+				}
+				g.addImport(i, other, imp.Path.Pos)
+			}
+		}
+	}
+	if status.ShouldHalt() {
+		return nil
+	}
+
+	for _, component := range findComponents(g) {
+		cycle := findCycle(g, component)
+		if cycle != nil {
+			reportCycle(status, g, cycle)
+			continue
+		}
+		g.Order = append(g.Order, component[0])
+	}
+	if status.ShouldHalt() {
+		return nil
+	}
+	return g
+}
+
+// Text renders the graph as one line per package, followed by an indented
+// line for each package it imports.
+func (g *PackageGraph) Text() string {
+	var buf bytes.Buffer
+	for _, n := range g.Order {
+		buf.WriteString(packagePath(g.Packages[n]))
+		buf.WriteString("\n")
+		for _, dst := range g.Imports[n] {
+			buf.WriteString("    ")
+			buf.WriteString(packagePath(g.Packages[dst]))
+			buf.WriteString("\n")
+		}
+	}
+	return buf.String()
+}
+
+// Dot renders the graph in graphviz format.
+func (g *PackageGraph) Dot() string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph G {\n")
+	for _, n := range g.Order {
+		buf.WriteString(fmt.Sprintf("  p%d[label=%q];\n", n, packagePath(g.Packages[n])))
+	}
+	for _, n := range g.Order {
+		for _, dst := range g.Imports[n] {
+			buf.WriteString(fmt.Sprintf("  p%d -> p%d;\n", n, dst))
+		}
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
@@ -0,0 +1,121 @@
+package tree
+
+import (
+	"evergreen/assert"
+	"evergreen/compiler"
+	"testing"
+)
+
+type testPackage struct {
+	path    []string
+	imports []string
+}
+
+// Records the errors reported to it instead of printing them.
+type recordingStatus struct {
+	errors []string
+}
+
+func (s *recordingStatus) GlobalError(message string) {
+	s.errors = append(s.errors, message)
+}
+
+func (s *recordingStatus) LocationError(loc int, message string) {
+	s.errors = append(s.errors, message)
+}
+
+func (s *recordingStatus) ShouldHalt() bool {
+	return len(s.errors) > 0
+}
+
+func (s *recordingStatus) ChildEnded() {
+}
+
+func (s *recordingStatus) Pass(name string) compiler.PassStatus {
+	return s
+}
+
+func (s *recordingStatus) Task(name string) compiler.TaskStatus {
+	return s
+}
+
+func (s *recordingStatus) Begin() {
+}
+
+func (s *recordingStatus) End() {
+}
+
+func makeTestProgram(packages []testPackage, offset int) *Program {
+	program := &Program{}
+	for _, tp := range packages {
+		file := &File{Name: "deps.dub"}
+		for _, imp := range tp.imports {
+			file.Imports = append(file.Imports, &ImportDecl{
+				Path: &StringLiteral{Pos: offset + 8, Value: imp},
+			})
+		}
+		program.Packages = append(program.Packages, &Package{
+			Path:  tp.path,
+			Files: []*File{file},
+		})
+	}
+	return program
+}
+
+func buildTestGraph(packages []testPackage) (*PackageGraph, compiler.CompileStatus) {
+	p := compiler.MakeProvider()
+	offset := p.AddFile("deps.dub", []rune("import (\"x\")\n"))
+	status := compiler.MakeStatus(p)
+	g := BuildPackageGraph(status.Pass("deps"), makeTestProgram(packages, offset))
+	return g, status
+}
+
+func TestPackageGraphOrder(t *testing.T) {
+	g, status := buildTestGraph([]testPackage{
+		{path: []string{"c"}, imports: []string{"b", "a/x"}},
+		{path: []string{"a", "x"}},
+		{path: []string{"b"}, imports: []string{"a/x", "a/x"}},
+	})
+	assert.IntEquals(t, status.ErrorCount(), 0)
+	assert.IntListEquals(t, g.Order, []int{1, 2, 0})
+	assert.IntListEquals(t, g.Imports[0], []int{2, 1})
+	assert.IntListEquals(t, g.Imports[2], []int{1})
+	assert.StringEquals(t, g.Text(), "a/x\nb\n    a/x\nc\n    b\n    a/x\n")
+}
+
+func TestPackageGraphCycle(t *testing.T) {
+	status := &recordingStatus{}
+	g := BuildPackageGraph(status, makeTestProgram([]testPackage{
+		{path: []string{"a"}, imports: []string{"b"}},
+		{path: []string{"b"}, imports: []string{"c"}},
+		{path: []string{"c"}, imports: []string{"a"}},
+		{path: []string{"d"}, imports: []string{"d"}},
+	}, 0))
+	if g != nil {
+		t.Fatal(g)
+	}
+	assert.IntEquals(t, len(status.errors), 2)
+	assert.StringEquals(t, status.errors[0], "import cycle: a -> b -> c -> a")
+	assert.StringEquals(t, status.errors[1], "import cycle: d -> d")
+}
+
+func TestPackageGraphFindCycle(t *testing.T) {
+	g := &PackageGraph{
+		Imports: [][]int{
+			{1},
+			{2, 0},
+			{0},
+		},
+	}
+	assert.IntListEquals(t, findCycle(g, []int{2, 1, 0}), []int{0, 1})
+}
+
+func TestPackageGraphMissing(t *testing.T) {
+	g, status := buildTestGraph([]testPackage{
+		{path: []string{"a"}, imports: []string{"nowhere"}},
+	})
+	if g != nil {
+		t.Fatal(g)
+	}
+	assert.IntEquals(t, status.ErrorCount(), 1)
+}
@@ -87,6 +87,16 @@ func parseProgram(status compiler.PassStatus, p compiler.LocationProvider, root
 	}
 }
 
+func DubPackageGraph(status compiler.PassStatus, p compiler.LocationProvider, root string) *PackageGraph {
+	status.Begin()
+	defer status.End()
+	program := parseProgram(status.Pass("parse"), p, root)
+	if status.ShouldHalt() {
+		return nil
+	}
+	return BuildPackageGraph(status.Pass("deps"), program)
+}
+
 func DubProgramFrontend(status compiler.PassStatus, p compiler.LocationProvider, root string) (*Program, *core.CoreProgram) {
 	status.Begin()
 	defer status.End()
@@ -94,7 +104,11 @@ func DubProgramFrontend(status compiler.PassStatus, p compiler.LocationProvider,
 	if status.ShouldHalt() {
 		return nil, nil
 	}
-	coreProg := SemanticPass(program, status.Pass("semantic"))
+	deps := BuildPackageGraph(status.Pass("deps"), program)
+	if status.ShouldHalt() {
+		return nil, nil
+	}
+	coreProg := SemanticPass(program, deps, status.Pass("semantic"))
 	if status.ShouldHalt() {
 		return nil, nil
 	}
@@ -0,0 +1,3602 @@
+package tree
+
+import (
+	"evergreen/dub/core"
+	"evergreen/dub/runtime"
+)
+
+func DecodeTextMatchBinary(d *runtime.BinaryDecoder) TextMatch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/RuneRangeMatch" {
+		return readRuneRangeMatchBinary(d, o)
+	}
+	if t == "dub/tree/StringLiteralMatch" {
+		return readStringLiteralMatchBinary(d, o)
+	}
+	if t == "dub/tree/MatchSequence" {
+		return readMatchSequenceBinary(d, o)
+	}
+	if t == "dub/tree/MatchChoice" {
+		return readMatchChoiceBinary(d, o)
+	}
+	if t == "dub/tree/MatchRepeat" {
+		return readMatchRepeatBinary(d, o)
+	}
+	if t == "dub/tree/MatchLookahead" {
+		return readMatchLookaheadBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *RuneFilter) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/RuneFilter") {
+		return
+	}
+	e.WriteRuneValue(node.Min)
+	e.WriteRuneValue(node.Max)
+}
+
+func (node *RuneFilter) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Min = d.ReadRuneValue()
+	node.Max = d.ReadRuneValue()
+}
+
+func readRuneFilterBinary(d *runtime.BinaryDecoder, o interface{}) *RuneFilter {
+	var node *RuneFilter
+	if o != nil {
+		return o.(*RuneFilter)
+	}
+	node = &RuneFilter{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *RuneFilter) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f2ef71aa9fb167ac")
+}
+
+func (node *RuneFilter) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f2ef71aa9fb167ac", "dub/tree/RuneFilter", node)
+}
+
+func DecodeRuneFilterBinary(d *runtime.BinaryDecoder) *RuneFilter {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/RuneFilter" {
+		return readRuneFilterBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *RuneRangeMatch) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *RuneFilter
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/RuneRangeMatch") {
+		return
+	}
+	e.WriteBool(node.Invert)
+	if node.Filters == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Filters))
+		for _, x = range node.Filters {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *RuneRangeMatch) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*RuneFilter
+	node.Invert = d.ReadBool()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*RuneFilter{}
+		for range n {
+			s = append(s, DecodeRuneFilterBinary(d))
+		}
+	}
+	node.Filters = s
+}
+
+func readRuneRangeMatchBinary(d *runtime.BinaryDecoder, o interface{}) *RuneRangeMatch {
+	var node *RuneRangeMatch
+	if o != nil {
+		return o.(*RuneRangeMatch)
+	}
+	node = &RuneRangeMatch{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *RuneRangeMatch) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "95891bc2caf8dfc2")
+}
+
+func (node *RuneRangeMatch) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "95891bc2caf8dfc2", "dub/tree/RuneRangeMatch", node)
+}
+
+func DecodeRuneRangeMatchBinary(d *runtime.BinaryDecoder) *RuneRangeMatch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/RuneRangeMatch" {
+		return readRuneRangeMatchBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StringLiteralMatch) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/StringLiteralMatch") {
+		return
+	}
+	e.WriteString(node.Value)
+}
+
+func (node *StringLiteralMatch) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadString()
+}
+
+func readStringLiteralMatchBinary(d *runtime.BinaryDecoder, o interface{}) *StringLiteralMatch {
+	var node *StringLiteralMatch
+	if o != nil {
+		return o.(*StringLiteralMatch)
+	}
+	node = &StringLiteralMatch{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StringLiteralMatch) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f43555073ae79df7")
+}
+
+func (node *StringLiteralMatch) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f43555073ae79df7", "dub/tree/StringLiteralMatch", node)
+}
+
+func DecodeStringLiteralMatchBinary(d *runtime.BinaryDecoder) *StringLiteralMatch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/StringLiteralMatch" {
+		return readStringLiteralMatchBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *MatchSequence) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x TextMatch
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/MatchSequence") {
+		return
+	}
+	if node.Matches == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Matches))
+		for _, x = range node.Matches {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *MatchSequence) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []TextMatch
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []TextMatch{}
+		for range n {
+			s = append(s, DecodeTextMatchBinary(d))
+		}
+	}
+	node.Matches = s
+}
+
+func readMatchSequenceBinary(d *runtime.BinaryDecoder, o interface{}) *MatchSequence {
+	var node *MatchSequence
+	if o != nil {
+		return o.(*MatchSequence)
+	}
+	node = &MatchSequence{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *MatchSequence) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "264ec13a820898f5")
+}
+
+func (node *MatchSequence) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "264ec13a820898f5", "dub/tree/MatchSequence", node)
+}
+
+func DecodeMatchSequenceBinary(d *runtime.BinaryDecoder) *MatchSequence {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/MatchSequence" {
+		return readMatchSequenceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *MatchChoice) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x TextMatch
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/MatchChoice") {
+		return
+	}
+	if node.Matches == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Matches))
+		for _, x = range node.Matches {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *MatchChoice) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []TextMatch
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []TextMatch{}
+		for range n {
+			s = append(s, DecodeTextMatchBinary(d))
+		}
+	}
+	node.Matches = s
+}
+
+func readMatchChoiceBinary(d *runtime.BinaryDecoder, o interface{}) *MatchChoice {
+	var node *MatchChoice
+	if o != nil {
+		return o.(*MatchChoice)
+	}
+	node = &MatchChoice{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *MatchChoice) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "264ec13a820898f5")
+}
+
+func (node *MatchChoice) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "264ec13a820898f5", "dub/tree/MatchChoice", node)
+}
+
+func DecodeMatchChoiceBinary(d *runtime.BinaryDecoder) *MatchChoice {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/MatchChoice" {
+		return readMatchChoiceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *MatchRepeat) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/MatchRepeat") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Match)
+	e.WriteInt(node.Min)
+}
+
+func (node *MatchRepeat) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Match = DecodeTextMatchBinary(d)
+	node.Min = d.ReadInt()
+}
+
+func readMatchRepeatBinary(d *runtime.BinaryDecoder, o interface{}) *MatchRepeat {
+	var node *MatchRepeat
+	if o != nil {
+		return o.(*MatchRepeat)
+	}
+	node = &MatchRepeat{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *MatchRepeat) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "264ec13a820898f5")
+}
+
+func (node *MatchRepeat) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "264ec13a820898f5", "dub/tree/MatchRepeat", node)
+}
+
+func DecodeMatchRepeatBinary(d *runtime.BinaryDecoder) *MatchRepeat {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/MatchRepeat" {
+		return readMatchRepeatBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *MatchLookahead) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/MatchLookahead") {
+		return
+	}
+	e.WriteBool(node.Invert)
+	runtime.EncodeBinary(e, node.Match)
+}
+
+func (node *MatchLookahead) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Invert = d.ReadBool()
+	node.Match = DecodeTextMatchBinary(d)
+}
+
+func readMatchLookaheadBinary(d *runtime.BinaryDecoder, o interface{}) *MatchLookahead {
+	var node *MatchLookahead
+	if o != nil {
+		return o.(*MatchLookahead)
+	}
+	node = &MatchLookahead{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *MatchLookahead) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "264ec13a820898f5")
+}
+
+func (node *MatchLookahead) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "264ec13a820898f5", "dub/tree/MatchLookahead", node)
+}
+
+func DecodeMatchLookaheadBinary(d *runtime.BinaryDecoder) *MatchLookahead {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/MatchLookahead" {
+		return readMatchLookaheadBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Id) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Id") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *Id) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readIdBinary(d *runtime.BinaryDecoder, o interface{}) *Id {
+	var node *Id
+	if o != nil {
+		return o.(*Id)
+	}
+	node = &Id{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Id) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "b4a7fb90336dbaba")
+}
+
+func (node *Id) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "b4a7fb90336dbaba", "dub/tree/Id", node)
+}
+
+func DecodeIdBinary(d *runtime.BinaryDecoder) *Id {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Id" {
+		return readIdBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeASTExprBinary(d *runtime.BinaryDecoder) ASTExpr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/RuneLiteral" {
+		return readRuneLiteralBinary(d, o)
+	}
+	if t == "dub/tree/StringLiteral" {
+		return readStringLiteralBinary(d, o)
+	}
+	if t == "dub/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	if t == "dub/tree/Float32Literal" {
+		return readFloat32LiteralBinary(d, o)
+	}
+	if t == "dub/tree/BoolLiteral" {
+		return readBoolLiteralBinary(d, o)
+	}
+	if t == "dub/tree/NilLiteral" {
+		return readNilLiteralBinary(d, o)
+	}
+	if t == "dub/tree/StringMatch" {
+		return readStringMatchBinary(d, o)
+	}
+	if t == "dub/tree/RuneMatch" {
+		return readRuneMatchBinary(d, o)
+	}
+	if t == "dub/tree/If" {
+		return readIfBinary(d, o)
+	}
+	if t == "dub/tree/Repeat" {
+		return readRepeatBinary(d, o)
+	}
+	if t == "dub/tree/Choice" {
+		return readChoiceBinary(d, o)
+	}
+	if t == "dub/tree/Optional" {
+		return readOptionalBinary(d, o)
+	}
+	if t == "dub/tree/Assign" {
+		return readAssignBinary(d, o)
+	}
+	if t == "dub/tree/NameRef" {
+		return readNameRefBinary(d, o)
+	}
+	if t == "dub/tree/GetLocal" {
+		return readGetLocalBinary(d, o)
+	}
+	if t == "dub/tree/SetLocal" {
+		return readSetLocalBinary(d, o)
+	}
+	if t == "dub/tree/Discard" {
+		return readDiscardBinary(d, o)
+	}
+	if t == "dub/tree/GetFunction" {
+		return readGetFunctionBinary(d, o)
+	}
+	if t == "dub/tree/GetFunctionTemplate" {
+		return readGetFunctionTemplateBinary(d, o)
+	}
+	if t == "dub/tree/GetPackage" {
+		return readGetPackageBinary(d, o)
+	}
+	if t == "dub/tree/Construct" {
+		return readConstructBinary(d, o)
+	}
+	if t == "dub/tree/ConstructList" {
+		return readConstructListBinary(d, o)
+	}
+	if t == "dub/tree/Coerce" {
+		return readCoerceBinary(d, o)
+	}
+	if t == "dub/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	if t == "dub/tree/Selector" {
+		return readSelectorBinary(d, o)
+	}
+	if t == "dub/tree/SpecializeTemplate" {
+		return readSpecializeTemplateBinary(d, o)
+	}
+	if t == "dub/tree/Fail" {
+		return readFailBinary(d, o)
+	}
+	if t == "dub/tree/Return" {
+		return readReturnBinary(d, o)
+	}
+	if t == "dub/tree/BinaryOp" {
+		return readBinaryOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *RuneLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/RuneLiteral") {
+		return
+	}
+	e.WriteString(node.Text)
+	e.WriteRuneValue(node.Value)
+}
+
+func (node *RuneLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+	node.Value = d.ReadRuneValue()
+}
+
+func readRuneLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *RuneLiteral {
+	var node *RuneLiteral
+	if o != nil {
+		return o.(*RuneLiteral)
+	}
+	node = &RuneLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *RuneLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d191cac6e6dbc736")
+}
+
+func (node *RuneLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d191cac6e6dbc736", "dub/tree/RuneLiteral", node)
+}
+
+func DecodeRuneLiteralBinary(d *runtime.BinaryDecoder) *RuneLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/RuneLiteral" {
+		return readRuneLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StringLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/StringLiteral") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+	e.WriteString(node.Value)
+}
+
+func (node *StringLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+	node.Value = d.ReadString()
+}
+
+func readStringLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *StringLiteral {
+	var node *StringLiteral
+	if o != nil {
+		return o.(*StringLiteral)
+	}
+	node = &StringLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StringLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "90e735eeec657dc4")
+}
+
+func (node *StringLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "90e735eeec657dc4", "dub/tree/StringLiteral", node)
+}
+
+func DecodeStringLiteralBinary(d *runtime.BinaryDecoder) *StringLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/StringLiteral" {
+		return readStringLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *IntLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/IntLiteral") {
+		return
+	}
+	e.WriteString(node.Text)
+	e.WriteInt(node.Value)
+}
+
+func (node *IntLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+	node.Value = d.ReadInt()
+}
+
+func readIntLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *IntLiteral {
+	var node *IntLiteral
+	if o != nil {
+		return o.(*IntLiteral)
+	}
+	node = &IntLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *IntLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "462b05d447427058")
+}
+
+func (node *IntLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "462b05d447427058", "dub/tree/IntLiteral", node)
+}
+
+func DecodeIntLiteralBinary(d *runtime.BinaryDecoder) *IntLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Float32Literal) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Float32Literal") {
+		return
+	}
+	e.WriteString(node.Text)
+	e.WriteFloat32(node.Value)
+}
+
+func (node *Float32Literal) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+	node.Value = d.ReadFloat32()
+}
+
+func readFloat32LiteralBinary(d *runtime.BinaryDecoder, o interface{}) *Float32Literal {
+	var node *Float32Literal
+	if o != nil {
+		return o.(*Float32Literal)
+	}
+	node = &Float32Literal{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Float32Literal) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "36088697f7bcbd7c")
+}
+
+func (node *Float32Literal) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "36088697f7bcbd7c", "dub/tree/Float32Literal", node)
+}
+
+func DecodeFloat32LiteralBinary(d *runtime.BinaryDecoder) *Float32Literal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Float32Literal" {
+		return readFloat32LiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BoolLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/BoolLiteral") {
+		return
+	}
+	e.WriteString(node.Text)
+	e.WriteBool(node.Value)
+}
+
+func (node *BoolLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+	node.Value = d.ReadBool()
+}
+
+func readBoolLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *BoolLiteral {
+	var node *BoolLiteral
+	if o != nil {
+		return o.(*BoolLiteral)
+	}
+	node = &BoolLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BoolLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ad196b45f5848342")
+}
+
+func (node *BoolLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ad196b45f5848342", "dub/tree/BoolLiteral", node)
+}
+
+func DecodeBoolLiteralBinary(d *runtime.BinaryDecoder) *BoolLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/BoolLiteral" {
+		return readBoolLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NilLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/NilLiteral") {
+		return
+	}
+}
+
+func (node *NilLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readNilLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *NilLiteral {
+	var node *NilLiteral
+	if o != nil {
+		return o.(*NilLiteral)
+	}
+	node = &NilLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NilLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "61f9a734eaaf3b68")
+}
+
+func (node *NilLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "61f9a734eaaf3b68", "dub/tree/NilLiteral", node)
+}
+
+func DecodeNilLiteralBinary(d *runtime.BinaryDecoder) *NilLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/NilLiteral" {
+		return readNilLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StringMatch) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/StringMatch") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Match)
+}
+
+func (node *StringMatch) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Match = DecodeTextMatchBinary(d)
+}
+
+func readStringMatchBinary(d *runtime.BinaryDecoder, o interface{}) *StringMatch {
+	var node *StringMatch
+	if o != nil {
+		return o.(*StringMatch)
+	}
+	node = &StringMatch{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StringMatch) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6ff6dd9c37590a0f")
+}
+
+func (node *StringMatch) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6ff6dd9c37590a0f", "dub/tree/StringMatch", node)
+}
+
+func DecodeStringMatchBinary(d *runtime.BinaryDecoder) *StringMatch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/StringMatch" {
+		return readStringMatchBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *RuneMatch) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/RuneMatch") {
+		return
+	}
+	node.Match.EncodeBinary(e)
+}
+
+func (node *RuneMatch) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Match = DecodeRuneRangeMatchBinary(d)
+}
+
+func readRuneMatchBinary(d *runtime.BinaryDecoder, o interface{}) *RuneMatch {
+	var node *RuneMatch
+	if o != nil {
+		return o.(*RuneMatch)
+	}
+	node = &RuneMatch{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *RuneMatch) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "74550c6b2cbeaded")
+}
+
+func (node *RuneMatch) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "74550c6b2cbeaded", "dub/tree/RuneMatch", node)
+}
+
+func DecodeRuneMatchBinary(d *runtime.BinaryDecoder) *RuneMatch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/RuneMatch" {
+		return readRuneMatchBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeASTDeclBinary(d *runtime.BinaryDecoder) ASTDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/StructDecl" {
+		return readStructDeclBinary(d, o)
+	}
+	if t == "dub/tree/FuncDecl" {
+		return readFuncDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeASTTypeRefBinary(d *runtime.BinaryDecoder) ASTTypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/TypeRef" {
+		return readTypeRefBinary(d, o)
+	}
+	if t == "dub/tree/ListTypeRef" {
+		return readListTypeRefBinary(d, o)
+	}
+	if t == "dub/tree/QualifiedTypeRef" {
+		return readQualifiedTypeRefBinary(d, o)
+	}
+	if t == "dub/tree/GetType" {
+		return readGetTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/TypeRef") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+}
+
+func (node *TypeRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+}
+
+func readTypeRefBinary(d *runtime.BinaryDecoder, o interface{}) *TypeRef {
+	var node *TypeRef
+	if o != nil {
+		return o.(*TypeRef)
+	}
+	node = &TypeRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e5b8201ef484f9e4")
+}
+
+func (node *TypeRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e5b8201ef484f9e4", "dub/tree/TypeRef", node)
+}
+
+func DecodeTypeRefBinary(d *runtime.BinaryDecoder) *TypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/TypeRef" {
+		return readTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ListTypeRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/ListTypeRef") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *ListTypeRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = DecodeASTTypeRefBinary(d)
+}
+
+func readListTypeRefBinary(d *runtime.BinaryDecoder, o interface{}) *ListTypeRef {
+	var node *ListTypeRef
+	if o != nil {
+		return o.(*ListTypeRef)
+	}
+	node = &ListTypeRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ListTypeRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "aa235ec2eb3d55df")
+}
+
+func (node *ListTypeRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "aa235ec2eb3d55df", "dub/tree/ListTypeRef", node)
+}
+
+func DecodeListTypeRefBinary(d *runtime.BinaryDecoder) *ListTypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/ListTypeRef" {
+		return readListTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *QualifiedTypeRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/QualifiedTypeRef") {
+		return
+	}
+	node.Package.EncodeBinary(e)
+	node.Name.EncodeBinary(e)
+}
+
+func (node *QualifiedTypeRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Package = DecodeIdBinary(d)
+	node.Name = DecodeIdBinary(d)
+}
+
+func readQualifiedTypeRefBinary(d *runtime.BinaryDecoder, o interface{}) *QualifiedTypeRef {
+	var node *QualifiedTypeRef
+	if o != nil {
+		return o.(*QualifiedTypeRef)
+	}
+	node = &QualifiedTypeRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *QualifiedTypeRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8940b86aa07b2dab")
+}
+
+func (node *QualifiedTypeRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8940b86aa07b2dab", "dub/tree/QualifiedTypeRef", node)
+}
+
+func DecodeQualifiedTypeRefBinary(d *runtime.BinaryDecoder) *QualifiedTypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/QualifiedTypeRef" {
+		return readQualifiedTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/GetType") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *GetType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = core.DecodeDubTypeBinary(d)
+}
+
+func readGetTypeBinary(d *runtime.BinaryDecoder, o interface{}) *GetType {
+	var node *GetType
+	if o != nil {
+		return o.(*GetType)
+	}
+	node = &GetType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e7d2d14e9818da96")
+}
+
+func (node *GetType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e7d2d14e9818da96", "dub/tree/GetType", node)
+}
+
+func DecodeGetTypeBinary(d *runtime.BinaryDecoder) *GetType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/GetType" {
+		return readGetTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeDestructureBinary(d *runtime.BinaryDecoder) Destructure {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/DestructureValue" {
+		return readDestructureValueBinary(d, o)
+	}
+	if t == "dub/tree/DestructureStruct" {
+		return readDestructureStructBinary(d, o)
+	}
+	if t == "dub/tree/DestructureList" {
+		return readDestructureListBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *DestructureValue) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/DestructureValue") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+}
+
+func (node *DestructureValue) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeASTExprBinary(d)
+}
+
+func readDestructureValueBinary(d *runtime.BinaryDecoder, o interface{}) *DestructureValue {
+	var node *DestructureValue
+	if o != nil {
+		return o.(*DestructureValue)
+	}
+	node = &DestructureValue{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *DestructureValue) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "95ee4df3b66a288c")
+}
+
+func (node *DestructureValue) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "95ee4df3b66a288c", "dub/tree/DestructureValue", node)
+}
+
+func DecodeDestructureValueBinary(d *runtime.BinaryDecoder) *DestructureValue {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/DestructureValue" {
+		return readDestructureValueBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *DestructureField) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/DestructureField") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Destructure)
+}
+
+func (node *DestructureField) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+	node.Destructure = DecodeDestructureBinary(d)
+}
+
+func readDestructureFieldBinary(d *runtime.BinaryDecoder, o interface{}) *DestructureField {
+	var node *DestructureField
+	if o != nil {
+		return o.(*DestructureField)
+	}
+	node = &DestructureField{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *DestructureField) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "39a378557671e919")
+}
+
+func (node *DestructureField) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "39a378557671e919", "dub/tree/DestructureField", node)
+}
+
+func DecodeDestructureFieldBinary(d *runtime.BinaryDecoder) *DestructureField {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/DestructureField" {
+		return readDestructureFieldBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *DestructureStruct) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *DestructureField
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/DestructureStruct") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *DestructureStruct) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*DestructureField
+	node.Type = DecodeASTTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*DestructureField{}
+		for range n {
+			s = append(s, DecodeDestructureFieldBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readDestructureStructBinary(d *runtime.BinaryDecoder, o interface{}) *DestructureStruct {
+	var node *DestructureStruct
+	if o != nil {
+		return o.(*DestructureStruct)
+	}
+	node = &DestructureStruct{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *DestructureStruct) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "39a378557671e919")
+}
+
+func (node *DestructureStruct) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "39a378557671e919", "dub/tree/DestructureStruct", node)
+}
+
+func DecodeDestructureStructBinary(d *runtime.BinaryDecoder) *DestructureStruct {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/DestructureStruct" {
+		return readDestructureStructBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *DestructureList) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Destructure
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/DestructureList") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *DestructureList) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Destructure
+	node.Type = DecodeASTTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Destructure{}
+		for range n {
+			s = append(s, DecodeDestructureBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readDestructureListBinary(d *runtime.BinaryDecoder, o interface{}) *DestructureList {
+	var node *DestructureList
+	if o != nil {
+		return o.(*DestructureList)
+	}
+	node = &DestructureList{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *DestructureList) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "39a378557671e919")
+}
+
+func (node *DestructureList) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "39a378557671e919", "dub/tree/DestructureList", node)
+}
+
+func DecodeDestructureListBinary(d *runtime.BinaryDecoder) *DestructureList {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/DestructureList" {
+		return readDestructureListBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *If) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 ASTExpr
+	var x1 ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/If") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	if node.Block == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Block))
+		for _, x0 = range node.Block {
+			runtime.EncodeBinary(e, x0)
+		}
+	}
+	if node.Else == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Else))
+		for _, x1 = range node.Else {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+}
+
+func (node *If) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []ASTExpr
+	var n1 int
+	var s1 []ASTExpr
+	node.Expr = DecodeASTExprBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []ASTExpr{}
+		for range n0 {
+			s0 = append(s0, DecodeASTExprBinary(d))
+		}
+	}
+	node.Block = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []ASTExpr{}
+		for range n1 {
+			s1 = append(s1, DecodeASTExprBinary(d))
+		}
+	}
+	node.Else = s1
+}
+
+func readIfBinary(d *runtime.BinaryDecoder, o interface{}) *If {
+	var node *If
+	if o != nil {
+		return o.(*If)
+	}
+	node = &If{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *If) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *If) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/If", node)
+}
+
+func DecodeIfBinary(d *runtime.BinaryDecoder) *If {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/If" {
+		return readIfBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Repeat) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Repeat") {
+		return
+	}
+	if node.Block == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Block))
+		for _, x = range node.Block {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+	e.WriteInt(node.Min)
+}
+
+func (node *Repeat) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []ASTExpr
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []ASTExpr{}
+		for range n {
+			s = append(s, DecodeASTExprBinary(d))
+		}
+	}
+	node.Block = s
+	node.Min = d.ReadInt()
+}
+
+func readRepeatBinary(d *runtime.BinaryDecoder, o interface{}) *Repeat {
+	var node *Repeat
+	if o != nil {
+		return o.(*Repeat)
+	}
+	node = &Repeat{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Repeat) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Repeat) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Repeat", node)
+}
+
+func DecodeRepeatBinary(d *runtime.BinaryDecoder) *Repeat {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Repeat" {
+		return readRepeatBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Choice) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 []ASTExpr
+	var x1 ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Choice") {
+		return
+	}
+	if node.Blocks == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Blocks))
+		for _, x0 = range node.Blocks {
+			if x0 == nil {
+				e.WriteNil()
+			} else {
+				e.WriteLength(len(x0))
+				for _, x1 = range x0 {
+					runtime.EncodeBinary(e, x1)
+				}
+			}
+		}
+	}
+}
+
+func (node *Choice) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 [][]ASTExpr
+	var n1 int
+	var s1 []ASTExpr
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = [][]ASTExpr{}
+		for range n0 {
+			n1 = d.ReadLength()
+			s1 = nil
+			if n1 >= 0 {
+				s1 = []ASTExpr{}
+				for range n1 {
+					s1 = append(s1, DecodeASTExprBinary(d))
+				}
+			}
+			s0 = append(s0, s1)
+		}
+	}
+	node.Blocks = s0
+}
+
+func readChoiceBinary(d *runtime.BinaryDecoder, o interface{}) *Choice {
+	var node *Choice
+	if o != nil {
+		return o.(*Choice)
+	}
+	node = &Choice{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Choice) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Choice) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Choice", node)
+}
+
+func DecodeChoiceBinary(d *runtime.BinaryDecoder) *Choice {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Choice" {
+		return readChoiceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Optional) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Optional") {
+		return
+	}
+	if node.Block == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Block))
+		for _, x = range node.Block {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *Optional) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []ASTExpr
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []ASTExpr{}
+		for range n {
+			s = append(s, DecodeASTExprBinary(d))
+		}
+	}
+	node.Block = s
+}
+
+func readOptionalBinary(d *runtime.BinaryDecoder, o interface{}) *Optional {
+	var node *Optional
+	if o != nil {
+		return o.(*Optional)
+	}
+	node = &Optional{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Optional) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Optional) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Optional", node)
+}
+
+func DecodeOptionalBinary(d *runtime.BinaryDecoder) *Optional {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Optional" {
+		return readOptionalBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Assign) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Assign") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	e.WriteInt(node.Pos)
+	if node.Targets == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Targets))
+		for _, x = range node.Targets {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+	runtime.EncodeBinary(e, node.Type)
+	e.WriteBool(node.Define)
+}
+
+func (node *Assign) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []ASTExpr
+	node.Expr = DecodeASTExprBinary(d)
+	node.Pos = d.ReadInt()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []ASTExpr{}
+		for range n {
+			s = append(s, DecodeASTExprBinary(d))
+		}
+	}
+	node.Targets = s
+	node.Type = DecodeASTTypeRefBinary(d)
+	node.Define = d.ReadBool()
+}
+
+func readAssignBinary(d *runtime.BinaryDecoder, o interface{}) *Assign {
+	var node *Assign
+	if o != nil {
+		return o.(*Assign)
+	}
+	node = &Assign{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Assign) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Assign) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Assign", node)
+}
+
+func DecodeAssignBinary(d *runtime.BinaryDecoder) *Assign {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Assign" {
+		return readAssignBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NameRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/NameRef") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+}
+
+func (node *NameRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+}
+
+func readNameRefBinary(d *runtime.BinaryDecoder, o interface{}) *NameRef {
+	var node *NameRef
+	if o != nil {
+		return o.(*NameRef)
+	}
+	node = &NameRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NameRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a7af3d569e22423")
+}
+
+func (node *NameRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a7af3d569e22423", "dub/tree/NameRef", node)
+}
+
+func DecodeNameRefBinary(d *runtime.BinaryDecoder) *NameRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/NameRef" {
+		return readNameRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetLocal) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/GetLocal") {
+		return
+	}
+	node.Info.EncodeBinary(e)
+}
+
+func (node *GetLocal) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Info = DecodeLocalInfoBinary(d)
+}
+
+func readGetLocalBinary(d *runtime.BinaryDecoder, o interface{}) *GetLocal {
+	var node *GetLocal
+	if o != nil {
+		return o.(*GetLocal)
+	}
+	node = &GetLocal{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetLocal) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "926c656123683961")
+}
+
+func (node *GetLocal) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "926c656123683961", "dub/tree/GetLocal", node)
+}
+
+func DecodeGetLocalBinary(d *runtime.BinaryDecoder) *GetLocal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/GetLocal" {
+		return readGetLocalBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SetLocal) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/SetLocal") {
+		return
+	}
+	node.Info.EncodeBinary(e)
+}
+
+func (node *SetLocal) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Info = DecodeLocalInfoBinary(d)
+}
+
+func readSetLocalBinary(d *runtime.BinaryDecoder, o interface{}) *SetLocal {
+	var node *SetLocal
+	if o != nil {
+		return o.(*SetLocal)
+	}
+	node = &SetLocal{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SetLocal) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ae357884463a4d99")
+}
+
+func (node *SetLocal) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ae357884463a4d99", "dub/tree/SetLocal", node)
+}
+
+func DecodeSetLocalBinary(d *runtime.BinaryDecoder) *SetLocal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/SetLocal" {
+		return readSetLocalBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Discard) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Discard") {
+		return
+	}
+}
+
+func (node *Discard) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readDiscardBinary(d *runtime.BinaryDecoder, o interface{}) *Discard {
+	var node *Discard
+	if o != nil {
+		return o.(*Discard)
+	}
+	node = &Discard{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Discard) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "880c9f6568a061e4")
+}
+
+func (node *Discard) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "880c9f6568a061e4", "dub/tree/Discard", node)
+}
+
+func DecodeDiscardBinary(d *runtime.BinaryDecoder) *Discard {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Discard" {
+		return readDiscardBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetFunction) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/GetFunction") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Func)
+}
+
+func (node *GetFunction) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Func = core.DecodeCallableBinary(d)
+}
+
+func readGetFunctionBinary(d *runtime.BinaryDecoder, o interface{}) *GetFunction {
+	var node *GetFunction
+	if o != nil {
+		return o.(*GetFunction)
+	}
+	node = &GetFunction{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetFunction) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "07c9f6dc015a2aed")
+}
+
+func (node *GetFunction) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "07c9f6dc015a2aed", "dub/tree/GetFunction", node)
+}
+
+func DecodeGetFunctionBinary(d *runtime.BinaryDecoder) *GetFunction {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/GetFunction" {
+		return readGetFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetFunctionTemplate) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/GetFunctionTemplate") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Template)
+}
+
+func (node *GetFunctionTemplate) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Template = core.DecodeCallableTemplateBinary(d)
+}
+
+func readGetFunctionTemplateBinary(d *runtime.BinaryDecoder, o interface{}) *GetFunctionTemplate {
+	var node *GetFunctionTemplate
+	if o != nil {
+		return o.(*GetFunctionTemplate)
+	}
+	node = &GetFunctionTemplate{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetFunctionTemplate) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "5bf2c3a7438847b7")
+}
+
+func (node *GetFunctionTemplate) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "5bf2c3a7438847b7", "dub/tree/GetFunctionTemplate", node)
+}
+
+func DecodeGetFunctionTemplateBinary(d *runtime.BinaryDecoder) *GetFunctionTemplate {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/GetFunctionTemplate" {
+		return readGetFunctionTemplateBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetPackage) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/GetPackage") {
+		return
+	}
+	node.Package.EncodeBinary(e)
+}
+
+func (node *GetPackage) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Package = core.DecodePackageBinary(d)
+}
+
+func readGetPackageBinary(d *runtime.BinaryDecoder, o interface{}) *GetPackage {
+	var node *GetPackage
+	if o != nil {
+		return o.(*GetPackage)
+	}
+	node = &GetPackage{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetPackage) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "1422f3c47e572c3a")
+}
+
+func (node *GetPackage) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "1422f3c47e572c3a", "dub/tree/GetPackage", node)
+}
+
+func DecodeGetPackageBinary(d *runtime.BinaryDecoder) *GetPackage {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/GetPackage" {
+		return readGetPackageBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NamedExpr) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/NamedExpr") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Expr)
+}
+
+func (node *NamedExpr) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+	node.Expr = DecodeASTExprBinary(d)
+}
+
+func readNamedExprBinary(d *runtime.BinaryDecoder, o interface{}) *NamedExpr {
+	var node *NamedExpr
+	if o != nil {
+		return o.(*NamedExpr)
+	}
+	node = &NamedExpr{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NamedExpr) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *NamedExpr) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/NamedExpr", node)
+}
+
+func DecodeNamedExprBinary(d *runtime.BinaryDecoder) *NamedExpr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/NamedExpr" {
+		return readNamedExprBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Construct) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *NamedExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Construct") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *Construct) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*NamedExpr
+	node.Type = DecodeASTTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*NamedExpr{}
+		for range n {
+			s = append(s, DecodeNamedExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readConstructBinary(d *runtime.BinaryDecoder, o interface{}) *Construct {
+	var node *Construct
+	if o != nil {
+		return o.(*Construct)
+	}
+	node = &Construct{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Construct) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Construct) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Construct", node)
+}
+
+func DecodeConstructBinary(d *runtime.BinaryDecoder) *Construct {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Construct" {
+		return readConstructBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstructList) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/ConstructList") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *ConstructList) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []ASTExpr
+	node.Type = DecodeASTTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []ASTExpr{}
+		for range n {
+			s = append(s, DecodeASTExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readConstructListBinary(d *runtime.BinaryDecoder, o interface{}) *ConstructList {
+	var node *ConstructList
+	if o != nil {
+		return o.(*ConstructList)
+	}
+	node = &ConstructList{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstructList) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *ConstructList) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/ConstructList", node)
+}
+
+func DecodeConstructListBinary(d *runtime.BinaryDecoder) *ConstructList {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/ConstructList" {
+		return readConstructListBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Coerce) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Coerce") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	runtime.EncodeBinary(e, node.Expr)
+}
+
+func (node *Coerce) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = DecodeASTTypeRefBinary(d)
+	node.Expr = DecodeASTExprBinary(d)
+}
+
+func readCoerceBinary(d *runtime.BinaryDecoder, o interface{}) *Coerce {
+	var node *Coerce
+	if o != nil {
+		return o.(*Coerce)
+	}
+	node = &Coerce{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Coerce) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Coerce) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Coerce", node)
+}
+
+func DecodeCoerceBinary(d *runtime.BinaryDecoder) *Coerce {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Coerce" {
+		return readCoerceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Call) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Call") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	e.WriteInt(node.Pos)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+	runtime.EncodeBinary(e, node.Target)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *Call) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []ASTExpr
+	node.Expr = DecodeASTExprBinary(d)
+	node.Pos = d.ReadInt()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []ASTExpr{}
+		for range n {
+			s = append(s, DecodeASTExprBinary(d))
+		}
+	}
+	node.Args = s
+	node.Target = core.DecodeCallableBinary(d)
+	node.T = core.DecodeDubTypeBinary(d)
+}
+
+func readCallBinary(d *runtime.BinaryDecoder, o interface{}) *Call {
+	var node *Call
+	if o != nil {
+		return o.(*Call)
+	}
+	node = &Call{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Call) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Call) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Call", node)
+}
+
+func DecodeCallBinary(d *runtime.BinaryDecoder) *Call {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Selector) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Selector") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	e.WriteInt(node.Pos)
+	node.Name.EncodeBinary(e)
+}
+
+func (node *Selector) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeASTExprBinary(d)
+	node.Pos = d.ReadInt()
+	node.Name = DecodeIdBinary(d)
+}
+
+func readSelectorBinary(d *runtime.BinaryDecoder, o interface{}) *Selector {
+	var node *Selector
+	if o != nil {
+		return o.(*Selector)
+	}
+	node = &Selector{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Selector) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Selector) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Selector", node)
+}
+
+func DecodeSelectorBinary(d *runtime.BinaryDecoder) *Selector {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Selector" {
+		return readSelectorBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SpecializeTemplate) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x ASTTypeRef
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/SpecializeTemplate") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	e.WriteInt(node.Pos)
+	if node.Types == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Types))
+		for _, x = range node.Types {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *SpecializeTemplate) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []ASTTypeRef
+	node.Expr = DecodeASTExprBinary(d)
+	node.Pos = d.ReadInt()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []ASTTypeRef{}
+		for range n {
+			s = append(s, DecodeASTTypeRefBinary(d))
+		}
+	}
+	node.Types = s
+}
+
+func readSpecializeTemplateBinary(d *runtime.BinaryDecoder, o interface{}) *SpecializeTemplate {
+	var node *SpecializeTemplate
+	if o != nil {
+		return o.(*SpecializeTemplate)
+	}
+	node = &SpecializeTemplate{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SpecializeTemplate) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *SpecializeTemplate) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/SpecializeTemplate", node)
+}
+
+func DecodeSpecializeTemplateBinary(d *runtime.BinaryDecoder) *SpecializeTemplate {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/SpecializeTemplate" {
+		return readSpecializeTemplateBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Fail) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Fail") {
+		return
+	}
+}
+
+func (node *Fail) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readFailBinary(d *runtime.BinaryDecoder, o interface{}) *Fail {
+	var node *Fail
+	if o != nil {
+		return o.(*Fail)
+	}
+	node = &Fail{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Fail) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9063a0a86689b492")
+}
+
+func (node *Fail) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9063a0a86689b492", "dub/tree/Fail", node)
+}
+
+func DecodeFailBinary(d *runtime.BinaryDecoder) *Fail {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Fail" {
+		return readFailBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Return) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Return") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	if node.Exprs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Exprs))
+		for _, x = range node.Exprs {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *Return) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []ASTExpr
+	node.Pos = d.ReadInt()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []ASTExpr{}
+		for range n {
+			s = append(s, DecodeASTExprBinary(d))
+		}
+	}
+	node.Exprs = s
+}
+
+func readReturnBinary(d *runtime.BinaryDecoder, o interface{}) *Return {
+	var node *Return
+	if o != nil {
+		return o.(*Return)
+	}
+	node = &Return{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Return) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *Return) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/Return", node)
+}
+
+func DecodeReturnBinary(d *runtime.BinaryDecoder) *Return {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Return" {
+		return readReturnBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BinaryOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/BinaryOp") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Left)
+	e.WriteString(node.Op)
+	e.WriteInt(node.OpPos)
+	runtime.EncodeBinary(e, node.Right)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *BinaryOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Left = DecodeASTExprBinary(d)
+	node.Op = d.ReadString()
+	node.OpPos = d.ReadInt()
+	node.Right = DecodeASTExprBinary(d)
+	node.T = core.DecodeDubTypeBinary(d)
+}
+
+func readBinaryOpBinary(d *runtime.BinaryDecoder, o interface{}) *BinaryOp {
+	var node *BinaryOp
+	if o != nil {
+		return o.(*BinaryOp)
+	}
+	node = &BinaryOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BinaryOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9ad20b901590b653")
+}
+
+func (node *BinaryOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9ad20b901590b653", "dub/tree/BinaryOp", node)
+}
+
+func DecodeBinaryOpBinary(d *runtime.BinaryDecoder) *BinaryOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/BinaryOp" {
+		return readBinaryOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TemplateParam) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/TemplateParam") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+}
+
+func (node *TemplateParam) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+}
+
+func readTemplateParamBinary(d *runtime.BinaryDecoder, o interface{}) *TemplateParam {
+	var node *TemplateParam
+	if o != nil {
+		return o.(*TemplateParam)
+	}
+	node = &TemplateParam{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TemplateParam) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "1049cdcea23727a4")
+}
+
+func (node *TemplateParam) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "1049cdcea23727a4", "dub/tree/TemplateParam", node)
+}
+
+func DecodeTemplateParamBinary(d *runtime.BinaryDecoder) *TemplateParam {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/TemplateParam" {
+		return readTemplateParamBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FieldDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/FieldDecl") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *FieldDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+	node.Type = DecodeASTTypeRefBinary(d)
+}
+
+func readFieldDeclBinary(d *runtime.BinaryDecoder, o interface{}) *FieldDecl {
+	var node *FieldDecl
+	if o != nil {
+		return o.(*FieldDecl)
+	}
+	node = &FieldDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FieldDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7f18cebcddae3d0f")
+}
+
+func (node *FieldDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7f18cebcddae3d0f", "dub/tree/FieldDecl", node)
+}
+
+func DecodeFieldDeclBinary(d *runtime.BinaryDecoder) *FieldDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/FieldDecl" {
+		return readFieldDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StructDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *FieldDecl
+	var x1 ASTTypeRef
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/StructDecl") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	e.WriteBool(node.Export)
+	runtime.EncodeBinary(e, node.Implements)
+	if node.Fields == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Fields))
+		for _, x0 = range node.Fields {
+			x0.EncodeBinary(e)
+		}
+	}
+	e.WriteBool(node.Scoped)
+	if node.Contains == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Contains))
+		for _, x1 = range node.Contains {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+	node.T.EncodeBinary(e)
+}
+
+func (node *StructDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*FieldDecl
+	var n1 int
+	var s1 []ASTTypeRef
+	node.Name = DecodeIdBinary(d)
+	node.Export = d.ReadBool()
+	node.Implements = DecodeASTTypeRefBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*FieldDecl{}
+		for range n0 {
+			s0 = append(s0, DecodeFieldDeclBinary(d))
+		}
+	}
+	node.Fields = s0
+	node.Scoped = d.ReadBool()
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []ASTTypeRef{}
+		for range n1 {
+			s1 = append(s1, DecodeASTTypeRefBinary(d))
+		}
+	}
+	node.Contains = s1
+	node.T = core.DecodeStructTypeBinary(d)
+}
+
+func readStructDeclBinary(d *runtime.BinaryDecoder, o interface{}) *StructDecl {
+	var node *StructDecl
+	if o != nil {
+		return o.(*StructDecl)
+	}
+	node = &StructDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StructDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "77da2d0e8db368bc")
+}
+
+func (node *StructDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "77da2d0e8db368bc", "dub/tree/StructDecl", node)
+}
+
+func DecodeStructDeclBinary(d *runtime.BinaryDecoder) *StructDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/StructDecl" {
+		return readStructDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *LocalInfo) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/LocalInfo") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *LocalInfo) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = LocalInfo_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.T = core.DecodeDubTypeBinary(d)
+}
+
+func readLocalInfoBinary(d *runtime.BinaryDecoder, o interface{}) *LocalInfo {
+	var node *LocalInfo
+	if o != nil {
+		return o.(*LocalInfo)
+	}
+	node = &LocalInfo{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *LocalInfo) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f5c8d826fdbaf2c0")
+}
+
+func (node *LocalInfo) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f5c8d826fdbaf2c0", "dub/tree/LocalInfo", node)
+}
+
+func DecodeLocalInfoBinary(d *runtime.BinaryDecoder) *LocalInfo {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/LocalInfo" {
+		return readLocalInfoBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Param) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Param") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Type)
+	node.Info.EncodeBinary(e)
+}
+
+func (node *Param) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+	node.Type = DecodeASTTypeRefBinary(d)
+	node.Info = DecodeLocalInfoBinary(d)
+}
+
+func readParamBinary(d *runtime.BinaryDecoder, o interface{}) *Param {
+	var node *Param
+	if o != nil {
+		return o.(*Param)
+	}
+	node = &Param{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Param) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "84b65e3e7af856a2")
+}
+
+func (node *Param) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "84b65e3e7af856a2", "dub/tree/Param", node)
+}
+
+func DecodeParamBinary(d *runtime.BinaryDecoder) *Param {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Param" {
+		return readParamBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FuncDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var o *LocalInfo
+	var x0 *TemplateParam
+	var x1 *Param
+	var x2 ASTTypeRef
+	var x3 ASTExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/FuncDecl") {
+		return
+	}
+	if node.LocalInfo_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.LocalInfo_Scope.objects))
+		for _, o = range node.LocalInfo_Scope.objects {
+			o.EncodeBinary(e)
+		}
+	}
+	node.Name.EncodeBinary(e)
+	e.WriteBool(node.Export)
+	e.WriteString(node.Inline)
+	if node.TemplateParams == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.TemplateParams))
+		for _, x0 = range node.TemplateParams {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x1 = range node.Params {
+			x1.EncodeBinary(e)
+		}
+	}
+	if node.ReturnTypes == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.ReturnTypes))
+		for _, x2 = range node.ReturnTypes {
+			runtime.EncodeBinary(e, x2)
+		}
+	}
+	if node.Block == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Block))
+		for _, x3 = range node.Block {
+			runtime.EncodeBinary(e, x3)
+		}
+	}
+	node.F.EncodeBinary(e)
+}
+
+func (node *FuncDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var n1 int
+	var s0 []*TemplateParam
+	var n2 int
+	var s1 []*Param
+	var n3 int
+	var s2 []ASTTypeRef
+	var n4 int
+	var s3 []ASTExpr
+	n0 = d.ReadLength()
+	if n0 >= 0 {
+		node.LocalInfo_Scope = &LocalInfo_Scope{}
+		for range n0 {
+			node.LocalInfo_Scope.objects = append(node.LocalInfo_Scope.objects, DecodeLocalInfoBinary(d))
+		}
+	}
+	node.Name = DecodeIdBinary(d)
+	node.Export = d.ReadBool()
+	node.Inline = d.ReadString()
+	n1 = d.ReadLength()
+	s0 = nil
+	if n1 >= 0 {
+		s0 = []*TemplateParam{}
+		for range n1 {
+			s0 = append(s0, DecodeTemplateParamBinary(d))
+		}
+	}
+	node.TemplateParams = s0
+	n2 = d.ReadLength()
+	s1 = nil
+	if n2 >= 0 {
+		s1 = []*Param{}
+		for range n2 {
+			s1 = append(s1, DecodeParamBinary(d))
+		}
+	}
+	node.Params = s1
+	n3 = d.ReadLength()
+	s2 = nil
+	if n3 >= 0 {
+		s2 = []ASTTypeRef{}
+		for range n3 {
+			s2 = append(s2, DecodeASTTypeRefBinary(d))
+		}
+	}
+	node.ReturnTypes = s2
+	n4 = d.ReadLength()
+	s3 = nil
+	if n4 >= 0 {
+		s3 = []ASTExpr{}
+		for range n4 {
+			s3 = append(s3, DecodeASTExprBinary(d))
+		}
+	}
+	node.Block = s3
+	node.F = core.DecodeFunctionBinary(d)
+}
+
+func readFuncDeclBinary(d *runtime.BinaryDecoder, o interface{}) *FuncDecl {
+	var node *FuncDecl
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	node = &FuncDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FuncDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8d5782f70d1a567f")
+}
+
+func (node *FuncDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8d5782f70d1a567f", "dub/tree/FuncDecl", node)
+}
+
+func DecodeFuncDeclBinary(d *runtime.BinaryDecoder) *FuncDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/FuncDecl" {
+		return readFuncDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Test) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Test") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Rule)
+	runtime.EncodeBinary(e, node.Type)
+	e.WriteString(node.Input)
+	e.WriteString(node.Flow)
+	runtime.EncodeBinary(e, node.Destructure)
+	node.File.EncodeBinary(e)
+}
+
+func (node *Test) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeIdBinary(d)
+	node.Rule = DecodeASTExprBinary(d)
+	node.Type = core.DecodeDubTypeBinary(d)
+	node.Input = d.ReadString()
+	node.Flow = d.ReadString()
+	node.Destructure = DecodeDestructureBinary(d)
+	node.File = core.DecodeFileBinary(d)
+}
+
+func readTestBinary(d *runtime.BinaryDecoder, o interface{}) *Test {
+	var node *Test
+	if o != nil {
+		return o.(*Test)
+	}
+	node = &Test{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Test) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "a4a79a0a61ba4cd8")
+}
+
+func (node *Test) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "a4a79a0a61ba4cd8", "dub/tree/Test", node)
+}
+
+func DecodeTestBinary(d *runtime.BinaryDecoder) *Test {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Test" {
+		return readTestBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ImportDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/ImportDecl") {
+		return
+	}
+	node.Path.EncodeBinary(e)
+}
+
+func (node *ImportDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Path = DecodeStringLiteralBinary(d)
+}
+
+func readImportDeclBinary(d *runtime.BinaryDecoder, o interface{}) *ImportDecl {
+	var node *ImportDecl
+	if o != nil {
+		return o.(*ImportDecl)
+	}
+	node = &ImportDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ImportDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f7ad7c6f6d53121f")
+}
+
+func (node *ImportDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f7ad7c6f6d53121f", "dub/tree/ImportDecl", node)
+}
+
+func DecodeImportDeclBinary(d *runtime.BinaryDecoder) *ImportDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/ImportDecl" {
+		return readImportDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *File) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *ImportDecl
+	var x1 ASTDecl
+	var x2 *Test
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/File") {
+		return
+	}
+	e.WriteString(node.Name)
+	if node.Imports == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Imports))
+		for _, x0 = range node.Imports {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Decls == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Decls))
+		for _, x1 = range node.Decls {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+	if node.Tests == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Tests))
+		for _, x2 = range node.Tests {
+			x2.EncodeBinary(e)
+		}
+	}
+	node.F.EncodeBinary(e)
+}
+
+func (node *File) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*ImportDecl
+	var n1 int
+	var s1 []ASTDecl
+	var n2 int
+	var s2 []*Test
+	node.Name = d.ReadString()
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*ImportDecl{}
+		for range n0 {
+			s0 = append(s0, DecodeImportDeclBinary(d))
+		}
+	}
+	node.Imports = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []ASTDecl{}
+		for range n1 {
+			s1 = append(s1, DecodeASTDeclBinary(d))
+		}
+	}
+	node.Decls = s1
+	n2 = d.ReadLength()
+	s2 = nil
+	if n2 >= 0 {
+		s2 = []*Test{}
+		for range n2 {
+			s2 = append(s2, DecodeTestBinary(d))
+		}
+	}
+	node.Tests = s2
+	node.F = core.DecodeFileBinary(d)
+}
+
+func readFileBinary(d *runtime.BinaryDecoder, o interface{}) *File {
+	var node *File
+	if o != nil {
+		return o.(*File)
+	}
+	node = &File{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *File) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e853bf90952ce0e3")
+}
+
+func (node *File) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e853bf90952ce0e3", "dub/tree/File", node)
+}
+
+func DecodeFileBinary(d *runtime.BinaryDecoder) *File {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/File" {
+		return readFileBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Package) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 string
+	var x1 *File
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Package") {
+		return
+	}
+	if node.Path == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Path))
+		for _, x0 = range node.Path {
+			e.WriteString(x0)
+		}
+	}
+	if node.Files == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Files))
+		for _, x1 = range node.Files {
+			x1.EncodeBinary(e)
+		}
+	}
+	node.P.EncodeBinary(e)
+}
+
+func (node *Package) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []string
+	var n1 int
+	var s1 []*File
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []string{}
+		for range n0 {
+			s0 = append(s0, d.ReadString())
+		}
+	}
+	node.Path = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*File{}
+		for range n1 {
+			s1 = append(s1, DecodeFileBinary(d))
+		}
+	}
+	node.Files = s1
+	node.P = core.DecodePackageBinary(d)
+}
+
+func readPackageBinary(d *runtime.BinaryDecoder, o interface{}) *Package {
+	var node *Package
+	if o != nil {
+		return o.(*Package)
+	}
+	node = &Package{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Package) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "2fa0080db2d167bb")
+}
+
+func (node *Package) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "2fa0080db2d167bb", "dub/tree/Package", node)
+}
+
+func DecodePackageBinary(d *runtime.BinaryDecoder) *Package {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Package" {
+		return readPackageBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Program) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *Package
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "dub/tree/Program") {
+		return
+	}
+	node.Builtins.EncodeBinary(e)
+	if node.Packages == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Packages))
+		for _, x = range node.Packages {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *Program) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*Package
+	node.Builtins = core.DecodeBuiltinTypeIndexBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*Package{}
+		for range n {
+			s = append(s, DecodePackageBinary(d))
+		}
+	}
+	node.Packages = s
+}
+
+func readProgramBinary(d *runtime.BinaryDecoder, o interface{}) *Program {
+	var node *Program
+	if o != nil {
+		return o.(*Program)
+	}
+	node = &Program{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Program) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d205735a0a2e4ae6")
+}
+
+func (node *Program) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d205735a0a2e4ae6", "dub/tree/Program", node)
+}
+
+func DecodeProgramBinary(d *runtime.BinaryDecoder) *Program {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "dub/tree/Program" {
+		return readProgramBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
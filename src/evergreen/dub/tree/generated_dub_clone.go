@@ -0,0 +1,4173 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func cloneTextMatch(c *runtime.Cloner, node TextMatch) TextMatch {
+	switch node.(type) {
+	case *RuneRangeMatch:
+		return node.(*RuneRangeMatch).clone(c)
+	case *StringLiteralMatch:
+		return node.(*StringLiteralMatch).clone(c)
+	case *MatchSequence:
+		return node.(*MatchSequence).clone(c)
+	case *MatchChoice:
+		return node.(*MatchChoice).clone(c)
+	case *MatchRepeat:
+		return node.(*MatchRepeat).clone(c)
+	case *MatchLookahead:
+		return node.(*MatchLookahead).clone(c)
+	}
+	return node
+}
+
+func equalTextMatch(c *runtime.Comparer, a TextMatch, b TextMatch) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *RuneRangeMatch:
+		switch b.(type) {
+		case *RuneRangeMatch:
+			return a.(*RuneRangeMatch).equal(c, b.(*RuneRangeMatch))
+		}
+	case *StringLiteralMatch:
+		switch b.(type) {
+		case *StringLiteralMatch:
+			return a.(*StringLiteralMatch).equal(c, b.(*StringLiteralMatch))
+		}
+	case *MatchSequence:
+		switch b.(type) {
+		case *MatchSequence:
+			return a.(*MatchSequence).equal(c, b.(*MatchSequence))
+		}
+	case *MatchChoice:
+		switch b.(type) {
+		case *MatchChoice:
+			return a.(*MatchChoice).equal(c, b.(*MatchChoice))
+		}
+	case *MatchRepeat:
+		switch b.(type) {
+		case *MatchRepeat:
+			return a.(*MatchRepeat).equal(c, b.(*MatchRepeat))
+		}
+	case *MatchLookahead:
+		switch b.(type) {
+		case *MatchLookahead:
+			return a.(*MatchLookahead).equal(c, b.(*MatchLookahead))
+		}
+	}
+	return a == b
+}
+
+func (node *RuneFilter) Clone() *RuneFilter {
+	var c *runtime.Cloner
+	var clone *RuneFilter
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &RuneFilter{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneFilter) clone(c *runtime.Cloner) *RuneFilter {
+	var o interface{}
+	var clone *RuneFilter
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*RuneFilter)
+	}
+	clone = &RuneFilter{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneFilter) cloneFields(c *runtime.Cloner, clone *RuneFilter) {
+	clone.Min = node.Min
+	clone.Max = node.Max
+}
+
+func (node *RuneFilter) Equal(other *RuneFilter) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *RuneFilter) equal(c *runtime.Comparer, other *RuneFilter) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Min != other.Min {
+		return false
+	}
+	if node.Max != other.Max {
+		return false
+	}
+	return true
+}
+
+func (node *RuneRangeMatch) Clone() *RuneRangeMatch {
+	var c *runtime.Cloner
+	var clone *RuneRangeMatch
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &RuneRangeMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneRangeMatch) clone(c *runtime.Cloner) *RuneRangeMatch {
+	var o interface{}
+	var clone *RuneRangeMatch
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*RuneRangeMatch)
+	}
+	clone = &RuneRangeMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneRangeMatch) cloneFields(c *runtime.Cloner, clone *RuneRangeMatch) {
+	var s []*RuneFilter
+	var e *RuneFilter
+	clone.Invert = node.Invert
+	s = nil
+	if node.Filters != nil {
+		s = []*RuneFilter{}
+		for _, e = range node.Filters {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Filters = s
+}
+
+func (node *RuneRangeMatch) Equal(other *RuneRangeMatch) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *RuneRangeMatch) equal(c *runtime.Comparer, other *RuneRangeMatch) bool {
+	var i int
+	var e *RuneFilter
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Invert != other.Invert {
+		return false
+	}
+	if len(node.Filters) != len(other.Filters) || node.Filters == nil != (other.Filters == nil) {
+		return false
+	}
+	for i, e = range node.Filters {
+		if !e.equal(c, other.Filters[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *StringLiteralMatch) Clone() *StringLiteralMatch {
+	var c *runtime.Cloner
+	var clone *StringLiteralMatch
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StringLiteralMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteralMatch) clone(c *runtime.Cloner) *StringLiteralMatch {
+	var o interface{}
+	var clone *StringLiteralMatch
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StringLiteralMatch)
+	}
+	clone = &StringLiteralMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteralMatch) cloneFields(c *runtime.Cloner, clone *StringLiteralMatch) {
+	clone.Value = node.Value
+}
+
+func (node *StringLiteralMatch) Equal(other *StringLiteralMatch) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StringLiteralMatch) equal(c *runtime.Comparer, other *StringLiteralMatch) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *MatchSequence) Clone() *MatchSequence {
+	var c *runtime.Cloner
+	var clone *MatchSequence
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &MatchSequence{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchSequence) clone(c *runtime.Cloner) *MatchSequence {
+	var o interface{}
+	var clone *MatchSequence
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*MatchSequence)
+	}
+	clone = &MatchSequence{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchSequence) cloneFields(c *runtime.Cloner, clone *MatchSequence) {
+	var s []TextMatch
+	var e TextMatch
+	s = nil
+	if node.Matches != nil {
+		s = []TextMatch{}
+		for _, e = range node.Matches {
+			s = append(s, cloneTextMatch(c, e))
+		}
+	}
+	clone.Matches = s
+}
+
+func (node *MatchSequence) Equal(other *MatchSequence) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *MatchSequence) equal(c *runtime.Comparer, other *MatchSequence) bool {
+	var i int
+	var e TextMatch
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Matches) != len(other.Matches) || node.Matches == nil != (other.Matches == nil) {
+		return false
+	}
+	for i, e = range node.Matches {
+		if !equalTextMatch(c, e, other.Matches[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *MatchChoice) Clone() *MatchChoice {
+	var c *runtime.Cloner
+	var clone *MatchChoice
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &MatchChoice{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchChoice) clone(c *runtime.Cloner) *MatchChoice {
+	var o interface{}
+	var clone *MatchChoice
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*MatchChoice)
+	}
+	clone = &MatchChoice{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchChoice) cloneFields(c *runtime.Cloner, clone *MatchChoice) {
+	var s []TextMatch
+	var e TextMatch
+	s = nil
+	if node.Matches != nil {
+		s = []TextMatch{}
+		for _, e = range node.Matches {
+			s = append(s, cloneTextMatch(c, e))
+		}
+	}
+	clone.Matches = s
+}
+
+func (node *MatchChoice) Equal(other *MatchChoice) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *MatchChoice) equal(c *runtime.Comparer, other *MatchChoice) bool {
+	var i int
+	var e TextMatch
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Matches) != len(other.Matches) || node.Matches == nil != (other.Matches == nil) {
+		return false
+	}
+	for i, e = range node.Matches {
+		if !equalTextMatch(c, e, other.Matches[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *MatchRepeat) Clone() *MatchRepeat {
+	var c *runtime.Cloner
+	var clone *MatchRepeat
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &MatchRepeat{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchRepeat) clone(c *runtime.Cloner) *MatchRepeat {
+	var o interface{}
+	var clone *MatchRepeat
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*MatchRepeat)
+	}
+	clone = &MatchRepeat{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchRepeat) cloneFields(c *runtime.Cloner, clone *MatchRepeat) {
+	clone.Match = cloneTextMatch(c, node.Match)
+	clone.Min = node.Min
+}
+
+func (node *MatchRepeat) Equal(other *MatchRepeat) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *MatchRepeat) equal(c *runtime.Comparer, other *MatchRepeat) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTextMatch(c, node.Match, other.Match) {
+		return false
+	}
+	if node.Min != other.Min {
+		return false
+	}
+	return true
+}
+
+func (node *MatchLookahead) Clone() *MatchLookahead {
+	var c *runtime.Cloner
+	var clone *MatchLookahead
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &MatchLookahead{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchLookahead) clone(c *runtime.Cloner) *MatchLookahead {
+	var o interface{}
+	var clone *MatchLookahead
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*MatchLookahead)
+	}
+	clone = &MatchLookahead{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MatchLookahead) cloneFields(c *runtime.Cloner, clone *MatchLookahead) {
+	clone.Invert = node.Invert
+	clone.Match = cloneTextMatch(c, node.Match)
+}
+
+func (node *MatchLookahead) Equal(other *MatchLookahead) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *MatchLookahead) equal(c *runtime.Comparer, other *MatchLookahead) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Invert != other.Invert {
+		return false
+	}
+	if !equalTextMatch(c, node.Match, other.Match) {
+		return false
+	}
+	return true
+}
+
+func (node *Id) Clone() *Id {
+	var c *runtime.Cloner
+	var clone *Id
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Id{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Id) clone(c *runtime.Cloner) *Id {
+	var o interface{}
+	var clone *Id
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Id)
+	}
+	clone = &Id{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Id) cloneFields(c *runtime.Cloner, clone *Id) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *Id) Equal(other *Id) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Id) equal(c *runtime.Comparer, other *Id) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func cloneASTExpr(c *runtime.Cloner, node ASTExpr) ASTExpr {
+	switch node.(type) {
+	case *RuneLiteral:
+		return node.(*RuneLiteral).clone(c)
+	case *StringLiteral:
+		return node.(*StringLiteral).clone(c)
+	case *IntLiteral:
+		return node.(*IntLiteral).clone(c)
+	case *Float32Literal:
+		return node.(*Float32Literal).clone(c)
+	case *BoolLiteral:
+		return node.(*BoolLiteral).clone(c)
+	case *NilLiteral:
+		return node.(*NilLiteral).clone(c)
+	case *StringMatch:
+		return node.(*StringMatch).clone(c)
+	case *RuneMatch:
+		return node.(*RuneMatch).clone(c)
+	case *If:
+		return node.(*If).clone(c)
+	case *Repeat:
+		return node.(*Repeat).clone(c)
+	case *Choice:
+		return node.(*Choice).clone(c)
+	case *Optional:
+		return node.(*Optional).clone(c)
+	case *Assign:
+		return node.(*Assign).clone(c)
+	case *NameRef:
+		return node.(*NameRef).clone(c)
+	case *GetLocal:
+		return node.(*GetLocal).clone(c)
+	case *SetLocal:
+		return node.(*SetLocal).clone(c)
+	case *Discard:
+		return node.(*Discard).clone(c)
+	case *GetFunction:
+		return node.(*GetFunction).clone(c)
+	case *GetFunctionTemplate:
+		return node.(*GetFunctionTemplate).clone(c)
+	case *GetPackage:
+		return node.(*GetPackage).clone(c)
+	case *Construct:
+		return node.(*Construct).clone(c)
+	case *ConstructList:
+		return node.(*ConstructList).clone(c)
+	case *Coerce:
+		return node.(*Coerce).clone(c)
+	case *Call:
+		return node.(*Call).clone(c)
+	case *Selector:
+		return node.(*Selector).clone(c)
+	case *SpecializeTemplate:
+		return node.(*SpecializeTemplate).clone(c)
+	case *Fail:
+		return node.(*Fail).clone(c)
+	case *Return:
+		return node.(*Return).clone(c)
+	case *BinaryOp:
+		return node.(*BinaryOp).clone(c)
+	}
+	return node
+}
+
+func equalASTExpr(c *runtime.Comparer, a ASTExpr, b ASTExpr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *RuneLiteral:
+		switch b.(type) {
+		case *RuneLiteral:
+			return a.(*RuneLiteral).equal(c, b.(*RuneLiteral))
+		}
+	case *StringLiteral:
+		switch b.(type) {
+		case *StringLiteral:
+			return a.(*StringLiteral).equal(c, b.(*StringLiteral))
+		}
+	case *IntLiteral:
+		switch b.(type) {
+		case *IntLiteral:
+			return a.(*IntLiteral).equal(c, b.(*IntLiteral))
+		}
+	case *Float32Literal:
+		switch b.(type) {
+		case *Float32Literal:
+			return a.(*Float32Literal).equal(c, b.(*Float32Literal))
+		}
+	case *BoolLiteral:
+		switch b.(type) {
+		case *BoolLiteral:
+			return a.(*BoolLiteral).equal(c, b.(*BoolLiteral))
+		}
+	case *NilLiteral:
+		switch b.(type) {
+		case *NilLiteral:
+			return a.(*NilLiteral).equal(c, b.(*NilLiteral))
+		}
+	case *StringMatch:
+		switch b.(type) {
+		case *StringMatch:
+			return a.(*StringMatch).equal(c, b.(*StringMatch))
+		}
+	case *RuneMatch:
+		switch b.(type) {
+		case *RuneMatch:
+			return a.(*RuneMatch).equal(c, b.(*RuneMatch))
+		}
+	case *If:
+		switch b.(type) {
+		case *If:
+			return a.(*If).equal(c, b.(*If))
+		}
+	case *Repeat:
+		switch b.(type) {
+		case *Repeat:
+			return a.(*Repeat).equal(c, b.(*Repeat))
+		}
+	case *Choice:
+		switch b.(type) {
+		case *Choice:
+			return a.(*Choice).equal(c, b.(*Choice))
+		}
+	case *Optional:
+		switch b.(type) {
+		case *Optional:
+			return a.(*Optional).equal(c, b.(*Optional))
+		}
+	case *Assign:
+		switch b.(type) {
+		case *Assign:
+			return a.(*Assign).equal(c, b.(*Assign))
+		}
+	case *NameRef:
+		switch b.(type) {
+		case *NameRef:
+			return a.(*NameRef).equal(c, b.(*NameRef))
+		}
+	case *GetLocal:
+		switch b.(type) {
+		case *GetLocal:
+			return a.(*GetLocal).equal(c, b.(*GetLocal))
+		}
+	case *SetLocal:
+		switch b.(type) {
+		case *SetLocal:
+			return a.(*SetLocal).equal(c, b.(*SetLocal))
+		}
+	case *Discard:
+		switch b.(type) {
+		case *Discard:
+			return a.(*Discard).equal(c, b.(*Discard))
+		}
+	case *GetFunction:
+		switch b.(type) {
+		case *GetFunction:
+			return a.(*GetFunction).equal(c, b.(*GetFunction))
+		}
+	case *GetFunctionTemplate:
+		switch b.(type) {
+		case *GetFunctionTemplate:
+			return a.(*GetFunctionTemplate).equal(c, b.(*GetFunctionTemplate))
+		}
+	case *GetPackage:
+		switch b.(type) {
+		case *GetPackage:
+			return a.(*GetPackage).equal(c, b.(*GetPackage))
+		}
+	case *Construct:
+		switch b.(type) {
+		case *Construct:
+			return a.(*Construct).equal(c, b.(*Construct))
+		}
+	case *ConstructList:
+		switch b.(type) {
+		case *ConstructList:
+			return a.(*ConstructList).equal(c, b.(*ConstructList))
+		}
+	case *Coerce:
+		switch b.(type) {
+		case *Coerce:
+			return a.(*Coerce).equal(c, b.(*Coerce))
+		}
+	case *Call:
+		switch b.(type) {
+		case *Call:
+			return a.(*Call).equal(c, b.(*Call))
+		}
+	case *Selector:
+		switch b.(type) {
+		case *Selector:
+			return a.(*Selector).equal(c, b.(*Selector))
+		}
+	case *SpecializeTemplate:
+		switch b.(type) {
+		case *SpecializeTemplate:
+			return a.(*SpecializeTemplate).equal(c, b.(*SpecializeTemplate))
+		}
+	case *Fail:
+		switch b.(type) {
+		case *Fail:
+			return a.(*Fail).equal(c, b.(*Fail))
+		}
+	case *Return:
+		switch b.(type) {
+		case *Return:
+			return a.(*Return).equal(c, b.(*Return))
+		}
+	case *BinaryOp:
+		switch b.(type) {
+		case *BinaryOp:
+			return a.(*BinaryOp).equal(c, b.(*BinaryOp))
+		}
+	}
+	return a == b
+}
+
+func (node *RuneLiteral) Clone() *RuneLiteral {
+	var c *runtime.Cloner
+	var clone *RuneLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &RuneLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneLiteral) clone(c *runtime.Cloner) *RuneLiteral {
+	var o interface{}
+	var clone *RuneLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*RuneLiteral)
+	}
+	clone = &RuneLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneLiteral) cloneFields(c *runtime.Cloner, clone *RuneLiteral) {
+	clone.Text = node.Text
+	clone.Value = node.Value
+}
+
+func (node *RuneLiteral) Equal(other *RuneLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *RuneLiteral) equal(c *runtime.Comparer, other *RuneLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *StringLiteral) Clone() *StringLiteral {
+	var c *runtime.Cloner
+	var clone *StringLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StringLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteral) clone(c *runtime.Cloner) *StringLiteral {
+	var o interface{}
+	var clone *StringLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StringLiteral)
+	}
+	clone = &StringLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteral) cloneFields(c *runtime.Cloner, clone *StringLiteral) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+	clone.Value = node.Value
+}
+
+func (node *StringLiteral) Equal(other *StringLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StringLiteral) equal(c *runtime.Comparer, other *StringLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *IntLiteral) Clone() *IntLiteral {
+	var c *runtime.Cloner
+	var clone *IntLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &IntLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntLiteral) clone(c *runtime.Cloner) *IntLiteral {
+	var o interface{}
+	var clone *IntLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*IntLiteral)
+	}
+	clone = &IntLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntLiteral) cloneFields(c *runtime.Cloner, clone *IntLiteral) {
+	clone.Text = node.Text
+	clone.Value = node.Value
+}
+
+func (node *IntLiteral) Equal(other *IntLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *IntLiteral) equal(c *runtime.Comparer, other *IntLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *Float32Literal) Clone() *Float32Literal {
+	var c *runtime.Cloner
+	var clone *Float32Literal
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Float32Literal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Float32Literal) clone(c *runtime.Cloner) *Float32Literal {
+	var o interface{}
+	var clone *Float32Literal
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Float32Literal)
+	}
+	clone = &Float32Literal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Float32Literal) cloneFields(c *runtime.Cloner, clone *Float32Literal) {
+	clone.Text = node.Text
+	clone.Value = node.Value
+}
+
+func (node *Float32Literal) Equal(other *Float32Literal) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Float32Literal) equal(c *runtime.Comparer, other *Float32Literal) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *BoolLiteral) Clone() *BoolLiteral {
+	var c *runtime.Cloner
+	var clone *BoolLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BoolLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BoolLiteral) clone(c *runtime.Cloner) *BoolLiteral {
+	var o interface{}
+	var clone *BoolLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BoolLiteral)
+	}
+	clone = &BoolLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BoolLiteral) cloneFields(c *runtime.Cloner, clone *BoolLiteral) {
+	clone.Text = node.Text
+	clone.Value = node.Value
+}
+
+func (node *BoolLiteral) Equal(other *BoolLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BoolLiteral) equal(c *runtime.Comparer, other *BoolLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *NilLiteral) Clone() *NilLiteral {
+	var c *runtime.Cloner
+	var clone *NilLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NilLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NilLiteral) clone(c *runtime.Cloner) *NilLiteral {
+	var o interface{}
+	var clone *NilLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NilLiteral)
+	}
+	clone = &NilLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NilLiteral) cloneFields(c *runtime.Cloner, clone *NilLiteral) {
+}
+
+func (node *NilLiteral) Equal(other *NilLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NilLiteral) equal(c *runtime.Comparer, other *NilLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *StringMatch) Clone() *StringMatch {
+	var c *runtime.Cloner
+	var clone *StringMatch
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StringMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringMatch) clone(c *runtime.Cloner) *StringMatch {
+	var o interface{}
+	var clone *StringMatch
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StringMatch)
+	}
+	clone = &StringMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringMatch) cloneFields(c *runtime.Cloner, clone *StringMatch) {
+	clone.Match = cloneTextMatch(c, node.Match)
+}
+
+func (node *StringMatch) Equal(other *StringMatch) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StringMatch) equal(c *runtime.Comparer, other *StringMatch) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTextMatch(c, node.Match, other.Match) {
+		return false
+	}
+	return true
+}
+
+func (node *RuneMatch) Clone() *RuneMatch {
+	var c *runtime.Cloner
+	var clone *RuneMatch
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &RuneMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneMatch) clone(c *runtime.Cloner) *RuneMatch {
+	var o interface{}
+	var clone *RuneMatch
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*RuneMatch)
+	}
+	clone = &RuneMatch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneMatch) cloneFields(c *runtime.Cloner, clone *RuneMatch) {
+	clone.Match = node.Match.clone(c)
+}
+
+func (node *RuneMatch) Equal(other *RuneMatch) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *RuneMatch) equal(c *runtime.Comparer, other *RuneMatch) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Match.equal(c, other.Match) {
+		return false
+	}
+	return true
+}
+
+func cloneASTDecl(c *runtime.Cloner, node ASTDecl) ASTDecl {
+	switch node.(type) {
+	case *StructDecl:
+		return node.(*StructDecl).clone(c)
+	case *FuncDecl:
+		return node.(*FuncDecl).clone(c)
+	}
+	return node
+}
+
+func equalASTDecl(c *runtime.Comparer, a ASTDecl, b ASTDecl) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *StructDecl:
+		switch b.(type) {
+		case *StructDecl:
+			return a.(*StructDecl).equal(c, b.(*StructDecl))
+		}
+	case *FuncDecl:
+		switch b.(type) {
+		case *FuncDecl:
+			return a.(*FuncDecl).equal(c, b.(*FuncDecl))
+		}
+	}
+	return a == b
+}
+
+func cloneASTTypeRef(c *runtime.Cloner, node ASTTypeRef) ASTTypeRef {
+	switch node.(type) {
+	case *TypeRef:
+		return node.(*TypeRef).clone(c)
+	case *ListTypeRef:
+		return node.(*ListTypeRef).clone(c)
+	case *QualifiedTypeRef:
+		return node.(*QualifiedTypeRef).clone(c)
+	case *GetType:
+		return node.(*GetType).clone(c)
+	}
+	return node
+}
+
+func equalASTTypeRef(c *runtime.Comparer, a ASTTypeRef, b ASTTypeRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *TypeRef:
+		switch b.(type) {
+		case *TypeRef:
+			return a.(*TypeRef).equal(c, b.(*TypeRef))
+		}
+	case *ListTypeRef:
+		switch b.(type) {
+		case *ListTypeRef:
+			return a.(*ListTypeRef).equal(c, b.(*ListTypeRef))
+		}
+	case *QualifiedTypeRef:
+		switch b.(type) {
+		case *QualifiedTypeRef:
+			return a.(*QualifiedTypeRef).equal(c, b.(*QualifiedTypeRef))
+		}
+	case *GetType:
+		switch b.(type) {
+		case *GetType:
+			return a.(*GetType).equal(c, b.(*GetType))
+		}
+	}
+	return a == b
+}
+
+func (node *TypeRef) Clone() *TypeRef {
+	var c *runtime.Cloner
+	var clone *TypeRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeRef) clone(c *runtime.Cloner) *TypeRef {
+	var o interface{}
+	var clone *TypeRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeRef)
+	}
+	clone = &TypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeRef) cloneFields(c *runtime.Cloner, clone *TypeRef) {
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *TypeRef) Equal(other *TypeRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeRef) equal(c *runtime.Comparer, other *TypeRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *ListTypeRef) Clone() *ListTypeRef {
+	var c *runtime.Cloner
+	var clone *ListTypeRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ListTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListTypeRef) clone(c *runtime.Cloner) *ListTypeRef {
+	var o interface{}
+	var clone *ListTypeRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ListTypeRef)
+	}
+	clone = &ListTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListTypeRef) cloneFields(c *runtime.Cloner, clone *ListTypeRef) {
+	clone.Type = cloneASTTypeRef(c, node.Type)
+}
+
+func (node *ListTypeRef) Equal(other *ListTypeRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ListTypeRef) equal(c *runtime.Comparer, other *ListTypeRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *QualifiedTypeRef) Clone() *QualifiedTypeRef {
+	var c *runtime.Cloner
+	var clone *QualifiedTypeRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &QualifiedTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *QualifiedTypeRef) clone(c *runtime.Cloner) *QualifiedTypeRef {
+	var o interface{}
+	var clone *QualifiedTypeRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*QualifiedTypeRef)
+	}
+	clone = &QualifiedTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *QualifiedTypeRef) cloneFields(c *runtime.Cloner, clone *QualifiedTypeRef) {
+	clone.Package = node.Package.clone(c)
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *QualifiedTypeRef) Equal(other *QualifiedTypeRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *QualifiedTypeRef) equal(c *runtime.Comparer, other *QualifiedTypeRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Package.equal(c, other.Package) {
+		return false
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *GetType) Clone() *GetType {
+	var c *runtime.Cloner
+	var clone *GetType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetType) clone(c *runtime.Cloner) *GetType {
+	var o interface{}
+	var clone *GetType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetType)
+	}
+	clone = &GetType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetType) cloneFields(c *runtime.Cloner, clone *GetType) {
+	clone.Type = node.Type
+}
+
+func (node *GetType) Equal(other *GetType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetType) equal(c *runtime.Comparer, other *GetType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Type != other.Type {
+		return false
+	}
+	return true
+}
+
+func cloneDestructure(c *runtime.Cloner, node Destructure) Destructure {
+	switch node.(type) {
+	case *DestructureValue:
+		return node.(*DestructureValue).clone(c)
+	case *DestructureStruct:
+		return node.(*DestructureStruct).clone(c)
+	case *DestructureList:
+		return node.(*DestructureList).clone(c)
+	}
+	return node
+}
+
+func equalDestructure(c *runtime.Comparer, a Destructure, b Destructure) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *DestructureValue:
+		switch b.(type) {
+		case *DestructureValue:
+			return a.(*DestructureValue).equal(c, b.(*DestructureValue))
+		}
+	case *DestructureStruct:
+		switch b.(type) {
+		case *DestructureStruct:
+			return a.(*DestructureStruct).equal(c, b.(*DestructureStruct))
+		}
+	case *DestructureList:
+		switch b.(type) {
+		case *DestructureList:
+			return a.(*DestructureList).equal(c, b.(*DestructureList))
+		}
+	}
+	return a == b
+}
+
+func (node *DestructureValue) Clone() *DestructureValue {
+	var c *runtime.Cloner
+	var clone *DestructureValue
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &DestructureValue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureValue) clone(c *runtime.Cloner) *DestructureValue {
+	var o interface{}
+	var clone *DestructureValue
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*DestructureValue)
+	}
+	clone = &DestructureValue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureValue) cloneFields(c *runtime.Cloner, clone *DestructureValue) {
+	clone.Expr = cloneASTExpr(c, node.Expr)
+}
+
+func (node *DestructureValue) Equal(other *DestructureValue) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *DestructureValue) equal(c *runtime.Comparer, other *DestructureValue) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	return true
+}
+
+func (node *DestructureField) Clone() *DestructureField {
+	var c *runtime.Cloner
+	var clone *DestructureField
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &DestructureField{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureField) clone(c *runtime.Cloner) *DestructureField {
+	var o interface{}
+	var clone *DestructureField
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*DestructureField)
+	}
+	clone = &DestructureField{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureField) cloneFields(c *runtime.Cloner, clone *DestructureField) {
+	clone.Name = node.Name.clone(c)
+	clone.Destructure = cloneDestructure(c, node.Destructure)
+}
+
+func (node *DestructureField) Equal(other *DestructureField) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *DestructureField) equal(c *runtime.Comparer, other *DestructureField) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalDestructure(c, node.Destructure, other.Destructure) {
+		return false
+	}
+	return true
+}
+
+func (node *DestructureStruct) Clone() *DestructureStruct {
+	var c *runtime.Cloner
+	var clone *DestructureStruct
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &DestructureStruct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureStruct) clone(c *runtime.Cloner) *DestructureStruct {
+	var o interface{}
+	var clone *DestructureStruct
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*DestructureStruct)
+	}
+	clone = &DestructureStruct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureStruct) cloneFields(c *runtime.Cloner, clone *DestructureStruct) {
+	var s []*DestructureField
+	var e *DestructureField
+	clone.Type = cloneASTTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []*DestructureField{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *DestructureStruct) Equal(other *DestructureStruct) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *DestructureStruct) equal(c *runtime.Comparer, other *DestructureStruct) bool {
+	var i int
+	var e *DestructureField
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equal(c, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *DestructureList) Clone() *DestructureList {
+	var c *runtime.Cloner
+	var clone *DestructureList
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &DestructureList{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureList) clone(c *runtime.Cloner) *DestructureList {
+	var o interface{}
+	var clone *DestructureList
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*DestructureList)
+	}
+	clone = &DestructureList{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *DestructureList) cloneFields(c *runtime.Cloner, clone *DestructureList) {
+	var s []Destructure
+	var e Destructure
+	clone.Type = cloneASTTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []Destructure{}
+		for _, e = range node.Args {
+			s = append(s, cloneDestructure(c, e))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *DestructureList) Equal(other *DestructureList) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *DestructureList) equal(c *runtime.Comparer, other *DestructureList) bool {
+	var i int
+	var e Destructure
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalDestructure(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *If) Clone() *If {
+	var c *runtime.Cloner
+	var clone *If
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &If{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *If) clone(c *runtime.Cloner) *If {
+	var o interface{}
+	var clone *If
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*If)
+	}
+	clone = &If{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *If) cloneFields(c *runtime.Cloner, clone *If) {
+	var s0 []ASTExpr
+	var e0 ASTExpr
+	var s1 []ASTExpr
+	var e1 ASTExpr
+	clone.Expr = cloneASTExpr(c, node.Expr)
+	s0 = nil
+	if node.Block != nil {
+		s0 = []ASTExpr{}
+		for _, e0 = range node.Block {
+			s0 = append(s0, cloneASTExpr(c, e0))
+		}
+	}
+	clone.Block = s0
+	s1 = nil
+	if node.Else != nil {
+		s1 = []ASTExpr{}
+		for _, e1 = range node.Else {
+			s1 = append(s1, cloneASTExpr(c, e1))
+		}
+	}
+	clone.Else = s1
+}
+
+func (node *If) Equal(other *If) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *If) equal(c *runtime.Comparer, other *If) bool {
+	var i0 int
+	var e0 ASTExpr
+	var i1 int
+	var e1 ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if len(node.Block) != len(other.Block) || node.Block == nil != (other.Block == nil) {
+		return false
+	}
+	for i0, e0 = range node.Block {
+		if !equalASTExpr(c, e0, other.Block[i0]) {
+			return false
+		}
+	}
+	if len(node.Else) != len(other.Else) || node.Else == nil != (other.Else == nil) {
+		return false
+	}
+	for i1, e1 = range node.Else {
+		if !equalASTExpr(c, e1, other.Else[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Repeat) Clone() *Repeat {
+	var c *runtime.Cloner
+	var clone *Repeat
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Repeat{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Repeat) clone(c *runtime.Cloner) *Repeat {
+	var o interface{}
+	var clone *Repeat
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Repeat)
+	}
+	clone = &Repeat{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Repeat) cloneFields(c *runtime.Cloner, clone *Repeat) {
+	var s []ASTExpr
+	var e ASTExpr
+	s = nil
+	if node.Block != nil {
+		s = []ASTExpr{}
+		for _, e = range node.Block {
+			s = append(s, cloneASTExpr(c, e))
+		}
+	}
+	clone.Block = s
+	clone.Min = node.Min
+}
+
+func (node *Repeat) Equal(other *Repeat) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Repeat) equal(c *runtime.Comparer, other *Repeat) bool {
+	var i int
+	var e ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Block) != len(other.Block) || node.Block == nil != (other.Block == nil) {
+		return false
+	}
+	for i, e = range node.Block {
+		if !equalASTExpr(c, e, other.Block[i]) {
+			return false
+		}
+	}
+	if node.Min != other.Min {
+		return false
+	}
+	return true
+}
+
+func (node *Choice) Clone() *Choice {
+	var c *runtime.Cloner
+	var clone *Choice
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Choice{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Choice) clone(c *runtime.Cloner) *Choice {
+	var o interface{}
+	var clone *Choice
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Choice)
+	}
+	clone = &Choice{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Choice) cloneFields(c *runtime.Cloner, clone *Choice) {
+	var s0 [][]ASTExpr
+	var e0 []ASTExpr
+	var s1 []ASTExpr
+	var e1 ASTExpr
+	s0 = nil
+	if node.Blocks != nil {
+		s0 = [][]ASTExpr{}
+		for _, e0 = range node.Blocks {
+			s1 = nil
+			if e0 != nil {
+				s1 = []ASTExpr{}
+				for _, e1 = range e0 {
+					s1 = append(s1, cloneASTExpr(c, e1))
+				}
+			}
+			s0 = append(s0, s1)
+		}
+	}
+	clone.Blocks = s0
+}
+
+func (node *Choice) Equal(other *Choice) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Choice) equal(c *runtime.Comparer, other *Choice) bool {
+	var i0 int
+	var e0 []ASTExpr
+	var i1 int
+	var e1 ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Blocks) != len(other.Blocks) || node.Blocks == nil != (other.Blocks == nil) {
+		return false
+	}
+	for i0, e0 = range node.Blocks {
+		if len(e0) != len(other.Blocks[i0]) || e0 == nil != (other.Blocks[i0] == nil) {
+			return false
+		}
+		for i1, e1 = range e0 {
+			if !equalASTExpr(c, e1, other.Blocks[i0][i1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (node *Optional) Clone() *Optional {
+	var c *runtime.Cloner
+	var clone *Optional
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Optional{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Optional) clone(c *runtime.Cloner) *Optional {
+	var o interface{}
+	var clone *Optional
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Optional)
+	}
+	clone = &Optional{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Optional) cloneFields(c *runtime.Cloner, clone *Optional) {
+	var s []ASTExpr
+	var e ASTExpr
+	s = nil
+	if node.Block != nil {
+		s = []ASTExpr{}
+		for _, e = range node.Block {
+			s = append(s, cloneASTExpr(c, e))
+		}
+	}
+	clone.Block = s
+}
+
+func (node *Optional) Equal(other *Optional) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Optional) equal(c *runtime.Comparer, other *Optional) bool {
+	var i int
+	var e ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Block) != len(other.Block) || node.Block == nil != (other.Block == nil) {
+		return false
+	}
+	for i, e = range node.Block {
+		if !equalASTExpr(c, e, other.Block[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Assign) Clone() *Assign {
+	var c *runtime.Cloner
+	var clone *Assign
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Assign{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Assign) clone(c *runtime.Cloner) *Assign {
+	var o interface{}
+	var clone *Assign
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Assign)
+	}
+	clone = &Assign{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Assign) cloneFields(c *runtime.Cloner, clone *Assign) {
+	var s []ASTExpr
+	var e ASTExpr
+	clone.Expr = cloneASTExpr(c, node.Expr)
+	clone.Pos = node.Pos
+	s = nil
+	if node.Targets != nil {
+		s = []ASTExpr{}
+		for _, e = range node.Targets {
+			s = append(s, cloneASTExpr(c, e))
+		}
+	}
+	clone.Targets = s
+	clone.Type = cloneASTTypeRef(c, node.Type)
+	clone.Define = node.Define
+}
+
+func (node *Assign) Equal(other *Assign) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Assign) equal(c *runtime.Comparer, other *Assign) bool {
+	var i int
+	var e ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if len(node.Targets) != len(other.Targets) || node.Targets == nil != (other.Targets == nil) {
+		return false
+	}
+	for i, e = range node.Targets {
+		if !equalASTExpr(c, e, other.Targets[i]) {
+			return false
+		}
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if node.Define != other.Define {
+		return false
+	}
+	return true
+}
+
+func (node *NameRef) Clone() *NameRef {
+	var c *runtime.Cloner
+	var clone *NameRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NameRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NameRef) clone(c *runtime.Cloner) *NameRef {
+	var o interface{}
+	var clone *NameRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NameRef)
+	}
+	clone = &NameRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NameRef) cloneFields(c *runtime.Cloner, clone *NameRef) {
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *NameRef) Equal(other *NameRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NameRef) equal(c *runtime.Comparer, other *NameRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *GetLocal) Clone() *GetLocal {
+	var c *runtime.Cloner
+	var clone *GetLocal
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetLocal) clone(c *runtime.Cloner) *GetLocal {
+	var o interface{}
+	var clone *GetLocal
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetLocal)
+	}
+	clone = &GetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetLocal) cloneFields(c *runtime.Cloner, clone *GetLocal) {
+	clone.Info = node.Info.clone(c)
+}
+
+func (node *GetLocal) Equal(other *GetLocal) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetLocal) equal(c *runtime.Comparer, other *GetLocal) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Info.equalRef(other.Info) {
+		return false
+	}
+	return true
+}
+
+func (node *SetLocal) Clone() *SetLocal {
+	var c *runtime.Cloner
+	var clone *SetLocal
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetLocal) clone(c *runtime.Cloner) *SetLocal {
+	var o interface{}
+	var clone *SetLocal
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SetLocal)
+	}
+	clone = &SetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetLocal) cloneFields(c *runtime.Cloner, clone *SetLocal) {
+	clone.Info = node.Info.clone(c)
+}
+
+func (node *SetLocal) Equal(other *SetLocal) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SetLocal) equal(c *runtime.Comparer, other *SetLocal) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Info.equalRef(other.Info) {
+		return false
+	}
+	return true
+}
+
+func (node *Discard) Clone() *Discard {
+	var c *runtime.Cloner
+	var clone *Discard
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Discard{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Discard) clone(c *runtime.Cloner) *Discard {
+	var o interface{}
+	var clone *Discard
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Discard)
+	}
+	clone = &Discard{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Discard) cloneFields(c *runtime.Cloner, clone *Discard) {
+}
+
+func (node *Discard) Equal(other *Discard) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Discard) equal(c *runtime.Comparer, other *Discard) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *GetFunction) Clone() *GetFunction {
+	var c *runtime.Cloner
+	var clone *GetFunction
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetFunction) clone(c *runtime.Cloner) *GetFunction {
+	var o interface{}
+	var clone *GetFunction
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetFunction)
+	}
+	clone = &GetFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetFunction) cloneFields(c *runtime.Cloner, clone *GetFunction) {
+	clone.Func = node.Func
+}
+
+func (node *GetFunction) Equal(other *GetFunction) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetFunction) equal(c *runtime.Comparer, other *GetFunction) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Func != other.Func {
+		return false
+	}
+	return true
+}
+
+func (node *GetFunctionTemplate) Clone() *GetFunctionTemplate {
+	var c *runtime.Cloner
+	var clone *GetFunctionTemplate
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetFunctionTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetFunctionTemplate) clone(c *runtime.Cloner) *GetFunctionTemplate {
+	var o interface{}
+	var clone *GetFunctionTemplate
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetFunctionTemplate)
+	}
+	clone = &GetFunctionTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetFunctionTemplate) cloneFields(c *runtime.Cloner, clone *GetFunctionTemplate) {
+	clone.Template = node.Template
+}
+
+func (node *GetFunctionTemplate) Equal(other *GetFunctionTemplate) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetFunctionTemplate) equal(c *runtime.Comparer, other *GetFunctionTemplate) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Template != other.Template {
+		return false
+	}
+	return true
+}
+
+func (node *GetPackage) Clone() *GetPackage {
+	var c *runtime.Cloner
+	var clone *GetPackage
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetPackage{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetPackage) clone(c *runtime.Cloner) *GetPackage {
+	var o interface{}
+	var clone *GetPackage
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetPackage)
+	}
+	clone = &GetPackage{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetPackage) cloneFields(c *runtime.Cloner, clone *GetPackage) {
+	clone.Package = node.Package
+}
+
+func (node *GetPackage) Equal(other *GetPackage) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetPackage) equal(c *runtime.Comparer, other *GetPackage) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Package != other.Package {
+		return false
+	}
+	return true
+}
+
+func (node *NamedExpr) Clone() *NamedExpr {
+	var c *runtime.Cloner
+	var clone *NamedExpr
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NamedExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedExpr) clone(c *runtime.Cloner) *NamedExpr {
+	var o interface{}
+	var clone *NamedExpr
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NamedExpr)
+	}
+	clone = &NamedExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedExpr) cloneFields(c *runtime.Cloner, clone *NamedExpr) {
+	clone.Name = node.Name.clone(c)
+	clone.Expr = cloneASTExpr(c, node.Expr)
+}
+
+func (node *NamedExpr) Equal(other *NamedExpr) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NamedExpr) equal(c *runtime.Comparer, other *NamedExpr) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	return true
+}
+
+func (node *Construct) Clone() *Construct {
+	var c *runtime.Cloner
+	var clone *Construct
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Construct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Construct) clone(c *runtime.Cloner) *Construct {
+	var o interface{}
+	var clone *Construct
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Construct)
+	}
+	clone = &Construct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Construct) cloneFields(c *runtime.Cloner, clone *Construct) {
+	var s []*NamedExpr
+	var e *NamedExpr
+	clone.Type = cloneASTTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []*NamedExpr{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *Construct) Equal(other *Construct) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Construct) equal(c *runtime.Comparer, other *Construct) bool {
+	var i int
+	var e *NamedExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equal(c, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *ConstructList) Clone() *ConstructList {
+	var c *runtime.Cloner
+	var clone *ConstructList
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstructList{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructList) clone(c *runtime.Cloner) *ConstructList {
+	var o interface{}
+	var clone *ConstructList
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstructList)
+	}
+	clone = &ConstructList{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructList) cloneFields(c *runtime.Cloner, clone *ConstructList) {
+	var s []ASTExpr
+	var e ASTExpr
+	clone.Type = cloneASTTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []ASTExpr{}
+		for _, e = range node.Args {
+			s = append(s, cloneASTExpr(c, e))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *ConstructList) Equal(other *ConstructList) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstructList) equal(c *runtime.Comparer, other *ConstructList) bool {
+	var i int
+	var e ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalASTExpr(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Coerce) Clone() *Coerce {
+	var c *runtime.Cloner
+	var clone *Coerce
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Coerce{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Coerce) clone(c *runtime.Cloner) *Coerce {
+	var o interface{}
+	var clone *Coerce
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Coerce)
+	}
+	clone = &Coerce{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Coerce) cloneFields(c *runtime.Cloner, clone *Coerce) {
+	clone.Type = cloneASTTypeRef(c, node.Type)
+	clone.Expr = cloneASTExpr(c, node.Expr)
+}
+
+func (node *Coerce) Equal(other *Coerce) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Coerce) equal(c *runtime.Comparer, other *Coerce) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	return true
+}
+
+func (node *Call) Clone() *Call {
+	var c *runtime.Cloner
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) clone(c *runtime.Cloner) *Call {
+	var o interface{}
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Call)
+	}
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) cloneFields(c *runtime.Cloner, clone *Call) {
+	var s []ASTExpr
+	var e ASTExpr
+	clone.Expr = cloneASTExpr(c, node.Expr)
+	clone.Pos = node.Pos
+	s = nil
+	if node.Args != nil {
+		s = []ASTExpr{}
+		for _, e = range node.Args {
+			s = append(s, cloneASTExpr(c, e))
+		}
+	}
+	clone.Args = s
+	clone.Target = node.Target
+	clone.T = node.T
+}
+
+func (node *Call) Equal(other *Call) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Call) equal(c *runtime.Comparer, other *Call) bool {
+	var i int
+	var e ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalASTExpr(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	if node.Target != other.Target {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *Selector) Clone() *Selector {
+	var c *runtime.Cloner
+	var clone *Selector
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Selector{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Selector) clone(c *runtime.Cloner) *Selector {
+	var o interface{}
+	var clone *Selector
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Selector)
+	}
+	clone = &Selector{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Selector) cloneFields(c *runtime.Cloner, clone *Selector) {
+	clone.Expr = cloneASTExpr(c, node.Expr)
+	clone.Pos = node.Pos
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *Selector) Equal(other *Selector) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Selector) equal(c *runtime.Comparer, other *Selector) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *SpecializeTemplate) Clone() *SpecializeTemplate {
+	var c *runtime.Cloner
+	var clone *SpecializeTemplate
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SpecializeTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SpecializeTemplate) clone(c *runtime.Cloner) *SpecializeTemplate {
+	var o interface{}
+	var clone *SpecializeTemplate
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SpecializeTemplate)
+	}
+	clone = &SpecializeTemplate{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SpecializeTemplate) cloneFields(c *runtime.Cloner, clone *SpecializeTemplate) {
+	var s []ASTTypeRef
+	var e ASTTypeRef
+	clone.Expr = cloneASTExpr(c, node.Expr)
+	clone.Pos = node.Pos
+	s = nil
+	if node.Types != nil {
+		s = []ASTTypeRef{}
+		for _, e = range node.Types {
+			s = append(s, cloneASTTypeRef(c, e))
+		}
+	}
+	clone.Types = s
+}
+
+func (node *SpecializeTemplate) Equal(other *SpecializeTemplate) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SpecializeTemplate) equal(c *runtime.Comparer, other *SpecializeTemplate) bool {
+	var i int
+	var e ASTTypeRef
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if len(node.Types) != len(other.Types) || node.Types == nil != (other.Types == nil) {
+		return false
+	}
+	for i, e = range node.Types {
+		if !equalASTTypeRef(c, e, other.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Fail) Clone() *Fail {
+	var c *runtime.Cloner
+	var clone *Fail
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Fail{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Fail) clone(c *runtime.Cloner) *Fail {
+	var o interface{}
+	var clone *Fail
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Fail)
+	}
+	clone = &Fail{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Fail) cloneFields(c *runtime.Cloner, clone *Fail) {
+}
+
+func (node *Fail) Equal(other *Fail) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Fail) equal(c *runtime.Comparer, other *Fail) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *Return) Clone() *Return {
+	var c *runtime.Cloner
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) clone(c *runtime.Cloner) *Return {
+	var o interface{}
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Return)
+	}
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) cloneFields(c *runtime.Cloner, clone *Return) {
+	var s []ASTExpr
+	var e ASTExpr
+	clone.Pos = node.Pos
+	s = nil
+	if node.Exprs != nil {
+		s = []ASTExpr{}
+		for _, e = range node.Exprs {
+			s = append(s, cloneASTExpr(c, e))
+		}
+	}
+	clone.Exprs = s
+}
+
+func (node *Return) Equal(other *Return) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Return) equal(c *runtime.Comparer, other *Return) bool {
+	var i int
+	var e ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if len(node.Exprs) != len(other.Exprs) || node.Exprs == nil != (other.Exprs == nil) {
+		return false
+	}
+	for i, e = range node.Exprs {
+		if !equalASTExpr(c, e, other.Exprs[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *BinaryOp) Clone() *BinaryOp {
+	var c *runtime.Cloner
+	var clone *BinaryOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BinaryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryOp) clone(c *runtime.Cloner) *BinaryOp {
+	var o interface{}
+	var clone *BinaryOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BinaryOp)
+	}
+	clone = &BinaryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryOp) cloneFields(c *runtime.Cloner, clone *BinaryOp) {
+	clone.Left = cloneASTExpr(c, node.Left)
+	clone.Op = node.Op
+	clone.OpPos = node.OpPos
+	clone.Right = cloneASTExpr(c, node.Right)
+	clone.T = node.T
+}
+
+func (node *BinaryOp) Equal(other *BinaryOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BinaryOp) equal(c *runtime.Comparer, other *BinaryOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalASTExpr(c, node.Left, other.Left) {
+		return false
+	}
+	if node.Op != other.Op {
+		return false
+	}
+	if node.OpPos != other.OpPos {
+		return false
+	}
+	if !equalASTExpr(c, node.Right, other.Right) {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *TemplateParam) Clone() *TemplateParam {
+	var c *runtime.Cloner
+	var clone *TemplateParam
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TemplateParam{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TemplateParam) clone(c *runtime.Cloner) *TemplateParam {
+	var o interface{}
+	var clone *TemplateParam
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TemplateParam)
+	}
+	clone = &TemplateParam{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TemplateParam) cloneFields(c *runtime.Cloner, clone *TemplateParam) {
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *TemplateParam) Equal(other *TemplateParam) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TemplateParam) equal(c *runtime.Comparer, other *TemplateParam) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *FieldDecl) Clone() *FieldDecl {
+	var c *runtime.Cloner
+	var clone *FieldDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FieldDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldDecl) clone(c *runtime.Cloner) *FieldDecl {
+	var o interface{}
+	var clone *FieldDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FieldDecl)
+	}
+	clone = &FieldDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldDecl) cloneFields(c *runtime.Cloner, clone *FieldDecl) {
+	clone.Name = node.Name.clone(c)
+	clone.Type = cloneASTTypeRef(c, node.Type)
+}
+
+func (node *FieldDecl) Equal(other *FieldDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FieldDecl) equal(c *runtime.Comparer, other *FieldDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *StructDecl) Clone() *StructDecl {
+	var c *runtime.Cloner
+	var clone *StructDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StructDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructDecl) clone(c *runtime.Cloner) *StructDecl {
+	var o interface{}
+	var clone *StructDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StructDecl)
+	}
+	clone = &StructDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructDecl) cloneFields(c *runtime.Cloner, clone *StructDecl) {
+	var s0 []*FieldDecl
+	var e0 *FieldDecl
+	var s1 []ASTTypeRef
+	var e1 ASTTypeRef
+	clone.Name = node.Name.clone(c)
+	clone.Export = node.Export
+	clone.Implements = cloneASTTypeRef(c, node.Implements)
+	s0 = nil
+	if node.Fields != nil {
+		s0 = []*FieldDecl{}
+		for _, e0 = range node.Fields {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Fields = s0
+	clone.Scoped = node.Scoped
+	s1 = nil
+	if node.Contains != nil {
+		s1 = []ASTTypeRef{}
+		for _, e1 = range node.Contains {
+			s1 = append(s1, cloneASTTypeRef(c, e1))
+		}
+	}
+	clone.Contains = s1
+	clone.T = node.T
+}
+
+func (node *StructDecl) Equal(other *StructDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StructDecl) equal(c *runtime.Comparer, other *StructDecl) bool {
+	var i0 int
+	var e0 *FieldDecl
+	var i1 int
+	var e1 ASTTypeRef
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if node.Export != other.Export {
+		return false
+	}
+	if !equalASTTypeRef(c, node.Implements, other.Implements) {
+		return false
+	}
+	if len(node.Fields) != len(other.Fields) || node.Fields == nil != (other.Fields == nil) {
+		return false
+	}
+	for i0, e0 = range node.Fields {
+		if !e0.equal(c, other.Fields[i0]) {
+			return false
+		}
+	}
+	if node.Scoped != other.Scoped {
+		return false
+	}
+	if len(node.Contains) != len(other.Contains) || node.Contains == nil != (other.Contains == nil) {
+		return false
+	}
+	for i1, e1 = range node.Contains {
+		if !equalASTTypeRef(c, e1, other.Contains[i1]) {
+			return false
+		}
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *LocalInfo) Clone() *LocalInfo {
+	var c *runtime.Cloner
+	var clone *LocalInfo
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &LocalInfo{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LocalInfo) clone(c *runtime.Cloner) *LocalInfo {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*LocalInfo)
+	}
+	return node
+}
+
+func (node *LocalInfo) cloneFields(c *runtime.Cloner, clone *LocalInfo) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.T = node.T
+}
+
+func (node *LocalInfo) Equal(other *LocalInfo) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *LocalInfo) equal(c *runtime.Comparer, other *LocalInfo) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *LocalInfo) equalRef(other *LocalInfo) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *Param) Clone() *Param {
+	var c *runtime.Cloner
+	var clone *Param
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Param{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Param) clone(c *runtime.Cloner) *Param {
+	var o interface{}
+	var clone *Param
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Param)
+	}
+	clone = &Param{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Param) cloneFields(c *runtime.Cloner, clone *Param) {
+	clone.Name = node.Name.clone(c)
+	clone.Type = cloneASTTypeRef(c, node.Type)
+	clone.Info = node.Info.clone(c)
+}
+
+func (node *Param) Equal(other *Param) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Param) equal(c *runtime.Comparer, other *Param) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalASTTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if !node.Info.equalRef(other.Info) {
+		return false
+	}
+	return true
+}
+
+func (node *FuncDecl) Clone() *FuncDecl {
+	var c *runtime.Cloner
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) clone(c *runtime.Cloner) *FuncDecl {
+	var o interface{}
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) cloneFields(c *runtime.Cloner, clone *FuncDecl) {
+	var o *LocalInfo
+	var x *LocalInfo
+	var i int
+	var s0 []*TemplateParam
+	var e0 *TemplateParam
+	var s1 []*Param
+	var e1 *Param
+	var s2 []ASTTypeRef
+	var e2 ASTTypeRef
+	var s3 []ASTExpr
+	var e3 ASTExpr
+	if node.LocalInfo_Scope != nil {
+		clone.LocalInfo_Scope = &LocalInfo_Scope{}
+		for _, o = range node.LocalInfo_Scope.objects {
+			x = &LocalInfo{}
+			c.Map(o, x)
+			clone.LocalInfo_Scope.objects = append(clone.LocalInfo_Scope.objects, x)
+		}
+		for i, o = range node.LocalInfo_Scope.objects {
+			o.cloneFields(c, clone.LocalInfo_Scope.objects[i])
+		}
+	}
+	clone.Name = node.Name.clone(c)
+	clone.Export = node.Export
+	clone.Inline = node.Inline
+	s0 = nil
+	if node.TemplateParams != nil {
+		s0 = []*TemplateParam{}
+		for _, e0 = range node.TemplateParams {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.TemplateParams = s0
+	s1 = nil
+	if node.Params != nil {
+		s1 = []*Param{}
+		for _, e1 = range node.Params {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Params = s1
+	s2 = nil
+	if node.ReturnTypes != nil {
+		s2 = []ASTTypeRef{}
+		for _, e2 = range node.ReturnTypes {
+			s2 = append(s2, cloneASTTypeRef(c, e2))
+		}
+	}
+	clone.ReturnTypes = s2
+	s3 = nil
+	if node.Block != nil {
+		s3 = []ASTExpr{}
+		for _, e3 = range node.Block {
+			s3 = append(s3, cloneASTExpr(c, e3))
+		}
+	}
+	clone.Block = s3
+	clone.F = node.F
+}
+
+func (node *FuncDecl) Equal(other *FuncDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FuncDecl) equal(c *runtime.Comparer, other *FuncDecl) bool {
+	var i0 int
+	var o *LocalInfo
+	var i1 int
+	var e0 *TemplateParam
+	var i2 int
+	var e1 *Param
+	var i3 int
+	var e2 ASTTypeRef
+	var i4 int
+	var e3 ASTExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.LocalInfo_Scope == nil != (other.LocalInfo_Scope == nil) {
+		return false
+	}
+	if node.LocalInfo_Scope != nil {
+		if len(node.LocalInfo_Scope.objects) != len(other.LocalInfo_Scope.objects) {
+			return false
+		}
+		for i0, o = range node.LocalInfo_Scope.objects {
+			if !o.equal(c, other.LocalInfo_Scope.objects[i0]) {
+				return false
+			}
+		}
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if node.Export != other.Export {
+		return false
+	}
+	if node.Inline != other.Inline {
+		return false
+	}
+	if len(node.TemplateParams) != len(other.TemplateParams) || node.TemplateParams == nil != (other.TemplateParams == nil) {
+		return false
+	}
+	for i1, e0 = range node.TemplateParams {
+		if !e0.equal(c, other.TemplateParams[i1]) {
+			return false
+		}
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i2, e1 = range node.Params {
+		if !e1.equal(c, other.Params[i2]) {
+			return false
+		}
+	}
+	if len(node.ReturnTypes) != len(other.ReturnTypes) || node.ReturnTypes == nil != (other.ReturnTypes == nil) {
+		return false
+	}
+	for i3, e2 = range node.ReturnTypes {
+		if !equalASTTypeRef(c, e2, other.ReturnTypes[i3]) {
+			return false
+		}
+	}
+	if len(node.Block) != len(other.Block) || node.Block == nil != (other.Block == nil) {
+		return false
+	}
+	for i4, e3 = range node.Block {
+		if !equalASTExpr(c, e3, other.Block[i4]) {
+			return false
+		}
+	}
+	if node.F != other.F {
+		return false
+	}
+	return true
+}
+
+func (node *Test) Clone() *Test {
+	var c *runtime.Cloner
+	var clone *Test
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Test{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Test) clone(c *runtime.Cloner) *Test {
+	var o interface{}
+	var clone *Test
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Test)
+	}
+	clone = &Test{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Test) cloneFields(c *runtime.Cloner, clone *Test) {
+	clone.Name = node.Name.clone(c)
+	clone.Rule = cloneASTExpr(c, node.Rule)
+	clone.Type = node.Type
+	clone.Input = node.Input
+	clone.Flow = node.Flow
+	clone.Destructure = cloneDestructure(c, node.Destructure)
+	clone.File = node.File
+}
+
+func (node *Test) Equal(other *Test) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Test) equal(c *runtime.Comparer, other *Test) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalASTExpr(c, node.Rule, other.Rule) {
+		return false
+	}
+	if node.Type != other.Type {
+		return false
+	}
+	if node.Input != other.Input {
+		return false
+	}
+	if node.Flow != other.Flow {
+		return false
+	}
+	if !equalDestructure(c, node.Destructure, other.Destructure) {
+		return false
+	}
+	if node.File != other.File {
+		return false
+	}
+	return true
+}
+
+func (node *ImportDecl) Clone() *ImportDecl {
+	var c *runtime.Cloner
+	var clone *ImportDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ImportDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ImportDecl) clone(c *runtime.Cloner) *ImportDecl {
+	var o interface{}
+	var clone *ImportDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ImportDecl)
+	}
+	clone = &ImportDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ImportDecl) cloneFields(c *runtime.Cloner, clone *ImportDecl) {
+	clone.Path = node.Path.clone(c)
+}
+
+func (node *ImportDecl) Equal(other *ImportDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ImportDecl) equal(c *runtime.Comparer, other *ImportDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Path.equal(c, other.Path) {
+		return false
+	}
+	return true
+}
+
+func (node *File) Clone() *File {
+	var c *runtime.Cloner
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) clone(c *runtime.Cloner) *File {
+	var o interface{}
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*File)
+	}
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) cloneFields(c *runtime.Cloner, clone *File) {
+	var s0 []*ImportDecl
+	var e0 *ImportDecl
+	var s1 []ASTDecl
+	var e1 ASTDecl
+	var s2 []*Test
+	var e2 *Test
+	clone.Name = node.Name
+	s0 = nil
+	if node.Imports != nil {
+		s0 = []*ImportDecl{}
+		for _, e0 = range node.Imports {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Imports = s0
+	s1 = nil
+	if node.Decls != nil {
+		s1 = []ASTDecl{}
+		for _, e1 = range node.Decls {
+			s1 = append(s1, cloneASTDecl(c, e1))
+		}
+	}
+	clone.Decls = s1
+	s2 = nil
+	if node.Tests != nil {
+		s2 = []*Test{}
+		for _, e2 = range node.Tests {
+			s2 = append(s2, e2.clone(c))
+		}
+	}
+	clone.Tests = s2
+	clone.F = node.F
+}
+
+func (node *File) Equal(other *File) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *File) equal(c *runtime.Comparer, other *File) bool {
+	var i0 int
+	var e0 *ImportDecl
+	var i1 int
+	var e1 ASTDecl
+	var i2 int
+	var e2 *Test
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Imports) != len(other.Imports) || node.Imports == nil != (other.Imports == nil) {
+		return false
+	}
+	for i0, e0 = range node.Imports {
+		if !e0.equal(c, other.Imports[i0]) {
+			return false
+		}
+	}
+	if len(node.Decls) != len(other.Decls) || node.Decls == nil != (other.Decls == nil) {
+		return false
+	}
+	for i1, e1 = range node.Decls {
+		if !equalASTDecl(c, e1, other.Decls[i1]) {
+			return false
+		}
+	}
+	if len(node.Tests) != len(other.Tests) || node.Tests == nil != (other.Tests == nil) {
+		return false
+	}
+	for i2, e2 = range node.Tests {
+		if !e2.equal(c, other.Tests[i2]) {
+			return false
+		}
+	}
+	if node.F != other.F {
+		return false
+	}
+	return true
+}
+
+func (node *Package) Clone() *Package {
+	var c *runtime.Cloner
+	var clone *Package
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Package{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Package) clone(c *runtime.Cloner) *Package {
+	var o interface{}
+	var clone *Package
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Package)
+	}
+	clone = &Package{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Package) cloneFields(c *runtime.Cloner, clone *Package) {
+	var s0 []string
+	var e0 string
+	var s1 []*File
+	var e1 *File
+	s0 = nil
+	if node.Path != nil {
+		s0 = []string{}
+		for _, e0 = range node.Path {
+			s0 = append(s0, e0)
+		}
+	}
+	clone.Path = s0
+	s1 = nil
+	if node.Files != nil {
+		s1 = []*File{}
+		for _, e1 = range node.Files {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Files = s1
+	clone.P = node.P
+}
+
+func (node *Package) Equal(other *Package) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Package) equal(c *runtime.Comparer, other *Package) bool {
+	var i0 int
+	var e0 string
+	var i1 int
+	var e1 *File
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Path) != len(other.Path) || node.Path == nil != (other.Path == nil) {
+		return false
+	}
+	for i0, e0 = range node.Path {
+		if e0 != other.Path[i0] {
+			return false
+		}
+	}
+	if len(node.Files) != len(other.Files) || node.Files == nil != (other.Files == nil) {
+		return false
+	}
+	for i1, e1 = range node.Files {
+		if !e1.equal(c, other.Files[i1]) {
+			return false
+		}
+	}
+	if node.P != other.P {
+		return false
+	}
+	return true
+}
+
+func (node *Program) Clone() *Program {
+	var c *runtime.Cloner
+	var clone *Program
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Program{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Program) clone(c *runtime.Cloner) *Program {
+	var o interface{}
+	var clone *Program
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Program)
+	}
+	clone = &Program{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Program) cloneFields(c *runtime.Cloner, clone *Program) {
+	var s []*Package
+	var e *Package
+	clone.Builtins = node.Builtins
+	s = nil
+	if node.Packages != nil {
+		s = []*Package{}
+		for _, e = range node.Packages {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Packages = s
+}
+
+func (node *Program) Equal(other *Program) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Program) equal(c *runtime.Comparer, other *Program) bool {
+	var i int
+	var e *Package
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Builtins != other.Builtins {
+		return false
+	}
+	if len(node.Packages) != len(other.Packages) || node.Packages == nil != (other.Packages == nil) {
+		return false
+	}
+	for i, e = range node.Packages {
+		if !e.equal(c, other.Packages[i]) {
+			return false
+		}
+	}
+	return true
+}
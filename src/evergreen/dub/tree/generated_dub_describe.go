@@ -0,0 +1,1044 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *RuneFilter) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("RuneFilter")
+	d.AddField("Min", runtime.Describe(node.Min))
+	d.AddField("Max", runtime.Describe(node.Max))
+	return d
+}
+
+func (node *RuneFilter) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *RuneRangeMatch) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *RuneFilter
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("RuneRangeMatch")
+	d.AddField("Invert", runtime.Describe(node.Invert))
+	l = runtime.MakeList("[]RuneFilter")
+	for _, e = range node.Filters {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Filters", l)
+	return d
+}
+
+func (node *RuneRangeMatch) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StringLiteralMatch) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StringLiteralMatch")
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *StringLiteralMatch) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *MatchSequence) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e TextMatch
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("MatchSequence")
+	l = runtime.MakeList("[]TextMatch")
+	for _, e = range node.Matches {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Matches", l)
+	return d
+}
+
+func (node *MatchSequence) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *MatchChoice) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e TextMatch
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("MatchChoice")
+	l = runtime.MakeList("[]TextMatch")
+	for _, e = range node.Matches {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Matches", l)
+	return d
+}
+
+func (node *MatchChoice) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *MatchRepeat) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("MatchRepeat")
+	d.AddField("Match", runtime.Describe(node.Match))
+	d.AddField("Min", runtime.Describe(node.Min))
+	return d
+}
+
+func (node *MatchRepeat) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *MatchLookahead) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("MatchLookahead")
+	d.AddField("Invert", runtime.Describe(node.Invert))
+	d.AddField("Match", runtime.Describe(node.Match))
+	return d
+}
+
+func (node *MatchLookahead) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Id) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Id")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Id) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *RuneLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("RuneLiteral")
+	d.AddField("Text", runtime.Describe(node.Text))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *RuneLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StringLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StringLiteral")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *StringLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *IntLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("IntLiteral")
+	d.AddField("Text", runtime.Describe(node.Text))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *IntLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Float32Literal) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Float32Literal")
+	d.AddField("Text", runtime.Describe(node.Text))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *Float32Literal) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BoolLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BoolLiteral")
+	d.AddField("Text", runtime.Describe(node.Text))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *BoolLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NilLiteral) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("NilLiteral")
+}
+
+func (node *NilLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StringMatch) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StringMatch")
+	d.AddField("Match", runtime.Describe(node.Match))
+	return d
+}
+
+func (node *StringMatch) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *RuneMatch) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("RuneMatch")
+	d.AddField("Match", runtime.Describe(node.Match))
+	return d
+}
+
+func (node *RuneMatch) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeRef")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *TypeRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ListTypeRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ListTypeRef")
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *ListTypeRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *QualifiedTypeRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("QualifiedTypeRef")
+	d.AddField("Package", runtime.Describe(node.Package))
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *QualifiedTypeRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetType")
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *GetType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *DestructureValue) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("DestructureValue")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	return d
+}
+
+func (node *DestructureValue) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *DestructureField) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("DestructureField")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Destructure", runtime.Describe(node.Destructure))
+	return d
+}
+
+func (node *DestructureField) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *DestructureStruct) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *DestructureField
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("DestructureStruct")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]DestructureField")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *DestructureStruct) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *DestructureList) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Destructure
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("DestructureList")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]Destructure")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *DestructureList) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *If) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 ASTExpr
+	var l1 *runtime.List
+	var e1 ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("If")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	l0 = runtime.MakeList("[]ASTExpr")
+	for _, e0 = range node.Block {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Block", l0)
+	l1 = runtime.MakeList("[]ASTExpr")
+	for _, e1 = range node.Else {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Else", l1)
+	return d
+}
+
+func (node *If) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Repeat) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Repeat")
+	l = runtime.MakeList("[]ASTExpr")
+	for _, e = range node.Block {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Block", l)
+	d.AddField("Min", runtime.Describe(node.Min))
+	return d
+}
+
+func (node *Repeat) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Choice) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 []ASTExpr
+	var l1 *runtime.List
+	var e1 ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Choice")
+	l0 = runtime.MakeList("[][]ASTExpr")
+	for _, e0 = range node.Blocks {
+		l1 = runtime.MakeList("[]ASTExpr")
+		for _, e1 = range e0 {
+			l1.Append(runtime.Describe(e1))
+		}
+		l0.Append(l1)
+	}
+	d.AddField("Blocks", l0)
+	return d
+}
+
+func (node *Choice) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Optional) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Optional")
+	l = runtime.MakeList("[]ASTExpr")
+	for _, e = range node.Block {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Block", l)
+	return d
+}
+
+func (node *Optional) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Assign) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Assign")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	l = runtime.MakeList("[]ASTExpr")
+	for _, e = range node.Targets {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Targets", l)
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Define", runtime.Describe(node.Define))
+	return d
+}
+
+func (node *Assign) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NameRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NameRef")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *NameRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetLocal) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetLocal")
+	d.AddField("Info", node.Info.DescribeRef())
+	return d
+}
+
+func (node *GetLocal) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SetLocal) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SetLocal")
+	d.AddField("Info", node.Info.DescribeRef())
+	return d
+}
+
+func (node *SetLocal) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Discard) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("Discard")
+}
+
+func (node *Discard) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetFunction) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetFunction")
+	d.AddField("Func", runtime.Describe(node.Func))
+	return d
+}
+
+func (node *GetFunction) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetFunctionTemplate) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetFunctionTemplate")
+	d.AddField("Template", runtime.Describe(node.Template))
+	return d
+}
+
+func (node *GetFunctionTemplate) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetPackage) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetPackage")
+	d.AddField("Package", node.Package.DescribeRef())
+	return d
+}
+
+func (node *GetPackage) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NamedExpr) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NamedExpr")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	return d
+}
+
+func (node *NamedExpr) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Construct) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *NamedExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Construct")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]NamedExpr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *Construct) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstructList) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstructList")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]ASTExpr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *ConstructList) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Coerce) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Coerce")
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	return d
+}
+
+func (node *Coerce) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Call) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Call")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	l = runtime.MakeList("[]ASTExpr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	d.AddField("Target", runtime.Describe(node.Target))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *Call) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Selector) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Selector")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *Selector) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SpecializeTemplate) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e ASTTypeRef
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SpecializeTemplate")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	l = runtime.MakeList("[]ASTTypeRef")
+	for _, e = range node.Types {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Types", l)
+	return d
+}
+
+func (node *SpecializeTemplate) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Fail) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("Fail")
+}
+
+func (node *Fail) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Return) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Return")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	l = runtime.MakeList("[]ASTExpr")
+	for _, e = range node.Exprs {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Exprs", l)
+	return d
+}
+
+func (node *Return) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BinaryOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BinaryOp")
+	d.AddField("Left", runtime.Describe(node.Left))
+	d.AddField("Op", runtime.Describe(node.Op))
+	d.AddField("OpPos", runtime.Describe(node.OpPos))
+	d.AddField("Right", runtime.Describe(node.Right))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *BinaryOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TemplateParam) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TemplateParam")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *TemplateParam) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FieldDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FieldDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *FieldDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StructDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *FieldDecl
+	var l1 *runtime.List
+	var e1 ASTTypeRef
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StructDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Export", runtime.Describe(node.Export))
+	d.AddField("Implements", runtime.Describe(node.Implements))
+	l0 = runtime.MakeList("[]FieldDecl")
+	for _, e0 = range node.Fields {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Fields", l0)
+	d.AddField("Scoped", runtime.Describe(node.Scoped))
+	l1 = runtime.MakeList("[]ASTTypeRef")
+	for _, e1 = range node.Contains {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Contains", l1)
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *StructDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *LocalInfo) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("LocalInfo")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *LocalInfo) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("LocalInfo", int(node.Index))
+}
+
+func (node *LocalInfo) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Param) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Param")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Info", node.Info.DescribeRef())
+	return d
+}
+
+func (node *Param) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FuncDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *TemplateParam
+	var l1 *runtime.List
+	var e1 *Param
+	var l2 *runtime.List
+	var e2 ASTTypeRef
+	var l3 *runtime.List
+	var e3 ASTExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FuncDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Export", runtime.Describe(node.Export))
+	d.AddField("Inline", runtime.Describe(node.Inline))
+	l0 = runtime.MakeList("[]TemplateParam")
+	for _, e0 = range node.TemplateParams {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("TemplateParams", l0)
+	l1 = runtime.MakeList("[]Param")
+	for _, e1 = range node.Params {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Params", l1)
+	l2 = runtime.MakeList("[]ASTTypeRef")
+	for _, e2 = range node.ReturnTypes {
+		l2.Append(runtime.Describe(e2))
+	}
+	d.AddField("ReturnTypes", l2)
+	l3 = runtime.MakeList("[]ASTExpr")
+	for _, e3 = range node.Block {
+		l3.Append(runtime.Describe(e3))
+	}
+	d.AddField("Block", l3)
+	d.AddField("F", node.F.DescribeRef())
+	return d
+}
+
+func (node *FuncDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Test) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Test")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Rule", runtime.Describe(node.Rule))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Input", runtime.Describe(node.Input))
+	d.AddField("Flow", runtime.Describe(node.Flow))
+	d.AddField("Destructure", runtime.Describe(node.Destructure))
+	d.AddField("File", node.File.DescribeRef())
+	return d
+}
+
+func (node *Test) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ImportDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ImportDecl")
+	d.AddField("Path", runtime.Describe(node.Path))
+	return d
+}
+
+func (node *ImportDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *File) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *ImportDecl
+	var l1 *runtime.List
+	var e1 ASTDecl
+	var l2 *runtime.List
+	var e2 *Test
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("File")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l0 = runtime.MakeList("[]ImportDecl")
+	for _, e0 = range node.Imports {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Imports", l0)
+	l1 = runtime.MakeList("[]ASTDecl")
+	for _, e1 = range node.Decls {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Decls", l1)
+	l2 = runtime.MakeList("[]Test")
+	for _, e2 = range node.Tests {
+		l2.Append(runtime.Describe(e2))
+	}
+	d.AddField("Tests", l2)
+	d.AddField("F", node.F.DescribeRef())
+	return d
+}
+
+func (node *File) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Package) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 string
+	var l1 *runtime.List
+	var e1 *File
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Package")
+	l0 = runtime.MakeList("[]string")
+	for _, e0 = range node.Path {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Path", l0)
+	l1 = runtime.MakeList("[]File")
+	for _, e1 = range node.Files {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Files", l1)
+	d.AddField("P", node.P.DescribeRef())
+	return d
+}
+
+func (node *Package) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Program) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *Package
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Program")
+	d.AddField("Builtins", runtime.Describe(node.Builtins))
+	l = runtime.MakeList("[]Package")
+	for _, e = range node.Packages {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Packages", l)
+	return d
+}
+
+func (node *Program) String() string {
+	return runtime.Format(node.Describe())
+}
@@ -0,0 +1,43 @@
+package tree_test
+
+import (
+	"encoding/json"
+	"evergreen/dub/runtime"
+	"generated/dub/tree"
+	"io/ioutil"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile("../../../../dubsrc/evergreen/dub/tree/parser.dub")
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := &runtime.State{Stream: []rune(string(data))}
+	file := tree.ParseFile(state)
+	if state.Flow != runtime.NORMAL {
+		t.Fatalf("Could not parse, deepest %d", state.Deepest())
+	}
+
+	encoded, err := json.Marshal(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &tree.File{}
+	err = json.Unmarshal(encoded, decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := runtime.Diff(file.Describe(), decoded.Describe())
+	if d != nil {
+		t.Fatal(d)
+	}
+
+	reencoded, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(reencoded) != string(encoded) {
+		t.Fatal("encoding is not stable")
+	}
+}
@@ -0,0 +1,5288 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func LineTerminator(frame *runtime.State) {
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '\n' {
+			frame.Consume()
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		if c1 == '\r' {
+			frame.Consume()
+			c2 = frame.Peek()
+			if frame.Flow == 0 {
+				if c2 == '\n' {
+					frame.Consume()
+					return
+				}
+				frame.Fail()
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint)
+	c3 = frame.Peek()
+	if frame.Flow == 0 {
+		if c3 == '\r' {
+			frame.Consume()
+		} else {
+			frame.Fail()
+		}
+	}
+}
+
+func SingleLineComment(frame *runtime.State) {
+	var c0 rune
+	var c1 rune
+	var checkpoint int
+	var c2 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '/' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == '/' {
+					frame.Consume()
+				loop0:
+					for {
+						checkpoint = frame.Checkpoint()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							switch c2 {
+							case '\n', '\r':
+								frame.Fail()
+							default:
+								frame.Consume()
+								continue loop0
+							}
+						}
+						frame.Recover(checkpoint)
+						return
+					}
+				}
+				frame.Fail()
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+}
+
+func S(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var c rune
+loop0:
+	for {
+		checkpoint0 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			switch c {
+			case ' ', '\t':
+				frame.Consume()
+				continue loop0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint1)
+		LineTerminator(frame)
+		if frame.Flow != 0 {
+			frame.Recover(checkpoint1)
+			SingleLineComment(frame)
+			if frame.Flow != 0 {
+				frame.Recover(checkpoint0)
+				return
+			}
+		}
+	}
+}
+
+func sInsert(frame *runtime.State) {
+	var checkpoint int
+	var c rune
+loop0:
+	for {
+		checkpoint = frame.Checkpoint()
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			switch c {
+			case ' ', '\t':
+				frame.Consume()
+				continue loop0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint)
+		return
+	}
+}
+
+func EndKeyword(frame *runtime.State) {
+	var checkpoint int
+	var c rune
+	var cond0 bool
+	var cond1 bool
+	checkpoint = frame.LookaheadBegin()
+	c = frame.Peek()
+	cond1 = frame.Flow == 0
+block1:
+	for {
+		if cond1 {
+			cond0 = c >= 'a'
+		block0:
+			for {
+				if cond0 {
+					if c <= 'z' {
+						break block0
+					}
+				}
+				if c >= 'A' {
+					if c <= 'Z' {
+						break block0
+					}
+				}
+				if c != '_' {
+					if c >= '0' {
+						if c <= '9' {
+							break block0
+						}
+					}
+					frame.Fail()
+					break block1
+				}
+				break
+			}
+			frame.Consume()
+			frame.LookaheadFail(checkpoint)
+			return
+		}
+		break
+	}
+	frame.LookaheadNormal(checkpoint)
+}
+
+func Ident(frame *runtime.State) (ret *Id) {
+	var p int
+	var checkpoint0 int
+	var checkpoint1 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	var c10 rune
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var c14 rune
+	var c15 rune
+	var c16 rune
+	var c17 rune
+	var c18 rune
+	var c19 rune
+	var c20 rune
+	var c21 rune
+	var c22 rune
+	var c23 rune
+	var c24 rune
+	var c25 rune
+	var c26 rune
+	var c27 rune
+	var c28 rune
+	var c29 rune
+	var c30 rune
+	var c31 rune
+	var c32 rune
+	var c33 rune
+	var c34 rune
+	var c35 rune
+	var c36 rune
+	var c37 rune
+	var c38 rune
+	var c39 rune
+	var c40 rune
+	var c41 rune
+	var c42 rune
+	var c43 rune
+	var c44 rune
+	var c45 rune
+	var c46 rune
+	var c47 rune
+	var c48 rune
+	var c49 rune
+	var c50 rune
+	var c51 rune
+	var c52 rune
+	var c53 rune
+	var c54 rune
+	var c55 rune
+	var c56 rune
+	var c57 rune
+	var c58 rune
+	var c59 rune
+	var c60 rune
+	var c61 rune
+	var c62 rune
+	var c63 rune
+	var c64 rune
+	var c65 rune
+	var c66 rune
+	var c67 rune
+	var c68 rune
+	var c69 rune
+	var c70 rune
+	var c71 rune
+	var c72 rune
+	var c73 rune
+	var c74 rune
+	var checkpoint2 int
+	var c75 rune
+	var cond0 bool
+	var begin int
+	var c76 rune
+	var cond1 bool
+	var checkpoint3 int
+	var c77 rune
+	var cond2 bool
+	var cond3 bool
+	var cond4 bool
+	var cond5 bool
+	p = frame.Checkpoint()
+	checkpoint0 = frame.LookaheadBegin()
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond3 = frame.Flow == 0
+block1:
+	for {
+	block0:
+		for {
+			if cond3 {
+				if c0 == 'f' {
+					frame.Consume()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 == 'u' {
+							frame.Consume()
+							c2 = frame.Peek()
+							if frame.Flow == 0 {
+								if c2 == 'n' {
+									frame.Consume()
+									c3 = frame.Peek()
+									if frame.Flow == 0 {
+										if c3 == 'c' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c4 = frame.Peek()
+			if frame.Flow == 0 {
+				if c4 == 't' {
+					frame.Consume()
+					c5 = frame.Peek()
+					if frame.Flow == 0 {
+						if c5 == 'e' {
+							frame.Consume()
+							c6 = frame.Peek()
+							if frame.Flow == 0 {
+								if c6 == 's' {
+									frame.Consume()
+									c7 = frame.Peek()
+									if frame.Flow == 0 {
+										if c7 == 't' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c8 = frame.Peek()
+			if frame.Flow == 0 {
+				if c8 == 's' {
+					frame.Consume()
+					c9 = frame.Peek()
+					if frame.Flow == 0 {
+						if c9 == 't' {
+							frame.Consume()
+							c10 = frame.Peek()
+							if frame.Flow == 0 {
+								if c10 == 'r' {
+									frame.Consume()
+									c11 = frame.Peek()
+									if frame.Flow == 0 {
+										if c11 == 'u' {
+											frame.Consume()
+											c12 = frame.Peek()
+											if frame.Flow == 0 {
+												if c12 == 'c' {
+													frame.Consume()
+													c13 = frame.Peek()
+													if frame.Flow == 0 {
+														if c13 == 't' {
+															frame.Consume()
+															break block0
+														}
+														frame.Fail()
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c14 = frame.Peek()
+			if frame.Flow == 0 {
+				if c14 == 'i' {
+					frame.Consume()
+					c15 = frame.Peek()
+					if frame.Flow == 0 {
+						if c15 == 'm' {
+							frame.Consume()
+							c16 = frame.Peek()
+							if frame.Flow == 0 {
+								if c16 == 'p' {
+									frame.Consume()
+									c17 = frame.Peek()
+									if frame.Flow == 0 {
+										if c17 == 'l' {
+											frame.Consume()
+											c18 = frame.Peek()
+											if frame.Flow == 0 {
+												if c18 == 'e' {
+													frame.Consume()
+													c19 = frame.Peek()
+													if frame.Flow == 0 {
+														if c19 == 'm' {
+															frame.Consume()
+															c20 = frame.Peek()
+															if frame.Flow == 0 {
+																if c20 == 'e' {
+																	frame.Consume()
+																	c21 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c21 == 'n' {
+																			frame.Consume()
+																			c22 = frame.Peek()
+																			if frame.Flow == 0 {
+																				if c22 == 't' {
+																					frame.Consume()
+																					c23 = frame.Peek()
+																					if frame.Flow == 0 {
+																						if c23 == 's' {
+																							frame.Consume()
+																							break block0
+																						}
+																						frame.Fail()
+																					}
+																				} else {
+																					frame.Fail()
+																				}
+																			}
+																		} else {
+																			frame.Fail()
+																		}
+																	}
+																} else {
+																	frame.Fail()
+																}
+															}
+														} else {
+															frame.Fail()
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c24 = frame.Peek()
+			if frame.Flow == 0 {
+				if c24 == 's' {
+					frame.Consume()
+					c25 = frame.Peek()
+					if frame.Flow == 0 {
+						if c25 == 't' {
+							frame.Consume()
+							c26 = frame.Peek()
+							if frame.Flow == 0 {
+								if c26 == 'a' {
+									frame.Consume()
+									c27 = frame.Peek()
+									if frame.Flow == 0 {
+										if c27 == 'r' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c28 = frame.Peek()
+			if frame.Flow == 0 {
+				if c28 == 'p' {
+					frame.Consume()
+					c29 = frame.Peek()
+					if frame.Flow == 0 {
+						if c29 == 'l' {
+							frame.Consume()
+							c30 = frame.Peek()
+							if frame.Flow == 0 {
+								if c30 == 'u' {
+									frame.Consume()
+									c31 = frame.Peek()
+									if frame.Flow == 0 {
+										if c31 == 's' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c32 = frame.Peek()
+			if frame.Flow == 0 {
+				if c32 == 'c' {
+					frame.Consume()
+					c33 = frame.Peek()
+					if frame.Flow == 0 {
+						if c33 == 'h' {
+							frame.Consume()
+							c34 = frame.Peek()
+							if frame.Flow == 0 {
+								if c34 == 'o' {
+									frame.Consume()
+									c35 = frame.Peek()
+									if frame.Flow == 0 {
+										if c35 == 'o' {
+											frame.Consume()
+											c36 = frame.Peek()
+											if frame.Flow == 0 {
+												if c36 == 's' {
+													frame.Consume()
+													c37 = frame.Peek()
+													if frame.Flow == 0 {
+														if c37 == 'e' {
+															frame.Consume()
+															break block0
+														}
+														frame.Fail()
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c38 = frame.Peek()
+			if frame.Flow == 0 {
+				if c38 == 'o' {
+					frame.Consume()
+					c39 = frame.Peek()
+					if frame.Flow == 0 {
+						if c39 == 'r' {
+							frame.Consume()
+							break block0
+						}
+						frame.Fail()
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c40 = frame.Peek()
+			if frame.Flow == 0 {
+				if c40 == 'q' {
+					frame.Consume()
+					c41 = frame.Peek()
+					if frame.Flow == 0 {
+						if c41 == 'u' {
+							frame.Consume()
+							c42 = frame.Peek()
+							if frame.Flow == 0 {
+								if c42 == 'e' {
+									frame.Consume()
+									c43 = frame.Peek()
+									if frame.Flow == 0 {
+										if c43 == 's' {
+											frame.Consume()
+											c44 = frame.Peek()
+											if frame.Flow == 0 {
+												if c44 == 't' {
+													frame.Consume()
+													c45 = frame.Peek()
+													if frame.Flow == 0 {
+														if c45 == 'i' {
+															frame.Consume()
+															c46 = frame.Peek()
+															if frame.Flow == 0 {
+																if c46 == 'o' {
+																	frame.Consume()
+																	c47 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c47 == 'n' {
+																			frame.Consume()
+																			break block0
+																		}
+																		frame.Fail()
+																	}
+																} else {
+																	frame.Fail()
+																}
+															}
+														} else {
+															frame.Fail()
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c48 = frame.Peek()
+			if frame.Flow == 0 {
+				if c48 == 'i' {
+					frame.Consume()
+					c49 = frame.Peek()
+					if frame.Flow == 0 {
+						if c49 == 'f' {
+							frame.Consume()
+							break block0
+						}
+						frame.Fail()
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c50 = frame.Peek()
+			if frame.Flow == 0 {
+				if c50 == 'e' {
+					frame.Consume()
+					c51 = frame.Peek()
+					if frame.Flow == 0 {
+						if c51 == 'l' {
+							frame.Consume()
+							c52 = frame.Peek()
+							if frame.Flow == 0 {
+								if c52 == 's' {
+									frame.Consume()
+									c53 = frame.Peek()
+									if frame.Flow == 0 {
+										if c53 == 'e' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c54 = frame.Peek()
+			if frame.Flow == 0 {
+				if c54 == 'r' {
+					frame.Consume()
+					c55 = frame.Peek()
+					if frame.Flow == 0 {
+						if c55 == 'e' {
+							frame.Consume()
+							c56 = frame.Peek()
+							if frame.Flow == 0 {
+								if c56 == 't' {
+									frame.Consume()
+									c57 = frame.Peek()
+									if frame.Flow == 0 {
+										if c57 == 'u' {
+											frame.Consume()
+											c58 = frame.Peek()
+											if frame.Flow == 0 {
+												if c58 == 'r' {
+													frame.Consume()
+													c59 = frame.Peek()
+													if frame.Flow == 0 {
+														if c59 == 'n' {
+															frame.Consume()
+															break block0
+														}
+														frame.Fail()
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c60 = frame.Peek()
+			if frame.Flow == 0 {
+				if c60 == 'v' {
+					frame.Consume()
+					c61 = frame.Peek()
+					if frame.Flow == 0 {
+						if c61 == 'a' {
+							frame.Consume()
+							c62 = frame.Peek()
+							if frame.Flow == 0 {
+								if c62 == 'r' {
+									frame.Consume()
+									break block0
+								}
+								frame.Fail()
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c63 = frame.Peek()
+			if frame.Flow == 0 {
+				if c63 == 't' {
+					frame.Consume()
+					c64 = frame.Peek()
+					if frame.Flow == 0 {
+						if c64 == 'r' {
+							frame.Consume()
+							c65 = frame.Peek()
+							if frame.Flow == 0 {
+								if c65 == 'u' {
+									frame.Consume()
+									c66 = frame.Peek()
+									if frame.Flow == 0 {
+										if c66 == 'e' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c67 = frame.Peek()
+			if frame.Flow == 0 {
+				if c67 == 'f' {
+					frame.Consume()
+					c68 = frame.Peek()
+					if frame.Flow == 0 {
+						if c68 == 'a' {
+							frame.Consume()
+							c69 = frame.Peek()
+							if frame.Flow == 0 {
+								if c69 == 'l' {
+									frame.Consume()
+									c70 = frame.Peek()
+									if frame.Flow == 0 {
+										if c70 == 's' {
+											frame.Consume()
+											c71 = frame.Peek()
+											if frame.Flow == 0 {
+												if c71 == 'e' {
+													frame.Consume()
+													break block0
+												}
+												frame.Fail()
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c72 = frame.Peek()
+			if frame.Flow == 0 {
+				if c72 == 'n' {
+					frame.Consume()
+					c73 = frame.Peek()
+					if frame.Flow == 0 {
+						if c73 == 'i' {
+							frame.Consume()
+							c74 = frame.Peek()
+							if frame.Flow == 0 {
+								if c74 == 'l' {
+									frame.Consume()
+								} else {
+									frame.Fail()
+									break block1
+								}
+							} else {
+								break block1
+							}
+						} else {
+							frame.Fail()
+							break block1
+						}
+					} else {
+						break block1
+					}
+				} else {
+					frame.Fail()
+					break block1
+				}
+			} else {
+				break block1
+			}
+			break
+		}
+		checkpoint2 = frame.LookaheadBegin()
+		c75 = frame.Peek()
+		cond4 = frame.Flow == 0
+	block3:
+		for {
+			if cond4 {
+				cond0 = c75 >= 'a'
+			block2:
+				for {
+					if cond0 {
+						if c75 <= 'z' {
+							break block2
+						}
+					}
+					if c75 >= 'A' {
+						if c75 <= 'Z' {
+							break block2
+						}
+					}
+					if c75 != '_' {
+						if c75 >= '0' {
+							if c75 <= '9' {
+								break block2
+							}
+						}
+						frame.Fail()
+						break block3
+					}
+					break
+				}
+				frame.Consume()
+				frame.LookaheadFail(checkpoint2)
+				break block1
+			}
+			break
+		}
+		frame.LookaheadNormal(checkpoint2)
+		frame.LookaheadFail(checkpoint0)
+		return
+	}
+	frame.LookaheadNormal(checkpoint0)
+	begin = frame.Checkpoint()
+	c76 = frame.Peek()
+	if frame.Flow == 0 {
+		cond1 = c76 >= 'a'
+	block4:
+		for {
+			if cond1 {
+				if c76 <= 'z' {
+					break block4
+				}
+			}
+			if c76 >= 'A' {
+				if c76 <= 'Z' {
+					break block4
+				}
+			}
+			if c76 != '_' {
+				frame.Fail()
+				return
+			}
+			break
+		}
+		frame.Consume()
+	loop7:
+		for {
+			checkpoint3 = frame.Checkpoint()
+			c77 = frame.Peek()
+			cond5 = frame.Flow == 0
+		block6:
+			for {
+				if cond5 {
+					cond2 = c77 >= 'a'
+				block5:
+					for {
+						if cond2 {
+							if c77 <= 'z' {
+								break block5
+							}
+						}
+						if c77 >= 'A' {
+							if c77 <= 'Z' {
+								break block5
+							}
+						}
+						if c77 != '_' {
+							if c77 >= '0' {
+								if c77 <= '9' {
+									break block5
+								}
+							}
+							frame.Fail()
+							break block6
+						}
+						break
+					}
+					frame.Consume()
+					continue loop7
+				}
+				break
+			}
+			frame.Recover(checkpoint3)
+			ret = &Id{Pos: p, Text: frame.Slice(begin, frame.Checkpoint())}
+			return
+		}
+	}
+	return
+}
+
+func ParseNumericLiteral(frame *runtime.State) (ret ASTExpr) {
+	var value0 int
+	var c_i int
+	var begin int
+	var c0 rune
+	var digit0 int
+	var value1 int
+	var checkpoint0 int
+	var c1 rune
+	var digit1 int
+	var checkpoint1 int
+	var c2 rune
+	var c3 rune
+	var digit2 int
+	var value2 int
+	var divisor0 int
+	var checkpoint2 int
+	var c4 rune
+	var digit3 int
+	var value3 int
+	var divisor1 int
+	var text string
+	var cond bool
+	value0 = 0
+	c_i = 1
+	begin = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 >= '0' {
+			if c0 <= '9' {
+				frame.Consume()
+				digit0 = int(c0) - int('0')
+				value1 = value0*10 + digit0
+			loop0:
+				for {
+					checkpoint0 = frame.Checkpoint()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 >= '0' {
+							if c1 <= '9' {
+								frame.Consume()
+								digit1 = int(c1) - int('0')
+								value1 = value1*10 + digit1
+								continue loop0
+							}
+						}
+						frame.Fail()
+					}
+					frame.Recover(checkpoint0)
+					checkpoint1 = frame.Checkpoint()
+					c2 = frame.Peek()
+					cond = frame.Flow == 0
+				block2:
+					for {
+						if cond {
+							if c2 == '.' {
+								frame.Consume()
+								c3 = frame.Peek()
+								if frame.Flow == 0 {
+									if c3 >= '0' {
+										if c3 <= '9' {
+											frame.Consume()
+											digit2 = int(c3) - int('0')
+											value2, divisor0 = value1*10+digit2, c_i*10
+										loop1:
+											for {
+												checkpoint2 = frame.Checkpoint()
+												c4 = frame.Peek()
+												if frame.Flow == 0 {
+													if c4 >= '0' {
+														if c4 <= '9' {
+															frame.Consume()
+															digit3 = int(c4) - int('0')
+															value2, divisor0 = value2*10+digit3, divisor0*10
+															continue loop1
+														}
+													}
+													frame.Fail()
+												}
+												frame.Recover(checkpoint2)
+												value3, divisor1 = value2, divisor0
+												break block2
+											}
+										}
+									}
+									frame.Fail()
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+						frame.Recover(checkpoint1)
+						value3, divisor1 = value1, c_i
+						break
+					}
+					text = frame.Slice(begin, frame.Checkpoint())
+					if divisor1 > 1 {
+						ret = &Float32Literal{Text: text, Value: float32(value3) / float32(divisor1)}
+						return
+					}
+					ret = &IntLiteral{Text: text, Value: value3}
+					return
+				}
+			}
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func EscapedChar(frame *runtime.State) (ret rune) {
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'a' {
+			frame.Consume()
+			ret = '\a'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		if c1 == 'b' {
+			frame.Consume()
+			ret = '\b'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c2 = frame.Peek()
+	if frame.Flow == 0 {
+		if c2 == 'f' {
+			frame.Consume()
+			ret = '\f'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c3 = frame.Peek()
+	if frame.Flow == 0 {
+		if c3 == 'n' {
+			frame.Consume()
+			ret = '\n'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c4 = frame.Peek()
+	if frame.Flow == 0 {
+		if c4 == 'r' {
+			frame.Consume()
+			ret = '\r'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c5 = frame.Peek()
+	if frame.Flow == 0 {
+		if c5 == 't' {
+			frame.Consume()
+			ret = '\t'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c6 = frame.Peek()
+	if frame.Flow == 0 {
+		if c6 == 'v' {
+			frame.Consume()
+			ret = '\v'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c7 = frame.Peek()
+	if frame.Flow == 0 {
+		if c7 == '\\' {
+			frame.Consume()
+			ret = '\\'
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c8 = frame.Peek()
+	if frame.Flow == 0 {
+		if c8 == '\'' {
+			frame.Consume()
+			ret = '\''
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c9 = frame.Peek()
+	if frame.Flow == 0 {
+		if c9 == '"' {
+			frame.Consume()
+			ret = '"'
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func DecodeString(frame *runtime.State) (ret string) {
+	var c0 rune
+	var contents []rune
+	var checkpoint0 int
+	var checkpoint1 int
+	var c1 rune
+	var c2 rune
+	var r rune
+	var c3 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '"' {
+			frame.Consume()
+			contents = []rune{}
+		loop0:
+			for {
+				checkpoint0 = frame.Checkpoint()
+				checkpoint1 = frame.Checkpoint()
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					switch c1 {
+					case '"', '\\':
+						frame.Fail()
+					default:
+						frame.Consume()
+						contents = append(contents, c1)
+						continue loop0
+					}
+				}
+				frame.Recover(checkpoint1)
+				c2 = frame.Peek()
+				if frame.Flow == 0 {
+					if c2 == '\\' {
+						frame.Consume()
+						r = EscapedChar(frame)
+						if frame.Flow == 0 {
+							contents = append(contents, r)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint0)
+				c3 = frame.Peek()
+				if frame.Flow == 0 {
+					if c3 == '"' {
+						frame.Consume()
+						ret = string(contents)
+						return
+					}
+					frame.Fail()
+					return
+				}
+				return
+			}
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func DecodeRune(frame *runtime.State) (ret0 rune, ret1 string) {
+	var begin int
+	var c0 rune
+	var checkpoint int
+	var c1 rune
+	var value0 rune
+	var c2 rune
+	var value1 rune
+	var c3 rune
+	var cond bool
+	begin = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '\'' {
+			frame.Consume()
+			checkpoint = frame.Checkpoint()
+			c1 = frame.Peek()
+			cond = frame.Flow == 0
+		block0:
+			for {
+				if cond {
+					switch c1 {
+					case '\\', '\'':
+						frame.Fail()
+					default:
+						frame.Consume()
+						value0 = c1
+						break block0
+					}
+				}
+				frame.Recover(checkpoint)
+				c2 = frame.Peek()
+				if frame.Flow == 0 {
+					if c2 == '\\' {
+						frame.Consume()
+						value1 = EscapedChar(frame)
+						if frame.Flow == 0 {
+							value0 = value1
+						} else {
+							return
+						}
+					} else {
+						frame.Fail()
+						return
+					}
+				} else {
+					return
+				}
+				break
+			}
+			c3 = frame.Peek()
+			if frame.Flow == 0 {
+				if c3 == '\'' {
+					frame.Consume()
+					ret0, ret1 = value0, frame.Slice(begin, frame.Checkpoint())
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func DecodeBool(frame *runtime.State) (ret0 bool, ret1 string) {
+	var begin int
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var value bool
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var cond bool
+	begin = frame.Checkpoint()
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block0:
+	for {
+		if cond {
+			if c0 == 't' {
+				frame.Consume()
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == 'r' {
+						frame.Consume()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							if c2 == 'u' {
+								frame.Consume()
+								c3 = frame.Peek()
+								if frame.Flow == 0 {
+									if c3 == 'e' {
+										frame.Consume()
+										value = true
+										break block0
+									}
+									frame.Fail()
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint)
+		c4 = frame.Peek()
+		if frame.Flow == 0 {
+			if c4 == 'f' {
+				frame.Consume()
+				c5 = frame.Peek()
+				if frame.Flow == 0 {
+					if c5 == 'a' {
+						frame.Consume()
+						c6 = frame.Peek()
+						if frame.Flow == 0 {
+							if c6 == 'l' {
+								frame.Consume()
+								c7 = frame.Peek()
+								if frame.Flow == 0 {
+									if c7 == 's' {
+										frame.Consume()
+										c8 = frame.Peek()
+										if frame.Flow == 0 {
+											if c8 == 'e' {
+												frame.Consume()
+												value = false
+											} else {
+												frame.Fail()
+												return
+											}
+										} else {
+											return
+										}
+									} else {
+										frame.Fail()
+										return
+									}
+								} else {
+									return
+								}
+							} else {
+								frame.Fail()
+								return
+							}
+						} else {
+							return
+						}
+					} else {
+						frame.Fail()
+						return
+					}
+				} else {
+					return
+				}
+			} else {
+				frame.Fail()
+				return
+			}
+		} else {
+			return
+		}
+		break
+	}
+	EndKeyword(frame)
+	if frame.Flow == 0 {
+		ret0, ret1 = value, frame.Slice(begin, frame.Checkpoint())
+		return
+	}
+	return
+}
+
+func ParseStringLiteral(frame *runtime.State) (ret *StringLiteral) {
+	var begin int
+	var value string
+	begin = frame.Checkpoint()
+	value = DecodeString(frame)
+	if frame.Flow == 0 {
+		ret = &StringLiteral{Pos: begin, Text: frame.Slice(begin, frame.Checkpoint()), Value: value}
+		return
+	}
+	return
+}
+
+func Literal(frame *runtime.State) (ret ASTExpr) {
+	var checkpoint int
+	var value0 rune
+	var text0 string
+	var r0 *StringLiteral
+	var r1 ASTExpr
+	var value1 bool
+	var text1 string
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	checkpoint = frame.Checkpoint()
+	value0, text0 = DecodeRune(frame)
+	if frame.Flow == 0 {
+		ret = &RuneLiteral{Text: text0, Value: value0}
+		return
+	}
+	frame.Recover(checkpoint)
+	r0 = ParseStringLiteral(frame)
+	if frame.Flow == 0 {
+		ret = r0
+		return
+	}
+	frame.Recover(checkpoint)
+	r1 = ParseNumericLiteral(frame)
+	if frame.Flow == 0 {
+		ret = r1
+		return
+	}
+	frame.Recover(checkpoint)
+	value1, text1 = DecodeBool(frame)
+	if frame.Flow == 0 {
+		ret = &BoolLiteral{Text: text1, Value: value1}
+		return
+	}
+	frame.Recover(checkpoint)
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'n' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'i' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'l' {
+							frame.Consume()
+							ret = &NilLiteral{}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func BinaryOperator(frame *runtime.State) (ret0 string, ret1 int) {
+	var checkpoint0 int
+	var begin0 int
+	var c0 rune
+	var begin1 int
+	var c1 rune
+	var begin2 int
+	var checkpoint1 int
+	var c2 rune
+	var checkpoint2 int
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var cond bool
+	checkpoint0 = frame.Checkpoint()
+	begin0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		switch c0 {
+		case '*', '/', '%':
+			frame.Consume()
+			ret0, ret1 = frame.Slice(begin0, frame.Checkpoint()), 5
+			return
+		default:
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	begin1 = frame.Checkpoint()
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		switch c1 {
+		case '+', '-':
+			frame.Consume()
+			ret0, ret1 = frame.Slice(begin1, frame.Checkpoint()), 4
+			return
+		default:
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	begin2 = frame.Checkpoint()
+	checkpoint1 = frame.Checkpoint()
+	c2 = frame.Peek()
+	cond = frame.Flow == 0
+block0:
+	for {
+		if cond {
+			switch c2 {
+			case '<', '>':
+				frame.Consume()
+				checkpoint2 = frame.Checkpoint()
+				c3 = frame.Peek()
+				if frame.Flow == 0 {
+					if c3 == '=' {
+						frame.Consume()
+						break block0
+					}
+					frame.Fail()
+				}
+				frame.Recover(checkpoint2)
+				break block0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint1)
+		c4 = frame.Peek()
+		if frame.Flow == 0 {
+			switch c4 {
+			case '!', '=':
+				frame.Consume()
+				c5 = frame.Peek()
+				if frame.Flow == 0 {
+					if c5 == '=' {
+						frame.Consume()
+					} else {
+						frame.Fail()
+						return
+					}
+				} else {
+					return
+				}
+			default:
+				frame.Fail()
+				return
+			}
+		} else {
+			return
+		}
+		break
+	}
+	ret0, ret1 = frame.Slice(begin2, frame.Checkpoint()), 3
+	return
+}
+
+func StringMatchExpr(frame *runtime.State) (ret *StringMatch) {
+	var c0 rune
+	var e TextMatch
+	var c1 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '/' {
+			frame.Consume()
+			S(frame)
+			e = ParseMatchChoice(frame)
+			if frame.Flow == 0 {
+				S(frame)
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == '/' {
+						frame.Consume()
+						ret = &StringMatch{Match: e}
+						return
+					}
+					frame.Fail()
+					return
+				}
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func RuneMatchExpr(frame *runtime.State) (ret *RuneMatch) {
+	var c rune
+	var e *RuneRangeMatch
+	c = frame.Peek()
+	if frame.Flow == 0 {
+		if c == '$' {
+			frame.Consume()
+			S(frame)
+			e = MatchRune(frame)
+			if frame.Flow == 0 {
+				ret = &RuneMatch{Match: e}
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseStructTypeRef(frame *runtime.State) (ret ASTTypeRef) {
+	var checkpoint int
+	var pkg *Id
+	var c rune
+	var r0 *Id
+	var r1 *Id
+	checkpoint = frame.Checkpoint()
+	pkg = Ident(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			if c == '.' {
+				frame.Consume()
+				S(frame)
+				r0 = Ident(frame)
+				if frame.Flow == 0 {
+					ret = &QualifiedTypeRef{Package: pkg, Name: r0}
+					return
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+	}
+	frame.Recover(checkpoint)
+	r1 = Ident(frame)
+	if frame.Flow == 0 {
+		ret = &TypeRef{Name: r1}
+		return
+	}
+	return
+}
+
+func ParseListTypeRef(frame *runtime.State) (ret *ListTypeRef) {
+	var c0 rune
+	var c1 rune
+	var checkpoint int
+	var r0 ASTTypeRef
+	var r1 ASTTypeRef
+	var r2 *ListTypeRef
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '[' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == ']' {
+					frame.Consume()
+					checkpoint = frame.Checkpoint()
+					r0 = ParseStructTypeRef(frame)
+					if frame.Flow == 0 {
+						r1 = r0
+					} else {
+						frame.Recover(checkpoint)
+						r2 = ParseListTypeRef(frame)
+						if frame.Flow == 0 {
+							r1 = r2
+						} else {
+							return
+						}
+					}
+					ret = &ListTypeRef{Type: r1}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseTypeRef(frame *runtime.State) (ret ASTTypeRef) {
+	var checkpoint int
+	var r0 ASTTypeRef
+	var r1 *ListTypeRef
+	checkpoint = frame.Checkpoint()
+	r0 = ParseStructTypeRef(frame)
+	if frame.Flow == 0 {
+		ret = r0
+		return
+	}
+	frame.Recover(checkpoint)
+	r1 = ParseListTypeRef(frame)
+	if frame.Flow == 0 {
+		ret = r1
+		return
+	}
+	return
+}
+
+func ParseDestructure(frame *runtime.State) (ret Destructure) {
+	var checkpoint0 int
+	var t0 ASTTypeRef
+	var c0 rune
+	var fields0 []*DestructureField
+	var checkpoint1 int
+	var name *Id
+	var c1 rune
+	var d Destructure
+	var c2 rune
+	var t1 *ListTypeRef
+	var c3 rune
+	var fields1 []Destructure
+	var checkpoint2 int
+	var r0 Destructure
+	var fields2 []Destructure
+	var fields3 []Destructure
+	var c4 rune
+	var r1 ASTExpr
+	var cond0 bool
+	var cond1 bool
+	checkpoint0 = frame.Checkpoint()
+	t0 = ParseStructTypeRef(frame)
+	cond0 = frame.Flow == 0
+block1:
+	for {
+		if cond0 {
+			S(frame)
+			c0 = frame.Peek()
+			if frame.Flow == 0 {
+				if c0 == '{' {
+					frame.Consume()
+					S(frame)
+					fields0 = []*DestructureField{}
+				loop0:
+					for {
+						checkpoint1 = frame.Checkpoint()
+						name = Ident(frame)
+						if frame.Flow == 0 {
+							S(frame)
+							c1 = frame.Peek()
+							if frame.Flow == 0 {
+								if c1 == ':' {
+									frame.Consume()
+									S(frame)
+									d = ParseDestructure(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										fields0 = append(fields0, &DestructureField{Name: name, Destructure: d})
+										continue loop0
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						}
+						frame.Recover(checkpoint1)
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							if c2 == '}' {
+								frame.Consume()
+								ret = &DestructureStruct{Type: t0, Args: fields0}
+								return
+							}
+							frame.Fail()
+							break block1
+						}
+						break block1
+					}
+				}
+				frame.Fail()
+			}
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	t1 = ParseListTypeRef(frame)
+	cond1 = frame.Flow == 0
+block2:
+	for {
+		if cond1 {
+			S(frame)
+			c3 = frame.Peek()
+			if frame.Flow == 0 {
+				if c3 == '{' {
+					frame.Consume()
+					S(frame)
+					fields1 = []Destructure{}
+					for {
+						checkpoint2 = frame.Checkpoint()
+						r0 = ParseDestructure(frame)
+						if frame.Flow == 0 {
+							fields2 = append(fields1, r0)
+							S(frame)
+							fields1 = fields2
+						} else {
+							fields3 = fields1
+							frame.Recover(checkpoint2)
+							c4 = frame.Peek()
+							if frame.Flow == 0 {
+								if c4 == '}' {
+									frame.Consume()
+									ret = &DestructureList{Type: t1, Args: fields3}
+									return
+								}
+								frame.Fail()
+								break block2
+							}
+							break block2
+						}
+					}
+				}
+				frame.Fail()
+			}
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	r1 = Literal(frame)
+	if frame.Flow == 0 {
+		ret = &DestructureValue{Expr: r1}
+		return
+	}
+	return
+}
+
+func ParseRuneFilterRune(frame *runtime.State) (ret rune) {
+	var checkpoint0 int
+	var c0 rune
+	var c1 rune
+	var checkpoint1 int
+	var r rune
+	var c2 rune
+	checkpoint0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		switch c0 {
+		case ']', '-', '\\':
+			frame.Fail()
+		default:
+			frame.Consume()
+			ret = c0
+			return
+		}
+	}
+	frame.Recover(checkpoint0)
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		if c1 == '\\' {
+			frame.Consume()
+			checkpoint1 = frame.Checkpoint()
+			r = EscapedChar(frame)
+			if frame.Flow == 0 {
+				ret = r
+				return
+			}
+			frame.Recover(checkpoint1)
+			c2 = frame.Peek()
+			if frame.Flow == 0 {
+				frame.Consume()
+				ret = c2
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseRuneFilter(frame *runtime.State) (ret *RuneFilter) {
+	var min rune
+	var checkpoint int
+	var c rune
+	var max0 rune
+	var max1 rune
+	var cond bool
+	min = ParseRuneFilterRune(frame)
+	if frame.Flow == 0 {
+		checkpoint = frame.Checkpoint()
+		c = frame.Peek()
+		cond = frame.Flow == 0
+	block0:
+		for {
+			if cond {
+				if c == '-' {
+					frame.Consume()
+					max0 = ParseRuneFilterRune(frame)
+					if frame.Flow == 0 {
+						max1 = max0
+						break block0
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint)
+			max1 = min
+			break
+		}
+		ret = &RuneFilter{Min: min, Max: max1}
+		return
+	}
+	return
+}
+
+func MatchRune(frame *runtime.State) (ret *RuneRangeMatch) {
+	var c0 rune
+	var c_b bool
+	var filters0 []*RuneFilter
+	var checkpoint0 int
+	var c1 rune
+	var invert bool
+	var filters1 []*RuneFilter
+	var checkpoint1 int
+	var r *RuneFilter
+	var c2 rune
+	var cond bool
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '[' {
+			frame.Consume()
+			c_b = false
+			filters0 = []*RuneFilter{}
+			checkpoint0 = frame.Checkpoint()
+			c1 = frame.Peek()
+			cond = frame.Flow == 0
+		block0:
+			for {
+				if cond {
+					if c1 == '^' {
+						frame.Consume()
+						invert, filters1 = true, filters0
+						break block0
+					}
+					frame.Fail()
+				}
+				frame.Recover(checkpoint0)
+				invert, filters1 = c_b, filters0
+				break
+			}
+			for {
+				checkpoint1 = frame.Checkpoint()
+				r = ParseRuneFilter(frame)
+				if frame.Flow == 0 {
+					filters1 = append(filters1, r)
+				} else {
+					frame.Recover(checkpoint1)
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == ']' {
+							frame.Consume()
+							ret = &RuneRangeMatch{Invert: invert, Filters: filters1}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+			}
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func Atom(frame *runtime.State) (ret TextMatch) {
+	var checkpoint int
+	var r *RuneRangeMatch
+	var value string
+	var c0 rune
+	var e TextMatch
+	var c1 rune
+	checkpoint = frame.Checkpoint()
+	r = MatchRune(frame)
+	if frame.Flow == 0 {
+		ret = r
+		return
+	}
+	frame.Recover(checkpoint)
+	value = DecodeString(frame)
+	if frame.Flow == 0 {
+		ret = &StringLiteralMatch{Value: value}
+		return
+	}
+	frame.Recover(checkpoint)
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '(' {
+			frame.Consume()
+			S(frame)
+			e = ParseMatchChoice(frame)
+			if frame.Flow == 0 {
+				S(frame)
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == ')' {
+						frame.Consume()
+						ret = e
+						return
+					}
+					frame.Fail()
+					return
+				}
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func MatchPostfix(frame *runtime.State) (ret TextMatch) {
+	var e TextMatch
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	e = Atom(frame)
+	if frame.Flow == 0 {
+		checkpoint = frame.Checkpoint()
+		S(frame)
+		c0 = frame.Peek()
+		if frame.Flow == 0 {
+			if c0 == '*' {
+				frame.Consume()
+				ret = &MatchRepeat{Match: e, Min: 0}
+				return
+			}
+			frame.Fail()
+		}
+		frame.Recover(checkpoint)
+		S(frame)
+		c1 = frame.Peek()
+		if frame.Flow == 0 {
+			if c1 == '+' {
+				frame.Consume()
+				ret = &MatchRepeat{Match: e, Min: 1}
+				return
+			}
+			frame.Fail()
+		}
+		frame.Recover(checkpoint)
+		S(frame)
+		c2 = frame.Peek()
+		if frame.Flow == 0 {
+			if c2 == '?' {
+				frame.Consume()
+				ret = &MatchChoice{Matches: []TextMatch{e, &MatchSequence{Matches: []TextMatch{}}}}
+				return
+			}
+			frame.Fail()
+		}
+		frame.Recover(checkpoint)
+		ret = e
+		return
+	}
+	return
+}
+
+func MatchPrefix(frame *runtime.State) (ret TextMatch) {
+	var checkpoint0 int
+	var invert0 bool
+	var checkpoint1 int
+	var c0 rune
+	var invert1 bool
+	var c1 rune
+	var r0 TextMatch
+	var r1 TextMatch
+	var cond bool
+	checkpoint0 = frame.Checkpoint()
+	invert0 = false
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block1:
+	for {
+	block0:
+		for {
+			if cond {
+				if c0 == '!' {
+					frame.Consume()
+					invert1 = true
+					break block0
+				}
+				frame.Fail()
+			}
+			frame.Recover(checkpoint1)
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == '&' {
+					frame.Consume()
+					invert1 = invert0
+				} else {
+					frame.Fail()
+					break block1
+				}
+			} else {
+				break block1
+			}
+			break
+		}
+		S(frame)
+		r0 = MatchPostfix(frame)
+		if frame.Flow == 0 {
+			ret = &MatchLookahead{Invert: invert1, Match: r0}
+			return
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	r1 = MatchPostfix(frame)
+	if frame.Flow == 0 {
+		ret = r1
+		return
+	}
+	return
+}
+
+func Sequence(frame *runtime.State) (ret TextMatch) {
+	var e TextMatch
+	var checkpoint0 int
+	var l0 []TextMatch
+	var r0 TextMatch
+	var l1 []TextMatch
+	var checkpoint1 int
+	var r1 TextMatch
+	e = MatchPrefix(frame)
+	if frame.Flow == 0 {
+		checkpoint0 = frame.Checkpoint()
+		l0 = []TextMatch{e}
+		S(frame)
+		r0 = MatchPrefix(frame)
+		if frame.Flow == 0 {
+			l1 = append(l0, r0)
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				r1 = MatchPrefix(frame)
+				if frame.Flow == 0 {
+					l1 = append(l1, r1)
+				} else {
+					frame.Recover(checkpoint1)
+					ret = &MatchSequence{Matches: l1}
+					return
+				}
+			}
+		}
+		frame.Recover(checkpoint0)
+		ret = e
+		return
+	}
+	return
+}
+
+func ParseMatchChoice(frame *runtime.State) (ret TextMatch) {
+	var e TextMatch
+	var checkpoint0 int
+	var l0 []TextMatch
+	var c0 rune
+	var r0 TextMatch
+	var l1 []TextMatch
+	var checkpoint1 int
+	var c1 rune
+	var r1 TextMatch
+	e = Sequence(frame)
+	if frame.Flow == 0 {
+		checkpoint0 = frame.Checkpoint()
+		l0 = []TextMatch{e}
+		S(frame)
+		c0 = frame.Peek()
+		if frame.Flow == 0 {
+			if c0 == '|' {
+				frame.Consume()
+				S(frame)
+				r0 = Sequence(frame)
+				if frame.Flow == 0 {
+					l1 = append(l0, r0)
+				loop0:
+					for {
+						checkpoint1 = frame.Checkpoint()
+						S(frame)
+						c1 = frame.Peek()
+						if frame.Flow == 0 {
+							if c1 == '|' {
+								frame.Consume()
+								S(frame)
+								r1 = Sequence(frame)
+								if frame.Flow == 0 {
+									l1 = append(l1, r1)
+									continue loop0
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+						frame.Recover(checkpoint1)
+						ret = &MatchChoice{Matches: l1}
+						return
+					}
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint0)
+		ret = e
+		return
+	}
+	return
+}
+
+func ParseExprList(frame *runtime.State) (ret []ASTExpr) {
+	var exprs0 []ASTExpr
+	var checkpoint0 int
+	var r0 ASTExpr
+	var exprs1 []ASTExpr
+	var checkpoint1 int
+	var c rune
+	var r1 ASTExpr
+	var exprs2 []ASTExpr
+	var cond bool
+	exprs0 = []ASTExpr{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseBinaryOp(frame, 1)
+	cond = frame.Flow == 0
+block1:
+	for {
+		if cond {
+			exprs1 = append(exprs0, r0)
+		loop0:
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				c = frame.Peek()
+				if frame.Flow == 0 {
+					if c == ',' {
+						frame.Consume()
+						S(frame)
+						r1 = ParseBinaryOp(frame, 1)
+						if frame.Flow == 0 {
+							exprs1 = append(exprs1, r1)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				exprs2 = exprs1
+				break block1
+			}
+		}
+		frame.Recover(checkpoint0)
+		exprs2 = exprs0
+		break
+	}
+	ret = exprs2
+	return
+}
+
+func ParseTargetList(frame *runtime.State) (ret []ASTExpr) {
+	var r0 *Id
+	var exprs []ASTExpr
+	var checkpoint int
+	var c rune
+	var r1 *Id
+	r0 = Ident(frame)
+	if frame.Flow == 0 {
+		exprs = []ASTExpr{&NameRef{Name: r0}}
+	loop0:
+		for {
+			checkpoint = frame.Checkpoint()
+			S(frame)
+			c = frame.Peek()
+			if frame.Flow == 0 {
+				if c == ',' {
+					frame.Consume()
+					S(frame)
+					r1 = Ident(frame)
+					if frame.Flow == 0 {
+						exprs = append(exprs, &NameRef{Name: r1})
+						continue loop0
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint)
+			ret = exprs
+			return
+		}
+	}
+	return
+}
+
+func ParseNamedExpr(frame *runtime.State) (ret *NamedExpr) {
+	var name *Id
+	var c rune
+	var r ASTExpr
+	name = Ident(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			if c == ':' {
+				frame.Consume()
+				S(frame)
+				r = ParseExpr(frame)
+				if frame.Flow == 0 {
+					ret = &NamedExpr{Name: name, Expr: r}
+					return
+				}
+				return
+			}
+			frame.Fail()
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseNamedExprList(frame *runtime.State) (ret []*NamedExpr) {
+	var exprs0 []*NamedExpr
+	var checkpoint0 int
+	var r0 *NamedExpr
+	var exprs1 []*NamedExpr
+	var checkpoint1 int
+	var c rune
+	var r1 *NamedExpr
+	var exprs2 []*NamedExpr
+	var cond bool
+	exprs0 = []*NamedExpr{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseNamedExpr(frame)
+	cond = frame.Flow == 0
+block1:
+	for {
+		if cond {
+			exprs1 = append(exprs0, r0)
+		loop0:
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				c = frame.Peek()
+				if frame.Flow == 0 {
+					if c == ',' {
+						frame.Consume()
+						S(frame)
+						r1 = ParseNamedExpr(frame)
+						if frame.Flow == 0 {
+							exprs1 = append(exprs1, r1)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				exprs2 = exprs1
+				break block1
+			}
+		}
+		frame.Recover(checkpoint0)
+		exprs2 = exprs0
+		break
+	}
+	ret = exprs2
+	return
+}
+
+func ParseReturnTypeList(frame *runtime.State) (ret []ASTTypeRef) {
+	var checkpoint0 int
+	var r0 []ASTTypeRef
+	var checkpoint1 int
+	var r1 ASTTypeRef
+	var r2 ASTTypeRef
+	var r3 *ListTypeRef
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseParenthTypeList(frame)
+	if frame.Flow == 0 {
+		ret = r0
+		return
+	}
+	frame.Recover(checkpoint0)
+	checkpoint1 = frame.Checkpoint()
+	r1 = ParseStructTypeRef(frame)
+	if frame.Flow == 0 {
+		r2 = r1
+	} else {
+		frame.Recover(checkpoint1)
+		r3 = ParseListTypeRef(frame)
+		if frame.Flow == 0 {
+			r2 = r3
+		} else {
+			frame.Recover(checkpoint0)
+			ret = []ASTTypeRef{}
+			return
+		}
+	}
+	ret = []ASTTypeRef{r2}
+	return
+}
+
+func PrimaryExpr(frame *runtime.State) (ret ASTExpr) {
+	var checkpoint0 int
+	var e0 ASTExpr
+	var e1 ASTExpr
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var checkpoint1 int
+	var r0 ASTTypeRef
+	var t0 ASTTypeRef
+	var r1 *ListTypeRef
+	var c7 rune
+	var child ASTExpr
+	var c8 rune
+	var t1 ASTTypeRef
+	var c9 rune
+	var args0 []*NamedExpr
+	var c10 rune
+	var t2 *ListTypeRef
+	var c11 rune
+	var args1 []ASTExpr
+	var c12 rune
+	var e2 *StringMatch
+	var e3 *RuneMatch
+	var c13 rune
+	var e4 ASTExpr
+	var c14 rune
+	var r2 *Id
+	var cond0 bool
+	var cond1 bool
+	checkpoint0 = frame.Checkpoint()
+	e0 = Literal(frame)
+	cond0 = frame.Flow == 0
+block1:
+	for {
+		if cond0 {
+			e1 = e0
+		} else {
+			frame.Recover(checkpoint0)
+			c0 = frame.Peek()
+			cond1 = frame.Flow == 0
+		block0:
+			for {
+				if cond1 {
+					if c0 == 'c' {
+						frame.Consume()
+						c1 = frame.Peek()
+						if frame.Flow == 0 {
+							if c1 == 'o' {
+								frame.Consume()
+								c2 = frame.Peek()
+								if frame.Flow == 0 {
+									if c2 == 'e' {
+										frame.Consume()
+										c3 = frame.Peek()
+										if frame.Flow == 0 {
+											if c3 == 'r' {
+												frame.Consume()
+												c4 = frame.Peek()
+												if frame.Flow == 0 {
+													if c4 == 'c' {
+														frame.Consume()
+														c5 = frame.Peek()
+														if frame.Flow == 0 {
+															if c5 == 'e' {
+																frame.Consume()
+																EndKeyword(frame)
+																if frame.Flow == 0 {
+																	S(frame)
+																	c6 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c6 == '(' {
+																			frame.Consume()
+																			S(frame)
+																			checkpoint1 = frame.Checkpoint()
+																			r0 = ParseStructTypeRef(frame)
+																			if frame.Flow == 0 {
+																				t0 = r0
+																			} else {
+																				frame.Recover(checkpoint1)
+																				r1 = ParseListTypeRef(frame)
+																				if frame.Flow == 0 {
+																					t0 = r1
+																				} else {
+																					break block0
+																				}
+																			}
+																			S(frame)
+																			c7 = frame.Peek()
+																			if frame.Flow == 0 {
+																				if c7 == ',' {
+																					frame.Consume()
+																					S(frame)
+																					child = ParseExpr(frame)
+																					if frame.Flow == 0 {
+																						S(frame)
+																						c8 = frame.Peek()
+																						if frame.Flow == 0 {
+																							if c8 == ')' {
+																								frame.Consume()
+																								e1 = &Coerce{Type: t0, Expr: child}
+																								break block1
+																							}
+																							frame.Fail()
+																						}
+																					}
+																				} else {
+																					frame.Fail()
+																				}
+																			}
+																		} else {
+																			frame.Fail()
+																		}
+																	}
+																}
+															} else {
+																frame.Fail()
+															}
+														}
+													} else {
+														frame.Fail()
+													}
+												}
+											} else {
+												frame.Fail()
+											}
+										}
+									} else {
+										frame.Fail()
+									}
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				break
+			}
+			frame.Recover(checkpoint0)
+			t1 = ParseStructTypeRef(frame)
+			if frame.Flow == 0 {
+				S(frame)
+				c9 = frame.Peek()
+				if frame.Flow == 0 {
+					if c9 == '{' {
+						frame.Consume()
+						S(frame)
+						args0 = ParseNamedExprList(frame)
+						S(frame)
+						c10 = frame.Peek()
+						if frame.Flow == 0 {
+							if c10 == '}' {
+								frame.Consume()
+								e1 = &Construct{Type: t1, Args: args0}
+								break block1
+							}
+							frame.Fail()
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+			}
+			frame.Recover(checkpoint0)
+			t2 = ParseListTypeRef(frame)
+			if frame.Flow == 0 {
+				S(frame)
+				c11 = frame.Peek()
+				if frame.Flow == 0 {
+					if c11 == '{' {
+						frame.Consume()
+						S(frame)
+						args1 = ParseExprList(frame)
+						S(frame)
+						c12 = frame.Peek()
+						if frame.Flow == 0 {
+							if c12 == '}' {
+								frame.Consume()
+								e1 = &ConstructList{Type: t2, Args: args1}
+								break block1
+							}
+							frame.Fail()
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+			}
+			frame.Recover(checkpoint0)
+			e2 = StringMatchExpr(frame)
+			if frame.Flow == 0 {
+				e1 = e2
+			} else {
+				frame.Recover(checkpoint0)
+				e3 = RuneMatchExpr(frame)
+				if frame.Flow == 0 {
+					e1 = e3
+				} else {
+					frame.Recover(checkpoint0)
+					c13 = frame.Peek()
+					if frame.Flow == 0 {
+						if c13 == '(' {
+							frame.Consume()
+							S(frame)
+							e4 = ParseExpr(frame)
+							if frame.Flow == 0 {
+								S(frame)
+								c14 = frame.Peek()
+								if frame.Flow == 0 {
+									if c14 == ')' {
+										frame.Consume()
+										e1 = e4
+										break block1
+									}
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+					frame.Recover(checkpoint0)
+					r2 = Ident(frame)
+					if frame.Flow == 0 {
+						e1 = &NameRef{Name: r2}
+					} else {
+						return
+					}
+				}
+			}
+		}
+		break
+	}
+	sInsert(frame)
+	ret = e1
+	return
+}
+
+func ParseNameRef(frame *runtime.State) (ret *NameRef) {
+	var r *Id
+	r = Ident(frame)
+	if frame.Flow == 0 {
+		ret = &NameRef{Name: r}
+		return
+	}
+	return
+}
+
+func PrimaryExprPostfix(frame *runtime.State) (ret ASTExpr) {
+	var e0 ASTExpr
+	var e1 ASTExpr
+	var checkpoint0 int
+	var pos int
+	var checkpoint1 int
+	var c0 rune
+	var args []ASTExpr
+	var c1 rune
+	var e2 ASTExpr
+	var c2 rune
+	var name *Id
+	var c3 rune
+	var types []ASTTypeRef
+	var c4 rune
+	var e3 ASTExpr
+	var cond bool
+	e0 = PrimaryExpr(frame)
+	if frame.Flow == 0 {
+		e1 = e0
+		for {
+			checkpoint0 = frame.Checkpoint()
+			pos = frame.Checkpoint()
+			checkpoint1 = frame.Checkpoint()
+			c0 = frame.Peek()
+			cond = frame.Flow == 0
+		block0:
+			for {
+				if cond {
+					if c0 == '(' {
+						frame.Consume()
+						S(frame)
+						args = ParseExprList(frame)
+						S(frame)
+						c1 = frame.Peek()
+						if frame.Flow == 0 {
+							if c1 == ')' {
+								frame.Consume()
+								e2 = &Call{Expr: e1, Pos: pos, Args: args}
+								break block0
+							}
+							frame.Fail()
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				c2 = frame.Peek()
+				if frame.Flow == 0 {
+					if c2 == '.' {
+						frame.Consume()
+						S(frame)
+						name = Ident(frame)
+						if frame.Flow == 0 {
+							e2 = &Selector{Expr: e1, Pos: pos, Name: name}
+							break block0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				c3 = frame.Peek()
+				if frame.Flow == 0 {
+					if c3 == '<' {
+						frame.Consume()
+						S(frame)
+						types = ParseTypeList(frame)
+						S(frame)
+						c4 = frame.Peek()
+						if frame.Flow == 0 {
+							if c4 == '>' {
+								frame.Consume()
+								e2 = &SpecializeTemplate{Expr: e1, Pos: pos, Types: types}
+								break block0
+							}
+							frame.Fail()
+							e3 = e1
+						} else {
+							e3 = e1
+						}
+					} else {
+						frame.Fail()
+						e3 = e1
+					}
+				} else {
+					e3 = e1
+				}
+				frame.Recover(checkpoint0)
+				ret = e3
+				return
+			}
+			sInsert(frame)
+			e1 = e2
+		}
+	}
+	return
+}
+
+func ParseBinaryOp(frame *runtime.State, min_prec int) (ret ASTExpr) {
+	var e0 ASTExpr
+	var e1 ASTExpr
+	var checkpoint int
+	var opPos int
+	var op string
+	var prec int
+	var r ASTExpr
+	e0 = PrimaryExprPostfix(frame)
+	if frame.Flow == 0 {
+		e1 = e0
+	loop0:
+		for {
+			checkpoint = frame.Checkpoint()
+			opPos = frame.Checkpoint()
+			op, prec = BinaryOperator(frame)
+			if frame.Flow == 0 {
+				if prec < min_prec {
+					frame.Fail()
+				} else {
+					S(frame)
+					r = ParseBinaryOp(frame, prec+1)
+					if frame.Flow == 0 {
+						e1 = &BinaryOp{Left: e1, Op: op, OpPos: opPos, Right: r}
+						continue loop0
+					}
+				}
+			}
+			frame.Recover(checkpoint)
+			ret = e1
+			return
+		}
+	}
+	return
+}
+
+func ParseExpr(frame *runtime.State) (ret ASTExpr) {
+	var r ASTExpr
+	r = ParseBinaryOp(frame, 1)
+	if frame.Flow == 0 {
+		ret = r
+		return
+	}
+	return
+}
+
+func ParseCompoundStatement(frame *runtime.State) (ret ASTExpr) {
+	var checkpoint0 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var block0 []ASTExpr
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var block1 []ASTExpr
+	var c8 rune
+	var c9 rune
+	var c10 rune
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var r0 []ASTExpr
+	var blocks0 [][]ASTExpr
+	var c14 rune
+	var c15 rune
+	var r1 []ASTExpr
+	var blocks1 [][]ASTExpr
+	var checkpoint1 int
+	var c16 rune
+	var c17 rune
+	var r2 []ASTExpr
+	var c18 rune
+	var c19 rune
+	var c20 rune
+	var c21 rune
+	var c22 rune
+	var c23 rune
+	var c24 rune
+	var c25 rune
+	var block2 []ASTExpr
+	var c26 rune
+	var c27 rune
+	var r3 ASTExpr
+	var block3 []ASTExpr
+	var else_0 []ASTExpr
+	var checkpoint2 int
+	var c28 rune
+	var c29 rune
+	var c30 rune
+	var c31 rune
+	var else_1 []ASTExpr
+	var else_2 []ASTExpr
+	var cond bool
+	checkpoint0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 's' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 't' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'a' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'r' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										block0 = ParseCodeBlock(frame)
+										if frame.Flow == 0 {
+											ret = &Repeat{Block: block0, Min: 0}
+											return
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c4 = frame.Peek()
+	if frame.Flow == 0 {
+		if c4 == 'p' {
+			frame.Consume()
+			c5 = frame.Peek()
+			if frame.Flow == 0 {
+				if c5 == 'l' {
+					frame.Consume()
+					c6 = frame.Peek()
+					if frame.Flow == 0 {
+						if c6 == 'u' {
+							frame.Consume()
+							c7 = frame.Peek()
+							if frame.Flow == 0 {
+								if c7 == 's' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										block1 = ParseCodeBlock(frame)
+										if frame.Flow == 0 {
+											ret = &Repeat{Block: block1, Min: 1}
+											return
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c8 = frame.Peek()
+	if frame.Flow == 0 {
+		if c8 == 'c' {
+			frame.Consume()
+			c9 = frame.Peek()
+			if frame.Flow == 0 {
+				if c9 == 'h' {
+					frame.Consume()
+					c10 = frame.Peek()
+					if frame.Flow == 0 {
+						if c10 == 'o' {
+							frame.Consume()
+							c11 = frame.Peek()
+							if frame.Flow == 0 {
+								if c11 == 'o' {
+									frame.Consume()
+									c12 = frame.Peek()
+									if frame.Flow == 0 {
+										if c12 == 's' {
+											frame.Consume()
+											c13 = frame.Peek()
+											if frame.Flow == 0 {
+												if c13 == 'e' {
+													frame.Consume()
+													EndKeyword(frame)
+													if frame.Flow == 0 {
+														S(frame)
+														r0 = ParseCodeBlock(frame)
+														if frame.Flow == 0 {
+															blocks0 = [][]ASTExpr{r0}
+															S(frame)
+															c14 = frame.Peek()
+															if frame.Flow == 0 {
+																if c14 == 'o' {
+																	frame.Consume()
+																	c15 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c15 == 'r' {
+																			frame.Consume()
+																			EndKeyword(frame)
+																			if frame.Flow == 0 {
+																				S(frame)
+																				r1 = ParseCodeBlock(frame)
+																				if frame.Flow == 0 {
+																					blocks1 = append(blocks0, r1)
+																				loop0:
+																					for {
+																						checkpoint1 = frame.Checkpoint()
+																						S(frame)
+																						c16 = frame.Peek()
+																						if frame.Flow == 0 {
+																							if c16 == 'o' {
+																								frame.Consume()
+																								c17 = frame.Peek()
+																								if frame.Flow == 0 {
+																									if c17 == 'r' {
+																										frame.Consume()
+																										EndKeyword(frame)
+																										if frame.Flow == 0 {
+																											S(frame)
+																											r2 = ParseCodeBlock(frame)
+																											if frame.Flow == 0 {
+																												blocks1 = append(blocks1, r2)
+																												continue loop0
+																											}
+																										}
+																									} else {
+																										frame.Fail()
+																									}
+																								}
+																							} else {
+																								frame.Fail()
+																							}
+																						}
+																						frame.Recover(checkpoint1)
+																						ret = &Choice{Blocks: blocks1}
+																						return
+																					}
+																				}
+																			}
+																		} else {
+																			frame.Fail()
+																		}
+																	}
+																} else {
+																	frame.Fail()
+																}
+															}
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c18 = frame.Peek()
+	if frame.Flow == 0 {
+		if c18 == 'q' {
+			frame.Consume()
+			c19 = frame.Peek()
+			if frame.Flow == 0 {
+				if c19 == 'u' {
+					frame.Consume()
+					c20 = frame.Peek()
+					if frame.Flow == 0 {
+						if c20 == 'e' {
+							frame.Consume()
+							c21 = frame.Peek()
+							if frame.Flow == 0 {
+								if c21 == 's' {
+									frame.Consume()
+									c22 = frame.Peek()
+									if frame.Flow == 0 {
+										if c22 == 't' {
+											frame.Consume()
+											c23 = frame.Peek()
+											if frame.Flow == 0 {
+												if c23 == 'i' {
+													frame.Consume()
+													c24 = frame.Peek()
+													if frame.Flow == 0 {
+														if c24 == 'o' {
+															frame.Consume()
+															c25 = frame.Peek()
+															if frame.Flow == 0 {
+																if c25 == 'n' {
+																	frame.Consume()
+																	EndKeyword(frame)
+																	if frame.Flow == 0 {
+																		S(frame)
+																		block2 = ParseCodeBlock(frame)
+																		if frame.Flow == 0 {
+																			ret = &Optional{Block: block2}
+																			return
+																		}
+																	}
+																} else {
+																	frame.Fail()
+																}
+															}
+														} else {
+															frame.Fail()
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c26 = frame.Peek()
+	if frame.Flow == 0 {
+		if c26 == 'i' {
+			frame.Consume()
+			c27 = frame.Peek()
+			if frame.Flow == 0 {
+				if c27 == 'f' {
+					frame.Consume()
+					EndKeyword(frame)
+					if frame.Flow == 0 {
+						S(frame)
+						r3 = ParseBinaryOp(frame, 1)
+						if frame.Flow == 0 {
+							S(frame)
+							block3 = ParseCodeBlock(frame)
+							if frame.Flow == 0 {
+								else_0 = []ASTExpr{}
+								checkpoint2 = frame.Checkpoint()
+								S(frame)
+								c28 = frame.Peek()
+								cond = frame.Flow == 0
+							block1:
+								for {
+									if cond {
+										if c28 == 'e' {
+											frame.Consume()
+											c29 = frame.Peek()
+											if frame.Flow == 0 {
+												if c29 == 'l' {
+													frame.Consume()
+													c30 = frame.Peek()
+													if frame.Flow == 0 {
+														if c30 == 's' {
+															frame.Consume()
+															c31 = frame.Peek()
+															if frame.Flow == 0 {
+																if c31 == 'e' {
+																	frame.Consume()
+																	EndKeyword(frame)
+																	if frame.Flow == 0 {
+																		S(frame)
+																		else_1 = ParseCodeBlock(frame)
+																		if frame.Flow == 0 {
+																			else_2 = else_1
+																			break block1
+																		}
+																	}
+																} else {
+																	frame.Fail()
+																}
+															}
+														} else {
+															frame.Fail()
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+									frame.Recover(checkpoint2)
+									else_2 = else_0
+									break
+								}
+								ret = &If{Expr: r3, Block: block3, Else: else_2}
+								return
+							}
+							return
+						}
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func EOS(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var c0 rune
+	var checkpoint2 int
+	var c1 rune
+	var checkpoint3 int
+	var checkpoint4 int
+	checkpoint0 = frame.Checkpoint()
+	S(frame)
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == ';' {
+			frame.Consume()
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint1)
+	checkpoint2 = frame.LookaheadBegin()
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		switch c1 {
+		case ')', '}':
+			frame.Consume()
+			frame.LookaheadNormal(checkpoint2)
+			return
+		default:
+			frame.Fail()
+		}
+	}
+	frame.LookaheadFail(checkpoint2)
+	frame.Recover(checkpoint1)
+	checkpoint3 = frame.LookaheadBegin()
+	frame.Peek()
+	if frame.Flow == 0 {
+		frame.Consume()
+		frame.LookaheadFail(checkpoint3)
+		frame.Recover(checkpoint0)
+		checkpoint4 = frame.Checkpoint()
+		SingleLineComment(frame)
+		if frame.Flow != 0 {
+			frame.Recover(checkpoint4)
+		}
+		LineTerminator(frame)
+	} else {
+		frame.LookaheadNormal(checkpoint3)
+	}
+}
+
+func ParseStatement(frame *runtime.State) (ret ASTExpr) {
+	var checkpoint0 int
+	var r0 ASTExpr
+	var pos0 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var r1 *Id
+	var r2 *NameRef
+	var checkpoint1 int
+	var r3 ASTTypeRef
+	var t ASTTypeRef
+	var r4 *ListTypeRef
+	var expr0 ASTExpr
+	var checkpoint2 int
+	var c3 rune
+	var r5 ASTExpr
+	var expr1 ASTExpr
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var pos1 int
+	var c8 rune
+	var c9 rune
+	var c10 rune
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var exprs []ASTExpr
+	var names []ASTExpr
+	var pos2 int
+	var defined0 bool
+	var checkpoint3 int
+	var c14 rune
+	var c15 rune
+	var defined1 bool
+	var c16 rune
+	var r6 ASTExpr
+	var r7 ASTExpr
+	var cond0 bool
+	var cond1 bool
+	var cond2 bool
+	var cond3 bool
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseCompoundStatement(frame)
+	if frame.Flow == 0 {
+		ret = r0
+		return
+	}
+	frame.Recover(checkpoint0)
+	pos0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond0 = frame.Flow == 0
+block0:
+	for {
+		if cond0 {
+			if c0 == 'v' {
+				frame.Consume()
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == 'a' {
+						frame.Consume()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							if c2 == 'r' {
+								frame.Consume()
+								EndKeyword(frame)
+								if frame.Flow == 0 {
+									S(frame)
+									r1 = Ident(frame)
+									if frame.Flow == 0 {
+										r2 = &NameRef{Name: r1}
+										S(frame)
+										checkpoint1 = frame.Checkpoint()
+										r3 = ParseStructTypeRef(frame)
+										if frame.Flow == 0 {
+											t = r3
+										} else {
+											frame.Recover(checkpoint1)
+											r4 = ParseListTypeRef(frame)
+											if frame.Flow == 0 {
+												t = r4
+											} else {
+												break block0
+											}
+										}
+										expr0 = nil
+										checkpoint2 = frame.Checkpoint()
+										S(frame)
+										c3 = frame.Peek()
+										cond1 = frame.Flow == 0
+									block1:
+										for {
+											if cond1 {
+												if c3 == '=' {
+													frame.Consume()
+													S(frame)
+													r5 = ParseBinaryOp(frame, 1)
+													if frame.Flow == 0 {
+														expr1 = r5
+														break block1
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+											frame.Recover(checkpoint2)
+											expr1 = expr0
+											break
+										}
+										EOS(frame)
+										if frame.Flow == 0 {
+											ret = &Assign{Expr: expr1, Pos: pos0, Targets: []ASTExpr{r2}, Type: t, Define: true}
+											return
+										}
+									}
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	c4 = frame.Peek()
+	if frame.Flow == 0 {
+		if c4 == 'f' {
+			frame.Consume()
+			c5 = frame.Peek()
+			if frame.Flow == 0 {
+				if c5 == 'a' {
+					frame.Consume()
+					c6 = frame.Peek()
+					if frame.Flow == 0 {
+						if c6 == 'i' {
+							frame.Consume()
+							c7 = frame.Peek()
+							if frame.Flow == 0 {
+								if c7 == 'l' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										sInsert(frame)
+										EOS(frame)
+										if frame.Flow == 0 {
+											ret = &Fail{}
+											return
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	pos1 = frame.Checkpoint()
+	c8 = frame.Peek()
+	if frame.Flow == 0 {
+		if c8 == 'r' {
+			frame.Consume()
+			c9 = frame.Peek()
+			if frame.Flow == 0 {
+				if c9 == 'e' {
+					frame.Consume()
+					c10 = frame.Peek()
+					if frame.Flow == 0 {
+						if c10 == 't' {
+							frame.Consume()
+							c11 = frame.Peek()
+							if frame.Flow == 0 {
+								if c11 == 'u' {
+									frame.Consume()
+									c12 = frame.Peek()
+									if frame.Flow == 0 {
+										if c12 == 'r' {
+											frame.Consume()
+											c13 = frame.Peek()
+											if frame.Flow == 0 {
+												if c13 == 'n' {
+													frame.Consume()
+													EndKeyword(frame)
+													if frame.Flow == 0 {
+														sInsert(frame)
+														exprs = ParseExprList(frame)
+														EOS(frame)
+														if frame.Flow == 0 {
+															ret = &Return{Pos: pos1, Exprs: exprs}
+															return
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	names = ParseTargetList(frame)
+	cond2 = frame.Flow == 0
+block3:
+	for {
+		if cond2 {
+			S(frame)
+			pos2 = frame.Checkpoint()
+			defined0 = false
+			checkpoint3 = frame.Checkpoint()
+			c14 = frame.Peek()
+			cond3 = frame.Flow == 0
+		block2:
+			for {
+				if cond3 {
+					if c14 == ':' {
+						frame.Consume()
+						c15 = frame.Peek()
+						if frame.Flow == 0 {
+							if c15 == '=' {
+								frame.Consume()
+								defined1 = true
+								break block2
+							}
+							frame.Fail()
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint3)
+				c16 = frame.Peek()
+				if frame.Flow == 0 {
+					if c16 == '=' {
+						frame.Consume()
+						defined1 = defined0
+					} else {
+						frame.Fail()
+						break block3
+					}
+				} else {
+					break block3
+				}
+				break
+			}
+			S(frame)
+			r6 = ParseBinaryOp(frame, 1)
+			if frame.Flow == 0 {
+				EOS(frame)
+				if frame.Flow == 0 {
+					ret = &Assign{Expr: r6, Pos: pos2, Targets: names, Define: defined1}
+					return
+				}
+			}
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	r7 = ParseBinaryOp(frame, 1)
+	if frame.Flow == 0 {
+		EOS(frame)
+		if frame.Flow == 0 {
+			ret = r7
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseCodeBlock(frame *runtime.State) (ret []ASTExpr) {
+	var c0 rune
+	var exprs0 []ASTExpr
+	var checkpoint int
+	var r ASTExpr
+	var exprs1 []ASTExpr
+	var exprs2 []ASTExpr
+	var c1 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '{' {
+			frame.Consume()
+			S(frame)
+			exprs0 = []ASTExpr{}
+			for {
+				checkpoint = frame.Checkpoint()
+				r = ParseStatement(frame)
+				if frame.Flow == 0 {
+					exprs1 = append(exprs0, r)
+					S(frame)
+					exprs0 = exprs1
+				} else {
+					exprs2 = exprs0
+					frame.Recover(checkpoint)
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 == '}' {
+							frame.Consume()
+							ret = exprs2
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+			}
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseTypeList(frame *runtime.State) (ret []ASTTypeRef) {
+	var types0 []ASTTypeRef
+	var checkpoint0 int
+	var checkpoint1 int
+	var r0 ASTTypeRef
+	var r1 ASTTypeRef
+	var r2 *ListTypeRef
+	var types1 []ASTTypeRef
+	var checkpoint2 int
+	var c rune
+	var checkpoint3 int
+	var r3 ASTTypeRef
+	var r4 ASTTypeRef
+	var r5 *ListTypeRef
+	var types2 []ASTTypeRef
+	var cond0 bool
+	var cond1 bool
+	types0 = []ASTTypeRef{}
+	checkpoint0 = frame.Checkpoint()
+	checkpoint1 = frame.Checkpoint()
+	r0 = ParseStructTypeRef(frame)
+	cond0 = frame.Flow == 0
+block0:
+	for {
+		if cond0 {
+			r1 = r0
+		} else {
+			frame.Recover(checkpoint1)
+			r2 = ParseListTypeRef(frame)
+			if frame.Flow == 0 {
+				r1 = r2
+			} else {
+				frame.Recover(checkpoint0)
+				types2 = types0
+				break block0
+			}
+		}
+		types1 = append(types0, r1)
+	loop2:
+		for {
+			checkpoint2 = frame.Checkpoint()
+			S(frame)
+			c = frame.Peek()
+			cond1 = frame.Flow == 0
+		block1:
+			for {
+				if cond1 {
+					if c == ',' {
+						frame.Consume()
+						S(frame)
+						checkpoint3 = frame.Checkpoint()
+						r3 = ParseStructTypeRef(frame)
+						if frame.Flow == 0 {
+							r4 = r3
+						} else {
+							frame.Recover(checkpoint3)
+							r5 = ParseListTypeRef(frame)
+							if frame.Flow == 0 {
+								r4 = r5
+							} else {
+								break block1
+							}
+						}
+						types1 = append(types1, r4)
+						continue loop2
+					}
+					frame.Fail()
+				}
+				break
+			}
+			frame.Recover(checkpoint2)
+			types2 = types1
+			break block0
+		}
+	}
+	ret = types2
+	return
+}
+
+func ParseParenthTypeList(frame *runtime.State) (ret []ASTTypeRef) {
+	var c0 rune
+	var types []ASTTypeRef
+	var c1 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '(' {
+			frame.Consume()
+			S(frame)
+			types = ParseTypeList(frame)
+			S(frame)
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == ')' {
+					frame.Consume()
+					ret = types
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseExport(frame *runtime.State) (ret bool) {
+	var c_b bool
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var exported bool
+	var cond bool
+	c_b = false
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block0:
+	for {
+		if cond {
+			if c0 == 'e' {
+				frame.Consume()
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == 'x' {
+						frame.Consume()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							if c2 == 'p' {
+								frame.Consume()
+								c3 = frame.Peek()
+								if frame.Flow == 0 {
+									if c3 == 'o' {
+										frame.Consume()
+										c4 = frame.Peek()
+										if frame.Flow == 0 {
+											if c4 == 'r' {
+												frame.Consume()
+												c5 = frame.Peek()
+												if frame.Flow == 0 {
+													if c5 == 't' {
+														frame.Consume()
+														EndKeyword(frame)
+														if frame.Flow == 0 {
+															S(frame)
+															exported = true
+															break block0
+														}
+													} else {
+														frame.Fail()
+													}
+												}
+											} else {
+												frame.Fail()
+											}
+										}
+									} else {
+										frame.Fail()
+									}
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint)
+		exported = c_b
+		break
+	}
+	ret = exported
+	return
+}
+
+func ParseInlineHint(frame *runtime.State) (ret string) {
+	var checkpoint0 int
+	var begin int
+	var checkpoint1 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	var c10 rune
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var slice string
+	var cond bool
+	checkpoint0 = frame.Checkpoint()
+	begin = frame.Checkpoint()
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block1:
+	for {
+	block0:
+		for {
+			if cond {
+				if c0 == 'i' {
+					frame.Consume()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 == 'n' {
+							frame.Consume()
+							c2 = frame.Peek()
+							if frame.Flow == 0 {
+								if c2 == 'l' {
+									frame.Consume()
+									c3 = frame.Peek()
+									if frame.Flow == 0 {
+										if c3 == 'i' {
+											frame.Consume()
+											c4 = frame.Peek()
+											if frame.Flow == 0 {
+												if c4 == 'n' {
+													frame.Consume()
+													c5 = frame.Peek()
+													if frame.Flow == 0 {
+														if c5 == 'e' {
+															frame.Consume()
+															break block0
+														}
+														frame.Fail()
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c6 = frame.Peek()
+			if frame.Flow == 0 {
+				if c6 == 'n' {
+					frame.Consume()
+					c7 = frame.Peek()
+					if frame.Flow == 0 {
+						if c7 == 'o' {
+							frame.Consume()
+							c8 = frame.Peek()
+							if frame.Flow == 0 {
+								if c8 == 'i' {
+									frame.Consume()
+									c9 = frame.Peek()
+									if frame.Flow == 0 {
+										if c9 == 'n' {
+											frame.Consume()
+											c10 = frame.Peek()
+											if frame.Flow == 0 {
+												if c10 == 'l' {
+													frame.Consume()
+													c11 = frame.Peek()
+													if frame.Flow == 0 {
+														if c11 == 'i' {
+															frame.Consume()
+															c12 = frame.Peek()
+															if frame.Flow == 0 {
+																if c12 == 'n' {
+																	frame.Consume()
+																	c13 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c13 == 'e' {
+																			frame.Consume()
+																		} else {
+																			frame.Fail()
+																			break block1
+																		}
+																	} else {
+																		break block1
+																	}
+																} else {
+																	frame.Fail()
+																	break block1
+																}
+															} else {
+																break block1
+															}
+														} else {
+															frame.Fail()
+															break block1
+														}
+													} else {
+														break block1
+													}
+												} else {
+													frame.Fail()
+													break block1
+												}
+											} else {
+												break block1
+											}
+										} else {
+											frame.Fail()
+											break block1
+										}
+									} else {
+										break block1
+									}
+								} else {
+									frame.Fail()
+									break block1
+								}
+							} else {
+								break block1
+							}
+						} else {
+							frame.Fail()
+							break block1
+						}
+					} else {
+						break block1
+					}
+				} else {
+					frame.Fail()
+					break block1
+				}
+			} else {
+				break block1
+			}
+			break
+		}
+		slice = frame.Slice(begin, frame.Checkpoint())
+		EndKeyword(frame)
+		if frame.Flow == 0 {
+			S(frame)
+			ret = slice
+			return
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	ret = ""
+	return
+}
+
+func ParseStructDecl(frame *runtime.State) (ret *StructDecl) {
+	var exported bool
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var name *Id
+	var c_b bool
+	var checkpoint0 int
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	var c10 rune
+	var c11 rune
+	var scoped bool
+	var contains0 []ASTTypeRef
+	var checkpoint1 int
+	var c12 rune
+	var c13 rune
+	var c14 rune
+	var c15 rune
+	var c16 rune
+	var c17 rune
+	var c18 rune
+	var c19 rune
+	var contains1 []ASTTypeRef
+	var contains2 []ASTTypeRef
+	var contains3 []ASTTypeRef
+	var impl0 ASTTypeRef
+	var checkpoint2 int
+	var c20 rune
+	var c21 rune
+	var c22 rune
+	var c23 rune
+	var c24 rune
+	var c25 rune
+	var c26 rune
+	var c27 rune
+	var c28 rune
+	var c29 rune
+	var checkpoint3 int
+	var r0 ASTTypeRef
+	var impl1 ASTTypeRef
+	var r1 *ListTypeRef
+	var impl2 ASTTypeRef
+	var impl3 ASTTypeRef
+	var c30 rune
+	var fields []*FieldDecl
+	var checkpoint4 int
+	var fn *Id
+	var checkpoint5 int
+	var r2 ASTTypeRef
+	var ft ASTTypeRef
+	var r3 *ListTypeRef
+	var c31 rune
+	var cond0 bool
+	var cond1 bool
+	var cond2 bool
+	var cond3 bool
+	exported = ParseExport(frame)
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 's' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 't' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'r' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'u' {
+									frame.Consume()
+									c4 = frame.Peek()
+									if frame.Flow == 0 {
+										if c4 == 'c' {
+											frame.Consume()
+											c5 = frame.Peek()
+											if frame.Flow == 0 {
+												if c5 == 't' {
+													frame.Consume()
+													EndKeyword(frame)
+													if frame.Flow == 0 {
+														S(frame)
+														name = Ident(frame)
+														if frame.Flow == 0 {
+															S(frame)
+															c_b = false
+															checkpoint0 = frame.Checkpoint()
+															c6 = frame.Peek()
+															cond0 = frame.Flow == 0
+														block0:
+															for {
+																if cond0 {
+																	if c6 == 's' {
+																		frame.Consume()
+																		c7 = frame.Peek()
+																		if frame.Flow == 0 {
+																			if c7 == 'c' {
+																				frame.Consume()
+																				c8 = frame.Peek()
+																				if frame.Flow == 0 {
+																					if c8 == 'o' {
+																						frame.Consume()
+																						c9 = frame.Peek()
+																						if frame.Flow == 0 {
+																							if c9 == 'p' {
+																								frame.Consume()
+																								c10 = frame.Peek()
+																								if frame.Flow == 0 {
+																									if c10 == 'e' {
+																										frame.Consume()
+																										c11 = frame.Peek()
+																										if frame.Flow == 0 {
+																											if c11 == 'd' {
+																												frame.Consume()
+																												EndKeyword(frame)
+																												if frame.Flow == 0 {
+																													S(frame)
+																													scoped = true
+																													break block0
+																												}
+																											} else {
+																												frame.Fail()
+																											}
+																										}
+																									} else {
+																										frame.Fail()
+																									}
+																								}
+																							} else {
+																								frame.Fail()
+																							}
+																						}
+																					} else {
+																						frame.Fail()
+																					}
+																				}
+																			} else {
+																				frame.Fail()
+																			}
+																		}
+																	} else {
+																		frame.Fail()
+																	}
+																}
+																frame.Recover(checkpoint0)
+																scoped = c_b
+																break
+															}
+															contains0 = []ASTTypeRef{}
+															checkpoint1 = frame.Checkpoint()
+															c12 = frame.Peek()
+															cond1 = frame.Flow == 0
+														block1:
+															for {
+																if cond1 {
+																	if c12 == 'c' {
+																		frame.Consume()
+																		c13 = frame.Peek()
+																		if frame.Flow == 0 {
+																			if c13 == 'o' {
+																				frame.Consume()
+																				c14 = frame.Peek()
+																				if frame.Flow == 0 {
+																					if c14 == 'n' {
+																						frame.Consume()
+																						c15 = frame.Peek()
+																						if frame.Flow == 0 {
+																							if c15 == 't' {
+																								frame.Consume()
+																								c16 = frame.Peek()
+																								if frame.Flow == 0 {
+																									if c16 == 'a' {
+																										frame.Consume()
+																										c17 = frame.Peek()
+																										if frame.Flow == 0 {
+																											if c17 == 'i' {
+																												frame.Consume()
+																												c18 = frame.Peek()
+																												if frame.Flow == 0 {
+																													if c18 == 'n' {
+																														frame.Consume()
+																														c19 = frame.Peek()
+																														if frame.Flow == 0 {
+																															if c19 == 's' {
+																																frame.Consume()
+																																EndKeyword(frame)
+																																if frame.Flow == 0 {
+																																	S(frame)
+																																	contains1 = ParseParenthTypeList(frame)
+																																	if frame.Flow == 0 {
+																																		S(frame)
+																																		contains2 = contains1
+																																		break block1
+																																	}
+																																	contains3 = contains0
+																																} else {
+																																	contains3 = contains0
+																																}
+																															} else {
+																																frame.Fail()
+																																contains3 = contains0
+																															}
+																														} else {
+																															contains3 = contains0
+																														}
+																													} else {
+																														frame.Fail()
+																														contains3 = contains0
+																													}
+																												} else {
+																													contains3 = contains0
+																												}
+																											} else {
+																												frame.Fail()
+																												contains3 = contains0
+																											}
+																										} else {
+																											contains3 = contains0
+																										}
+																									} else {
+																										frame.Fail()
+																										contains3 = contains0
+																									}
+																								} else {
+																									contains3 = contains0
+																								}
+																							} else {
+																								frame.Fail()
+																								contains3 = contains0
+																							}
+																						} else {
+																							contains3 = contains0
+																						}
+																					} else {
+																						frame.Fail()
+																						contains3 = contains0
+																					}
+																				} else {
+																					contains3 = contains0
+																				}
+																			} else {
+																				frame.Fail()
+																				contains3 = contains0
+																			}
+																		} else {
+																			contains3 = contains0
+																		}
+																	} else {
+																		frame.Fail()
+																		contains3 = contains0
+																	}
+																} else {
+																	contains3 = contains0
+																}
+																frame.Recover(checkpoint1)
+																contains2 = contains3
+																break
+															}
+															impl0 = nil
+															checkpoint2 = frame.Checkpoint()
+															c20 = frame.Peek()
+															cond2 = frame.Flow == 0
+														block3:
+															for {
+															block2:
+																for {
+																	if cond2 {
+																		if c20 == 'i' {
+																			frame.Consume()
+																			c21 = frame.Peek()
+																			if frame.Flow == 0 {
+																				if c21 == 'm' {
+																					frame.Consume()
+																					c22 = frame.Peek()
+																					if frame.Flow == 0 {
+																						if c22 == 'p' {
+																							frame.Consume()
+																							c23 = frame.Peek()
+																							if frame.Flow == 0 {
+																								if c23 == 'l' {
+																									frame.Consume()
+																									c24 = frame.Peek()
+																									if frame.Flow == 0 {
+																										if c24 == 'e' {
+																											frame.Consume()
+																											c25 = frame.Peek()
+																											if frame.Flow == 0 {
+																												if c25 == 'm' {
+																													frame.Consume()
+																													c26 = frame.Peek()
+																													if frame.Flow == 0 {
+																														if c26 == 'e' {
+																															frame.Consume()
+																															c27 = frame.Peek()
+																															if frame.Flow == 0 {
+																																if c27 == 'n' {
+																																	frame.Consume()
+																																	c28 = frame.Peek()
+																																	if frame.Flow == 0 {
+																																		if c28 == 't' {
+																																			frame.Consume()
+																																			c29 = frame.Peek()
+																																			if frame.Flow == 0 {
+																																				if c29 == 's' {
+																																					frame.Consume()
+																																					EndKeyword(frame)
+																																					if frame.Flow == 0 {
+																																						S(frame)
+																																						checkpoint3 = frame.Checkpoint()
+																																						r0 = ParseStructTypeRef(frame)
+																																						if frame.Flow == 0 {
+																																							impl1 = r0
+																																						} else {
+																																							frame.Recover(checkpoint3)
+																																							r1 = ParseListTypeRef(frame)
+																																							if frame.Flow == 0 {
+																																								impl1 = r1
+																																							} else {
+																																								impl3 = impl0
+																																								break block2
+																																							}
+																																						}
+																																						S(frame)
+																																						impl2 = impl1
+																																						break block3
+																																					}
+																																					impl3 = impl0
+																																				} else {
+																																					frame.Fail()
+																																					impl3 = impl0
+																																				}
+																																			} else {
+																																				impl3 = impl0
+																																			}
+																																		} else {
+																																			frame.Fail()
+																																			impl3 = impl0
+																																		}
+																																	} else {
+																																		impl3 = impl0
+																																	}
+																																} else {
+																																	frame.Fail()
+																																	impl3 = impl0
+																																}
+																															} else {
+																																impl3 = impl0
+																															}
+																														} else {
+																															frame.Fail()
+																															impl3 = impl0
+																														}
+																													} else {
+																														impl3 = impl0
+																													}
+																												} else {
+																													frame.Fail()
+																													impl3 = impl0
+																												}
+																											} else {
+																												impl3 = impl0
+																											}
+																										} else {
+																											frame.Fail()
+																											impl3 = impl0
+																										}
+																									} else {
+																										impl3 = impl0
+																									}
+																								} else {
+																									frame.Fail()
+																									impl3 = impl0
+																								}
+																							} else {
+																								impl3 = impl0
+																							}
+																						} else {
+																							frame.Fail()
+																							impl3 = impl0
+																						}
+																					} else {
+																						impl3 = impl0
+																					}
+																				} else {
+																					frame.Fail()
+																					impl3 = impl0
+																				}
+																			} else {
+																				impl3 = impl0
+																			}
+																		} else {
+																			frame.Fail()
+																			impl3 = impl0
+																		}
+																	} else {
+																		impl3 = impl0
+																	}
+																	break
+																}
+																frame.Recover(checkpoint2)
+																impl2 = impl3
+																break
+															}
+															c30 = frame.Peek()
+															if frame.Flow == 0 {
+																if c30 == '{' {
+																	frame.Consume()
+																	S(frame)
+																	fields = []*FieldDecl{}
+																loop5:
+																	for {
+																		checkpoint4 = frame.Checkpoint()
+																		fn = Ident(frame)
+																		cond3 = frame.Flow == 0
+																	block4:
+																		for {
+																			if cond3 {
+																				S(frame)
+																				checkpoint5 = frame.Checkpoint()
+																				r2 = ParseStructTypeRef(frame)
+																				if frame.Flow == 0 {
+																					ft = r2
+																				} else {
+																					frame.Recover(checkpoint5)
+																					r3 = ParseListTypeRef(frame)
+																					if frame.Flow == 0 {
+																						ft = r3
+																					} else {
+																						break block4
+																					}
+																				}
+																				S(frame)
+																				fields = append(fields, &FieldDecl{Name: fn, Type: ft})
+																				continue loop5
+																			}
+																			break
+																		}
+																		frame.Recover(checkpoint4)
+																		c31 = frame.Peek()
+																		if frame.Flow == 0 {
+																			if c31 == '}' {
+																				frame.Consume()
+																				ret = &StructDecl{Name: name, Export: exported, Implements: impl2, Fields: fields, Scoped: scoped, Contains: contains2}
+																				return
+																			}
+																			frame.Fail()
+																			return
+																		}
+																		return
+																	}
+																}
+																frame.Fail()
+																return
+															}
+															return
+														}
+														return
+													}
+													return
+												}
+												frame.Fail()
+												return
+											}
+											return
+										}
+										frame.Fail()
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseTemplateParam(frame *runtime.State) (ret *TemplateParam) {
+	var r *Id
+	r = Ident(frame)
+	if frame.Flow == 0 {
+		ret = &TemplateParam{Name: r}
+		return
+	}
+	return
+}
+
+func ParseTemplateParamList(frame *runtime.State) (ret []*TemplateParam) {
+	var tparams0 []*TemplateParam
+	var checkpoint0 int
+	var c0 rune
+	var r0 *Id
+	var tparams1 []*TemplateParam
+	var tparams2 []*TemplateParam
+	var checkpoint1 int
+	var c1 rune
+	var r1 *Id
+	var tparams3 []*TemplateParam
+	var tparams4 []*TemplateParam
+	var c2 rune
+	var tparams5 []*TemplateParam
+	var tparams6 []*TemplateParam
+	var cond bool
+	tparams0 = []*TemplateParam{}
+	checkpoint0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block1:
+	for {
+	block2:
+		for {
+			if cond {
+				if c0 == '<' {
+					frame.Consume()
+					S(frame)
+					r0 = Ident(frame)
+					if frame.Flow == 0 {
+						tparams1 = append(tparams0, &TemplateParam{Name: r0})
+						S(frame)
+						tparams2 = tparams1
+					loop0:
+						for {
+							checkpoint1 = frame.Checkpoint()
+							c1 = frame.Peek()
+							if frame.Flow == 0 {
+								if c1 == ',' {
+									frame.Consume()
+									S(frame)
+									r1 = Ident(frame)
+									if frame.Flow == 0 {
+										tparams3 = append(tparams2, &TemplateParam{Name: r1})
+										S(frame)
+										tparams2 = tparams3
+										continue loop0
+									}
+									tparams4 = tparams2
+								} else {
+									frame.Fail()
+									tparams4 = tparams2
+								}
+							} else {
+								tparams4 = tparams2
+							}
+							frame.Recover(checkpoint1)
+							c2 = frame.Peek()
+							if frame.Flow == 0 {
+								if c2 == '>' {
+									frame.Consume()
+									tparams5 = tparams4
+									break block1
+								}
+								frame.Fail()
+								tparams6 = tparams4
+								break block2
+							}
+							tparams6 = tparams4
+							break block2
+						}
+					}
+					tparams6 = tparams0
+				} else {
+					frame.Fail()
+					tparams6 = tparams0
+				}
+			} else {
+				tparams6 = tparams0
+			}
+			break
+		}
+		frame.Recover(checkpoint0)
+		tparams5 = tparams6
+		break
+	}
+	ret = tparams5
+	return
+}
+
+func ParseParam(frame *runtime.State) (ret *Param) {
+	var name *Id
+	var checkpoint int
+	var r0 ASTTypeRef
+	var type0 ASTTypeRef
+	var r1 *ListTypeRef
+	name = Ident(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		checkpoint = frame.Checkpoint()
+		r0 = ParseStructTypeRef(frame)
+		if frame.Flow == 0 {
+			type0 = r0
+		} else {
+			frame.Recover(checkpoint)
+			r1 = ParseListTypeRef(frame)
+			if frame.Flow == 0 {
+				type0 = r1
+			} else {
+				return
+			}
+		}
+		ret = &Param{Name: name, Type: type0}
+		return
+	}
+	return
+}
+
+func ParseParamList(frame *runtime.State) (ret []*Param) {
+	var params0 []*Param
+	var checkpoint0 int
+	var r0 *Param
+	var params1 []*Param
+	var checkpoint1 int
+	var c rune
+	var r1 *Param
+	var params2 []*Param
+	var cond bool
+	params0 = []*Param{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseParam(frame)
+	cond = frame.Flow == 0
+block1:
+	for {
+		if cond {
+			params1 = append(params0, r0)
+		loop0:
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				c = frame.Peek()
+				if frame.Flow == 0 {
+					if c == ',' {
+						frame.Consume()
+						S(frame)
+						r1 = ParseParam(frame)
+						if frame.Flow == 0 {
+							params1 = append(params1, r1)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				params2 = params1
+				break block1
+			}
+		}
+		frame.Recover(checkpoint0)
+		params2 = params0
+		break
+	}
+	ret = params2
+	return
+}
+
+func ParseFuncDecl(frame *runtime.State) (ret *FuncDecl) {
+	var exported bool
+	var inline string
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var name *Id
+	var tparams []*TemplateParam
+	var c4 rune
+	var params []*Param
+	var c5 rune
+	var retTypes []ASTTypeRef
+	var block []ASTExpr
+	exported = ParseExport(frame)
+	inline = ParseInlineHint(frame)
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'f' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'u' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'n' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'c' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										name = Ident(frame)
+										if frame.Flow == 0 {
+											S(frame)
+											tparams = ParseTemplateParamList(frame)
+											S(frame)
+											c4 = frame.Peek()
+											if frame.Flow == 0 {
+												if c4 == '(' {
+													frame.Consume()
+													S(frame)
+													params = ParseParamList(frame)
+													S(frame)
+													c5 = frame.Peek()
+													if frame.Flow == 0 {
+														if c5 == ')' {
+															frame.Consume()
+															S(frame)
+															retTypes = ParseReturnTypeList(frame)
+															S(frame)
+															block = ParseCodeBlock(frame)
+															if frame.Flow == 0 {
+																ret = &FuncDecl{Name: name, Export: exported, Inline: inline, TemplateParams: tparams, Params: params, ReturnTypes: retTypes, Block: block, LocalInfo_Scope: &LocalInfo_Scope{}}
+																return
+															}
+															return
+														}
+														frame.Fail()
+														return
+													}
+													return
+												}
+												frame.Fail()
+												return
+											}
+											return
+										}
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseMatchState(frame *runtime.State) (ret string) {
+	var checkpoint0 int
+	var begin int
+	var checkpoint1 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	var slice string
+	var cond bool
+	checkpoint0 = frame.Checkpoint()
+	begin = frame.Checkpoint()
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block1:
+	for {
+	block0:
+		for {
+			if cond {
+				if c0 == 'N' {
+					frame.Consume()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 == 'O' {
+							frame.Consume()
+							c2 = frame.Peek()
+							if frame.Flow == 0 {
+								if c2 == 'R' {
+									frame.Consume()
+									c3 = frame.Peek()
+									if frame.Flow == 0 {
+										if c3 == 'M' {
+											frame.Consume()
+											c4 = frame.Peek()
+											if frame.Flow == 0 {
+												if c4 == 'A' {
+													frame.Consume()
+													c5 = frame.Peek()
+													if frame.Flow == 0 {
+														if c5 == 'L' {
+															frame.Consume()
+															break block0
+														}
+														frame.Fail()
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c6 = frame.Peek()
+			if frame.Flow == 0 {
+				if c6 == 'F' {
+					frame.Consume()
+					c7 = frame.Peek()
+					if frame.Flow == 0 {
+						if c7 == 'A' {
+							frame.Consume()
+							c8 = frame.Peek()
+							if frame.Flow == 0 {
+								if c8 == 'I' {
+									frame.Consume()
+									c9 = frame.Peek()
+									if frame.Flow == 0 {
+										if c9 == 'L' {
+											frame.Consume()
+										} else {
+											frame.Fail()
+											break block1
+										}
+									} else {
+										break block1
+									}
+								} else {
+									frame.Fail()
+									break block1
+								}
+							} else {
+								break block1
+							}
+						} else {
+							frame.Fail()
+							break block1
+						}
+					} else {
+						break block1
+					}
+				} else {
+					frame.Fail()
+					break block1
+				}
+			} else {
+				break block1
+			}
+			break
+		}
+		slice = frame.Slice(begin, frame.Checkpoint())
+		EndKeyword(frame)
+		if frame.Flow == 0 {
+			ret = slice
+			return
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	ret = "NORMAL"
+	return
+}
+
+func ParseTest(frame *runtime.State) (ret *Test) {
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var name *Id
+	var r ASTExpr
+	var input string
+	var flow string
+	var d Destructure
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 't' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'e' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 's' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 't' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										name = Ident(frame)
+										if frame.Flow == 0 {
+											S(frame)
+											r = ParseBinaryOp(frame, 1)
+											if frame.Flow == 0 {
+												S(frame)
+												input = DecodeString(frame)
+												if frame.Flow == 0 {
+													S(frame)
+													flow = ParseMatchState(frame)
+													S(frame)
+													d = ParseDestructure(frame)
+													if frame.Flow == 0 {
+														ret = &Test{Name: name, Rule: r, Input: input, Flow: flow, Destructure: d}
+														return
+													}
+													return
+												}
+												return
+											}
+											return
+										}
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseImports(frame *runtime.State) (ret []*ImportDecl) {
+	var imports0 []*ImportDecl
+	var checkpoint0 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var imports1 []*ImportDecl
+	var checkpoint1 int
+	var r *StringLiteral
+	var imports2 []*ImportDecl
+	var imports3 []*ImportDecl
+	var c7 rune
+	var imports4 []*ImportDecl
+	var imports5 []*ImportDecl
+	var cond bool
+	imports0 = []*ImportDecl{}
+	checkpoint0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block0:
+	for {
+	block1:
+		for {
+			if cond {
+				if c0 == 'i' {
+					frame.Consume()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 == 'm' {
+							frame.Consume()
+							c2 = frame.Peek()
+							if frame.Flow == 0 {
+								if c2 == 'p' {
+									frame.Consume()
+									c3 = frame.Peek()
+									if frame.Flow == 0 {
+										if c3 == 'o' {
+											frame.Consume()
+											c4 = frame.Peek()
+											if frame.Flow == 0 {
+												if c4 == 'r' {
+													frame.Consume()
+													c5 = frame.Peek()
+													if frame.Flow == 0 {
+														if c5 == 't' {
+															frame.Consume()
+															EndKeyword(frame)
+															if frame.Flow == 0 {
+																S(frame)
+																c6 = frame.Peek()
+																if frame.Flow == 0 {
+																	if c6 == '(' {
+																		frame.Consume()
+																		S(frame)
+																		imports1 = imports0
+																		for {
+																			checkpoint1 = frame.Checkpoint()
+																			r = ParseStringLiteral(frame)
+																			if frame.Flow == 0 {
+																				imports2 = append(imports1, &ImportDecl{Path: r})
+																				S(frame)
+																				imports1 = imports2
+																			} else {
+																				imports3 = imports1
+																				frame.Recover(checkpoint1)
+																				c7 = frame.Peek()
+																				if frame.Flow == 0 {
+																					if c7 == ')' {
+																						frame.Consume()
+																						imports4 = imports3
+																						break block0
+																					}
+																					frame.Fail()
+																					imports5 = imports3
+																					break block1
+																				}
+																				imports5 = imports3
+																				break block1
+																			}
+																		}
+																	}
+																	frame.Fail()
+																	imports5 = imports0
+																} else {
+																	imports5 = imports0
+																}
+															} else {
+																imports5 = imports0
+															}
+														} else {
+															frame.Fail()
+															imports5 = imports0
+														}
+													} else {
+														imports5 = imports0
+													}
+												} else {
+													frame.Fail()
+													imports5 = imports0
+												}
+											} else {
+												imports5 = imports0
+											}
+										} else {
+											frame.Fail()
+											imports5 = imports0
+										}
+									} else {
+										imports5 = imports0
+									}
+								} else {
+									frame.Fail()
+									imports5 = imports0
+								}
+							} else {
+								imports5 = imports0
+							}
+						} else {
+							frame.Fail()
+							imports5 = imports0
+						}
+					} else {
+						imports5 = imports0
+					}
+				} else {
+					frame.Fail()
+					imports5 = imports0
+				}
+			} else {
+				imports5 = imports0
+			}
+			break
+		}
+		frame.Recover(checkpoint0)
+		imports4 = imports5
+		break
+	}
+	ret = imports4
+	return
+}
+
+func ParseFile(frame *runtime.State) (ret *File) {
+	var decls0 []ASTDecl
+	var tests0 []*Test
+	var imports []*ImportDecl
+	var decls1 []ASTDecl
+	var tests1 []*Test
+	var checkpoint0 int
+	var checkpoint1 int
+	var r0 *FuncDecl
+	var decls2 []ASTDecl
+	var tests2 []*Test
+	var r1 *StructDecl
+	var r2 *Test
+	var decls3 []ASTDecl
+	var tests3 []*Test
+	var checkpoint2 int
+	decls0 = []ASTDecl{}
+	tests0 = []*Test{}
+	S(frame)
+	imports = ParseImports(frame)
+	S(frame)
+	decls1, tests1 = decls0, tests0
+	for {
+		checkpoint0 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		r0 = ParseFuncDecl(frame)
+		if frame.Flow == 0 {
+			decls2, tests2 = append(decls1, r0), tests1
+		} else {
+			frame.Recover(checkpoint1)
+			r1 = ParseStructDecl(frame)
+			if frame.Flow == 0 {
+				decls2, tests2 = append(decls1, r1), tests1
+			} else {
+				frame.Recover(checkpoint1)
+				r2 = ParseTest(frame)
+				if frame.Flow == 0 {
+					decls2, tests2 = decls1, append(tests1, r2)
+				} else {
+					decls3, tests3 = decls1, tests1
+					frame.Recover(checkpoint0)
+					checkpoint2 = frame.LookaheadBegin()
+					frame.Peek()
+					if frame.Flow == 0 {
+						frame.Consume()
+						frame.LookaheadFail(checkpoint2)
+						return
+					}
+					frame.LookaheadNormal(checkpoint2)
+					ret = &File{Imports: imports, Decls: decls3, Tests: tests3}
+					return
+				}
+			}
+		}
+		S(frame)
+		decls1, tests1 = decls2, tests2
+	}
+}
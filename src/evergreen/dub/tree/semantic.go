@@ -5,6 +5,8 @@ import (
 	"evergreen/dub/core"
 	"fmt"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 type tupleLUT struct {
@@ -165,6 +167,13 @@ func IsDiscard(name string) bool {
 	return name == "_"
 }
 
+// IsExportedName follows the Go convention: capitalized names are visible
+// outside the package that declares them.
+func IsExportedName(name string) bool {
+	r, _ := utf8.DecodeRuneInString(name)
+	return unicode.IsUpper(r)
+}
+
 func createLocal(ctx *semanticPassContext, decl *FuncDecl, name *Id, t core.DubType, scope *semanticScope) *LocalInfo {
 	info, exists := scope.localInfo(name.Text)
 	if exists {
@@ -541,6 +550,10 @@ func semanticExprPass(ctx *semanticPassContext, decl *FuncDecl, expr ASTExpr, sc
 				ctx.Status.LocationError(expr.Pos, fmt.Sprintf("unknown name %#v", expr.Name.Text))
 				return expr, unresolvedType
 			}
+			if !isExported(child) {
+				ctx.Status.LocationError(expr.Name.Pos, fmt.Sprintf("%#v is not exported by package %#v", expr.Name.Text, strings.Join(e.Package.Path, "/")))
+				return expr, unresolvedType
+			}
 			return rewriteNamedLookup(child)
 		default:
 			panic(e)
@@ -655,6 +668,10 @@ func semanticTypePass(ctx *semanticPassContext, node ASTTypeRef) (ASTTypeRef, co
 			ctx.Status.LocationError(node.Name.Pos, fmt.Sprintf("Could not resolve name %#v", name))
 			return node, unresolvedType
 		}
+		if !isExported(d) {
+			ctx.Status.LocationError(node.Name.Pos, fmt.Sprintf("%#v is not exported by package %#v", name, strings.Join(scope.Path, "/")))
+			return node, unresolvedType
+		}
 		t, ok := asType(d)
 		if !ok {
 			ctx.Status.LocationError(node.Name.Pos, fmt.Sprintf("%#v is not a type", name))
@@ -860,6 +877,24 @@ type namedPackage struct {
 func (element *namedPackage) isNamedElement() {
 }
 
+// Only the declarations of a package can be seen from other packages.  Imports
+// are not re-exported.
+func isExported(node namedElement) bool {
+	switch node := node.(type) {
+	case *namedType:
+		st, ok := node.T.(*core.StructType)
+		return ok && st.Exported
+	case *namedCallable:
+		f, ok := node.Func.(*core.Function)
+		return ok && f.Exported
+	case *namedCallableTemplate:
+		f, ok := node.Func.(*core.FunctionTemplate)
+		return ok && f.Exported
+	default:
+		return false
+	}
+}
+
 func asPackage(node namedElement) (*ModuleScope, bool) {
 	switch node := node.(type) {
 	case *namedPackage:
@@ -1024,6 +1059,7 @@ type semanticPassContext struct {
 	Program        *ProgramScope
 	Module         *ModuleScope
 	ModuleContexts []*semanticPassContext
+	Deps           *PackageGraph
 	Status         compiler.PassStatus
 	Core           *core.CoreProgram
 	Functions      []*FuncDecl
@@ -1036,23 +1072,20 @@ func resolveImport(ctx *semanticPassContext, imp *ImportDecl) {
 	path := imp.Path.Value
 	parts := strings.Split(path, "/")
 
-	// HACK O(n^2)
-	for _, other := range ctx.ModuleContexts {
-		otherPath := other.Module.Path
-		found := strings.Join(otherPath, "/") == path
-		if found {
-			name := parts[len(parts)-1]
-			// HACK should use file-local namespace.
-			_, exists := ctx.Module.Namespace[name]
-			if exists {
-				ctx.Status.LocationError(pos, fmt.Sprintf("Tried to redefine %#v", name))
-			} else {
-				ctx.Module.Namespace[name] = &namedPackage{Scope: other.Module}
-			}
-			return
-		}
+	index, ok := ctx.Deps.Lookup(path)
+	if !ok {
+		// Missing modules are reported when building the package graph.
+		panic(path)
+	}
+	other := ctx.ModuleContexts[index]
+	name := parts[len(parts)-1]
+	// HACK should use file-local namespace.
+	_, exists := ctx.Module.Namespace[name]
+	if exists {
+		ctx.Status.LocationError(pos, fmt.Sprintf("Tried to redefine %#v", name))
+	} else {
+		ctx.Module.Namespace[name] = &namedPackage{Scope: other.Module}
 	}
-	ctx.Status.LocationError(pos, fmt.Sprintf("cannot find module %#v", path))
 }
 
 func indexModule(ctx *semanticPassContext, pkg *Package) {
@@ -1070,8 +1103,10 @@ func indexModule(ctx *semanticPassContext, pkg *Package) {
 					ctx.Status.LocationError(decl.Name.Pos, fmt.Sprintf("Tried to redefine %#v", name))
 				} else {
 					f := &core.Function{
-						Name: name,
-						File: file.F,
+						Name:     name,
+						Exported: decl.Export || IsExportedName(name),
+						Inline:   decl.Inline,
+						File:     file.F,
 					}
 
 					if len(decl.TemplateParams) == 0 {
@@ -1083,7 +1118,8 @@ func indexModule(ctx *semanticPassContext, pkg *Package) {
 						}
 					} else {
 						f := &core.FunctionTemplate{
-							Name: name,
+							Name:     name,
+							Exported: f.Exported,
 						}
 						ctx.Module.Namespace[name] = &namedCallableTemplate{
 							Func: f,
@@ -1097,7 +1133,8 @@ func indexModule(ctx *semanticPassContext, pkg *Package) {
 					ctx.Status.LocationError(decl.Name.Pos, fmt.Sprintf("Tried to redefine %#v", name))
 				} else {
 					st := &core.StructType{
-						File: file.F,
+						Exported: decl.Export || IsExportedName(name),
+						File:     file.F,
 					}
 					decl.T = st
 					ctx.Core.Structures = append(ctx.Core.Structures, st)
@@ -1108,6 +1145,39 @@ func indexModule(ctx *semanticPassContext, pkg *Package) {
 			}
 		}
 	}
+	checkExportedNames(ctx, pkg)
+}
+
+// Exported declarations are capitalized in the generated code, so an exported
+// name must not become another name in the same package.
+func checkExportedNames(ctx *semanticPassContext, pkg *Package) {
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			var name *Id
+			switch decl := decl.(type) {
+			case *FuncDecl:
+				if !decl.Export {
+					continue
+				}
+				name = decl.Name
+			case *StructDecl:
+				if !decl.Export {
+					continue
+				}
+				name = decl.Name
+			default:
+				panic(decl)
+			}
+			r, size := utf8.DecodeRuneInString(name.Text)
+			exported := string(unicode.ToUpper(r)) + name.Text[size:]
+			if exported == name.Text {
+				continue
+			}
+			if _, exists := ctx.Module.Namespace[exported]; exists {
+				ctx.Status.LocationError(name.Pos, fmt.Sprintf("%#v is exported as %#v, which is already declared", name.Text, exported))
+			}
+		}
+	}
 }
 
 func resolveSignatures(ctx *semanticPassContext, pkg *Package) {
@@ -1142,12 +1212,13 @@ func semanticModulePass(ctx *semanticPassContext, pkg *Package) {
 			}
 		}
 		for _, tst := range file.Tests {
+			tst.File = file.F
 			semanticTestPass(ctx, tst)
 		}
 	}
 }
 
-func SemanticPass(program *Program, status compiler.PassStatus) *core.CoreProgram {
+func SemanticPass(program *Program, deps *PackageGraph, status compiler.PassStatus) *core.CoreProgram {
 	status.Begin()
 	defer status.End()
 
@@ -1193,6 +1264,7 @@ func SemanticPass(program *Program, status compiler.PassStatus) *core.CoreProgra
 			Program:        programScope,
 			Module:         moduleScope,
 			ModuleContexts: ctxs,
+			Deps:           deps,
 			Status:         status,
 			Core:           coreProg,
 			Memo:           memo,
@@ -1200,20 +1272,21 @@ func SemanticPass(program *Program, status compiler.PassStatus) *core.CoreProgra
 		}
 	}
 
-	for i, pkg := range program.Packages {
-		indexModule(ctxs[i], pkg)
+	// Dependencies are processed before the packages that import them.
+	for _, i := range deps.Order {
+		indexModule(ctxs[i], program.Packages[i])
 	}
 	if status.ShouldHalt() {
 		return nil
 	}
-	for i, pkg := range program.Packages {
-		resolveSignatures(ctxs[i], pkg)
+	for _, i := range deps.Order {
+		resolveSignatures(ctxs[i], program.Packages[i])
 	}
 	if status.ShouldHalt() {
 		return nil
 	}
-	for i, pkg := range program.Packages {
-		semanticModulePass(ctxs[i], pkg)
+	for _, i := range deps.Order {
+		semanticModulePass(ctxs[i], program.Packages[i])
 	}
 	return coreProg
 }
@@ -0,0 +1,80 @@
+package tree
+
+import (
+	"evergreen/assert"
+	"evergreen/compiler"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const exportsSub = `export func id<T>(a T) T {
+  return a
+}
+
+func priv<T>(a T) T {
+  return a
+}
+
+func helper() int {
+  return 1
+}
+
+export func bar() int {
+  return helper()
+}
+
+struct hidden {
+  x int
+}
+
+export struct shown {
+  x int
+}
+`
+
+// Checks a program where the root package uses package sub.
+func checkWithSub(t *testing.T, root string) compiler.CompileStatus {
+	dir, err := ioutil.TempDir("", "exports")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"root.dub":    "import (\n  \"sub\"\n)\n\n" + root,
+		"sub/sub.dub": exportsSub,
+	}
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	DubProgramFrontend(status.Pass("dub_frontend"), p, dir)
+	return status
+}
+
+func TestExports(t *testing.T) {
+	status := checkWithSub(t, "func F(s sub.shown) int {\n  sub.id\n  return sub.bar()\n}\n")
+	assert.IntEquals(t, status.ErrorCount(), 0)
+
+	for _, root := range []string{
+		"func F() int {\n  return sub.helper()\n}\n",
+		"func F() {\n  sub.priv\n}\n",
+		"func F(h sub.hidden) {\n}\n",
+		// Both would be called Bar in Go.
+		"export func bar() {\n}\n\nfunc Bar() {\n}\n",
+		"func Bar() {\n}\n\nexport func bar() {\n}\n",
+		"export struct point {\n}\n\nstruct Point {\n}\n",
+	} {
+		status := checkWithSub(t, root)
+		if status.ErrorCount() != 1 {
+			t.Errorf("expected 1 error, got %d for %#v", status.ErrorCount(), root)
+		}
+	}
+}
@@ -30,6 +30,7 @@ type TypeDefType struct {
 	Name    string
 	Type    GoType
 	Package *Package
+	File    *File
 }
 
 func (node *TypeDefType) isGoType() {
@@ -52,6 +53,7 @@ type StructType struct {
 	Name    string
 	Fields  []*Field
 	Package *Package
+	File    *File
 	Methods []*Function
 }
 
@@ -62,6 +64,7 @@ type InterfaceType struct {
 	Name    string
 	Fields  []*Field
 	Package *Package
+	File    *File
 }
 
 func (node *InterfaceType) isGoType() {
@@ -91,6 +94,7 @@ type Function_Scope struct {
 type Function struct {
 	Name    string
 	Package *Package
+	File    *File
 	Index   Function_Ref
 }
 
@@ -113,11 +117,25 @@ type Package_Scope struct {
 type Package struct {
 	Path      []string
 	Extern    bool
+	Files     []*File
 	Functions []*Function
 	Index     Package_Ref
 }
 
+type File_Ref uint32
+
+type File_Scope struct {
+	objects []*File
+}
+
+type File struct {
+	Name    string
+	Package *Package
+	Index   File_Ref
+}
+
 type CoreProgram struct {
 	Package_Scope  *Package_Scope
+	File_Scope     *File_Scope
 	Function_Scope *Function_Scope
 }
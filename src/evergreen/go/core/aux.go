@@ -49,6 +49,28 @@ func (iter *packageIterator) Value() (Package_Ref, *Package) {
 	return Package_Ref(iter.current), iter.scope.objects[iter.current]
 }
 
+func (scope *File_Scope) Get(ref File_Ref) *File {
+	if scope.objects[ref].Index != ref {
+		panic(scope.objects[ref].Index)
+	}
+	return scope.objects[ref]
+}
+
+func (scope *File_Scope) Register(info *File) *File {
+	info.Index = File_Ref(len(scope.objects))
+	scope.objects = append(scope.objects, info)
+	return info
+}
+
+func (scope *File_Scope) Len() int {
+	return len(scope.objects)
+}
+
+func InsertFileIntoPackage(coreProg *CoreProgram, p *Package, f *File) {
+	f.Package = p
+	p.Files = append(p.Files, f)
+}
+
 func (scope *Function_Scope) Get(ref Function_Ref) *Function {
 	if scope.objects[ref].Index != ref {
 		panic(scope.objects[ref].Index)
@@ -0,0 +1,977 @@
+package core
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func DecodeGoTypeBinary(d *runtime.BinaryDecoder) GoType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/PointerType" {
+		return readPointerTypeBinary(d, o)
+	}
+	if t == "go/core/SliceType" {
+		return readSliceTypeBinary(d, o)
+	}
+	if t == "go/core/ExternalType" {
+		return readExternalTypeBinary(d, o)
+	}
+	if t == "go/core/TypeDefType" {
+		return readTypeDefTypeBinary(d, o)
+	}
+	if t == "go/core/FuncType" {
+		return readFuncTypeBinary(d, o)
+	}
+	if t == "go/core/StructType" {
+		return readStructTypeBinary(d, o)
+	}
+	if t == "go/core/InterfaceType" {
+		return readInterfaceTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *PointerType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/PointerType") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Element)
+}
+
+func (node *PointerType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Element = DecodeGoTypeBinary(d)
+}
+
+func readPointerTypeBinary(d *runtime.BinaryDecoder, o interface{}) *PointerType {
+	var node *PointerType
+	if o != nil {
+		return o.(*PointerType)
+	}
+	node = &PointerType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *PointerType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9355f61c3717fd1d")
+}
+
+func (node *PointerType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9355f61c3717fd1d", "go/core/PointerType", node)
+}
+
+func DecodePointerTypeBinary(d *runtime.BinaryDecoder) *PointerType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/PointerType" {
+		return readPointerTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SliceType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/SliceType") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Element)
+}
+
+func (node *SliceType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Element = DecodeGoTypeBinary(d)
+}
+
+func readSliceTypeBinary(d *runtime.BinaryDecoder, o interface{}) *SliceType {
+	var node *SliceType
+	if o != nil {
+		return o.(*SliceType)
+	}
+	node = &SliceType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SliceType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9355f61c3717fd1d")
+}
+
+func (node *SliceType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9355f61c3717fd1d", "go/core/SliceType", node)
+}
+
+func DecodeSliceTypeBinary(d *runtime.BinaryDecoder) *SliceType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/SliceType" {
+		return readSliceTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ExternalType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/ExternalType") {
+		return
+	}
+	e.WriteString(node.Name)
+	node.Package.EncodeBinary(e)
+}
+
+func (node *ExternalType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Package = DecodePackageBinary(d)
+}
+
+func readExternalTypeBinary(d *runtime.BinaryDecoder, o interface{}) *ExternalType {
+	var node *ExternalType
+	if o != nil {
+		return o.(*ExternalType)
+	}
+	node = &ExternalType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ExternalType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9439aff446c0c0cb")
+}
+
+func (node *ExternalType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9439aff446c0c0cb", "go/core/ExternalType", node)
+}
+
+func DecodeExternalTypeBinary(d *runtime.BinaryDecoder) *ExternalType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/ExternalType" {
+		return readExternalTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeDefType) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/TypeDefType") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+	node.Package.EncodeBinary(e)
+	node.File.EncodeBinary(e)
+}
+
+func (node *TypeDefType) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeGoTypeBinary(d)
+	node.Package = DecodePackageBinary(d)
+	node.File = DecodeFileBinary(d)
+}
+
+func readTypeDefTypeBinary(d *runtime.BinaryDecoder, o interface{}) *TypeDefType {
+	var node *TypeDefType
+	if o != nil {
+		return o.(*TypeDefType)
+	}
+	node = &TypeDefType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeDefType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9355f61c3717fd1d")
+}
+
+func (node *TypeDefType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9355f61c3717fd1d", "go/core/TypeDefType", node)
+}
+
+func DecodeTypeDefTypeBinary(d *runtime.BinaryDecoder) *TypeDefType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/TypeDefType" {
+		return readTypeDefTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FuncType) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 GoType
+	var x1 GoType
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/FuncType") {
+		return
+	}
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x0 = range node.Params {
+			runtime.EncodeBinary(e, x0)
+		}
+	}
+	if node.Results == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Results))
+		for _, x1 = range node.Results {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+}
+
+func (node *FuncType) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []GoType
+	var n1 int
+	var s1 []GoType
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []GoType{}
+		for range n0 {
+			s0 = append(s0, DecodeGoTypeBinary(d))
+		}
+	}
+	node.Params = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []GoType{}
+		for range n1 {
+			s1 = append(s1, DecodeGoTypeBinary(d))
+		}
+	}
+	node.Results = s1
+}
+
+func readFuncTypeBinary(d *runtime.BinaryDecoder, o interface{}) *FuncType {
+	var node *FuncType
+	if o != nil {
+		return o.(*FuncType)
+	}
+	node = &FuncType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FuncType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9355f61c3717fd1d")
+}
+
+func (node *FuncType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9355f61c3717fd1d", "go/core/FuncType", node)
+}
+
+func DecodeFuncTypeBinary(d *runtime.BinaryDecoder) *FuncType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/FuncType" {
+		return readFuncTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Field) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/Field") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *Field) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeGoTypeBinary(d)
+}
+
+func readFieldBinary(d *runtime.BinaryDecoder, o interface{}) *Field {
+	var node *Field
+	if o != nil {
+		return o.(*Field)
+	}
+	node = &Field{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Field) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9355f61c3717fd1d")
+}
+
+func (node *Field) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9355f61c3717fd1d", "go/core/Field", node)
+}
+
+func DecodeFieldBinary(d *runtime.BinaryDecoder) *Field {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/Field" {
+		return readFieldBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StructType) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Field
+	var x1 *Function
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/StructType") {
+		return
+	}
+	e.WriteString(node.Name)
+	if node.Fields == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Fields))
+		for _, x0 = range node.Fields {
+			x0.EncodeBinary(e)
+		}
+	}
+	node.Package.EncodeBinary(e)
+	node.File.EncodeBinary(e)
+	if node.Methods == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Methods))
+		for _, x1 = range node.Methods {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *StructType) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Field
+	var n1 int
+	var s1 []*Function
+	node.Name = d.ReadString()
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Field{}
+		for range n0 {
+			s0 = append(s0, DecodeFieldBinary(d))
+		}
+	}
+	node.Fields = s0
+	node.Package = DecodePackageBinary(d)
+	node.File = DecodeFileBinary(d)
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*Function{}
+		for range n1 {
+			s1 = append(s1, DecodeFunctionBinary(d))
+		}
+	}
+	node.Methods = s1
+}
+
+func readStructTypeBinary(d *runtime.BinaryDecoder, o interface{}) *StructType {
+	var node *StructType
+	if o != nil {
+		return o.(*StructType)
+	}
+	node = &StructType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StructType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9355f61c3717fd1d")
+}
+
+func (node *StructType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9355f61c3717fd1d", "go/core/StructType", node)
+}
+
+func DecodeStructTypeBinary(d *runtime.BinaryDecoder) *StructType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/StructType" {
+		return readStructTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *InterfaceType) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *Field
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/InterfaceType") {
+		return
+	}
+	e.WriteString(node.Name)
+	if node.Fields == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Fields))
+		for _, x = range node.Fields {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Package.EncodeBinary(e)
+	node.File.EncodeBinary(e)
+}
+
+func (node *InterfaceType) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*Field
+	node.Name = d.ReadString()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*Field{}
+		for range n {
+			s = append(s, DecodeFieldBinary(d))
+		}
+	}
+	node.Fields = s
+	node.Package = DecodePackageBinary(d)
+	node.File = DecodeFileBinary(d)
+}
+
+func readInterfaceTypeBinary(d *runtime.BinaryDecoder, o interface{}) *InterfaceType {
+	var node *InterfaceType
+	if o != nil {
+		return o.(*InterfaceType)
+	}
+	node = &InterfaceType{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *InterfaceType) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9355f61c3717fd1d")
+}
+
+func (node *InterfaceType) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9355f61c3717fd1d", "go/core/InterfaceType", node)
+}
+
+func DecodeInterfaceTypeBinary(d *runtime.BinaryDecoder) *InterfaceType {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/InterfaceType" {
+		return readInterfaceTypeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BuiltinTypeIndex) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/BuiltinTypeIndex") {
+		return
+	}
+	node.Int.EncodeBinary(e)
+	node.UInt32.EncodeBinary(e)
+	node.Int64.EncodeBinary(e)
+	node.Float32.EncodeBinary(e)
+	node.Bool.EncodeBinary(e)
+	node.String.EncodeBinary(e)
+	node.Rune.EncodeBinary(e)
+	node.Append.EncodeBinary(e)
+}
+
+func (node *BuiltinTypeIndex) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Int = DecodeExternalTypeBinary(d)
+	node.UInt32 = DecodeExternalTypeBinary(d)
+	node.Int64 = DecodeExternalTypeBinary(d)
+	node.Float32 = DecodeExternalTypeBinary(d)
+	node.Bool = DecodeExternalTypeBinary(d)
+	node.String = DecodeExternalTypeBinary(d)
+	node.Rune = DecodeExternalTypeBinary(d)
+	node.Append = DecodeIntrinsicFunctionBinary(d)
+}
+
+func readBuiltinTypeIndexBinary(d *runtime.BinaryDecoder, o interface{}) *BuiltinTypeIndex {
+	var node *BuiltinTypeIndex
+	if o != nil {
+		return o.(*BuiltinTypeIndex)
+	}
+	node = &BuiltinTypeIndex{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BuiltinTypeIndex) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "a7197879a09a2d46")
+}
+
+func (node *BuiltinTypeIndex) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "a7197879a09a2d46", "go/core/BuiltinTypeIndex", node)
+}
+
+func DecodeBuiltinTypeIndexBinary(d *runtime.BinaryDecoder) *BuiltinTypeIndex {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/BuiltinTypeIndex" {
+		return readBuiltinTypeIndexBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeCallableBinary(d *runtime.BinaryDecoder) Callable {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/Function" {
+		return readFunctionBinary(d, o)
+	}
+	if t == "go/core/IntrinsicFunction" {
+		return readIntrinsicFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Function) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/Function") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	node.Package.EncodeBinary(e)
+	node.File.EncodeBinary(e)
+}
+
+func (node *Function) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = Function_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.Package = DecodePackageBinary(d)
+	node.File = DecodeFileBinary(d)
+}
+
+func readFunctionBinary(d *runtime.BinaryDecoder, o interface{}) *Function {
+	var node *Function
+	if o != nil {
+		return o.(*Function)
+	}
+	node = &Function{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Function) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "585a7bcc067409d1")
+}
+
+func (node *Function) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "585a7bcc067409d1", "go/core/Function", node)
+}
+
+func DecodeFunctionBinary(d *runtime.BinaryDecoder) *Function {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/Function" {
+		return readFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *IntrinsicFunction) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/IntrinsicFunction") {
+		return
+	}
+	e.WriteString(node.Name)
+}
+
+func (node *IntrinsicFunction) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+}
+
+func readIntrinsicFunctionBinary(d *runtime.BinaryDecoder, o interface{}) *IntrinsicFunction {
+	var node *IntrinsicFunction
+	if o != nil {
+		return o.(*IntrinsicFunction)
+	}
+	node = &IntrinsicFunction{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *IntrinsicFunction) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "16619067fe96a319")
+}
+
+func (node *IntrinsicFunction) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "16619067fe96a319", "go/core/IntrinsicFunction", node)
+}
+
+func DecodeIntrinsicFunctionBinary(d *runtime.BinaryDecoder) *IntrinsicFunction {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/IntrinsicFunction" {
+		return readIntrinsicFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Package) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 string
+	var x1 *File
+	var x2 *Function
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/Package") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	if node.Path == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Path))
+		for _, x0 = range node.Path {
+			e.WriteString(x0)
+		}
+	}
+	e.WriteBool(node.Extern)
+	if node.Files == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Files))
+		for _, x1 = range node.Files {
+			x1.EncodeBinary(e)
+		}
+	}
+	if node.Functions == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Functions))
+		for _, x2 = range node.Functions {
+			x2.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *Package) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []string
+	var n1 int
+	var s1 []*File
+	var n2 int
+	var s2 []*Function
+	node.Index = Package_Ref(d.ReadUint32())
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []string{}
+		for range n0 {
+			s0 = append(s0, d.ReadString())
+		}
+	}
+	node.Path = s0
+	node.Extern = d.ReadBool()
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*File{}
+		for range n1 {
+			s1 = append(s1, DecodeFileBinary(d))
+		}
+	}
+	node.Files = s1
+	n2 = d.ReadLength()
+	s2 = nil
+	if n2 >= 0 {
+		s2 = []*Function{}
+		for range n2 {
+			s2 = append(s2, DecodeFunctionBinary(d))
+		}
+	}
+	node.Functions = s2
+}
+
+func readPackageBinary(d *runtime.BinaryDecoder, o interface{}) *Package {
+	var node *Package
+	if o != nil {
+		return o.(*Package)
+	}
+	node = &Package{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Package) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "585a7bcc067409d1")
+}
+
+func (node *Package) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "585a7bcc067409d1", "go/core/Package", node)
+}
+
+func DecodePackageBinary(d *runtime.BinaryDecoder) *Package {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/Package" {
+		return readPackageBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *File) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/File") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	node.Package.EncodeBinary(e)
+}
+
+func (node *File) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = File_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.Package = DecodePackageBinary(d)
+}
+
+func readFileBinary(d *runtime.BinaryDecoder, o interface{}) *File {
+	var node *File
+	if o != nil {
+		return o.(*File)
+	}
+	node = &File{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *File) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "585a7bcc067409d1")
+}
+
+func (node *File) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "585a7bcc067409d1", "go/core/File", node)
+}
+
+func DecodeFileBinary(d *runtime.BinaryDecoder) *File {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/File" {
+		return readFileBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *CoreProgram) EncodeBinary(e *runtime.BinaryEncoder) {
+	var o0 *Package
+	var o1 *File
+	var o2 *Function
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/core/CoreProgram") {
+		return
+	}
+	if node.Package_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Package_Scope.objects))
+		for _, o0 = range node.Package_Scope.objects {
+			o0.EncodeBinary(e)
+		}
+	}
+	if node.File_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.File_Scope.objects))
+		for _, o1 = range node.File_Scope.objects {
+			o1.EncodeBinary(e)
+		}
+	}
+	if node.Function_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Function_Scope.objects))
+		for _, o2 = range node.Function_Scope.objects {
+			o2.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *CoreProgram) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var n1 int
+	var n2 int
+	n0 = d.ReadLength()
+	if n0 >= 0 {
+		node.Package_Scope = &Package_Scope{}
+		for range n0 {
+			node.Package_Scope.objects = append(node.Package_Scope.objects, DecodePackageBinary(d))
+		}
+	}
+	n1 = d.ReadLength()
+	if n1 >= 0 {
+		node.File_Scope = &File_Scope{}
+		for range n1 {
+			node.File_Scope.objects = append(node.File_Scope.objects, DecodeFileBinary(d))
+		}
+	}
+	n2 = d.ReadLength()
+	if n2 >= 0 {
+		node.Function_Scope = &Function_Scope{}
+		for range n2 {
+			node.Function_Scope.objects = append(node.Function_Scope.objects, DecodeFunctionBinary(d))
+		}
+	}
+}
+
+func readCoreProgramBinary(d *runtime.BinaryDecoder, o interface{}) *CoreProgram {
+	var node *CoreProgram
+	if o != nil {
+		return o.(*CoreProgram)
+	}
+	node = &CoreProgram{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *CoreProgram) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d478ab041c40e5ff")
+}
+
+func (node *CoreProgram) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d478ab041c40e5ff", "go/core/CoreProgram", node)
+}
+
+func DecodeCoreProgramBinary(d *runtime.BinaryDecoder) *CoreProgram {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/core/CoreProgram" {
+		return readCoreProgramBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
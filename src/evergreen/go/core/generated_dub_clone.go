@@ -0,0 +1,1176 @@
+package core
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func cloneGoType(c *runtime.Cloner, node GoType) GoType {
+	switch node.(type) {
+	case *PointerType:
+		return node.(*PointerType).clone(c)
+	case *SliceType:
+		return node.(*SliceType).clone(c)
+	case *ExternalType:
+		return node.(*ExternalType).clone(c)
+	case *TypeDefType:
+		return node.(*TypeDefType).clone(c)
+	case *FuncType:
+		return node.(*FuncType).clone(c)
+	case *StructType:
+		return node.(*StructType).clone(c)
+	case *InterfaceType:
+		return node.(*InterfaceType).clone(c)
+	}
+	return node
+}
+
+func equalGoType(c *runtime.Comparer, a GoType, b GoType) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *PointerType:
+		switch b.(type) {
+		case *PointerType:
+			return a.(*PointerType).equal(c, b.(*PointerType))
+		}
+	case *SliceType:
+		switch b.(type) {
+		case *SliceType:
+			return a.(*SliceType).equal(c, b.(*SliceType))
+		}
+	case *ExternalType:
+		switch b.(type) {
+		case *ExternalType:
+			return a.(*ExternalType).equal(c, b.(*ExternalType))
+		}
+	case *TypeDefType:
+		switch b.(type) {
+		case *TypeDefType:
+			return a.(*TypeDefType).equal(c, b.(*TypeDefType))
+		}
+	case *FuncType:
+		switch b.(type) {
+		case *FuncType:
+			return a.(*FuncType).equal(c, b.(*FuncType))
+		}
+	case *StructType:
+		switch b.(type) {
+		case *StructType:
+			return a.(*StructType).equal(c, b.(*StructType))
+		}
+	case *InterfaceType:
+		switch b.(type) {
+		case *InterfaceType:
+			return a.(*InterfaceType).equal(c, b.(*InterfaceType))
+		}
+	}
+	return a == b
+}
+
+func (node *PointerType) Clone() *PointerType {
+	var c *runtime.Cloner
+	var clone *PointerType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &PointerType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PointerType) clone(c *runtime.Cloner) *PointerType {
+	var o interface{}
+	var clone *PointerType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*PointerType)
+	}
+	clone = &PointerType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PointerType) cloneFields(c *runtime.Cloner, clone *PointerType) {
+	clone.Element = cloneGoType(c, node.Element)
+}
+
+func (node *PointerType) Equal(other *PointerType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *PointerType) equal(c *runtime.Comparer, other *PointerType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalGoType(c, node.Element, other.Element) {
+		return false
+	}
+	return true
+}
+
+func (node *SliceType) Clone() *SliceType {
+	var c *runtime.Cloner
+	var clone *SliceType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SliceType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SliceType) clone(c *runtime.Cloner) *SliceType {
+	var o interface{}
+	var clone *SliceType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SliceType)
+	}
+	clone = &SliceType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SliceType) cloneFields(c *runtime.Cloner, clone *SliceType) {
+	clone.Element = cloneGoType(c, node.Element)
+}
+
+func (node *SliceType) Equal(other *SliceType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SliceType) equal(c *runtime.Comparer, other *SliceType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalGoType(c, node.Element, other.Element) {
+		return false
+	}
+	return true
+}
+
+func (node *ExternalType) Clone() *ExternalType {
+	var c *runtime.Cloner
+	var clone *ExternalType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ExternalType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ExternalType) clone(c *runtime.Cloner) *ExternalType {
+	var o interface{}
+	var clone *ExternalType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ExternalType)
+	}
+	clone = &ExternalType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ExternalType) cloneFields(c *runtime.Cloner, clone *ExternalType) {
+	clone.Name = node.Name
+	clone.Package = node.Package.clone(c)
+}
+
+func (node *ExternalType) Equal(other *ExternalType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ExternalType) equal(c *runtime.Comparer, other *ExternalType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Package.equalRef(other.Package) {
+		return false
+	}
+	return true
+}
+
+func (node *TypeDefType) Clone() *TypeDefType {
+	var c *runtime.Cloner
+	var clone *TypeDefType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeDefType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeDefType) clone(c *runtime.Cloner) *TypeDefType {
+	var o interface{}
+	var clone *TypeDefType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeDefType)
+	}
+	clone = &TypeDefType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeDefType) cloneFields(c *runtime.Cloner, clone *TypeDefType) {
+	clone.Name = node.Name
+	clone.Type = cloneGoType(c, node.Type)
+	clone.Package = node.Package.clone(c)
+	clone.File = node.File.clone(c)
+}
+
+func (node *TypeDefType) Equal(other *TypeDefType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeDefType) equal(c *runtime.Comparer, other *TypeDefType) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalGoType(c, node.Type, other.Type) {
+		return false
+	}
+	if !node.Package.equalRef(other.Package) {
+		return false
+	}
+	if !node.File.equalRef(other.File) {
+		return false
+	}
+	return true
+}
+
+func (node *FuncType) Clone() *FuncType {
+	var c *runtime.Cloner
+	var clone *FuncType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FuncType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncType) clone(c *runtime.Cloner) *FuncType {
+	var o interface{}
+	var clone *FuncType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FuncType)
+	}
+	clone = &FuncType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncType) cloneFields(c *runtime.Cloner, clone *FuncType) {
+	var s0 []GoType
+	var e0 GoType
+	var s1 []GoType
+	var e1 GoType
+	s0 = nil
+	if node.Params != nil {
+		s0 = []GoType{}
+		for _, e0 = range node.Params {
+			s0 = append(s0, cloneGoType(c, e0))
+		}
+	}
+	clone.Params = s0
+	s1 = nil
+	if node.Results != nil {
+		s1 = []GoType{}
+		for _, e1 = range node.Results {
+			s1 = append(s1, cloneGoType(c, e1))
+		}
+	}
+	clone.Results = s1
+}
+
+func (node *FuncType) Equal(other *FuncType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FuncType) equal(c *runtime.Comparer, other *FuncType) bool {
+	var i0 int
+	var e0 GoType
+	var i1 int
+	var e1 GoType
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i0, e0 = range node.Params {
+		if !equalGoType(c, e0, other.Params[i0]) {
+			return false
+		}
+	}
+	if len(node.Results) != len(other.Results) || node.Results == nil != (other.Results == nil) {
+		return false
+	}
+	for i1, e1 = range node.Results {
+		if !equalGoType(c, e1, other.Results[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Field) Clone() *Field {
+	var c *runtime.Cloner
+	var clone *Field
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Field{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Field) clone(c *runtime.Cloner) *Field {
+	var o interface{}
+	var clone *Field
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Field)
+	}
+	clone = &Field{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Field) cloneFields(c *runtime.Cloner, clone *Field) {
+	clone.Name = node.Name
+	clone.Type = cloneGoType(c, node.Type)
+}
+
+func (node *Field) Equal(other *Field) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Field) equal(c *runtime.Comparer, other *Field) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalGoType(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *StructType) Clone() *StructType {
+	var c *runtime.Cloner
+	var clone *StructType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StructType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructType) clone(c *runtime.Cloner) *StructType {
+	var o interface{}
+	var clone *StructType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StructType)
+	}
+	clone = &StructType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructType) cloneFields(c *runtime.Cloner, clone *StructType) {
+	var s0 []*Field
+	var e0 *Field
+	var s1 []*Function
+	var e1 *Function
+	clone.Name = node.Name
+	s0 = nil
+	if node.Fields != nil {
+		s0 = []*Field{}
+		for _, e0 = range node.Fields {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Fields = s0
+	clone.Package = node.Package.clone(c)
+	clone.File = node.File.clone(c)
+	s1 = nil
+	if node.Methods != nil {
+		s1 = []*Function{}
+		for _, e1 = range node.Methods {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Methods = s1
+}
+
+func (node *StructType) Equal(other *StructType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StructType) equal(c *runtime.Comparer, other *StructType) bool {
+	var i0 int
+	var e0 *Field
+	var i1 int
+	var e1 *Function
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Fields) != len(other.Fields) || node.Fields == nil != (other.Fields == nil) {
+		return false
+	}
+	for i0, e0 = range node.Fields {
+		if !e0.equal(c, other.Fields[i0]) {
+			return false
+		}
+	}
+	if !node.Package.equalRef(other.Package) {
+		return false
+	}
+	if !node.File.equalRef(other.File) {
+		return false
+	}
+	if len(node.Methods) != len(other.Methods) || node.Methods == nil != (other.Methods == nil) {
+		return false
+	}
+	for i1, e1 = range node.Methods {
+		if !e1.equalRef(other.Methods[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *InterfaceType) Clone() *InterfaceType {
+	var c *runtime.Cloner
+	var clone *InterfaceType
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &InterfaceType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *InterfaceType) clone(c *runtime.Cloner) *InterfaceType {
+	var o interface{}
+	var clone *InterfaceType
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*InterfaceType)
+	}
+	clone = &InterfaceType{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *InterfaceType) cloneFields(c *runtime.Cloner, clone *InterfaceType) {
+	var s []*Field
+	var e *Field
+	clone.Name = node.Name
+	s = nil
+	if node.Fields != nil {
+		s = []*Field{}
+		for _, e = range node.Fields {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Fields = s
+	clone.Package = node.Package.clone(c)
+	clone.File = node.File.clone(c)
+}
+
+func (node *InterfaceType) Equal(other *InterfaceType) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *InterfaceType) equal(c *runtime.Comparer, other *InterfaceType) bool {
+	var i int
+	var e *Field
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Fields) != len(other.Fields) || node.Fields == nil != (other.Fields == nil) {
+		return false
+	}
+	for i, e = range node.Fields {
+		if !e.equal(c, other.Fields[i]) {
+			return false
+		}
+	}
+	if !node.Package.equalRef(other.Package) {
+		return false
+	}
+	if !node.File.equalRef(other.File) {
+		return false
+	}
+	return true
+}
+
+func (node *BuiltinTypeIndex) Clone() *BuiltinTypeIndex {
+	var c *runtime.Cloner
+	var clone *BuiltinTypeIndex
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BuiltinTypeIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BuiltinTypeIndex) clone(c *runtime.Cloner) *BuiltinTypeIndex {
+	var o interface{}
+	var clone *BuiltinTypeIndex
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BuiltinTypeIndex)
+	}
+	clone = &BuiltinTypeIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BuiltinTypeIndex) cloneFields(c *runtime.Cloner, clone *BuiltinTypeIndex) {
+	clone.Int = node.Int.clone(c)
+	clone.UInt32 = node.UInt32.clone(c)
+	clone.Int64 = node.Int64.clone(c)
+	clone.Float32 = node.Float32.clone(c)
+	clone.Bool = node.Bool.clone(c)
+	clone.String = node.String.clone(c)
+	clone.Rune = node.Rune.clone(c)
+	clone.Append = node.Append.clone(c)
+}
+
+func (node *BuiltinTypeIndex) Equal(other *BuiltinTypeIndex) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BuiltinTypeIndex) equal(c *runtime.Comparer, other *BuiltinTypeIndex) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Int.equal(c, other.Int) {
+		return false
+	}
+	if !node.UInt32.equal(c, other.UInt32) {
+		return false
+	}
+	if !node.Int64.equal(c, other.Int64) {
+		return false
+	}
+	if !node.Float32.equal(c, other.Float32) {
+		return false
+	}
+	if !node.Bool.equal(c, other.Bool) {
+		return false
+	}
+	if !node.String.equal(c, other.String) {
+		return false
+	}
+	if !node.Rune.equal(c, other.Rune) {
+		return false
+	}
+	if !node.Append.equal(c, other.Append) {
+		return false
+	}
+	return true
+}
+
+func cloneCallable(c *runtime.Cloner, node Callable) Callable {
+	switch node.(type) {
+	case *Function:
+		return node.(*Function).clone(c)
+	case *IntrinsicFunction:
+		return node.(*IntrinsicFunction).clone(c)
+	}
+	return node
+}
+
+func equalCallable(c *runtime.Comparer, a Callable, b Callable) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *Function:
+		switch b.(type) {
+		case *Function:
+			return a.(*Function).equalRef(b.(*Function))
+		}
+	case *IntrinsicFunction:
+		switch b.(type) {
+		case *IntrinsicFunction:
+			return a.(*IntrinsicFunction).equal(c, b.(*IntrinsicFunction))
+		}
+	}
+	return a == b
+}
+
+func (node *Function) Clone() *Function {
+	var c *runtime.Cloner
+	var clone *Function
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Function{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Function) clone(c *runtime.Cloner) *Function {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Function)
+	}
+	return node
+}
+
+func (node *Function) cloneFields(c *runtime.Cloner, clone *Function) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.Package = node.Package.clone(c)
+	clone.File = node.File.clone(c)
+}
+
+func (node *Function) Equal(other *Function) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Function) equal(c *runtime.Comparer, other *Function) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Package.equalRef(other.Package) {
+		return false
+	}
+	if !node.File.equalRef(other.File) {
+		return false
+	}
+	return true
+}
+
+func (node *Function) equalRef(other *Function) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *IntrinsicFunction) Clone() *IntrinsicFunction {
+	var c *runtime.Cloner
+	var clone *IntrinsicFunction
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &IntrinsicFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntrinsicFunction) clone(c *runtime.Cloner) *IntrinsicFunction {
+	var o interface{}
+	var clone *IntrinsicFunction
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*IntrinsicFunction)
+	}
+	clone = &IntrinsicFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntrinsicFunction) cloneFields(c *runtime.Cloner, clone *IntrinsicFunction) {
+	clone.Name = node.Name
+}
+
+func (node *IntrinsicFunction) Equal(other *IntrinsicFunction) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *IntrinsicFunction) equal(c *runtime.Comparer, other *IntrinsicFunction) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+func (node *Package) Clone() *Package {
+	var c *runtime.Cloner
+	var clone *Package
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Package{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Package) clone(c *runtime.Cloner) *Package {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Package)
+	}
+	return node
+}
+
+func (node *Package) cloneFields(c *runtime.Cloner, clone *Package) {
+	var s0 []string
+	var e0 string
+	var s1 []*File
+	var e1 *File
+	var s2 []*Function
+	var e2 *Function
+	clone.Index = node.Index
+	s0 = nil
+	if node.Path != nil {
+		s0 = []string{}
+		for _, e0 = range node.Path {
+			s0 = append(s0, e0)
+		}
+	}
+	clone.Path = s0
+	clone.Extern = node.Extern
+	s1 = nil
+	if node.Files != nil {
+		s1 = []*File{}
+		for _, e1 = range node.Files {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Files = s1
+	s2 = nil
+	if node.Functions != nil {
+		s2 = []*Function{}
+		for _, e2 = range node.Functions {
+			s2 = append(s2, e2.clone(c))
+		}
+	}
+	clone.Functions = s2
+}
+
+func (node *Package) Equal(other *Package) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Package) equal(c *runtime.Comparer, other *Package) bool {
+	var i0 int
+	var e0 string
+	var i1 int
+	var e1 *File
+	var i2 int
+	var e2 *Function
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if len(node.Path) != len(other.Path) || node.Path == nil != (other.Path == nil) {
+		return false
+	}
+	for i0, e0 = range node.Path {
+		if e0 != other.Path[i0] {
+			return false
+		}
+	}
+	if node.Extern != other.Extern {
+		return false
+	}
+	if len(node.Files) != len(other.Files) || node.Files == nil != (other.Files == nil) {
+		return false
+	}
+	for i1, e1 = range node.Files {
+		if !e1.equalRef(other.Files[i1]) {
+			return false
+		}
+	}
+	if len(node.Functions) != len(other.Functions) || node.Functions == nil != (other.Functions == nil) {
+		return false
+	}
+	for i2, e2 = range node.Functions {
+		if !e2.equalRef(other.Functions[i2]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Package) equalRef(other *Package) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *File) Clone() *File {
+	var c *runtime.Cloner
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) clone(c *runtime.Cloner) *File {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*File)
+	}
+	return node
+}
+
+func (node *File) cloneFields(c *runtime.Cloner, clone *File) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.Package = node.Package.clone(c)
+}
+
+func (node *File) Equal(other *File) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *File) equal(c *runtime.Comparer, other *File) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Package.equalRef(other.Package) {
+		return false
+	}
+	return true
+}
+
+func (node *File) equalRef(other *File) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *CoreProgram) Clone() *CoreProgram {
+	var c *runtime.Cloner
+	var clone *CoreProgram
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &CoreProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CoreProgram) clone(c *runtime.Cloner) *CoreProgram {
+	var o interface{}
+	var clone *CoreProgram
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*CoreProgram)
+	}
+	clone = &CoreProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CoreProgram) cloneFields(c *runtime.Cloner, clone *CoreProgram) {
+	var o0 *Package
+	var x0 *Package
+	var i0 int
+	var o1 *File
+	var x1 *File
+	var i1 int
+	var o2 *Function
+	var x2 *Function
+	var i2 int
+	if node.Package_Scope != nil {
+		clone.Package_Scope = &Package_Scope{}
+		for _, o0 = range node.Package_Scope.objects {
+			x0 = &Package{}
+			c.Map(o0, x0)
+			clone.Package_Scope.objects = append(clone.Package_Scope.objects, x0)
+		}
+		for i0, o0 = range node.Package_Scope.objects {
+			o0.cloneFields(c, clone.Package_Scope.objects[i0])
+		}
+	}
+	if node.File_Scope != nil {
+		clone.File_Scope = &File_Scope{}
+		for _, o1 = range node.File_Scope.objects {
+			x1 = &File{}
+			c.Map(o1, x1)
+			clone.File_Scope.objects = append(clone.File_Scope.objects, x1)
+		}
+		for i1, o1 = range node.File_Scope.objects {
+			o1.cloneFields(c, clone.File_Scope.objects[i1])
+		}
+	}
+	if node.Function_Scope != nil {
+		clone.Function_Scope = &Function_Scope{}
+		for _, o2 = range node.Function_Scope.objects {
+			x2 = &Function{}
+			c.Map(o2, x2)
+			clone.Function_Scope.objects = append(clone.Function_Scope.objects, x2)
+		}
+		for i2, o2 = range node.Function_Scope.objects {
+			o2.cloneFields(c, clone.Function_Scope.objects[i2])
+		}
+	}
+}
+
+func (node *CoreProgram) Equal(other *CoreProgram) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *CoreProgram) equal(c *runtime.Comparer, other *CoreProgram) bool {
+	var i0 int
+	var o0 *Package
+	var i1 int
+	var o1 *File
+	var i2 int
+	var o2 *Function
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Package_Scope == nil != (other.Package_Scope == nil) {
+		return false
+	}
+	if node.Package_Scope != nil {
+		if len(node.Package_Scope.objects) != len(other.Package_Scope.objects) {
+			return false
+		}
+		for i0, o0 = range node.Package_Scope.objects {
+			if !o0.equal(c, other.Package_Scope.objects[i0]) {
+				return false
+			}
+		}
+	}
+	if node.File_Scope == nil != (other.File_Scope == nil) {
+		return false
+	}
+	if node.File_Scope != nil {
+		if len(node.File_Scope.objects) != len(other.File_Scope.objects) {
+			return false
+		}
+		for i1, o1 = range node.File_Scope.objects {
+			if !o1.equal(c, other.File_Scope.objects[i1]) {
+				return false
+			}
+		}
+	}
+	if node.Function_Scope == nil != (other.Function_Scope == nil) {
+		return false
+	}
+	if node.Function_Scope != nil {
+		if len(node.Function_Scope.objects) != len(other.Function_Scope.objects) {
+			return false
+		}
+		for i2, o2 = range node.Function_Scope.objects {
+			if !o2.equal(c, other.Function_Scope.objects[i2]) {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,289 @@
+package core
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *PointerType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("PointerType")
+	d.AddField("Element", runtime.Describe(node.Element))
+	return d
+}
+
+func (node *PointerType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SliceType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SliceType")
+	d.AddField("Element", runtime.Describe(node.Element))
+	return d
+}
+
+func (node *SliceType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ExternalType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ExternalType")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Package", node.Package.DescribeRef())
+	return d
+}
+
+func (node *ExternalType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeDefType) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeDefType")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Package", node.Package.DescribeRef())
+	d.AddField("File", node.File.DescribeRef())
+	return d
+}
+
+func (node *TypeDefType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FuncType) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 GoType
+	var l1 *runtime.List
+	var e1 GoType
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FuncType")
+	l0 = runtime.MakeList("[]GoType")
+	for _, e0 = range node.Params {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Params", l0)
+	l1 = runtime.MakeList("[]GoType")
+	for _, e1 = range node.Results {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Results", l1)
+	return d
+}
+
+func (node *FuncType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Field) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Field")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *Field) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StructType) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Field
+	var l1 *runtime.List
+	var e1 *Function
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StructType")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l0 = runtime.MakeList("[]Field")
+	for _, e0 = range node.Fields {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Fields", l0)
+	d.AddField("Package", node.Package.DescribeRef())
+	d.AddField("File", node.File.DescribeRef())
+	l1 = runtime.MakeList("[]Function")
+	for _, e1 = range node.Methods {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Methods", l1)
+	return d
+}
+
+func (node *StructType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *InterfaceType) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *Field
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("InterfaceType")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l = runtime.MakeList("[]Field")
+	for _, e = range node.Fields {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Fields", l)
+	d.AddField("Package", node.Package.DescribeRef())
+	d.AddField("File", node.File.DescribeRef())
+	return d
+}
+
+func (node *InterfaceType) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BuiltinTypeIndex) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BuiltinTypeIndex")
+	d.AddField("Int", runtime.Describe(node.Int))
+	d.AddField("UInt32", runtime.Describe(node.UInt32))
+	d.AddField("Int64", runtime.Describe(node.Int64))
+	d.AddField("Float32", runtime.Describe(node.Float32))
+	d.AddField("Bool", runtime.Describe(node.Bool))
+	d.AddField("String", runtime.Describe(node.String))
+	d.AddField("Rune", runtime.Describe(node.Rune))
+	d.AddField("Append", runtime.Describe(node.Append))
+	return d
+}
+
+func (node *Function) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Function")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Package", node.Package.DescribeRef())
+	d.AddField("File", node.File.DescribeRef())
+	return d
+}
+
+func (node *Function) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("Function", int(node.Index))
+}
+
+func (node *Function) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *IntrinsicFunction) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("IntrinsicFunction")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *IntrinsicFunction) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Package) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 string
+	var l1 *runtime.List
+	var e1 *File
+	var l2 *runtime.List
+	var e2 *Function
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Package")
+	l0 = runtime.MakeList("[]string")
+	for _, e0 = range node.Path {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Path", l0)
+	d.AddField("Extern", runtime.Describe(node.Extern))
+	l1 = runtime.MakeList("[]File")
+	for _, e1 = range node.Files {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Files", l1)
+	l2 = runtime.MakeList("[]Function")
+	for _, e2 = range node.Functions {
+		l2.Append(e2.DescribeRef())
+	}
+	d.AddField("Functions", l2)
+	return d
+}
+
+func (node *Package) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("Package", int(node.Index))
+}
+
+func (node *Package) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *File) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("File")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Package", node.Package.DescribeRef())
+	return d
+}
+
+func (node *File) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("File", int(node.Index))
+}
+
+func (node *File) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *CoreProgram) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("CoreProgram")
+}
+
+func (node *CoreProgram) String() string {
+	return runtime.Format(node.Describe())
+}
@@ -31,6 +31,20 @@ func (scope *Register_Scope) Len() int {
 	return len(scope.objects)
 }
 
+func (scope *Register_Scope) Remap(remap []int, count int) {
+	objects := make([]*Register, count)
+	for i, info := range scope.objects {
+		idx := remap[i]
+		if idx >= 0 {
+			objects[idx] = info
+			info.Index = Register_Ref(idx)
+		} else {
+			info.Index = ^Register_Ref(0)
+		}
+	}
+	scope.objects = objects
+}
+
 func (scope *FlowFunc_Scope) Get(ref FlowFunc_Ref) *FlowFunc {
 	if scope.objects[ref].Index != ref {
 		panic(scope.objects[ref].Index)
@@ -0,0 +1,396 @@
+package flow
+
+import (
+	"evergreen/go/core"
+	"evergreen/graph"
+	"math"
+)
+
+// Sparse conditional constant propagation, after Wegman and Zadeck.
+//
+// Each register starts out undefined and is lowered to a constant, and then
+// to varying, as definitions reach it.  Only nodes reachable through edges
+// known to be executable are evaluated, so a branch on a constant condition
+// does not pollute the registers defined on the path that is never taken.
+
+type latticeLevel int
+
+const (
+	undefined latticeLevel = iota
+	constant
+	varying
+)
+
+// Constants are int64, float32, bool, rune, or string.  Nil is never
+// treated as a constant, as it is not comparable across types.
+type latticeValue struct {
+	level latticeLevel
+	value interface{}
+}
+
+func meet(a latticeValue, b latticeValue) latticeValue {
+	switch {
+	case a.level == undefined:
+		return b
+	case b.level == undefined:
+		return a
+	case a.level == constant && b.level == constant && a.value == b.value:
+		return a
+	default:
+		return latticeValue{level: varying}
+	}
+}
+
+type sccp struct {
+	decl       *FlowFunc
+	builtins   *core.BuiltinTypeIndex
+	values     []latticeValue
+	executable []bool
+	visited    []bool
+	uses       [][]graph.NodeID
+	edgeQueue  []graph.EdgeID
+	regQueue   []*Register
+}
+
+func (s *sccp) lower(reg *Register, value latticeValue) {
+	if reg == nil {
+		return
+	}
+	old := s.values[reg.Index]
+	updated := meet(old, value)
+	if updated != old {
+		s.values[reg.Index] = updated
+		s.regQueue = append(s.regQueue, reg)
+	}
+}
+
+func (s *sccp) markEdge(e graph.EdgeID) {
+	if !s.executable[e] {
+		s.executable[e] = true
+		s.edgeQueue = append(s.edgeQueue, e)
+	}
+}
+
+func (s *sccp) markExits(n graph.NodeID) {
+	eit := s.decl.CFG.ExitIterator(n)
+	for eit.HasNext() {
+		e, _ := eit.GetNext()
+		s.markEdge(e)
+	}
+}
+
+func varyingValue() latticeValue {
+	return latticeValue{level: varying}
+}
+
+func constantValue(value interface{}) latticeValue {
+	return latticeValue{level: constant, value: value}
+}
+
+func fitsInt32(value int64) bool {
+	return value >= math.MinInt32 && value <= math.MaxInt32
+}
+
+// Folds an operation on two constants.  Integer arithmetic is only folded if
+// the result is small enough to be the same for every size of int.
+func foldBinary(op string, left interface{}, right interface{}) (interface{}, bool) {
+	switch l := left.(type) {
+	case int64:
+		r, ok := right.(int64)
+		if !ok {
+			return nil, false
+		}
+		var result int64
+		switch op {
+		case "+":
+			result = l + r
+		case "-":
+			result = l - r
+		case "*":
+			result = l * r
+		case "==":
+			return l == r, true
+		case "!=":
+			return l != r, true
+		case "<":
+			return l < r, true
+		case "<=":
+			return l <= r, true
+		case ">":
+			return l > r, true
+		case ">=":
+			return l >= r, true
+		default:
+			return nil, false
+		}
+		if !fitsInt32(l) || !fitsInt32(r) || !fitsInt32(result) {
+			return nil, false
+		}
+		return result, true
+	case rune:
+		r, ok := right.(rune)
+		if !ok {
+			return nil, false
+		}
+		switch op {
+		case "+":
+			return l + r, true
+		case "-":
+			return l - r, true
+		case "==":
+			return l == r, true
+		case "!=":
+			return l != r, true
+		case "<":
+			return l < r, true
+		case "<=":
+			return l <= r, true
+		case ">":
+			return l > r, true
+		case ">=":
+			return l >= r, true
+		}
+	case float32:
+		r, ok := right.(float32)
+		if !ok {
+			return nil, false
+		}
+		switch op {
+		case "==":
+			return l == r, true
+		case "!=":
+			return l != r, true
+		case "<":
+			return l < r, true
+		case "<=":
+			return l <= r, true
+		case ">":
+			return l > r, true
+		case ">=":
+			return l >= r, true
+		}
+	case bool:
+		r, ok := right.(bool)
+		if !ok {
+			return nil, false
+		}
+		switch op {
+		case "==":
+			return l == r, true
+		case "!=":
+			return l != r, true
+		case "&&":
+			return l && r, true
+		case "||":
+			return l || r, true
+		}
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			return nil, false
+		}
+		switch op {
+		case "+":
+			return l + r, true
+		case "==":
+			return l == r, true
+		case "!=":
+			return l != r, true
+		case "<":
+			return l < r, true
+		case "<=":
+			return l <= r, true
+		case ">":
+			return l > r, true
+		case ">=":
+			return l >= r, true
+		}
+	}
+	return nil, false
+}
+
+// Can the type of a register hold a folded constant of the given kind?
+// Named types based on the builtins are left alone.
+func (s *sccp) holds(reg *Register, value interface{}) bool {
+	switch value.(type) {
+	case int64:
+		return reg.T == s.builtins.Int || reg.T == s.builtins.Int64
+	case rune:
+		return reg.T == s.builtins.Rune
+	case float32:
+		return reg.T == s.builtins.Float32
+	case bool:
+		return reg.T == s.builtins.Bool
+	case string:
+		return reg.T == s.builtins.String
+	default:
+		return false
+	}
+}
+
+func (s *sccp) evaluate(n graph.NodeID) {
+	op := s.decl.Ops[n]
+	switch op := op.(type) {
+	case *ConstantInt:
+		s.lower(op.Dst, constantValue(op.Value))
+	case *ConstantRune:
+		s.lower(op.Dst, constantValue(op.Value))
+	case *ConstantFloat32:
+		s.lower(op.Dst, constantValue(op.Value))
+	case *ConstantBool:
+		s.lower(op.Dst, constantValue(op.Value))
+	case *ConstantString:
+		s.lower(op.Dst, constantValue(op.Value))
+	case *BinaryOp:
+		left := s.values[op.Left.Index]
+		right := s.values[op.Right.Index]
+		if left.level == undefined || right.level == undefined {
+			// Wait until both inputs are known.
+		} else if left.level == constant && right.level == constant {
+			result, ok := foldBinary(op.Op, left.value, right.value)
+			if ok && op.Dst != nil && s.holds(op.Dst, result) {
+				s.lower(op.Dst, constantValue(result))
+			} else {
+				s.lower(op.Dst, varyingValue())
+			}
+		} else {
+			s.lower(op.Dst, varyingValue())
+		}
+	case *Transfer:
+		for i, dst := range op.Dsts {
+			s.lower(dst, s.values[op.Srcs[i].Index])
+		}
+	case *Switch:
+		cond := s.values[op.Cond.Index]
+		switch cond.level {
+		case undefined:
+			return
+		case constant:
+			want := COND_FALSE
+			if cond.value == true {
+				want = COND_TRUE
+			}
+			eit := s.decl.CFG.ExitIterator(n)
+			for eit.HasNext() {
+				e, _ := eit.GetNext()
+				if s.decl.Edges[e] == want {
+					s.markEdge(e)
+				}
+			}
+			return
+		}
+	default:
+		for _, dst := range OpDefs(s.decl, op) {
+			s.lower(dst, varyingValue())
+		}
+	}
+	s.markExits(n)
+}
+
+func (s *sccp) run() {
+	g := s.decl.CFG
+	s.visited[g.Entry()] = true
+	s.evaluate(g.Entry())
+	for len(s.edgeQueue) > 0 || len(s.regQueue) > 0 {
+		for len(s.edgeQueue) > 0 {
+			e := s.edgeQueue[len(s.edgeQueue)-1]
+			s.edgeQueue = s.edgeQueue[:len(s.edgeQueue)-1]
+			dst := g.EdgeExit(e)
+			if !s.visited[dst] {
+				s.visited[dst] = true
+				s.evaluate(dst)
+			}
+		}
+		for len(s.regQueue) > 0 {
+			reg := s.regQueue[len(s.regQueue)-1]
+			s.regQueue = s.regQueue[:len(s.regQueue)-1]
+			for _, n := range s.uses[reg.Index] {
+				if s.visited[n] {
+					s.evaluate(n)
+				}
+			}
+		}
+	}
+}
+
+func constantOp(value interface{}, dst *Register) GoOp {
+	switch value := value.(type) {
+	case int64:
+		return &ConstantInt{Value: value, Dst: dst}
+	case rune:
+		return &ConstantRune{Value: value, Dst: dst}
+	case float32:
+		return &ConstantFloat32{Value: value, Dst: dst}
+	case bool:
+		return &ConstantBool{Value: value, Dst: dst}
+	case string:
+		return &ConstantString{Value: value, Dst: dst}
+	default:
+		panic(value)
+	}
+}
+
+// Replaces computations that always produce the same value with constants,
+// and removes branches that are never taken.  Assumes SSA form.
+func PropagateConstants(decl *FlowFunc, builtins *core.BuiltinTypeIndex) {
+	g := decl.CFG
+	s := &sccp{
+		decl:       decl,
+		builtins:   builtins,
+		values:     make([]latticeValue, decl.Register_Scope.Len()),
+		executable: make([]bool, g.NumEdges()),
+		visited:    make([]bool, g.NumNodes()),
+		uses:       make([][]graph.NodeID, decl.Register_Scope.Len()),
+	}
+	order, _ := graph.ReversePostorder(g)
+	defined := make([]bool, decl.Register_Scope.Len())
+	for _, n := range order {
+		op := decl.Ops[n]
+		for _, reg := range OpUses(op) {
+			s.uses[reg.Index] = append(s.uses[reg.Index], n)
+		}
+		for _, reg := range OpDefs(decl, op) {
+			if reg != nil {
+				defined[reg.Index] = true
+			}
+		}
+	}
+	// Registers that are never assigned hold their zero value, which is not
+	// tracked.
+	for i, isDefined := range defined {
+		if !isDefined {
+			s.values[i] = varyingValue()
+		}
+	}
+	s.run()
+
+	for _, n := range order {
+		if !s.visited[n] {
+			continue
+		}
+		switch op := decl.Ops[n].(type) {
+		case *BinaryOp:
+			if op.Dst == nil {
+				continue
+			}
+			value := s.values[op.Dst.Index]
+			if value.level == constant {
+				decl.Ops[n] = constantOp(value.value, op.Dst)
+			}
+		case *Switch:
+			if s.values[op.Cond.Index].level == constant {
+				decl.Ops[n] = &Nop{}
+			}
+		}
+		eit := g.ExitIterator(n)
+		for eit.HasNext() {
+			e, _ := eit.GetNext()
+			if !s.executable[e] {
+				g.KillEdge(e)
+			} else if _, ok := decl.Ops[n].(*Nop); ok {
+				decl.Edges[e] = NORMAL
+			}
+		}
+	}
+	removeUnreachable(decl)
+}
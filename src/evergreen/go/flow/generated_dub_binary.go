@@ -0,0 +1,1536 @@
+package flow
+
+import (
+	"evergreen/dub/runtime"
+	"evergreen/go/core"
+	"evergreen/graph"
+)
+
+func (node *Register) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Register") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *Register) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = Register_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.T = core.DecodeGoTypeBinary(d)
+}
+
+func readRegisterBinary(d *runtime.BinaryDecoder, o interface{}) *Register {
+	var node *Register
+	if o != nil {
+		return o.(*Register)
+	}
+	node = &Register{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Register) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9c94ed148aff8b19")
+}
+
+func (node *Register) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9c94ed148aff8b19", "go/flow/Register", node)
+}
+
+func DecodeRegisterBinary(d *runtime.BinaryDecoder) *Register {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Register" {
+		return readRegisterBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FlowFunc) EncodeBinary(e *runtime.BinaryEncoder) {
+	var o *Register
+	var x0 *Register
+	var x1 *Register
+	var x2 GoOp
+	var x3 int
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/FlowFunc") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	if node.Register_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Register_Scope.objects))
+		for _, o = range node.Register_Scope.objects {
+			o.EncodeBinary(e)
+		}
+	}
+	node.Function.EncodeBinary(e)
+	node.Recv.EncodeBinary(e)
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x0 = range node.Params {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Results == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Results))
+		for _, x1 = range node.Results {
+			x1.EncodeBinary(e)
+		}
+	}
+	if node.CFG == nil {
+		e.WriteBool(false)
+	} else {
+		e.WriteBool(true)
+		e.WriteOpaque(node.CFG)
+	}
+	if node.Ops == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Ops))
+		for _, x2 = range node.Ops {
+			runtime.EncodeBinary(e, x2)
+		}
+	}
+	if node.Edges == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Edges))
+		for _, x3 = range node.Edges {
+			e.WriteInt(x3)
+		}
+	}
+}
+
+func (node *FlowFunc) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var n1 int
+	var s0 []*Register
+	var n2 int
+	var s1 []*Register
+	var n3 int
+	var s2 []GoOp
+	var n4 int
+	var s3 []int
+	node.Index = FlowFunc_Ref(d.ReadUint32())
+	n0 = d.ReadLength()
+	if n0 >= 0 {
+		node.Register_Scope = &Register_Scope{}
+		for range n0 {
+			node.Register_Scope.objects = append(node.Register_Scope.objects, DecodeRegisterBinary(d))
+		}
+	}
+	node.Function = core.DecodeFunctionBinary(d)
+	node.Recv = DecodeRegisterBinary(d)
+	n1 = d.ReadLength()
+	s0 = nil
+	if n1 >= 0 {
+		s0 = []*Register{}
+		for range n1 {
+			s0 = append(s0, DecodeRegisterBinary(d))
+		}
+	}
+	node.Params = s0
+	n2 = d.ReadLength()
+	s1 = nil
+	if n2 >= 0 {
+		s1 = []*Register{}
+		for range n2 {
+			s1 = append(s1, DecodeRegisterBinary(d))
+		}
+	}
+	node.Results = s1
+	node.CFG = nil
+	if d.ReadBool() {
+		node.CFG = &graph.Graph{}
+		d.ReadOpaque(node.CFG)
+	}
+	n3 = d.ReadLength()
+	s2 = nil
+	if n3 >= 0 {
+		s2 = []GoOp{}
+		for range n3 {
+			s2 = append(s2, DecodeGoOpBinary(d))
+		}
+	}
+	node.Ops = s2
+	n4 = d.ReadLength()
+	s3 = nil
+	if n4 >= 0 {
+		s3 = []int{}
+		for range n4 {
+			s3 = append(s3, d.ReadInt())
+		}
+	}
+	node.Edges = s3
+}
+
+func readFlowFuncBinary(d *runtime.BinaryDecoder, o interface{}) *FlowFunc {
+	var node *FlowFunc
+	if o != nil {
+		return o.(*FlowFunc)
+	}
+	node = &FlowFunc{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FlowFunc) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "0302afbda7cb5e82")
+}
+
+func (node *FlowFunc) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "0302afbda7cb5e82", "go/flow/FlowFunc", node)
+}
+
+func DecodeFlowFuncBinary(d *runtime.BinaryDecoder) *FlowFunc {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/FlowFunc" {
+		return readFlowFuncBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeGoOpBinary(d *runtime.BinaryDecoder) GoOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstantNil" {
+		return readConstantNilBinary(d, o)
+	}
+	if t == "go/flow/ConstantInt" {
+		return readConstantIntBinary(d, o)
+	}
+	if t == "go/flow/ConstantFloat32" {
+		return readConstantFloat32Binary(d, o)
+	}
+	if t == "go/flow/ConstantBool" {
+		return readConstantBoolBinary(d, o)
+	}
+	if t == "go/flow/ConstantRune" {
+		return readConstantRuneBinary(d, o)
+	}
+	if t == "go/flow/ConstantString" {
+		return readConstantStringBinary(d, o)
+	}
+	if t == "go/flow/BinaryOp" {
+		return readBinaryOpBinary(d, o)
+	}
+	if t == "go/flow/Attr" {
+		return readAttrBinary(d, o)
+	}
+	if t == "go/flow/Call" {
+		return readCallBinary(d, o)
+	}
+	if t == "go/flow/MethodCall" {
+		return readMethodCallBinary(d, o)
+	}
+	if t == "go/flow/ConstructStruct" {
+		return readConstructStructBinary(d, o)
+	}
+	if t == "go/flow/ConstructSlice" {
+		return readConstructSliceBinary(d, o)
+	}
+	if t == "go/flow/Coerce" {
+		return readCoerceBinary(d, o)
+	}
+	if t == "go/flow/Transfer" {
+		return readTransferBinary(d, o)
+	}
+	if t == "go/flow/Return" {
+		return readReturnBinary(d, o)
+	}
+	if t == "go/flow/Nop" {
+		return readNopBinary(d, o)
+	}
+	if t == "go/flow/Entry" {
+		return readEntryBinary(d, o)
+	}
+	if t == "go/flow/Switch" {
+		return readSwitchBinary(d, o)
+	}
+	if t == "go/flow/Exit" {
+		return readExitBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantNil) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstantNil") {
+		return
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantNil) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstantNilBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantNil {
+	var node *ConstantNil
+	if o != nil {
+		return o.(*ConstantNil)
+	}
+	node = &ConstantNil{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantNil) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "491e835f2a2a9b14")
+}
+
+func (node *ConstantNil) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "491e835f2a2a9b14", "go/flow/ConstantNil", node)
+}
+
+func DecodeConstantNilBinary(d *runtime.BinaryDecoder) *ConstantNil {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstantNil" {
+		return readConstantNilBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantInt) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstantInt") {
+		return
+	}
+	e.WriteInt64(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantInt) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadInt64()
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstantIntBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantInt {
+	var node *ConstantInt
+	if o != nil {
+		return o.(*ConstantInt)
+	}
+	node = &ConstantInt{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantInt) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8ce3e0ab8d54582b")
+}
+
+func (node *ConstantInt) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8ce3e0ab8d54582b", "go/flow/ConstantInt", node)
+}
+
+func DecodeConstantIntBinary(d *runtime.BinaryDecoder) *ConstantInt {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstantInt" {
+		return readConstantIntBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantFloat32) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstantFloat32") {
+		return
+	}
+	e.WriteFloat32(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantFloat32) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadFloat32()
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstantFloat32Binary(d *runtime.BinaryDecoder, o interface{}) *ConstantFloat32 {
+	var node *ConstantFloat32
+	if o != nil {
+		return o.(*ConstantFloat32)
+	}
+	node = &ConstantFloat32{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantFloat32) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "12b78cac1bffc631")
+}
+
+func (node *ConstantFloat32) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "12b78cac1bffc631", "go/flow/ConstantFloat32", node)
+}
+
+func DecodeConstantFloat32Binary(d *runtime.BinaryDecoder) *ConstantFloat32 {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstantFloat32" {
+		return readConstantFloat32Binary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantBool) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstantBool") {
+		return
+	}
+	e.WriteBool(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantBool) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadBool()
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstantBoolBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantBool {
+	var node *ConstantBool
+	if o != nil {
+		return o.(*ConstantBool)
+	}
+	node = &ConstantBool{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantBool) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "be698886a77939a9")
+}
+
+func (node *ConstantBool) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "be698886a77939a9", "go/flow/ConstantBool", node)
+}
+
+func DecodeConstantBoolBinary(d *runtime.BinaryDecoder) *ConstantBool {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstantBool" {
+		return readConstantBoolBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantRune) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstantRune") {
+		return
+	}
+	e.WriteRuneValue(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantRune) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadRuneValue()
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstantRuneBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantRune {
+	var node *ConstantRune
+	if o != nil {
+		return o.(*ConstantRune)
+	}
+	node = &ConstantRune{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantRune) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "21d77a30c192b69d")
+}
+
+func (node *ConstantRune) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "21d77a30c192b69d", "go/flow/ConstantRune", node)
+}
+
+func DecodeConstantRuneBinary(d *runtime.BinaryDecoder) *ConstantRune {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstantRune" {
+		return readConstantRuneBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantString) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstantString") {
+		return
+	}
+	e.WriteString(node.Value)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstantString) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadString()
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstantStringBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantString {
+	var node *ConstantString
+	if o != nil {
+		return o.(*ConstantString)
+	}
+	node = &ConstantString{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantString) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "a464f47372825e35")
+}
+
+func (node *ConstantString) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "a464f47372825e35", "go/flow/ConstantString", node)
+}
+
+func DecodeConstantStringBinary(d *runtime.BinaryDecoder) *ConstantString {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstantString" {
+		return readConstantStringBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BinaryOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/BinaryOp") {
+		return
+	}
+	node.Left.EncodeBinary(e)
+	e.WriteString(node.Op)
+	node.Right.EncodeBinary(e)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *BinaryOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Left = DecodeRegisterBinary(d)
+	node.Op = d.ReadString()
+	node.Right = DecodeRegisterBinary(d)
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readBinaryOpBinary(d *runtime.BinaryDecoder, o interface{}) *BinaryOp {
+	var node *BinaryOp
+	if o != nil {
+		return o.(*BinaryOp)
+	}
+	node = &BinaryOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BinaryOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "75cc09c033d5d18b")
+}
+
+func (node *BinaryOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "75cc09c033d5d18b", "go/flow/BinaryOp", node)
+}
+
+func DecodeBinaryOpBinary(d *runtime.BinaryDecoder) *BinaryOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/BinaryOp" {
+		return readBinaryOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Attr) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Attr") {
+		return
+	}
+	node.Expr.EncodeBinary(e)
+	e.WriteString(node.Name)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *Attr) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeRegisterBinary(d)
+	node.Name = d.ReadString()
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readAttrBinary(d *runtime.BinaryDecoder, o interface{}) *Attr {
+	var node *Attr
+	if o != nil {
+		return o.(*Attr)
+	}
+	node = &Attr{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Attr) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f885e69e436a6148")
+}
+
+func (node *Attr) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f885e69e436a6148", "go/flow/Attr", node)
+}
+
+func DecodeAttrBinary(d *runtime.BinaryDecoder) *Attr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Attr" {
+		return readAttrBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Call) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Register
+	var x1 *Register
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Call") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Target)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x0 = range node.Args {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Dsts == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Dsts))
+		for _, x1 = range node.Dsts {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *Call) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Register
+	var n1 int
+	var s1 []*Register
+	node.Target = core.DecodeCallableBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Register{}
+		for range n0 {
+			s0 = append(s0, DecodeRegisterBinary(d))
+		}
+	}
+	node.Args = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*Register{}
+		for range n1 {
+			s1 = append(s1, DecodeRegisterBinary(d))
+		}
+	}
+	node.Dsts = s1
+}
+
+func readCallBinary(d *runtime.BinaryDecoder, o interface{}) *Call {
+	var node *Call
+	if o != nil {
+		return o.(*Call)
+	}
+	node = &Call{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Call) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "18124b9978589c5f")
+}
+
+func (node *Call) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "18124b9978589c5f", "go/flow/Call", node)
+}
+
+func DecodeCallBinary(d *runtime.BinaryDecoder) *Call {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Call" {
+		return readCallBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *MethodCall) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Register
+	var x1 *Register
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/MethodCall") {
+		return
+	}
+	node.Expr.EncodeBinary(e)
+	e.WriteString(node.Name)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x0 = range node.Args {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Dsts == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Dsts))
+		for _, x1 = range node.Dsts {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *MethodCall) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Register
+	var n1 int
+	var s1 []*Register
+	node.Expr = DecodeRegisterBinary(d)
+	node.Name = d.ReadString()
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Register{}
+		for range n0 {
+			s0 = append(s0, DecodeRegisterBinary(d))
+		}
+	}
+	node.Args = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*Register{}
+		for range n1 {
+			s1 = append(s1, DecodeRegisterBinary(d))
+		}
+	}
+	node.Dsts = s1
+}
+
+func readMethodCallBinary(d *runtime.BinaryDecoder, o interface{}) *MethodCall {
+	var node *MethodCall
+	if o != nil {
+		return o.(*MethodCall)
+	}
+	node = &MethodCall{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *MethodCall) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "582be9e402a8434c")
+}
+
+func (node *MethodCall) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "582be9e402a8434c", "go/flow/MethodCall", node)
+}
+
+func DecodeMethodCallBinary(d *runtime.BinaryDecoder) *MethodCall {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/MethodCall" {
+		return readMethodCallBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NamedArg) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/NamedArg") {
+		return
+	}
+	e.WriteString(node.Name)
+	node.Arg.EncodeBinary(e)
+}
+
+func (node *NamedArg) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Arg = DecodeRegisterBinary(d)
+}
+
+func readNamedArgBinary(d *runtime.BinaryDecoder, o interface{}) *NamedArg {
+	var node *NamedArg
+	if o != nil {
+		return o.(*NamedArg)
+	}
+	node = &NamedArg{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NamedArg) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "4ca366721aa1354d")
+}
+
+func (node *NamedArg) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "4ca366721aa1354d", "go/flow/NamedArg", node)
+}
+
+func DecodeNamedArgBinary(d *runtime.BinaryDecoder) *NamedArg {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/NamedArg" {
+		return readNamedArgBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstructStruct) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *NamedArg
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstructStruct") {
+		return
+	}
+	node.Type.EncodeBinary(e)
+	e.WriteBool(node.AddrTaken)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstructStruct) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*NamedArg
+	node.Type = core.DecodeStructTypeBinary(d)
+	node.AddrTaken = d.ReadBool()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*NamedArg{}
+		for range n {
+			s = append(s, DecodeNamedArgBinary(d))
+		}
+	}
+	node.Args = s
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstructStructBinary(d *runtime.BinaryDecoder, o interface{}) *ConstructStruct {
+	var node *ConstructStruct
+	if o != nil {
+		return o.(*ConstructStruct)
+	}
+	node = &ConstructStruct{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstructStruct) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "baa680b24b4d8622")
+}
+
+func (node *ConstructStruct) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "baa680b24b4d8622", "go/flow/ConstructStruct", node)
+}
+
+func DecodeConstructStructBinary(d *runtime.BinaryDecoder) *ConstructStruct {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstructStruct" {
+		return readConstructStructBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstructSlice) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *Register
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/ConstructSlice") {
+		return
+	}
+	node.Type.EncodeBinary(e)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *ConstructSlice) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*Register
+	node.Type = core.DecodeSliceTypeBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*Register{}
+		for range n {
+			s = append(s, DecodeRegisterBinary(d))
+		}
+	}
+	node.Args = s
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readConstructSliceBinary(d *runtime.BinaryDecoder, o interface{}) *ConstructSlice {
+	var node *ConstructSlice
+	if o != nil {
+		return o.(*ConstructSlice)
+	}
+	node = &ConstructSlice{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstructSlice) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "1e414f52b2709dfb")
+}
+
+func (node *ConstructSlice) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "1e414f52b2709dfb", "go/flow/ConstructSlice", node)
+}
+
+func DecodeConstructSliceBinary(d *runtime.BinaryDecoder) *ConstructSlice {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/ConstructSlice" {
+		return readConstructSliceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Coerce) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Coerce") {
+		return
+	}
+	node.Src.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Type)
+	node.Dst.EncodeBinary(e)
+}
+
+func (node *Coerce) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Src = DecodeRegisterBinary(d)
+	node.Type = core.DecodeGoTypeBinary(d)
+	node.Dst = DecodeRegisterBinary(d)
+}
+
+func readCoerceBinary(d *runtime.BinaryDecoder, o interface{}) *Coerce {
+	var node *Coerce
+	if o != nil {
+		return o.(*Coerce)
+	}
+	node = &Coerce{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Coerce) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "50ae0f8e94a3549c")
+}
+
+func (node *Coerce) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "50ae0f8e94a3549c", "go/flow/Coerce", node)
+}
+
+func DecodeCoerceBinary(d *runtime.BinaryDecoder) *Coerce {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Coerce" {
+		return readCoerceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Transfer) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Register
+	var x1 *Register
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Transfer") {
+		return
+	}
+	if node.Srcs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Srcs))
+		for _, x0 = range node.Srcs {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Dsts == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Dsts))
+		for _, x1 = range node.Dsts {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *Transfer) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Register
+	var n1 int
+	var s1 []*Register
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Register{}
+		for range n0 {
+			s0 = append(s0, DecodeRegisterBinary(d))
+		}
+	}
+	node.Srcs = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*Register{}
+		for range n1 {
+			s1 = append(s1, DecodeRegisterBinary(d))
+		}
+	}
+	node.Dsts = s1
+}
+
+func readTransferBinary(d *runtime.BinaryDecoder, o interface{}) *Transfer {
+	var node *Transfer
+	if o != nil {
+		return o.(*Transfer)
+	}
+	node = &Transfer{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Transfer) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "71005e9b108d73c8")
+}
+
+func (node *Transfer) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "71005e9b108d73c8", "go/flow/Transfer", node)
+}
+
+func DecodeTransferBinary(d *runtime.BinaryDecoder) *Transfer {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Transfer" {
+		return readTransferBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Return) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *Register
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Return") {
+		return
+	}
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *Return) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*Register
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*Register{}
+		for range n {
+			s = append(s, DecodeRegisterBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readReturnBinary(d *runtime.BinaryDecoder, o interface{}) *Return {
+	var node *Return
+	if o != nil {
+		return o.(*Return)
+	}
+	node = &Return{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Return) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7f2d5ed247439ac7")
+}
+
+func (node *Return) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7f2d5ed247439ac7", "go/flow/Return", node)
+}
+
+func DecodeReturnBinary(d *runtime.BinaryDecoder) *Return {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Return" {
+		return readReturnBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Nop) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Nop") {
+		return
+	}
+}
+
+func (node *Nop) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readNopBinary(d *runtime.BinaryDecoder, o interface{}) *Nop {
+	var node *Nop
+	if o != nil {
+		return o.(*Nop)
+	}
+	node = &Nop{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Nop) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "08d72ffec2efeaed")
+}
+
+func (node *Nop) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "08d72ffec2efeaed", "go/flow/Nop", node)
+}
+
+func DecodeNopBinary(d *runtime.BinaryDecoder) *Nop {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Nop" {
+		return readNopBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Entry) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Entry") {
+		return
+	}
+}
+
+func (node *Entry) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readEntryBinary(d *runtime.BinaryDecoder, o interface{}) *Entry {
+	var node *Entry
+	if o != nil {
+		return o.(*Entry)
+	}
+	node = &Entry{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Entry) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "76d4ee715f3cfe36")
+}
+
+func (node *Entry) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "76d4ee715f3cfe36", "go/flow/Entry", node)
+}
+
+func DecodeEntryBinary(d *runtime.BinaryDecoder) *Entry {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Entry" {
+		return readEntryBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Switch) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Switch") {
+		return
+	}
+	node.Cond.EncodeBinary(e)
+}
+
+func (node *Switch) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Cond = DecodeRegisterBinary(d)
+}
+
+func readSwitchBinary(d *runtime.BinaryDecoder, o interface{}) *Switch {
+	var node *Switch
+	if o != nil {
+		return o.(*Switch)
+	}
+	node = &Switch{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Switch) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "a9bde3c5a2b92d7c")
+}
+
+func (node *Switch) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "a9bde3c5a2b92d7c", "go/flow/Switch", node)
+}
+
+func DecodeSwitchBinary(d *runtime.BinaryDecoder) *Switch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Switch" {
+		return readSwitchBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Exit) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/Exit") {
+		return
+	}
+}
+
+func (node *Exit) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readExitBinary(d *runtime.BinaryDecoder, o interface{}) *Exit {
+	var node *Exit
+	if o != nil {
+		return o.(*Exit)
+	}
+	node = &Exit{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Exit) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "466a45b3db6f6ad0")
+}
+
+func (node *Exit) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "466a45b3db6f6ad0", "go/flow/Exit", node)
+}
+
+func DecodeExitBinary(d *runtime.BinaryDecoder) *Exit {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/Exit" {
+		return readExitBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FlowProgram) EncodeBinary(e *runtime.BinaryEncoder) {
+	var o *FlowFunc
+	var x core.GoType
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/flow/FlowProgram") {
+		return
+	}
+	if node.FlowFunc_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.FlowFunc_Scope.objects))
+		for _, o = range node.FlowFunc_Scope.objects {
+			o.EncodeBinary(e)
+		}
+	}
+	if node.Types == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Types))
+		for _, x = range node.Types {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+	node.Builtins.EncodeBinary(e)
+}
+
+func (node *FlowProgram) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var n1 int
+	var s []core.GoType
+	n0 = d.ReadLength()
+	if n0 >= 0 {
+		node.FlowFunc_Scope = &FlowFunc_Scope{}
+		for range n0 {
+			node.FlowFunc_Scope.objects = append(node.FlowFunc_Scope.objects, DecodeFlowFuncBinary(d))
+		}
+	}
+	n1 = d.ReadLength()
+	s = nil
+	if n1 >= 0 {
+		s = []core.GoType{}
+		for range n1 {
+			s = append(s, core.DecodeGoTypeBinary(d))
+		}
+	}
+	node.Types = s
+	node.Builtins = core.DecodeBuiltinTypeIndexBinary(d)
+}
+
+func readFlowProgramBinary(d *runtime.BinaryDecoder, o interface{}) *FlowProgram {
+	var node *FlowProgram
+	if o != nil {
+		return o.(*FlowProgram)
+	}
+	node = &FlowProgram{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FlowProgram) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "be7eecdab8d379ac")
+}
+
+func (node *FlowProgram) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "be7eecdab8d379ac", "go/flow/FlowProgram", node)
+}
+
+func DecodeFlowProgramBinary(d *runtime.BinaryDecoder) *FlowProgram {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/flow/FlowProgram" {
+		return readFlowProgramBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
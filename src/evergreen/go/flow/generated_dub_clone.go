@@ -0,0 +1,1791 @@
+package flow
+
+import (
+	"evergreen/dub/runtime"
+	"evergreen/go/core"
+	"evergreen/graph"
+)
+
+func (node *Register) Clone() *Register {
+	var c *runtime.Cloner
+	var clone *Register
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Register{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Register) clone(c *runtime.Cloner) *Register {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Register)
+	}
+	return node
+}
+
+func (node *Register) cloneFields(c *runtime.Cloner, clone *Register) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.T = node.T
+}
+
+func (node *Register) Equal(other *Register) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Register) equal(c *runtime.Comparer, other *Register) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *Register) equalRef(other *Register) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *FlowFunc) Clone() *FlowFunc {
+	var c *runtime.Cloner
+	var clone *FlowFunc
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FlowFunc{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FlowFunc) clone(c *runtime.Cloner) *FlowFunc {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FlowFunc)
+	}
+	return node
+}
+
+func (node *FlowFunc) cloneFields(c *runtime.Cloner, clone *FlowFunc) {
+	var o *Register
+	var x *Register
+	var i int
+	var s0 []*Register
+	var e0 *Register
+	var s1 []*Register
+	var e1 *Register
+	var g *graph.Graph
+	var s2 []GoOp
+	var e2 GoOp
+	var s3 []int
+	var e3 int
+	clone.Index = node.Index
+	if node.Register_Scope != nil {
+		clone.Register_Scope = &Register_Scope{}
+		for _, o = range node.Register_Scope.objects {
+			x = &Register{}
+			c.Map(o, x)
+			clone.Register_Scope.objects = append(clone.Register_Scope.objects, x)
+		}
+		for i, o = range node.Register_Scope.objects {
+			o.cloneFields(c, clone.Register_Scope.objects[i])
+		}
+	}
+	clone.Function = node.Function
+	clone.Recv = node.Recv.clone(c)
+	s0 = nil
+	if node.Params != nil {
+		s0 = []*Register{}
+		for _, e0 = range node.Params {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Params = s0
+	s1 = nil
+	if node.Results != nil {
+		s1 = []*Register{}
+		for _, e1 = range node.Results {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Results = s1
+	g = nil
+	if node.CFG != nil {
+		g = node.CFG.Copy()
+	}
+	clone.CFG = g
+	s2 = nil
+	if node.Ops != nil {
+		s2 = []GoOp{}
+		for _, e2 = range node.Ops {
+			s2 = append(s2, cloneGoOp(c, e2))
+		}
+	}
+	clone.Ops = s2
+	s3 = nil
+	if node.Edges != nil {
+		s3 = []int{}
+		for _, e3 = range node.Edges {
+			s3 = append(s3, e3)
+		}
+	}
+	clone.Edges = s3
+}
+
+func (node *FlowFunc) Equal(other *FlowFunc) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FlowFunc) equal(c *runtime.Comparer, other *FlowFunc) bool {
+	var i0 int
+	var o *Register
+	var i1 int
+	var e0 *Register
+	var i2 int
+	var e1 *Register
+	var i3 int
+	var e2 GoOp
+	var i4 int
+	var e3 int
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Register_Scope == nil != (other.Register_Scope == nil) {
+		return false
+	}
+	if node.Register_Scope != nil {
+		if len(node.Register_Scope.objects) != len(other.Register_Scope.objects) {
+			return false
+		}
+		for i0, o = range node.Register_Scope.objects {
+			if !o.equal(c, other.Register_Scope.objects[i0]) {
+				return false
+			}
+		}
+	}
+	if node.Function != other.Function {
+		return false
+	}
+	if !node.Recv.equalRef(other.Recv) {
+		return false
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i1, e0 = range node.Params {
+		if !e0.equalRef(other.Params[i1]) {
+			return false
+		}
+	}
+	if len(node.Results) != len(other.Results) || node.Results == nil != (other.Results == nil) {
+		return false
+	}
+	for i2, e1 = range node.Results {
+		if !e1.equalRef(other.Results[i2]) {
+			return false
+		}
+	}
+	if !node.CFG.Equal(other.CFG) {
+		return false
+	}
+	if len(node.Ops) != len(other.Ops) || node.Ops == nil != (other.Ops == nil) {
+		return false
+	}
+	for i3, e2 = range node.Ops {
+		if !equalGoOp(c, e2, other.Ops[i3]) {
+			return false
+		}
+	}
+	if len(node.Edges) != len(other.Edges) || node.Edges == nil != (other.Edges == nil) {
+		return false
+	}
+	for i4, e3 = range node.Edges {
+		if e3 != other.Edges[i4] {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *FlowFunc) equalRef(other *FlowFunc) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func cloneGoOp(c *runtime.Cloner, node GoOp) GoOp {
+	switch node.(type) {
+	case *ConstantNil:
+		return node.(*ConstantNil).clone(c)
+	case *ConstantInt:
+		return node.(*ConstantInt).clone(c)
+	case *ConstantFloat32:
+		return node.(*ConstantFloat32).clone(c)
+	case *ConstantBool:
+		return node.(*ConstantBool).clone(c)
+	case *ConstantRune:
+		return node.(*ConstantRune).clone(c)
+	case *ConstantString:
+		return node.(*ConstantString).clone(c)
+	case *BinaryOp:
+		return node.(*BinaryOp).clone(c)
+	case *Attr:
+		return node.(*Attr).clone(c)
+	case *Call:
+		return node.(*Call).clone(c)
+	case *MethodCall:
+		return node.(*MethodCall).clone(c)
+	case *ConstructStruct:
+		return node.(*ConstructStruct).clone(c)
+	case *ConstructSlice:
+		return node.(*ConstructSlice).clone(c)
+	case *Coerce:
+		return node.(*Coerce).clone(c)
+	case *Transfer:
+		return node.(*Transfer).clone(c)
+	case *Return:
+		return node.(*Return).clone(c)
+	case *Nop:
+		return node.(*Nop).clone(c)
+	case *Entry:
+		return node.(*Entry).clone(c)
+	case *Switch:
+		return node.(*Switch).clone(c)
+	case *Exit:
+		return node.(*Exit).clone(c)
+	}
+	return node
+}
+
+func equalGoOp(c *runtime.Comparer, a GoOp, b GoOp) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *ConstantNil:
+		switch b.(type) {
+		case *ConstantNil:
+			return a.(*ConstantNil).equal(c, b.(*ConstantNil))
+		}
+	case *ConstantInt:
+		switch b.(type) {
+		case *ConstantInt:
+			return a.(*ConstantInt).equal(c, b.(*ConstantInt))
+		}
+	case *ConstantFloat32:
+		switch b.(type) {
+		case *ConstantFloat32:
+			return a.(*ConstantFloat32).equal(c, b.(*ConstantFloat32))
+		}
+	case *ConstantBool:
+		switch b.(type) {
+		case *ConstantBool:
+			return a.(*ConstantBool).equal(c, b.(*ConstantBool))
+		}
+	case *ConstantRune:
+		switch b.(type) {
+		case *ConstantRune:
+			return a.(*ConstantRune).equal(c, b.(*ConstantRune))
+		}
+	case *ConstantString:
+		switch b.(type) {
+		case *ConstantString:
+			return a.(*ConstantString).equal(c, b.(*ConstantString))
+		}
+	case *BinaryOp:
+		switch b.(type) {
+		case *BinaryOp:
+			return a.(*BinaryOp).equal(c, b.(*BinaryOp))
+		}
+	case *Attr:
+		switch b.(type) {
+		case *Attr:
+			return a.(*Attr).equal(c, b.(*Attr))
+		}
+	case *Call:
+		switch b.(type) {
+		case *Call:
+			return a.(*Call).equal(c, b.(*Call))
+		}
+	case *MethodCall:
+		switch b.(type) {
+		case *MethodCall:
+			return a.(*MethodCall).equal(c, b.(*MethodCall))
+		}
+	case *ConstructStruct:
+		switch b.(type) {
+		case *ConstructStruct:
+			return a.(*ConstructStruct).equal(c, b.(*ConstructStruct))
+		}
+	case *ConstructSlice:
+		switch b.(type) {
+		case *ConstructSlice:
+			return a.(*ConstructSlice).equal(c, b.(*ConstructSlice))
+		}
+	case *Coerce:
+		switch b.(type) {
+		case *Coerce:
+			return a.(*Coerce).equal(c, b.(*Coerce))
+		}
+	case *Transfer:
+		switch b.(type) {
+		case *Transfer:
+			return a.(*Transfer).equal(c, b.(*Transfer))
+		}
+	case *Return:
+		switch b.(type) {
+		case *Return:
+			return a.(*Return).equal(c, b.(*Return))
+		}
+	case *Nop:
+		switch b.(type) {
+		case *Nop:
+			return a.(*Nop).equal(c, b.(*Nop))
+		}
+	case *Entry:
+		switch b.(type) {
+		case *Entry:
+			return a.(*Entry).equal(c, b.(*Entry))
+		}
+	case *Switch:
+		switch b.(type) {
+		case *Switch:
+			return a.(*Switch).equal(c, b.(*Switch))
+		}
+	case *Exit:
+		switch b.(type) {
+		case *Exit:
+			return a.(*Exit).equal(c, b.(*Exit))
+		}
+	}
+	return a == b
+}
+
+func (node *ConstantNil) Clone() *ConstantNil {
+	var c *runtime.Cloner
+	var clone *ConstantNil
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantNil{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantNil) clone(c *runtime.Cloner) *ConstantNil {
+	var o interface{}
+	var clone *ConstantNil
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantNil)
+	}
+	clone = &ConstantNil{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantNil) cloneFields(c *runtime.Cloner, clone *ConstantNil) {
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantNil) Equal(other *ConstantNil) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantNil) equal(c *runtime.Comparer, other *ConstantNil) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantInt) Clone() *ConstantInt {
+	var c *runtime.Cloner
+	var clone *ConstantInt
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantInt{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantInt) clone(c *runtime.Cloner) *ConstantInt {
+	var o interface{}
+	var clone *ConstantInt
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantInt)
+	}
+	clone = &ConstantInt{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantInt) cloneFields(c *runtime.Cloner, clone *ConstantInt) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantInt) Equal(other *ConstantInt) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantInt) equal(c *runtime.Comparer, other *ConstantInt) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantFloat32) Clone() *ConstantFloat32 {
+	var c *runtime.Cloner
+	var clone *ConstantFloat32
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantFloat32{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantFloat32) clone(c *runtime.Cloner) *ConstantFloat32 {
+	var o interface{}
+	var clone *ConstantFloat32
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantFloat32)
+	}
+	clone = &ConstantFloat32{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantFloat32) cloneFields(c *runtime.Cloner, clone *ConstantFloat32) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantFloat32) Equal(other *ConstantFloat32) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantFloat32) equal(c *runtime.Comparer, other *ConstantFloat32) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantBool) Clone() *ConstantBool {
+	var c *runtime.Cloner
+	var clone *ConstantBool
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantBool{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantBool) clone(c *runtime.Cloner) *ConstantBool {
+	var o interface{}
+	var clone *ConstantBool
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantBool)
+	}
+	clone = &ConstantBool{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantBool) cloneFields(c *runtime.Cloner, clone *ConstantBool) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantBool) Equal(other *ConstantBool) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantBool) equal(c *runtime.Comparer, other *ConstantBool) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantRune) Clone() *ConstantRune {
+	var c *runtime.Cloner
+	var clone *ConstantRune
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantRune{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantRune) clone(c *runtime.Cloner) *ConstantRune {
+	var o interface{}
+	var clone *ConstantRune
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantRune)
+	}
+	clone = &ConstantRune{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantRune) cloneFields(c *runtime.Cloner, clone *ConstantRune) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantRune) Equal(other *ConstantRune) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantRune) equal(c *runtime.Comparer, other *ConstantRune) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantString) Clone() *ConstantString {
+	var c *runtime.Cloner
+	var clone *ConstantString
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantString{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantString) clone(c *runtime.Cloner) *ConstantString {
+	var o interface{}
+	var clone *ConstantString
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantString)
+	}
+	clone = &ConstantString{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantString) cloneFields(c *runtime.Cloner, clone *ConstantString) {
+	clone.Value = node.Value
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstantString) Equal(other *ConstantString) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantString) equal(c *runtime.Comparer, other *ConstantString) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *BinaryOp) Clone() *BinaryOp {
+	var c *runtime.Cloner
+	var clone *BinaryOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BinaryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryOp) clone(c *runtime.Cloner) *BinaryOp {
+	var o interface{}
+	var clone *BinaryOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BinaryOp)
+	}
+	clone = &BinaryOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryOp) cloneFields(c *runtime.Cloner, clone *BinaryOp) {
+	clone.Left = node.Left.clone(c)
+	clone.Op = node.Op
+	clone.Right = node.Right.clone(c)
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *BinaryOp) Equal(other *BinaryOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BinaryOp) equal(c *runtime.Comparer, other *BinaryOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Left.equalRef(other.Left) {
+		return false
+	}
+	if node.Op != other.Op {
+		return false
+	}
+	if !node.Right.equalRef(other.Right) {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *Attr) Clone() *Attr {
+	var c *runtime.Cloner
+	var clone *Attr
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Attr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Attr) clone(c *runtime.Cloner) *Attr {
+	var o interface{}
+	var clone *Attr
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Attr)
+	}
+	clone = &Attr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Attr) cloneFields(c *runtime.Cloner, clone *Attr) {
+	clone.Expr = node.Expr.clone(c)
+	clone.Name = node.Name
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *Attr) Equal(other *Attr) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Attr) equal(c *runtime.Comparer, other *Attr) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Expr.equalRef(other.Expr) {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *Call) Clone() *Call {
+	var c *runtime.Cloner
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) clone(c *runtime.Cloner) *Call {
+	var o interface{}
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Call)
+	}
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) cloneFields(c *runtime.Cloner, clone *Call) {
+	var s0 []*Register
+	var e0 *Register
+	var s1 []*Register
+	var e1 *Register
+	clone.Target = node.Target
+	s0 = nil
+	if node.Args != nil {
+		s0 = []*Register{}
+		for _, e0 = range node.Args {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Args = s0
+	s1 = nil
+	if node.Dsts != nil {
+		s1 = []*Register{}
+		for _, e1 = range node.Dsts {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Dsts = s1
+}
+
+func (node *Call) Equal(other *Call) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Call) equal(c *runtime.Comparer, other *Call) bool {
+	var i0 int
+	var e0 *Register
+	var i1 int
+	var e1 *Register
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Target != other.Target {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i0, e0 = range node.Args {
+		if !e0.equalRef(other.Args[i0]) {
+			return false
+		}
+	}
+	if len(node.Dsts) != len(other.Dsts) || node.Dsts == nil != (other.Dsts == nil) {
+		return false
+	}
+	for i1, e1 = range node.Dsts {
+		if !e1.equalRef(other.Dsts[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *MethodCall) Clone() *MethodCall {
+	var c *runtime.Cloner
+	var clone *MethodCall
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &MethodCall{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MethodCall) clone(c *runtime.Cloner) *MethodCall {
+	var o interface{}
+	var clone *MethodCall
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*MethodCall)
+	}
+	clone = &MethodCall{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *MethodCall) cloneFields(c *runtime.Cloner, clone *MethodCall) {
+	var s0 []*Register
+	var e0 *Register
+	var s1 []*Register
+	var e1 *Register
+	clone.Expr = node.Expr.clone(c)
+	clone.Name = node.Name
+	s0 = nil
+	if node.Args != nil {
+		s0 = []*Register{}
+		for _, e0 = range node.Args {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Args = s0
+	s1 = nil
+	if node.Dsts != nil {
+		s1 = []*Register{}
+		for _, e1 = range node.Dsts {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Dsts = s1
+}
+
+func (node *MethodCall) Equal(other *MethodCall) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *MethodCall) equal(c *runtime.Comparer, other *MethodCall) bool {
+	var i0 int
+	var e0 *Register
+	var i1 int
+	var e1 *Register
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Expr.equalRef(other.Expr) {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i0, e0 = range node.Args {
+		if !e0.equalRef(other.Args[i0]) {
+			return false
+		}
+	}
+	if len(node.Dsts) != len(other.Dsts) || node.Dsts == nil != (other.Dsts == nil) {
+		return false
+	}
+	for i1, e1 = range node.Dsts {
+		if !e1.equalRef(other.Dsts[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *NamedArg) Clone() *NamedArg {
+	var c *runtime.Cloner
+	var clone *NamedArg
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NamedArg{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedArg) clone(c *runtime.Cloner) *NamedArg {
+	var o interface{}
+	var clone *NamedArg
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NamedArg)
+	}
+	clone = &NamedArg{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedArg) cloneFields(c *runtime.Cloner, clone *NamedArg) {
+	clone.Name = node.Name
+	clone.Arg = node.Arg.clone(c)
+}
+
+func (node *NamedArg) Equal(other *NamedArg) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NamedArg) equal(c *runtime.Comparer, other *NamedArg) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Arg.equalRef(other.Arg) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstructStruct) Clone() *ConstructStruct {
+	var c *runtime.Cloner
+	var clone *ConstructStruct
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstructStruct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructStruct) clone(c *runtime.Cloner) *ConstructStruct {
+	var o interface{}
+	var clone *ConstructStruct
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstructStruct)
+	}
+	clone = &ConstructStruct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructStruct) cloneFields(c *runtime.Cloner, clone *ConstructStruct) {
+	var s []*NamedArg
+	var e *NamedArg
+	clone.Type = node.Type
+	clone.AddrTaken = node.AddrTaken
+	s = nil
+	if node.Args != nil {
+		s = []*NamedArg{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstructStruct) Equal(other *ConstructStruct) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstructStruct) equal(c *runtime.Comparer, other *ConstructStruct) bool {
+	var i int
+	var e *NamedArg
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Type != other.Type {
+		return false
+	}
+	if node.AddrTaken != other.AddrTaken {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equal(c, other.Args[i]) {
+			return false
+		}
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *ConstructSlice) Clone() *ConstructSlice {
+	var c *runtime.Cloner
+	var clone *ConstructSlice
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstructSlice{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructSlice) clone(c *runtime.Cloner) *ConstructSlice {
+	var o interface{}
+	var clone *ConstructSlice
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstructSlice)
+	}
+	clone = &ConstructSlice{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstructSlice) cloneFields(c *runtime.Cloner, clone *ConstructSlice) {
+	var s []*Register
+	var e *Register
+	clone.Type = node.Type
+	s = nil
+	if node.Args != nil {
+		s = []*Register{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *ConstructSlice) Equal(other *ConstructSlice) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstructSlice) equal(c *runtime.Comparer, other *ConstructSlice) bool {
+	var i int
+	var e *Register
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Type != other.Type {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equalRef(other.Args[i]) {
+			return false
+		}
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *Coerce) Clone() *Coerce {
+	var c *runtime.Cloner
+	var clone *Coerce
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Coerce{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Coerce) clone(c *runtime.Cloner) *Coerce {
+	var o interface{}
+	var clone *Coerce
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Coerce)
+	}
+	clone = &Coerce{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Coerce) cloneFields(c *runtime.Cloner, clone *Coerce) {
+	clone.Src = node.Src.clone(c)
+	clone.Type = node.Type
+	clone.Dst = node.Dst.clone(c)
+}
+
+func (node *Coerce) Equal(other *Coerce) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Coerce) equal(c *runtime.Comparer, other *Coerce) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Src.equalRef(other.Src) {
+		return false
+	}
+	if node.Type != other.Type {
+		return false
+	}
+	if !node.Dst.equalRef(other.Dst) {
+		return false
+	}
+	return true
+}
+
+func (node *Transfer) Clone() *Transfer {
+	var c *runtime.Cloner
+	var clone *Transfer
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Transfer{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Transfer) clone(c *runtime.Cloner) *Transfer {
+	var o interface{}
+	var clone *Transfer
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Transfer)
+	}
+	clone = &Transfer{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Transfer) cloneFields(c *runtime.Cloner, clone *Transfer) {
+	var s0 []*Register
+	var e0 *Register
+	var s1 []*Register
+	var e1 *Register
+	s0 = nil
+	if node.Srcs != nil {
+		s0 = []*Register{}
+		for _, e0 = range node.Srcs {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Srcs = s0
+	s1 = nil
+	if node.Dsts != nil {
+		s1 = []*Register{}
+		for _, e1 = range node.Dsts {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Dsts = s1
+}
+
+func (node *Transfer) Equal(other *Transfer) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Transfer) equal(c *runtime.Comparer, other *Transfer) bool {
+	var i0 int
+	var e0 *Register
+	var i1 int
+	var e1 *Register
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Srcs) != len(other.Srcs) || node.Srcs == nil != (other.Srcs == nil) {
+		return false
+	}
+	for i0, e0 = range node.Srcs {
+		if !e0.equalRef(other.Srcs[i0]) {
+			return false
+		}
+	}
+	if len(node.Dsts) != len(other.Dsts) || node.Dsts == nil != (other.Dsts == nil) {
+		return false
+	}
+	for i1, e1 = range node.Dsts {
+		if !e1.equalRef(other.Dsts[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Return) Clone() *Return {
+	var c *runtime.Cloner
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) clone(c *runtime.Cloner) *Return {
+	var o interface{}
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Return)
+	}
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) cloneFields(c *runtime.Cloner, clone *Return) {
+	var s []*Register
+	var e *Register
+	s = nil
+	if node.Args != nil {
+		s = []*Register{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *Return) Equal(other *Return) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Return) equal(c *runtime.Comparer, other *Return) bool {
+	var i int
+	var e *Register
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equalRef(other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Nop) Clone() *Nop {
+	var c *runtime.Cloner
+	var clone *Nop
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Nop{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Nop) clone(c *runtime.Cloner) *Nop {
+	var o interface{}
+	var clone *Nop
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Nop)
+	}
+	clone = &Nop{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Nop) cloneFields(c *runtime.Cloner, clone *Nop) {
+}
+
+func (node *Nop) Equal(other *Nop) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Nop) equal(c *runtime.Comparer, other *Nop) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *Entry) Clone() *Entry {
+	var c *runtime.Cloner
+	var clone *Entry
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Entry{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Entry) clone(c *runtime.Cloner) *Entry {
+	var o interface{}
+	var clone *Entry
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Entry)
+	}
+	clone = &Entry{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Entry) cloneFields(c *runtime.Cloner, clone *Entry) {
+}
+
+func (node *Entry) Equal(other *Entry) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Entry) equal(c *runtime.Comparer, other *Entry) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *Switch) Clone() *Switch {
+	var c *runtime.Cloner
+	var clone *Switch
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Switch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Switch) clone(c *runtime.Cloner) *Switch {
+	var o interface{}
+	var clone *Switch
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Switch)
+	}
+	clone = &Switch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Switch) cloneFields(c *runtime.Cloner, clone *Switch) {
+	clone.Cond = node.Cond.clone(c)
+}
+
+func (node *Switch) Equal(other *Switch) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Switch) equal(c *runtime.Comparer, other *Switch) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Cond.equalRef(other.Cond) {
+		return false
+	}
+	return true
+}
+
+func (node *Exit) Clone() *Exit {
+	var c *runtime.Cloner
+	var clone *Exit
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Exit{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Exit) clone(c *runtime.Cloner) *Exit {
+	var o interface{}
+	var clone *Exit
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Exit)
+	}
+	clone = &Exit{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Exit) cloneFields(c *runtime.Cloner, clone *Exit) {
+}
+
+func (node *Exit) Equal(other *Exit) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Exit) equal(c *runtime.Comparer, other *Exit) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *FlowProgram) Clone() *FlowProgram {
+	var c *runtime.Cloner
+	var clone *FlowProgram
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FlowProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FlowProgram) clone(c *runtime.Cloner) *FlowProgram {
+	var o interface{}
+	var clone *FlowProgram
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FlowProgram)
+	}
+	clone = &FlowProgram{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FlowProgram) cloneFields(c *runtime.Cloner, clone *FlowProgram) {
+	var o *FlowFunc
+	var x *FlowFunc
+	var i int
+	var s []core.GoType
+	var e core.GoType
+	if node.FlowFunc_Scope != nil {
+		clone.FlowFunc_Scope = &FlowFunc_Scope{}
+		for _, o = range node.FlowFunc_Scope.objects {
+			x = &FlowFunc{}
+			c.Map(o, x)
+			clone.FlowFunc_Scope.objects = append(clone.FlowFunc_Scope.objects, x)
+		}
+		for i, o = range node.FlowFunc_Scope.objects {
+			o.cloneFields(c, clone.FlowFunc_Scope.objects[i])
+		}
+	}
+	s = nil
+	if node.Types != nil {
+		s = []core.GoType{}
+		for _, e = range node.Types {
+			s = append(s, e)
+		}
+	}
+	clone.Types = s
+	clone.Builtins = node.Builtins
+}
+
+func (node *FlowProgram) Equal(other *FlowProgram) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FlowProgram) equal(c *runtime.Comparer, other *FlowProgram) bool {
+	var i0 int
+	var o *FlowFunc
+	var i1 int
+	var e core.GoType
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.FlowFunc_Scope == nil != (other.FlowFunc_Scope == nil) {
+		return false
+	}
+	if node.FlowFunc_Scope != nil {
+		if len(node.FlowFunc_Scope.objects) != len(other.FlowFunc_Scope.objects) {
+			return false
+		}
+		for i0, o = range node.FlowFunc_Scope.objects {
+			if !o.equal(c, other.FlowFunc_Scope.objects[i0]) {
+				return false
+			}
+		}
+	}
+	if len(node.Types) != len(other.Types) || node.Types == nil != (other.Types == nil) {
+		return false
+	}
+	for i1, e = range node.Types {
+		if e != other.Types[i1] {
+			return false
+		}
+	}
+	if node.Builtins != other.Builtins {
+		return false
+	}
+	return true
+}
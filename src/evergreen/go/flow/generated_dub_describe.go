@@ -0,0 +1,449 @@
+package flow
+
+import (
+	"evergreen/dub/runtime"
+	"evergreen/go/core"
+)
+
+func (node *Register) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Register")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *Register) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("Register", int(node.Index))
+}
+
+func (node *Register) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FlowFunc) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Register
+	var l1 *runtime.List
+	var e1 *Register
+	var l2 *runtime.List
+	var e2 GoOp
+	var l3 *runtime.List
+	var e3 int
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FlowFunc")
+	d.AddField("Function", node.Function.DescribeRef())
+	d.AddField("Recv", node.Recv.DescribeRef())
+	l0 = runtime.MakeList("[]Register")
+	for _, e0 = range node.Params {
+		l0.Append(e0.DescribeRef())
+	}
+	d.AddField("Params", l0)
+	l1 = runtime.MakeList("[]Register")
+	for _, e1 = range node.Results {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Results", l1)
+	d.AddField("CFG", runtime.Describe(node.CFG))
+	l2 = runtime.MakeList("[]GoOp")
+	for _, e2 = range node.Ops {
+		l2.Append(runtime.Describe(e2))
+	}
+	d.AddField("Ops", l2)
+	l3 = runtime.MakeList("[]int")
+	for _, e3 = range node.Edges {
+		l3.Append(runtime.Describe(e3))
+	}
+	d.AddField("Edges", l3)
+	return d
+}
+
+func (node *FlowFunc) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("FlowFunc", int(node.Index))
+}
+
+func (node *FlowFunc) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantNil) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantNil")
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantNil) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantInt) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantInt")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantInt) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantFloat32) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantFloat32")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantFloat32) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantBool) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantBool")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantBool) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantRune) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantRune")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantRune) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantString) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantString")
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstantString) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BinaryOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BinaryOp")
+	d.AddField("Left", node.Left.DescribeRef())
+	d.AddField("Op", runtime.Describe(node.Op))
+	d.AddField("Right", node.Right.DescribeRef())
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *BinaryOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Attr) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Attr")
+	d.AddField("Expr", node.Expr.DescribeRef())
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *Attr) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Call) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Register
+	var l1 *runtime.List
+	var e1 *Register
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Call")
+	d.AddField("Target", runtime.Describe(node.Target))
+	l0 = runtime.MakeList("[]Register")
+	for _, e0 = range node.Args {
+		l0.Append(e0.DescribeRef())
+	}
+	d.AddField("Args", l0)
+	l1 = runtime.MakeList("[]Register")
+	for _, e1 = range node.Dsts {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Dsts", l1)
+	return d
+}
+
+func (node *Call) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *MethodCall) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Register
+	var l1 *runtime.List
+	var e1 *Register
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("MethodCall")
+	d.AddField("Expr", node.Expr.DescribeRef())
+	d.AddField("Name", runtime.Describe(node.Name))
+	l0 = runtime.MakeList("[]Register")
+	for _, e0 = range node.Args {
+		l0.Append(e0.DescribeRef())
+	}
+	d.AddField("Args", l0)
+	l1 = runtime.MakeList("[]Register")
+	for _, e1 = range node.Dsts {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Dsts", l1)
+	return d
+}
+
+func (node *MethodCall) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NamedArg) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NamedArg")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Arg", node.Arg.DescribeRef())
+	return d
+}
+
+func (node *NamedArg) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstructStruct) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *NamedArg
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstructStruct")
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("AddrTaken", runtime.Describe(node.AddrTaken))
+	l = runtime.MakeList("[]NamedArg")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstructStruct) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstructSlice) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *Register
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstructSlice")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]Register")
+	for _, e = range node.Args {
+		l.Append(e.DescribeRef())
+	}
+	d.AddField("Args", l)
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *ConstructSlice) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Coerce) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Coerce")
+	d.AddField("Src", node.Src.DescribeRef())
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Dst", node.Dst.DescribeRef())
+	return d
+}
+
+func (node *Coerce) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Transfer) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Register
+	var l1 *runtime.List
+	var e1 *Register
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Transfer")
+	l0 = runtime.MakeList("[]Register")
+	for _, e0 = range node.Srcs {
+		l0.Append(e0.DescribeRef())
+	}
+	d.AddField("Srcs", l0)
+	l1 = runtime.MakeList("[]Register")
+	for _, e1 = range node.Dsts {
+		l1.Append(e1.DescribeRef())
+	}
+	d.AddField("Dsts", l1)
+	return d
+}
+
+func (node *Transfer) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Return) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *Register
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Return")
+	l = runtime.MakeList("[]Register")
+	for _, e = range node.Args {
+		l.Append(e.DescribeRef())
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *Return) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Nop) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("Nop")
+}
+
+func (node *Nop) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Entry) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("Entry")
+}
+
+func (node *Entry) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Switch) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Switch")
+	d.AddField("Cond", node.Cond.DescribeRef())
+	return d
+}
+
+func (node *Switch) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Exit) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("Exit")
+}
+
+func (node *Exit) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FlowProgram) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e core.GoType
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FlowProgram")
+	l = runtime.MakeList("[]GoType")
+	for _, e = range node.Types {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Types", l)
+	d.AddField("Builtins", runtime.Describe(node.Builtins))
+	return d
+}
+
+func (node *FlowProgram) String() string {
+	return runtime.Format(node.Describe())
+}
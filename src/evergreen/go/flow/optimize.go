@@ -0,0 +1,390 @@
+package flow
+
+import (
+	"evergreen/compiler"
+	"evergreen/go/core"
+	"evergreen/graph"
+)
+
+// Optimization levels.
+const (
+	// Translate the flow graphs as they are.
+	O0 = iota
+	// Convert to SSA, propagate copies, and remove dead ops.
+	O1
+	// Also propagate constants and remove branches that cannot be taken.
+	O2
+)
+
+// Disconnects a node with a single exit, leaving a Nop behind so that ops in
+// dead nodes are never mistaken for live ones.
+func killNode(decl *FlowFunc, n graph.NodeID) {
+	decl.CFG.KillNode(n)
+	decl.Ops[n] = &Nop{}
+}
+
+// Removes every node that cannot be reached from the entry.
+func removeUnreachable(decl *FlowFunc) {
+	g := decl.CFG
+	order, _ := graph.ReversePostorder(g)
+	reachable := make([]bool, g.NumNodes())
+	for _, n := range order {
+		reachable[n] = true
+	}
+	nit := g.NodeIterator()
+	for nit.HasNext() {
+		n := nit.GetNext()
+		if reachable[n] {
+			continue
+		}
+		eit := g.ExitIterator(n)
+		for eit.HasNext() {
+			e, _ := eit.GetNext()
+			g.KillEdge(e)
+		}
+		if n != g.Exit() {
+			decl.Ops[n] = &Nop{}
+		}
+	}
+}
+
+// Pure ops can be removed if their outputs are not used.
+func IsPure(op GoOp) bool {
+	switch op := op.(type) {
+	case *ConstantNil, *ConstantInt, *ConstantFloat32, *ConstantBool, *ConstantRune, *ConstantString:
+		return true
+	case *BinaryOp, *Attr, *ConstructStruct, *ConstructSlice, *Coerce, *Transfer:
+		return true
+	case *Call:
+		// Intrinsics such as append do not have side effects, and Go does
+		// not allow their results to be discarded.
+		_, ok := op.Target.(*core.IntrinsicFunction)
+		return ok
+	default:
+		return false
+	}
+}
+
+// Rewrites every use of a register according to a replacement table.
+func replaceUses(op GoOp, replace []*Register) {
+	get := func(reg *Register) *Register {
+		for replace[reg.Index] != nil {
+			reg = replace[reg.Index]
+		}
+		return reg
+	}
+	getList := func(regs []*Register) {
+		for i, reg := range regs {
+			regs[i] = get(reg)
+		}
+	}
+	switch op := op.(type) {
+	case *BinaryOp:
+		op.Left = get(op.Left)
+		op.Right = get(op.Right)
+	case *Attr:
+		op.Expr = get(op.Expr)
+	case *Call:
+		getList(op.Args)
+	case *MethodCall:
+		op.Expr = get(op.Expr)
+		getList(op.Args)
+	case *ConstructStruct:
+		for _, arg := range op.Args {
+			arg.Arg = get(arg.Arg)
+		}
+	case *ConstructSlice:
+		getList(op.Args)
+	case *Coerce:
+		op.Src = get(op.Src)
+	case *Transfer:
+		getList(op.Srcs)
+	case *Return:
+		getList(op.Args)
+	case *Switch:
+		op.Cond = get(op.Cond)
+	}
+}
+
+// Removes transfers that do not change the value of a register, and
+// forwards copies to their uses.  A register defined by transfers that all
+// copy the same value is also a copy.  Assumes SSA form.
+func PropagateCopies(decl *FlowFunc) {
+	g := decl.CFG
+	pinned := pinnedRegisters(decl)
+	for {
+		order, _ := graph.ReversePostorder(g)
+
+		// Find the single source of each register defined by transfers.
+		source := make([]*Register, decl.Register_Scope.Len())
+		copyable := make([]bool, decl.Register_Scope.Len())
+		for i := range copyable {
+			copyable[i] = !pinned[i]
+		}
+		for _, n := range order {
+			op := decl.Ops[n]
+			transfer, ok := op.(*Transfer)
+			if !ok {
+				for _, dst := range OpDefs(decl, op) {
+					if dst != nil {
+						copyable[dst.Index] = false
+					}
+				}
+				continue
+			}
+			for i, dst := range transfer.Dsts {
+				src := transfer.Srcs[i]
+				if src == dst {
+					continue
+				}
+				if source[dst.Index] == nil {
+					source[dst.Index] = src
+				} else if source[dst.Index] != src {
+					copyable[dst.Index] = false
+				}
+			}
+		}
+
+		replace := make([]*Register, decl.Register_Scope.Len())
+		changed := false
+		for i, src := range source {
+			if src != nil && copyable[i] {
+				replace[i] = src
+				changed = true
+			}
+		}
+		if !changed {
+			return
+		}
+		// Break cycles, which are only possible in unreachable code.
+		for i := range replace {
+			seen := map[*Register]bool{}
+			for reg := replace[i]; reg != nil; reg = replace[reg.Index] {
+				if seen[reg] {
+					replace[i] = nil
+					break
+				}
+				seen[reg] = true
+			}
+		}
+
+		for _, n := range order {
+			op := decl.Ops[n]
+			replaceUses(op, replace)
+			transfer, ok := op.(*Transfer)
+			if !ok {
+				continue
+			}
+			srcs := []*Register{}
+			dsts := []*Register{}
+			for i, dst := range transfer.Dsts {
+				if replace[dst.Index] != nil || transfer.Srcs[i] == dst {
+					continue
+				}
+				srcs = append(srcs, transfer.Srcs[i])
+				dsts = append(dsts, dst)
+			}
+			transfer.Srcs = srcs
+			transfer.Dsts = dsts
+			if len(dsts) == 0 {
+				killNode(decl, n)
+			}
+		}
+	}
+}
+
+// Removes ops that have no side effects and produce values that are never
+// used.  Assumes SSA form.
+func EliminateDeadOps(decl *FlowFunc) {
+	g := decl.CFG
+	order, _ := graph.ReversePostorder(g)
+
+	// Find the nodes that define each register.
+	defs := make([][]graph.NodeID, decl.Register_Scope.Len())
+	for _, n := range order {
+		for _, dst := range OpDefs(decl, decl.Ops[n]) {
+			if dst != nil {
+				defs[dst.Index] = append(defs[dst.Index], n)
+			}
+		}
+	}
+
+	// Mark registers used by ops with side effects, and everything they
+	// depend on.
+	live := make([]bool, decl.Register_Scope.Len())
+	pending := []*Register{}
+	mark := func(reg *Register) {
+		if !live[reg.Index] {
+			live[reg.Index] = true
+			pending = append(pending, reg)
+		}
+	}
+	for _, r := range decl.Results {
+		mark(r)
+	}
+	for _, n := range order {
+		op := decl.Ops[n]
+		if !IsPure(op) {
+			for _, reg := range OpUses(op) {
+				mark(reg)
+			}
+		}
+	}
+	for len(pending) > 0 {
+		reg := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for _, n := range defs[reg.Index] {
+			op := decl.Ops[n]
+			if transfer, ok := op.(*Transfer); ok {
+				// Only the matching source is needed.
+				for i, dst := range transfer.Dsts {
+					if dst == reg {
+						mark(transfer.Srcs[i])
+					}
+				}
+			} else if IsPure(op) {
+				for _, use := range OpUses(op) {
+					mark(use)
+				}
+			}
+		}
+	}
+
+	isDead := func(reg *Register) bool {
+		return reg == nil || !live[reg.Index]
+	}
+	for _, n := range order {
+		switch op := decl.Ops[n].(type) {
+		case *Transfer:
+			srcs := []*Register{}
+			dsts := []*Register{}
+			for i, dst := range op.Dsts {
+				if !isDead(dst) {
+					srcs = append(srcs, op.Srcs[i])
+					dsts = append(dsts, dst)
+				}
+			}
+			op.Srcs = srcs
+			op.Dsts = dsts
+			if len(dsts) == 0 {
+				killNode(decl, n)
+			}
+		case *Call:
+			op.Dsts = removeDeadOutputs(op.Dsts, isDead)
+			if len(op.Dsts) == 0 && IsPure(op) {
+				killNode(decl, n)
+			}
+		case *MethodCall:
+			op.Dsts = removeDeadOutputs(op.Dsts, isDead)
+		default:
+			if !IsPure(op) {
+				continue
+			}
+			dead := true
+			for _, dst := range OpDefs(decl, op) {
+				if !isDead(dst) {
+					dead = false
+				}
+			}
+			if dead {
+				killNode(decl, n)
+			}
+		}
+	}
+}
+
+// Discards unused outputs of a call.  If none of them are used, the call is
+// treated as a statement.
+func removeDeadOutputs(dsts []*Register, isDead func(*Register) bool) []*Register {
+	anyLive := false
+	for i, dst := range dsts {
+		if isDead(dst) {
+			dsts[i] = nil
+		} else {
+			anyLive = true
+		}
+	}
+	if !anyLive {
+		return []*Register{}
+	}
+	return dsts
+}
+
+// Removes nodes that do nothing.
+func removeNops(decl *FlowFunc) {
+	g := decl.CFG
+	order, _ := graph.ReversePostorder(g)
+	for _, n := range order {
+		if _, ok := decl.Ops[n].(*Nop); !ok {
+			continue
+		}
+		if _, next := g.GetUniqueExit(n); next != graph.NoNode && next != n {
+			killNode(decl, n)
+		}
+	}
+}
+
+// Drops registers that are no longer referenced.
+func compactRegisters(decl *FlowFunc) {
+	used := make([]bool, decl.Register_Scope.Len())
+	use := func(reg *Register) {
+		if reg != nil {
+			used[reg.Index] = true
+		}
+	}
+	if decl.Recv != nil {
+		use(decl.Recv)
+	}
+	for _, r := range decl.Params {
+		use(r)
+	}
+	for _, r := range decl.Results {
+		use(r)
+	}
+	order, _ := graph.ReversePostorder(decl.CFG)
+	for _, n := range order {
+		op := decl.Ops[n]
+		for _, reg := range OpUses(op) {
+			use(reg)
+		}
+		for _, reg := range OpDefs(decl, op) {
+			use(reg)
+		}
+	}
+	remap := make([]int, len(used))
+	count := 0
+	for i, isUsed := range used {
+		if isUsed {
+			remap[i] = count
+			count += 1
+		} else {
+			remap[i] = -1
+		}
+	}
+	decl.Register_Scope.Remap(remap, count)
+}
+
+func OptimizeFunc(decl *FlowFunc, builtins *core.BuiltinTypeIndex, level int) {
+	if level <= O0 {
+		return
+	}
+	SSA(decl)
+	if level >= O2 {
+		PropagateConstants(decl, builtins)
+	}
+	PropagateCopies(decl)
+	EliminateDeadOps(decl)
+	removeNops(decl)
+	compactRegisters(decl)
+}
+
+func OptimizeProgram(status compiler.PassStatus, program *FlowProgram, level int) {
+	status.Begin()
+	defer status.End()
+
+	iter := program.FlowFunc_Scope.Iter()
+	for iter.Next() {
+		_, decl := iter.Value()
+		OptimizeFunc(decl, program.Builtins, level)
+	}
+}
@@ -0,0 +1,157 @@
+package flow
+
+import (
+	"evergreen/go/core"
+	"evergreen/graph"
+	"testing"
+)
+
+func makeBuiltins() *core.BuiltinTypeIndex {
+	return &core.BuiltinTypeIndex{
+		Int:    &core.ExternalType{Name: "int"},
+		Bool:   &core.ExternalType{Name: "bool"},
+		String: &core.ExternalType{Name: "string"},
+	}
+}
+
+// Builds:
+//
+//	a := 1
+//	b := 2
+//	unused := 3
+//	if a < b {
+//		x = "yes"
+//	} else {
+//		x = "no"
+//	}
+//	y := x
+//	return y
+func makeBranchyFunc(builtins *core.BuiltinTypeIndex) *FlowFunc {
+	decl := &FlowFunc{Register_Scope: &Register_Scope{}}
+	b := MakeGoFlowBuilder(decl)
+	ra := b.MakeRegister("a", builtins.Int)
+	rb := b.MakeRegister("b", builtins.Int)
+	unused := b.MakeRegister("unused", builtins.Int)
+	cond := b.MakeRegister("cond", builtins.Bool)
+	x := b.MakeRegister("x", builtins.String)
+	y := b.MakeRegister("y", builtins.String)
+	ret := b.MakeRegister("ret", builtins.String)
+	decl.Results = []*Register{ret}
+
+	g := decl.CFG
+	n0 := b.EmitOp(&ConstantInt{Value: 1, Dst: ra})
+	n1 := b.EmitOp(&ConstantInt{Value: 2, Dst: rb})
+	n2 := b.EmitOp(&ConstantInt{Value: 3, Dst: unused})
+	n3 := b.EmitOp(&BinaryOp{Left: ra, Op: "<", Right: rb, Dst: cond})
+	n4 := b.EmitOp(&Switch{Cond: cond})
+	t := b.EmitOp(&ConstantString{Value: "yes", Dst: x})
+	f := b.EmitOp(&ConstantString{Value: "no", Dst: x})
+	cp := b.EmitOp(&Transfer{Srcs: []*Register{x}, Dsts: []*Register{y}})
+	tr := b.EmitOp(&Transfer{Srcs: []*Register{y}, Dsts: []*Register{ret}})
+	r := b.EmitOp(&Return{})
+
+	b.EmitConnection(g.Entry(), NORMAL, n0)
+	b.EmitConnection(n0, NORMAL, n1)
+	b.EmitConnection(n1, NORMAL, n2)
+	b.EmitConnection(n2, NORMAL, n3)
+	b.EmitConnection(n3, NORMAL, n4)
+	b.EmitConnection(n4, COND_TRUE, t)
+	b.EmitConnection(n4, COND_FALSE, f)
+	b.EmitConnection(t, NORMAL, cp)
+	b.EmitConnection(f, NORMAL, cp)
+	b.EmitConnection(cp, NORMAL, tr)
+	b.EmitConnection(tr, NORMAL, r)
+	b.EmitConnection(r, RETURN, g.Exit())
+	return decl
+}
+
+func reachableOps(decl *FlowFunc) []GoOp {
+	order, _ := graph.ReversePostorder(decl.CFG)
+	ops := make([]GoOp, len(order))
+	for i, n := range order {
+		ops[i] = decl.Ops[n]
+	}
+	return ops
+}
+
+func TestOptimizeO1(t *testing.T) {
+	builtins := makeBuiltins()
+	decl := makeBranchyFunc(builtins)
+	OptimizeFunc(decl, builtins, O1)
+
+	transfers := 0
+	for _, op := range reachableOps(decl) {
+		switch op := op.(type) {
+		case *ConstantInt:
+			if op.Value == 3 {
+				t.Error("dead constant was not removed")
+			}
+		case *Transfer:
+			transfers += 1
+			for _, dst := range op.Dsts {
+				if dst.Name == "y" {
+					t.Error("copy was not propagated")
+				}
+			}
+		case *Nop:
+			t.Error("nop was not removed")
+		}
+	}
+	// One transfer for each side of the merge, and one for the result.
+	if transfers != 3 {
+		t.Errorf("expected 3 transfers, got %d", transfers)
+	}
+}
+
+func TestOptimizeO2(t *testing.T) {
+	builtins := makeBuiltins()
+	decl := makeBranchyFunc(builtins)
+	OptimizeFunc(decl, builtins, O2)
+
+	ops := reachableOps(decl)
+	if len(ops) != 5 {
+		t.Fatalf("expected 5 ops, got %#v", ops)
+	}
+	value, ok := ops[1].(*ConstantString)
+	if !ok || value.Value != "yes" {
+		t.Fatalf("expected the true branch, got %#v", ops[1])
+	}
+	transfer, ok := ops[2].(*Transfer)
+	if !ok || len(transfer.Srcs) != 1 || transfer.Srcs[0] != value.Dst || transfer.Dsts[0] != decl.Results[0] {
+		t.Fatalf("expected the constant to be returned, got %#v", ops[2])
+	}
+	if _, ok := ops[3].(*Return); !ok {
+		t.Fatalf("expected return, got %#v", ops[3])
+	}
+	if decl.Register_Scope.Len() != 2 {
+		t.Errorf("expected 2 registers, got %d", decl.Register_Scope.Len())
+	}
+}
+
+func TestFoldBinary(t *testing.T) {
+	cases := []struct {
+		op     string
+		left   interface{}
+		right  interface{}
+		result interface{}
+		ok     bool
+	}{
+		{"+", int64(2), int64(3), int64(5), true},
+		{"<", int64(2), int64(3), true, true},
+		{"*", int64(1 << 20), int64(1 << 20), nil, false},
+		{"/", int64(6), int64(3), nil, false},
+		{"-", 'b', 'a', rune(1), true},
+		{"==", "x", "x", true, true},
+		{"+", "x", "y", "xy", true},
+		{"||", false, true, true, true},
+		{"<", float32(1), float32(2), true, true},
+		{"+", float32(1), float32(2), nil, false},
+		{"==", int64(1), "1", nil, false},
+	}
+	for _, c := range cases {
+		result, ok := foldBinary(c.op, c.left, c.right)
+		if ok != c.ok || result != c.result {
+			t.Errorf("%#v %s %#v: expected %#v %v, got %#v %v", c.left, c.op, c.right, c.result, c.ok, result, ok)
+		}
+	}
+}
@@ -0,0 +1,321 @@
+package flow
+
+import (
+	"evergreen/graph"
+	"evergreen/ssi"
+)
+
+// Registers that keep their identity through SSA conversion.  Results are
+// assigned right before returning and implicitly read by the return.
+func pinnedRegisters(decl *FlowFunc) []bool {
+	pinned := make([]bool, decl.Register_Scope.Len())
+	for _, r := range decl.Results {
+		pinned[r.Index] = true
+	}
+	return pinned
+}
+
+// Registers read by an op.
+func OpUses(op GoOp) []*Register {
+	switch op := op.(type) {
+	case *BinaryOp:
+		return []*Register{op.Left, op.Right}
+	case *Attr:
+		return []*Register{op.Expr}
+	case *Call:
+		return op.Args
+	case *MethodCall:
+		return append([]*Register{op.Expr}, op.Args...)
+	case *ConstructStruct:
+		regs := make([]*Register, len(op.Args))
+		for i, arg := range op.Args {
+			regs[i] = arg.Arg
+		}
+		return regs
+	case *ConstructSlice:
+		return op.Args
+	case *Coerce:
+		return []*Register{op.Src}
+	case *Transfer:
+		return op.Srcs
+	case *Return:
+		return op.Args
+	case *Switch:
+		return []*Register{op.Cond}
+	case *Entry, *Exit, *Nop, *ConstantNil, *ConstantInt, *ConstantFloat32, *ConstantBool, *ConstantRune, *ConstantString:
+		return nil
+	default:
+		panic(op)
+	}
+}
+
+// Registers written by an op.  Discarded outputs are nil.
+func OpDefs(decl *FlowFunc, op GoOp) []*Register {
+	switch op := op.(type) {
+	case *Entry:
+		defs := []*Register{}
+		if decl.Recv != nil {
+			defs = append(defs, decl.Recv)
+		}
+		return append(defs, decl.Params...)
+	case *ConstantNil:
+		return []*Register{op.Dst}
+	case *ConstantInt:
+		return []*Register{op.Dst}
+	case *ConstantFloat32:
+		return []*Register{op.Dst}
+	case *ConstantBool:
+		return []*Register{op.Dst}
+	case *ConstantRune:
+		return []*Register{op.Dst}
+	case *ConstantString:
+		return []*Register{op.Dst}
+	case *BinaryOp:
+		return []*Register{op.Dst}
+	case *Attr:
+		return []*Register{op.Dst}
+	case *Call:
+		return op.Dsts
+	case *MethodCall:
+		return op.Dsts
+	case *ConstructStruct:
+		return []*Register{op.Dst}
+	case *ConstructSlice:
+		return []*Register{op.Dst}
+	case *Coerce:
+		return []*Register{op.Dst}
+	case *Transfer:
+		return op.Dsts
+	case *Exit, *Nop, *Return, *Switch:
+		return nil
+	default:
+		panic(op)
+	}
+}
+
+func makeDefUse(decl *FlowFunc, order []graph.NodeID, pinned []bool) *ssi.DefUseCollector {
+	defuse := ssi.CreateDefUse(len(decl.Ops), decl.Register_Scope.Len())
+	nit := graph.OrderedIterator(order)
+	for nit.HasNext() {
+		n := nit.GetNext()
+		op := decl.Ops[n]
+		for _, reg := range OpUses(op) {
+			if !pinned[reg.Index] {
+				defuse.AddUse(n, int(reg.Index))
+			}
+		}
+		for _, reg := range OpDefs(decl, op) {
+			if reg != nil && !pinned[reg.Index] {
+				defuse.AddDef(n, int(reg.Index))
+			}
+		}
+	}
+	return defuse
+}
+
+type nameMap struct {
+	names     []map[Register_Ref]*Register
+	transfers []map[Register_Ref]*Register
+	idoms     []graph.NodeID
+}
+
+func (nm *nameMap) get(n graph.NodeID, reg *Register) *Register {
+	newReg, ok := nm.names[n][reg.Index]
+	if !ok {
+		if n == nm.idoms[n] {
+			// Not defined on the way in, so the zero value is read.
+			return reg
+		}
+		newReg = nm.get(nm.idoms[n], reg)
+		nm.names[n][reg.Index] = newReg
+	}
+	return newReg
+}
+
+type renamer struct {
+	decl   *FlowFunc
+	pinned []bool
+	nm     *nameMap
+}
+
+func (r *renamer) allocate(reg *Register) *Register {
+	return r.decl.Register_Scope.Register(&Register{
+		Name: reg.Name,
+		T:    reg.T,
+	})
+}
+
+func (r *renamer) get(n graph.NodeID, reg *Register) *Register {
+	if r.pinned[reg.Index] {
+		return reg
+	}
+	return r.nm.get(n, reg)
+}
+
+func (r *renamer) getList(n graph.NodeID, regs []*Register) {
+	for i, reg := range regs {
+		regs[i] = r.get(n, reg)
+	}
+}
+
+func (r *renamer) output(n graph.NodeID, reg *Register) *Register {
+	if reg == nil || r.pinned[reg.Index] {
+		return reg
+	}
+	newReg := r.allocate(reg)
+	r.nm.names[n][reg.Index] = newReg
+	return newReg
+}
+
+// All the transfers into a node must agree on the names they define.
+func (r *renamer) transfer(dst graph.NodeID, reg *Register) *Register {
+	if r.pinned[reg.Index] {
+		return reg
+	}
+	newReg, ok := r.nm.transfers[dst][reg.Index]
+	if !ok {
+		newReg = r.allocate(reg)
+		r.nm.transfers[dst][reg.Index] = newReg
+		r.nm.names[dst][reg.Index] = newReg
+	}
+	return newReg
+}
+
+func (r *renamer) renameOp(n graph.NodeID, data GoOp) {
+	switch op := data.(type) {
+	case *Entry:
+		// Parameters keep their registers.
+		for _, reg := range OpDefs(r.decl, op) {
+			r.nm.names[n][reg.Index] = reg
+		}
+	case *Exit, *Nop:
+	case *ConstantNil:
+		op.Dst = r.output(n, op.Dst)
+	case *ConstantInt:
+		op.Dst = r.output(n, op.Dst)
+	case *ConstantFloat32:
+		op.Dst = r.output(n, op.Dst)
+	case *ConstantBool:
+		op.Dst = r.output(n, op.Dst)
+	case *ConstantRune:
+		op.Dst = r.output(n, op.Dst)
+	case *ConstantString:
+		op.Dst = r.output(n, op.Dst)
+	case *BinaryOp:
+		op.Left = r.get(n, op.Left)
+		op.Right = r.get(n, op.Right)
+		op.Dst = r.output(n, op.Dst)
+	case *Attr:
+		op.Expr = r.get(n, op.Expr)
+		op.Dst = r.output(n, op.Dst)
+	case *Call:
+		r.getList(n, op.Args)
+		for i, dst := range op.Dsts {
+			op.Dsts[i] = r.output(n, dst)
+		}
+	case *MethodCall:
+		op.Expr = r.get(n, op.Expr)
+		r.getList(n, op.Args)
+		for i, dst := range op.Dsts {
+			op.Dsts[i] = r.output(n, dst)
+		}
+	case *ConstructStruct:
+		for _, arg := range op.Args {
+			arg.Arg = r.get(n, arg.Arg)
+		}
+		op.Dst = r.output(n, op.Dst)
+	case *ConstructSlice:
+		r.getList(n, op.Args)
+		op.Dst = r.output(n, op.Dst)
+	case *Coerce:
+		op.Src = r.get(n, op.Src)
+		op.Dst = r.output(n, op.Dst)
+	case *Transfer:
+		r.getList(n, op.Srcs)
+		// The destinations need to be consistent based on the target
+		_, tgt := r.decl.CFG.GetUniqueExit(n)
+		for i, dst := range op.Dsts {
+			op.Dsts[i] = r.transfer(tgt, dst)
+		}
+	case *Return:
+		r.getList(n, op.Args)
+	case *Switch:
+		op.Cond = r.get(n, op.Cond)
+	default:
+		panic(data)
+	}
+}
+
+func rename(decl *FlowFunc, pinned []bool) {
+	g := decl.CFG
+	order, index := graph.ReversePostorder(g)
+	idoms := graph.FindDominators(g, order, index)
+
+	nm := &nameMap{
+		names:     make([]map[Register_Ref]*Register, g.NumNodes()),
+		transfers: make([]map[Register_Ref]*Register, g.NumNodes()),
+		idoms:     idoms,
+	}
+	for i := 0; i < g.NumNodes(); i++ {
+		nm.names[i] = map[Register_Ref]*Register{}
+		nm.transfers[i] = map[Register_Ref]*Register{}
+	}
+	r := &renamer{decl: decl, pinned: pinned, nm: nm}
+
+	nit := graph.OrderedIterator(order)
+	for nit.HasNext() {
+		n := nit.GetNext()
+		r.renameOp(n, decl.Ops[n])
+	}
+}
+
+// Inserts a transfer on every edge entering a node that merges definitions.
+func placeTransfers(decl *FlowFunc, order []graph.NodeID, builder *ssi.SSIBuilder) {
+	g := decl.CFG
+	nit := graph.OrderedIterator(order)
+	for nit.HasNext() {
+		n := nit.GetNext()
+		eit := g.ExitIterator(n)
+		for eit.HasNext() {
+			edge, dst := eit.GetNext()
+			phiFuncs := builder.PhiFuncs[dst]
+			if len(phiFuncs) == 0 {
+				continue
+			}
+			op := &Transfer{
+				Srcs: make([]*Register, len(phiFuncs)),
+				Dsts: make([]*Register, len(phiFuncs)),
+			}
+			for j, v := range phiFuncs {
+				reg := decl.Register_Scope.Get(Register_Ref(v))
+				op.Srcs[j] = reg
+				op.Dsts[j] = reg
+			}
+			tn := AllocNode(decl, op)
+			te := AllocEdge(decl, NORMAL)
+			g.ConnectEdgeEntry(tn, te)
+			g.InsertInEdge(te, edge)
+		}
+	}
+}
+
+// Converts a function into SSA form.  Each register is defined once, except
+// for registers defined by the transfers leading into a merge point, which
+// act as phi functions.
+func SSA(decl *FlowFunc) {
+	// Dominance is only meaningful for reachable nodes.
+	removeUnreachable(decl)
+	order, _ := graph.ReversePostorder(decl.CFG)
+
+	pinned := pinnedRegisters(decl)
+	defuse := makeDefUse(decl, order, pinned)
+	live := ssi.FindLiveVars(decl.CFG, defuse)
+	builder := ssi.CreateSSIBuilder(decl.CFG, live)
+	for i := 0; i < decl.Register_Scope.Len(); i++ {
+		if !pinned[i] {
+			ssi.SSI(builder, i, defuse.VarDefAt[i])
+		}
+	}
+	placeTransfers(decl, order, builder)
+	rename(decl, pinned)
+}
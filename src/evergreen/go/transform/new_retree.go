@@ -63,7 +63,12 @@ func linearExit(decl *flow.FlowFunc, nid graph.NodeID) graph.NodeID {
 }
 
 func opToStmts(decl *flow.FlowFunc, lcl_map []*tree.LocalInfo, nid graph.NodeID, block []tree.Stmt) ([]tree.Stmt, bool) {
-	op := decl.Ops[nid]
+	return translateOp(decl, lcl_map, nid, decl.Ops[nid], block)
+}
+
+// The op is passed separately so that rewritten copies of the graph can
+// translate ops that are not in decl.
+func translateOp(decl *flow.FlowFunc, lcl_map []*tree.LocalInfo, nid graph.NodeID, op flow.GoOp, block []tree.Stmt) ([]tree.Stmt, bool) {
 	terminal := false
 	switch op := op.(type) {
 	case *flow.Entry:
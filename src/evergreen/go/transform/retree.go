@@ -5,7 +5,6 @@ import (
 	"evergreen/go/core"
 	"evergreen/go/flow"
 	"evergreen/go/tree"
-	"evergreen/graph"
 	"fmt"
 )
 
@@ -21,23 +20,6 @@ func blockLabel(i int) *tree.Label {
 	return &tree.Label{Text: blockName(i)}
 }
 
-func findBlockHeads(g *graph.Graph, order []graph.NodeID) ([]graph.NodeID, map[graph.NodeID]int) {
-	heads := []graph.NodeID{}
-	labels := map[graph.NodeID]int{}
-	uid := 0
-
-	nit := graph.OrderedIterator(order)
-	for nit.HasNext() {
-		n := nit.GetNext()
-		if (n == g.Entry() || g.HasMultipleEntries(n)) && n != g.Exit() {
-			heads = append(heads, n)
-			labels[n] = uid
-			uid = uid + 1
-		}
-	}
-	return heads, labels
-}
-
 func getLocal(lclMap []*tree.LocalInfo, reg *flow.Register) *tree.GetLocal {
 	return &tree.GetLocal{
 		Info: lclMap[reg.Index],
@@ -101,188 +83,7 @@ func callableName(c core.Callable) string {
 	}
 }
 
-func generateNode(coreProg *core.CoreProgram, decl *flow.FlowFunc, lclMap []*tree.LocalInfo, labels map[graph.NodeID]int, parent_label int, is_head bool, node graph.NodeID, block []tree.Stmt) ([]tree.Stmt, bool) {
-	g := decl.CFG
-	for {
-		if !is_head {
-			label, ok := labels[node]
-			if ok {
-				can_fallthrough := label == parent_label+1
-				can_fallthrough = false // Disabled
-				if can_fallthrough {
-					return block, true
-				} else {
-
-					block = append(block, gotoBlock(label))
-					return block, false
-				}
-			}
-		}
-
-		op := decl.Ops[node]
-		switch op := op.(type) {
-		case *flow.Entry:
-			// TODO
-		case *flow.Exit:
-			block = append(block, &tree.Return{})
-			return block, false
-		case *flow.ConstantString:
-			block = append(block, scalarAssign(&tree.StringLiteral{
-				Value: op.Value,
-			}, lclMap, op.Dst))
-		case *flow.ConstantRune:
-			block = append(block, scalarAssign(&tree.RuneLiteral{
-				Value: op.Value,
-			}, lclMap, op.Dst))
-		case *flow.ConstantInt:
-			block = append(block, scalarAssign(&tree.IntLiteral{
-				Value: int(op.Value),
-			}, lclMap, op.Dst))
-		case *flow.ConstantFloat32:
-			block = append(block, scalarAssign(&tree.Float32Literal{
-				Value: op.Value,
-			}, lclMap, op.Dst))
-		case *flow.ConstantBool:
-			block = append(block, scalarAssign(&tree.BoolLiteral{
-				Value: op.Value,
-			}, lclMap, op.Dst))
-		case *flow.ConstantNil:
-			block = append(block, scalarAssign(&tree.NilLiteral{}, lclMap, op.Dst))
-		case *flow.Call:
-			f := op.Target
-			block = append(block, multiAssign(&tree.Call{
-				Expr: &tree.GetFunction{Func: f},
-				Args: getLocalList(lclMap, op.Args),
-			}, lclMap, op.Dsts))
-		case *flow.MethodCall:
-			// TODO simple IR
-			block = append(block, multiAssign(&tree.Call{
-				Expr: &tree.Selector{
-					Expr: getLocal(lclMap, op.Expr),
-					Text: op.Name,
-				},
-				Args: getLocalList(lclMap, op.Args),
-			}, lclMap, op.Dsts))
-		case *flow.Transfer:
-			srcs := []tree.Expr{}
-			tgts := []tree.Target{}
-			// SSA can cause registers to be transfered to themselves.  Filter this out.
-			for i := 0; i < len(op.Srcs); i++ {
-				src := op.Srcs[i]
-				tgt := op.Dsts[i]
-				if src != tgt {
-					srcs = append(srcs, getLocal(lclMap, src))
-					tgts = append(tgts, setLocal(lclMap, tgt))
-				}
-			}
-			if len(srcs) > 0 {
-				block = append(block, &tree.Assign{
-					Sources: srcs,
-					Op:      "=",
-					Targets: tgts,
-				})
-			}
-		case *flow.Coerce:
-			block = append(block, scalarAssign(&tree.TypeCoerce{
-				Type: tree.RefForType(op.Type),
-				Expr: getLocal(lclMap, op.Src),
-			}, lclMap, op.Dst))
-		case *flow.Attr:
-			block = append(block, scalarAssign(&tree.Selector{
-				Expr: getLocal(lclMap, op.Expr),
-				Text: op.Name,
-			}, lclMap, op.Dst))
-		case *flow.BinaryOp:
-			block = append(block, scalarAssign(&tree.BinaryExpr{
-				Left:  getLocal(lclMap, op.Left),
-				Op:    op.Op,
-				Right: getLocal(lclMap, op.Right),
-			}, lclMap, op.Dst))
-		case *flow.ConstructStruct:
-			args := make([]*tree.KeywordExpr, len(op.Args))
-			for i, arg := range op.Args {
-				args[i] = &tree.KeywordExpr{
-					Name: arg.Name,
-					Expr: getLocal(lclMap, arg.Arg),
-				}
-			}
-			var expr tree.Expr = &tree.StructLiteral{
-				Type: tree.RefForType(op.Type),
-				Args: args,
-			}
-			if op.AddrTaken {
-				expr = &tree.UnaryExpr{
-					Op:   "&",
-					Expr: expr,
-				}
-			}
-			block = append(block, scalarAssign(expr, lclMap, op.Dst))
-		case *flow.ConstructSlice:
-			ref := tree.RefForType(op.Type)
-			sref, ok := ref.(*tree.SliceRef)
-			if !ok {
-				panic(op.Type)
-			}
-			block = append(block, scalarAssign(&tree.ListLiteral{
-				Type: sref,
-				Args: getLocalList(lclMap, op.Args),
-			}, lclMap, op.Dst))
-		case *flow.Nop:
-			// TODO
-		case *flow.Switch:
-			var body []tree.Stmt
-			bodyFall := false
-			var elseBody []tree.Stmt
-			elseFall := false
-
-			eit := g.ExitIterator(node)
-			for eit.HasNext() {
-				e, next := eit.GetNext()
-				flowID := decl.Edges[e]
-				child, childFall := generateNode(coreProg, decl, lclMap, labels, parent_label, false, next, []tree.Stmt{})
-				switch flowID {
-				case flow.COND_TRUE:
-					body, bodyFall = child, childFall
-				case flow.COND_FALSE:
-					elseBody, elseFall = child, childFall
-				default:
-					panic(flowID)
-				}
-			}
-			var else_ *tree.Block = nil
-			if len(elseBody) > 0 && bodyFall {
-				else_ = &tree.Block{Body: elseBody}
-			}
-			block = append(block, &tree.If{
-				Cond: getLocal(lclMap, op.Cond),
-				T:    &tree.Block{Body: body},
-				F:    else_,
-			})
-			if !bodyFall {
-				block = append(block, elseBody...)
-			}
-			return block, bodyFall || elseFall
-		case *flow.Return:
-			block = append(block, &tree.Return{
-				Args: getLocalList(lclMap, op.Args),
-			})
-			return block, false
-		default:
-			panic(op)
-		}
-
-		_, next := g.GetUniqueExit(node)
-		if next == graph.NoNode {
-			panic(decl.Ops[node])
-		}
-		node = next
-		is_head = false
-	}
-}
-
 func RetreeFunc(coreProg *core.CoreProgram, f *core.Function, decl *flow.FlowFunc) *tree.FuncDecl {
-	//return NewRetreeFunc(coreProg, f, decl)
-
 	funcDecl := &tree.FuncDecl{
 		Name:            f.Name,
 		LocalInfo_Scope: &tree.LocalInfo_Scope{},
@@ -308,30 +109,16 @@ func RetreeFunc(coreProg *core.CoreProgram, f *core.Function, decl *flow.FlowFun
 	// Don't reconstruct empty functions.
 	_, first := decl.CFG.GetUniqueExit(decl.CFG.Entry())
 	if first != decl.CFG.Exit() {
-		order, _ := graph.ReversePostorder(decl.CFG)
-		heads, labels := findBlockHeads(decl.CFG, order)
-
-		// Generate Go code from flow blocks
-		stmts := []tree.Stmt{}
-		for _, node := range heads {
-			block := []tree.Stmt{}
-			label, _ := labels[node]
-			// HACK assume label 0 is always the entry node.
-			if label != 0 {
-				block = append(block, blockLabel(label))
-			}
-			block, _ = generateNode(coreProg, decl, lclMap, labels, label, true, node, block)
-			// Extend the statement list
-			stmts = append(stmts, block...)
-		}
-
-		funcDecl.Block.Body = stmts
+		funcDecl.Block.Body = structureFunc(decl, lclMap)
 	}
 	return funcDecl
 }
 
 type TreeBypass struct {
-	Tests []*tree.FileAST
+	// Test files to emit for each package.
+	Tests [][]*tree.FileAST
+	// Additional files to emit for each package.
+	Extra [][]*tree.FileAST
 }
 
 func pathLeaf(path []string) string {
@@ -352,6 +139,19 @@ func getPackage(t core.GoType) *core.Package {
 
 }
 
+func getFile(t core.GoType) *core.File {
+	switch t := t.(type) {
+	case *core.StructType:
+		return t.File
+	case *core.InterfaceType:
+		return t.File
+	case *core.TypeDefType:
+		return t.File
+	default:
+		panic(t)
+	}
+}
+
 func declForType(t core.GoType) tree.Decl {
 	switch t := t.(type) {
 	case *core.TypeDefType:
@@ -392,10 +192,38 @@ func declForType(t core.GoType) tree.Decl {
 	}
 }
 
-func generateGoFile(coreProg *core.CoreProgram, flowProg *flow.FlowProgram, types []core.GoType, funcs []*core.Function, file *tree.FileAST) {
-	file.Name = "generated_dub.go"
+// Declarations without a source file are collected into this file.
+const defaultFileName = "generated_dub.go"
+
+// Emits a Go file for each source file in the package, in the order the
+// source files were declared.  Files that end up empty are dropped.
+func generateGoFiles(coreProg *core.CoreProgram, flowProg *flow.FlowProgram, pkg *core.Package, types []core.GoType) []*tree.FileAST {
+	leaf := pathLeaf(pkg.Path)
+	files := []*tree.FileAST{}
+	lut := map[*core.File]*tree.FileAST{}
+	getFileAST := func(f *core.File) *tree.FileAST {
+		file, ok := lut[f]
+		if !ok {
+			name := defaultFileName
+			if f != nil {
+				name = f.Name
+			}
+			file = &tree.FileAST{
+				Name:    name,
+				Package: leaf,
+				Imports: []*tree.Import{},
+			}
+			lut[f] = file
+			files = append(files, file)
+		}
+		return file
+	}
+	for _, f := range pkg.Files {
+		getFileAST(f)
+	}
 
 	for _, t := range types {
+		file := getFileAST(getFile(t))
 		file.Decls = append(file.Decls, declForType(t))
 
 		st, ok := t.(*core.StructType)
@@ -407,13 +235,22 @@ func generateGoFile(coreProg *core.CoreProgram, flowProg *flow.FlowProgram, type
 		}
 	}
 
-	for _, cf := range funcs {
+	for _, cf := range pkg.Functions {
 		f := flowProg.FlowFunc_Scope.Get(flow.FlowFunc_Ref(cf.Index))
 		if f.Recv != nil {
 			continue
 		}
+		file := getFileAST(cf.File)
 		file.Decls = append(file.Decls, RetreeFunc(coreProg, cf, f))
 	}
+
+	nonempty := []*tree.FileAST{}
+	for _, file := range files {
+		if len(file.Decls) != 0 {
+			nonempty = append(nonempty, file)
+		}
+	}
+	return nonempty
 }
 
 func FlowToTree(status compiler.PassStatus, program *flow.FlowProgram, coreProg *core.CoreProgram, bypass *TreeBypass) *tree.ProgramAST {
@@ -434,17 +271,9 @@ func FlowToTree(status compiler.PassStatus, program *flow.FlowProgram, coreProg
 		if pkg.Extern {
 			continue
 		}
-		leaf := pathLeaf(pkg.Path)
-		fileAST := &tree.FileAST{
-			Package: leaf,
-			Imports: []*tree.Import{},
-		}
-		fileDecls := []*tree.FileAST{fileAST}
-
-		generateGoFile(coreProg, program, packageTypes[p], pkg.Functions, fileAST)
-		if bypass.Tests[p] != nil {
-			fileDecls = append(fileDecls, bypass.Tests[p])
-		}
+		fileDecls := generateGoFiles(coreProg, program, pkg, packageTypes[p])
+		fileDecls = append(fileDecls, bypass.Tests[p]...)
+		fileDecls = append(fileDecls, bypass.Extra[p]...)
 
 		pkgAST := &tree.PackageAST{
 			Files: fileDecls,
@@ -0,0 +1,630 @@
+package transform
+
+import (
+	"evergreen/go/flow"
+	"evergreen/go/tree"
+	"evergreen/graph"
+	"fmt"
+)
+
+// Structured control flow recovery.
+//
+// The flow graph of a function is translated into if/else, switch, and for
+// statements without using goto.  Each node is emitted inside the node that
+// immediately dominates it.  A node with more than one forward entry is
+// emitted after the code of its dominator, and jumps to it are either falls
+// through or a break out of a labeled for statement that wraps the code that
+// jumps.  Loop headers are wrapped in a for statement, and jumping back to
+// one is a continue.
+//
+// This only works for reducible graphs, so irreducible loops are made
+// reducible by splitting nodes beforehand.
+
+// The flow of an edge leaving a rune switch into its default case.  Edges
+// into the other cases have the index of the case as their flow.
+const defaultCase = -1
+
+type runeSwitch struct {
+	Subject *flow.Register
+	Cases   [][]rune
+}
+
+// A copy of the flow graph of a function that can be rewritten.
+type structuredGraph struct {
+	decl     *flow.FlowFunc
+	g        *graph.Graph
+	ops      []flow.GoOp
+	switches []*runeSwitch
+	flows    []int
+}
+
+func makeStructuredGraph(decl *flow.FlowFunc) *structuredGraph {
+	sg := &structuredGraph{
+		decl:     decl,
+		g:        decl.CFG.Copy(),
+		ops:      append([]flow.GoOp(nil), decl.Ops...),
+		switches: make([]*runeSwitch, len(decl.Ops)),
+		flows:    append([]int(nil), decl.Edges...),
+	}
+	return sg
+}
+
+func (sg *structuredGraph) createNode(op flow.GoOp, sw *runeSwitch) graph.NodeID {
+	n := sg.g.CreateNode()
+	sg.ops = append(sg.ops, op)
+	sg.switches = append(sg.switches, sw)
+	return n
+}
+
+func (sg *structuredGraph) connect(src graph.NodeID, flow int, dst graph.NodeID) {
+	e := sg.g.CreateEdge()
+	sg.flows = append(sg.flows, flow)
+	sg.g.ConnectEdge(src, e, dst)
+}
+
+func (sg *structuredGraph) uniqueEntry(n graph.NodeID) graph.NodeID {
+	if sg.g.HasMultipleEntries(n) {
+		return graph.NoNode
+	}
+	eit := sg.g.EntryIterator(n)
+	for eit.HasNext() {
+		src, _ := eit.GetNext()
+		return src
+	}
+	return graph.NoNode
+}
+
+func (sg *structuredGraph) branchExits(n graph.NodeID) (graph.NodeID, graph.NodeID) {
+	t := graph.NoNode
+	f := graph.NoNode
+	xit := sg.g.ExitIterator(n)
+	for xit.HasNext() {
+		e, dst := xit.GetNext()
+		switch sg.flows[e] {
+		case flow.COND_TRUE:
+			t = dst
+		case flow.COND_FALSE:
+			f = dst
+		default:
+			panic(sg.flows[e])
+		}
+	}
+	if t == graph.NoNode || f == graph.NoNode {
+		panic(n)
+	}
+	return t, f
+}
+
+func (sg *structuredGraph) countReads() []int {
+	reads := make([]int, sg.decl.Register_Scope.Len())
+	for n, op := range sg.ops {
+		if sw := sg.switches[n]; sw != nil {
+			reads[sw.Subject.Index] += 1
+			continue
+		}
+		for _, reg := range flow.OpUses(op) {
+			reads[reg.Index] += 1
+		}
+	}
+	// Results are read by the implicit return.
+	for _, reg := range sg.decl.Results {
+		reads[reg.Index] += 1
+	}
+	return reads
+}
+
+type runeTest struct {
+	subject *flow.Register
+	value   rune
+	nodes   [3]graph.NodeID
+	t       graph.NodeID
+	f       graph.NodeID
+}
+
+// Matches "k = 'x'; b = subject == k; if b {...}" starting at n.  The
+// temporaries must not be read anywhere else, as they will not be emitted.
+func (sg *structuredGraph) matchRuneTest(n graph.NodeID, reads []int) (*runeTest, bool) {
+	constant, ok := sg.ops[n].(*flow.ConstantRune)
+	if !ok || constant.Dst == nil || reads[constant.Dst.Index] != 1 {
+		return nil, false
+	}
+	_, compareID := sg.g.GetUniqueExit(n)
+	if compareID == graph.NoNode || sg.uniqueEntry(compareID) != n {
+		return nil, false
+	}
+	compare, ok := sg.ops[compareID].(*flow.BinaryOp)
+	if !ok || compare.Op != "==" || compare.Right != constant.Dst || compare.Left == constant.Dst || compare.Dst == nil || compare.Dst == compare.Left || reads[compare.Dst.Index] != 1 {
+		return nil, false
+	}
+	_, switchID := sg.g.GetUniqueExit(compareID)
+	if switchID == graph.NoNode || sg.uniqueEntry(switchID) != compareID {
+		return nil, false
+	}
+	branch, ok := sg.ops[switchID].(*flow.Switch)
+	if !ok || branch.Cond != compare.Dst {
+		return nil, false
+	}
+	t, f := sg.branchExits(switchID)
+	return &runeTest{
+		subject: compare.Left,
+		value:   constant.Value,
+		nodes:   [3]graph.NodeID{n, compareID, switchID},
+		t:       t,
+		f:       f,
+	}, true
+}
+
+// Collapses chains of rune comparisons against the same register into
+// switches.
+func (sg *structuredGraph) collapseRuneSwitches() {
+	reads := sg.countReads()
+	order, _ := graph.ReversePostorder(sg.g)
+	for _, n := range order {
+		first, ok := sg.matchRuneTest(n, reads)
+		if !ok {
+			continue
+		}
+		chain := []*runeTest{first}
+		for {
+			last := chain[len(chain)-1]
+			if sg.uniqueEntry(last.f) != last.nodes[2] {
+				break
+			}
+			next, ok := sg.matchRuneTest(last.f, reads)
+			if !ok || next.subject != first.subject || next.nodes[0] == n {
+				break
+			}
+			chain = append(chain, next)
+		}
+		if len(chain) < 2 {
+			continue
+		}
+
+		// Group the values by destination.  Later tests for a value that was
+		// already tested can never succeed, and tests that go to the same
+		// place as the default case are redundant.
+		defaultDst := chain[len(chain)-1].f
+		sw := &runeSwitch{Subject: first.subject}
+		targets := []graph.NodeID{}
+		caseIndex := map[graph.NodeID]int{}
+		seen := map[rune]bool{}
+		for _, test := range chain {
+			if seen[test.value] {
+				continue
+			}
+			seen[test.value] = true
+			if test.t == defaultDst {
+				continue
+			}
+			i, ok := caseIndex[test.t]
+			if !ok {
+				i = len(sw.Cases)
+				caseIndex[test.t] = i
+				sw.Cases = append(sw.Cases, nil)
+				targets = append(targets, test.t)
+			}
+			sw.Cases[i] = append(sw.Cases[i], test.value)
+		}
+		if len(sw.Cases) == 0 {
+			continue
+		}
+
+		// Detach the chain and replace its first node with the switch.
+		for i, test := range chain {
+			for j, node := range test.nodes {
+				if i == 0 && j == 0 {
+					continue
+				}
+				xit := sg.g.ExitIterator(node)
+				for xit.HasNext() {
+					e, _ := xit.GetNext()
+					sg.g.KillEdge(e)
+				}
+			}
+		}
+		xit := sg.g.ExitIterator(n)
+		for xit.HasNext() {
+			e, _ := xit.GetNext()
+			sg.g.KillEdge(e)
+		}
+		sg.ops[n] = nil
+		sg.switches[n] = sw
+		for i, dst := range targets {
+			sg.connect(n, i, dst)
+		}
+		sg.connect(n, defaultCase, defaultDst)
+	}
+}
+
+// Replaces branches that end up in the same place either way with a plain
+// edge, so the condition is not left without a reader.
+func (sg *structuredGraph) foldTrivialBranches() {
+	order, _ := graph.ReversePostorder(sg.g)
+	for _, n := range order {
+		if _, ok := sg.ops[n].(*flow.Switch); !ok {
+			continue
+		}
+		t, f := sg.branchExits(n)
+		dst := sg.skipEmpty(t)
+		if dst == graph.NoNode || dst != sg.skipEmpty(f) {
+			continue
+		}
+		xit := sg.g.ExitIterator(n)
+		for xit.HasNext() {
+			e, _ := xit.GetNext()
+			sg.g.KillEdge(e)
+		}
+		sg.ops[n] = &flow.Nop{}
+		sg.connect(n, flow.NORMAL, dst)
+	}
+}
+
+// Removes pure ops whose results are never read.  Rewriting the graph can
+// orphan computations that were only used by a branch.
+func (sg *structuredGraph) removeDeadOps() {
+	for changed := true; changed; {
+		changed = false
+		reads := sg.countReads()
+		for n, op := range sg.ops {
+			if !flow.IsPure(op) || isEmpty(op) {
+				continue
+			}
+			dead := true
+			for _, dst := range flow.OpDefs(sg.decl, op) {
+				if dst != nil && reads[dst.Index] != 0 {
+					dead = false
+				}
+			}
+			if dead {
+				sg.ops[n] = &flow.Nop{}
+				changed = true
+			}
+		}
+	}
+}
+
+// Splits nodes until no loop can be entered other than through its header.
+// The copies share the ops and switches of the nodes they were split from.
+func (sg *structuredGraph) makeReducible() ([]graph.NodeInfo, []graph.EdgeType, []graph.NodeID) {
+	nodeOrigin, edgeOrigin := graph.MakeReducible(sg.g)
+	for n := len(sg.ops); n < len(nodeOrigin); n++ {
+		sg.ops = append(sg.ops, sg.ops[nodeOrigin[n]])
+		sg.switches = append(sg.switches, sg.switches[nodeOrigin[n]])
+	}
+	for e := len(sg.flows); e < len(edgeOrigin); e++ {
+		sg.flows = append(sg.flows, sg.flows[edgeOrigin[e]])
+	}
+	return graph.AnalyzeStructure(sg.g)
+}
+
+type frameKind int
+
+const (
+	loopFrame frameKind = iota
+	blockFrame
+	switchFrame
+)
+
+// A statement that can be broken out of or continued.
+type structFrame struct {
+	kind   frameKind
+	target graph.NodeID
+	label  string
+	used   bool
+}
+
+type structurer struct {
+	sg       *structuredGraph
+	lclMap   []*tree.LocalInfo
+	info     []graph.NodeInfo
+	edges    []graph.EdgeType
+	children [][]graph.NodeID
+	merge    []bool
+	frames   []*structFrame
+	labels   int
+}
+
+// Does the op translate into no statements?
+func isEmpty(op flow.GoOp) bool {
+	switch op := op.(type) {
+	case *flow.Entry, *flow.Nop:
+		return true
+	case *flow.Transfer:
+		for i, src := range op.Srcs {
+			if src != op.Dsts[i] {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// Follows the chain of empty nodes starting at n to the first node that does
+// something.  A return without values is the same as reaching the exit.
+// Returns NoNode if the chain never ends.
+func (sg *structuredGraph) skipEmpty(n graph.NodeID) graph.NodeID {
+	for steps := 0; sg.switches[n] == nil && (isEmpty(sg.ops[n]) || isBareReturn(sg.ops[n])); steps++ {
+		_, next := sg.g.GetUniqueExit(n)
+		if next == graph.NoNode || steps > sg.g.NumNodes() {
+			return graph.NoNode
+		}
+		n = next
+	}
+	return n
+}
+
+func isBareReturn(op flow.GoOp) bool {
+	ret, ok := op.(*flow.Return)
+	return ok && len(ret.Args) == 0
+}
+
+// Terminal nodes leave the function without doing anything else, so they
+// can be duplicated instead of merged.
+func (sg *structuredGraph) isTerminal(n graph.NodeID) bool {
+	n = sg.skipEmpty(n)
+	if n == graph.NoNode {
+		return false
+	}
+	switch sg.ops[n].(type) {
+	case *flow.Exit, *flow.Return:
+		return true
+	default:
+		return false
+	}
+}
+
+func makeStructurer(sg *structuredGraph, lclMap []*tree.LocalInfo) *structurer {
+	info, edges, postorder := sg.makeReducible()
+	g := sg.g
+	s := &structurer{
+		sg:       sg,
+		lclMap:   lclMap,
+		info:     info,
+		edges:    edges,
+		children: make([][]graph.NodeID, g.NumNodes()),
+		merge:    make([]bool, g.NumNodes()),
+	}
+
+	for i := len(postorder) - 1; i >= 0; i-- {
+		n := postorder[i]
+		forward := 0
+		eit := g.EntryIterator(n)
+		for eit.HasNext() {
+			_, e := eit.GetNext()
+			if edges[e] != graph.BACKWARD {
+				forward += 1
+			}
+		}
+		// Terminal nodes are duplicated rather than merged.
+		s.merge[n] = forward > 1 && !sg.isTerminal(n)
+		if n != g.Entry() {
+			idom := info[n].IDom
+			s.children[idom] = append(s.children[idom], n)
+		}
+	}
+	return s
+}
+
+func (s *structurer) push(kind frameKind, target graph.NodeID) *structFrame {
+	frame := &structFrame{kind: kind, target: target}
+	s.frames = append(s.frames, frame)
+	return frame
+}
+
+func (s *structurer) pop() {
+	s.frames = s.frames[:len(s.frames)-1]
+}
+
+// Finds the frame a jump refers to, and if the jump needs a label to get
+// there.  Loops and blocks are both emitted as for statements.
+func (s *structurer) jump(kind frameKind, target graph.NodeID, isContinue bool) string {
+	innermost := true
+	for i := len(s.frames) - 1; i >= 0; i-- {
+		frame := s.frames[i]
+		if frame.kind == kind && frame.target == target {
+			frame.used = true
+			if innermost {
+				return ""
+			}
+			if frame.label == "" {
+				if kind == loopFrame {
+					frame.label = fmt.Sprintf("loop%d", s.labels)
+				} else {
+					frame.label = fmt.Sprintf("block%d", s.labels)
+				}
+				s.labels += 1
+			}
+			return frame.label
+		}
+		if frame.kind != switchFrame || !isContinue {
+			innermost = false
+		}
+	}
+	panic(target)
+}
+
+func isStraightLine(stmt tree.Stmt) bool {
+	switch stmt.(type) {
+	case *tree.Assign, *tree.Call:
+		return true
+	default:
+		return false
+	}
+}
+
+// Wraps the body of a frame in a labeled for statement.
+func (s *structurer) wrap(frame *structFrame, body []tree.Stmt) []tree.Stmt {
+	stmts := []tree.Stmt{}
+	if frame.label != "" {
+		stmts = append(stmts, &tree.Label{Text: frame.label})
+	}
+	return append(stmts, &tree.For{Block: &tree.Block{Body: body}})
+}
+
+// Emits n and the nodes it dominates.  If control falls off the end of the
+// statements it reaches follow.
+func (s *structurer) emitTree(n graph.NodeID, follow graph.NodeID) ([]tree.Stmt, bool) {
+	merges := []graph.NodeID{}
+	for _, child := range s.children[n] {
+		if s.merge[child] {
+			merges = append(merges, child)
+		}
+	}
+	if s.info[n].IsHead {
+		frame := s.push(loopFrame, n)
+		body, _ := s.emitWithin(n, merges, n)
+		s.pop()
+		// Loops are only left by jumping to an enclosing frame.
+		return s.wrap(frame, body), false
+	}
+	return s.emitWithin(n, merges, follow)
+}
+
+// Emits n followed by the merge nodes it dominates.  The last merge node is
+// the outermost, as it comes last in reverse postorder.
+func (s *structurer) emitWithin(n graph.NodeID, merges []graph.NodeID, follow graph.NodeID) ([]tree.Stmt, bool) {
+	if len(merges) == 0 {
+		return s.emitNode(n, follow)
+	}
+	last := merges[len(merges)-1]
+	frame := s.push(blockFrame, last)
+	body, falls := s.emitWithin(n, merges[:len(merges)-1], last)
+	s.pop()
+	if frame.used {
+		if falls {
+			body = append(body, &tree.Break{})
+		}
+		// Straight-line code at the start of the block cannot jump, so it
+		// can be moved out of the for statement.
+		prefix := 0
+		for prefix < len(body) && isStraightLine(body[prefix]) {
+			prefix += 1
+		}
+		body = append(body[:prefix:prefix], s.wrap(frame, body[prefix:])...)
+	}
+	rest, falls := s.emitTree(last, follow)
+	return append(body, rest...), falls
+}
+
+func (s *structurer) emitBranch(e graph.EdgeID, dst graph.NodeID, follow graph.NodeID) ([]tree.Stmt, bool) {
+	switch {
+	case dst == follow:
+		return nil, true
+	case s.edges[e] == graph.BACKWARD:
+		return []tree.Stmt{&tree.Continue{Text: s.jump(loopFrame, dst, true)}}, false
+	case s.merge[dst]:
+		return []tree.Stmt{&tree.Break{Text: s.jump(blockFrame, dst, false)}}, false
+	default:
+		return s.emitTree(dst, follow)
+	}
+}
+
+func (s *structurer) emitNode(n graph.NodeID, follow graph.NodeID) ([]tree.Stmt, bool) {
+	g := s.sg.g
+	if sw := s.sg.switches[n]; sw != nil {
+		return s.emitSwitch(n, sw, follow)
+	}
+	switch op := s.sg.ops[n].(type) {
+	case *flow.Exit:
+		return []tree.Stmt{&tree.Return{}}, false
+	case *flow.Return:
+		if len(op.Args) == 0 && follow == g.Exit() {
+			return nil, true
+		}
+		return []tree.Stmt{&tree.Return{Args: getLocalList(s.lclMap, op.Args)}}, false
+	case *flow.Switch:
+		var t, f []tree.Stmt
+		var tFalls, fFalls bool
+		xit := g.ExitIterator(n)
+		for xit.HasNext() {
+			e, dst := xit.GetNext()
+			switch s.sg.flows[e] {
+			case flow.COND_TRUE:
+				t, tFalls = s.emitBranch(e, dst, follow)
+			case flow.COND_FALSE:
+				f, fFalls = s.emitBranch(e, dst, follow)
+			default:
+				panic(s.sg.flows[e])
+			}
+		}
+		var cond tree.Expr = getLocal(s.lclMap, op.Cond)
+		if len(t) == 0 {
+			if len(f) == 0 {
+				return nil, true
+			}
+			cond = &tree.UnaryExpr{Op: "!", Expr: cond}
+			t, f = f, t
+			tFalls, fFalls = fFalls, tFalls
+		}
+		stmt := &tree.If{Cond: cond, T: &tree.Block{Body: t}}
+		if !tFalls {
+			// No need for an else if the true branch does not fall through.
+			return append([]tree.Stmt{stmt}, f...), fFalls
+		}
+		if len(f) != 0 {
+			stmt.F = &tree.Block{Body: f}
+		}
+		return []tree.Stmt{stmt}, true
+	default:
+		stmts, _ := translateOp(s.sg.decl, s.lclMap, n, op, []tree.Stmt{})
+		e, next := g.GetUniqueExit(n)
+		if next == graph.NoNode {
+			panic(op)
+		}
+		rest, falls := s.emitBranch(e, next, follow)
+		return append(stmts, rest...), falls
+	}
+}
+
+func (s *structurer) emitSwitch(n graph.NodeID, sw *runeSwitch, follow graph.NodeID) ([]tree.Stmt, bool) {
+	stmt := &tree.Switch{
+		Expr:  getLocal(s.lclMap, sw.Subject),
+		Cases: make([]*tree.SwitchCase, len(sw.Cases)),
+	}
+	falls := false
+	s.push(switchFrame, n)
+	xit := s.sg.g.ExitIterator(n)
+	for xit.HasNext() {
+		e, dst := xit.GetNext()
+		body, bodyFalls := s.emitBranch(e, dst, follow)
+		falls = falls || bodyFalls
+		block := &tree.Block{Body: body}
+		i := s.sg.flows[e]
+		if i == defaultCase {
+			if len(body) != 0 {
+				stmt.Default = block
+			}
+			continue
+		}
+		values := make([]tree.Expr, len(sw.Cases[i]))
+		for j, v := range sw.Cases[i] {
+			values[j] = &tree.RuneLiteral{Value: v}
+		}
+		stmt.Cases[i] = &tree.SwitchCase{Exprs: values, Block: block}
+	}
+	s.pop()
+	if stmt.Default == nil {
+		// Control falls through an empty default case.
+		falls = true
+	}
+	return []tree.Stmt{stmt}, falls
+}
+
+// Translates the flow graph of a function into structured statements.
+func structureFunc(decl *flow.FlowFunc, lclMap []*tree.LocalInfo) []tree.Stmt {
+	sg := makeStructuredGraph(decl)
+	sg.collapseRuneSwitches()
+	sg.foldTrivialBranches()
+	sg.removeDeadOps()
+	s := makeStructurer(sg, lclMap)
+
+	// Functions without results can fall off the end instead of returning.
+	follow := graph.NoNode
+	if len(decl.Results) == 0 {
+		follow = sg.g.Exit()
+	}
+	stmts, _ := s.emitTree(sg.g.Entry(), follow)
+	return stmts
+}
@@ -0,0 +1,304 @@
+package transform
+
+import (
+	"evergreen/assert"
+	"evergreen/go/core"
+	"evergreen/go/flow"
+	"evergreen/go/tree"
+	"evergreen/graph"
+	"testing"
+)
+
+var boolType = &core.ExternalType{Name: "bool"}
+var intType = &core.ExternalType{Name: "int"}
+var runeType = &core.ExternalType{Name: "rune"}
+
+func makeTestLocals(decl *flow.FlowFunc) []*tree.LocalInfo {
+	lclMap := make([]*tree.LocalInfo, decl.Register_Scope.Len())
+	for i := range lclMap {
+		reg := decl.Register_Scope.Get(flow.Register_Ref(i))
+		lclMap[i] = &tree.LocalInfo{Name: reg.Name}
+	}
+	return lclMap
+}
+
+// Flattens nested statements, outer statements first.
+func allStmts(stmts []tree.Stmt) []tree.Stmt {
+	out := []tree.Stmt{}
+	for _, stmt := range stmts {
+		out = append(out, stmt)
+		switch stmt := stmt.(type) {
+		case *tree.If:
+			out = append(out, allStmts(stmt.T.Body)...)
+			if stmt.F != nil {
+				out = append(out, allStmts(stmt.F.Body)...)
+			}
+		case *tree.For:
+			out = append(out, allStmts(stmt.Block.Body)...)
+		case *tree.Switch:
+			for _, c := range stmt.Cases {
+				out = append(out, allStmts(c.Block.Body)...)
+			}
+			if stmt.Default != nil {
+				out = append(out, allStmts(stmt.Default.Body)...)
+			}
+		case *tree.BlockStmt:
+			out = append(out, allStmts(stmt.Block.Body)...)
+		}
+	}
+	return out
+}
+
+func checkNoGoto(stmts []tree.Stmt, t *testing.T) {
+	for _, stmt := range allStmts(stmts) {
+		if _, ok := stmt.(*tree.Goto); ok {
+			t.Errorf("unexpected goto %#v", stmt)
+		}
+	}
+}
+
+// Builds:
+//
+//	if p { goto a } else { goto b }
+//	a: if q { goto b } else { return 1 }
+//	b: if q { goto a } else { return 2 }
+//
+// The loop between a and b can be entered at either node.
+func makeTwoEntryLoop() (*flow.FlowFunc, graph.NodeID, graph.NodeID) {
+	decl := &flow.FlowFunc{Register_Scope: &flow.Register_Scope{}}
+	b := flow.MakeGoFlowBuilder(decl)
+	p := b.MakeRegister("p", boolType)
+	q := b.MakeRegister("q", boolType)
+	r := b.MakeRegister("r", intType)
+	decl.Params = []*flow.Register{p, q}
+	decl.Results = []*flow.Register{r}
+
+	g := decl.CFG
+	start := b.EmitOp(&flow.Switch{Cond: p})
+	na := b.EmitOp(&flow.Switch{Cond: q})
+	nb := b.EmitOp(&flow.Switch{Cond: q})
+	one := b.EmitOp(&flow.ConstantInt{Value: 1, Dst: r})
+	two := b.EmitOp(&flow.ConstantInt{Value: 2, Dst: r})
+	ret := b.EmitOp(&flow.Return{})
+
+	b.EmitConnection(g.Entry(), flow.NORMAL, start)
+	b.EmitConnection(start, flow.COND_TRUE, na)
+	b.EmitConnection(start, flow.COND_FALSE, nb)
+	b.EmitConnection(na, flow.COND_TRUE, nb)
+	b.EmitConnection(na, flow.COND_FALSE, one)
+	b.EmitConnection(nb, flow.COND_TRUE, na)
+	b.EmitConnection(nb, flow.COND_FALSE, two)
+	b.EmitConnection(one, flow.NORMAL, ret)
+	b.EmitConnection(two, flow.NORMAL, ret)
+	b.EmitConnection(ret, flow.RETURN, g.Exit())
+	return decl, na, nb
+}
+
+func TestStructureTwoEntryLoop(t *testing.T) {
+	decl, na, nb := makeTwoEntryLoop()
+	sg := makeStructuredGraph(decl)
+	_, edges, _ := sg.makeReducible()
+	for e, kind := range edges {
+		if kind == graph.REENTRY {
+			t.Errorf("edge %d still enters a loop", e)
+		}
+	}
+
+	// The second entry gets its own copy of the loop, and the copies leave the
+	// loop the same way the originals do.
+	exits := map[flow.GoOp]graph.NodeID{}
+	exits[decl.Ops[na]] = graph.NoNode
+	exits[decl.Ops[nb]] = graph.NoNode
+	copies := 0
+	nit := sg.g.NodeIterator()
+	for nit.HasNext() {
+		n := nit.GetNext()
+		if _, ok := exits[sg.ops[n]]; !ok {
+			continue
+		}
+		if int(n) >= len(decl.Ops) {
+			copies += 1
+		}
+		_, f := sg.branchExits(n)
+		if prev := exits[sg.ops[n]]; prev != graph.NoNode && prev != f {
+			t.Errorf("copy of node %d leaves to %d instead of %d", n, f, prev)
+		}
+		exits[sg.ops[n]] = f
+	}
+	assert.IntEquals(t, copies, 2)
+
+	stmts := structureFunc(decl, makeTestLocals(decl))
+	checkNoGoto(stmts, t)
+	// Both copies of the loop leave through the same merge points.
+	assigns := 0
+	for _, stmt := range allStmts(stmts) {
+		if _, ok := stmt.(*tree.Assign); ok {
+			assigns += 1
+		}
+	}
+	assert.IntEquals(t, assigns, 2)
+}
+
+// Builds:
+//
+//	if c == 'a' { r = 1 } else if c == 'b' { r = 2 } else if c == 'c' { r = 1 } else { r = 0 }
+//	return r
+func makeRuneChain() *flow.FlowFunc {
+	decl := &flow.FlowFunc{Register_Scope: &flow.Register_Scope{}}
+	b := flow.MakeGoFlowBuilder(decl)
+	c := b.MakeRegister("c", runeType)
+	r := b.MakeRegister("r", intType)
+	decl.Params = []*flow.Register{c}
+	decl.Results = []*flow.Register{r}
+
+	g := decl.CFG
+	one := b.EmitOp(&flow.ConstantInt{Value: 1, Dst: r})
+	two := b.EmitOp(&flow.ConstantInt{Value: 2, Dst: r})
+	zero := b.EmitOp(&flow.ConstantInt{Value: 0, Dst: r})
+	ret := b.EmitOp(&flow.Return{})
+
+	prev := g.Entry()
+	prevFlow := flow.NORMAL
+	for i, value := range []rune{'a', 'b', 'c'} {
+		k := b.MakeRegister("k", runeType)
+		cond := b.MakeRegister("cond", boolType)
+		constant := b.EmitOp(&flow.ConstantRune{Value: value, Dst: k})
+		compare := b.EmitOp(&flow.BinaryOp{Left: c, Op: "==", Right: k, Dst: cond})
+		branch := b.EmitOp(&flow.Switch{Cond: cond})
+		b.EmitConnection(prev, prevFlow, constant)
+		b.EmitConnection(constant, flow.NORMAL, compare)
+		b.EmitConnection(compare, flow.NORMAL, branch)
+		if i == 1 {
+			b.EmitConnection(branch, flow.COND_TRUE, two)
+		} else {
+			b.EmitConnection(branch, flow.COND_TRUE, one)
+		}
+		prev = branch
+		prevFlow = flow.COND_FALSE
+	}
+	b.EmitConnection(prev, prevFlow, zero)
+	b.EmitConnection(one, flow.NORMAL, ret)
+	b.EmitConnection(two, flow.NORMAL, ret)
+	b.EmitConnection(zero, flow.NORMAL, ret)
+	b.EmitConnection(ret, flow.RETURN, g.Exit())
+	return decl
+}
+
+func TestStructureRuneSwitch(t *testing.T) {
+	decl := makeRuneChain()
+	stmts := structureFunc(decl, makeTestLocals(decl))
+	checkNoGoto(stmts, t)
+
+	switches := []*tree.Switch{}
+	for _, stmt := range allStmts(stmts) {
+		switch stmt := stmt.(type) {
+		case *tree.Switch:
+			switches = append(switches, stmt)
+		case *tree.If:
+			t.Errorf("comparison was not collapsed: %#v", stmt)
+		}
+	}
+	if len(switches) != 1 {
+		t.Fatalf("expected 1 switch, got %d", len(switches))
+	}
+	sw := switches[0]
+	assert.StringEquals(t, sw.Expr.(*tree.GetLocal).Info.Name, "c")
+	// Values with the same destination share a case.
+	if len(sw.Cases) != 2 {
+		t.Fatalf("expected 2 cases, got %d", len(sw.Cases))
+	}
+	values := [][]rune{}
+	for _, c := range sw.Cases {
+		caseValues := []rune{}
+		for _, expr := range c.Exprs {
+			caseValues = append(caseValues, expr.(*tree.RuneLiteral).Value)
+		}
+		values = append(values, caseValues)
+	}
+	assert.StringEquals(t, string(values[0]), "ac")
+	assert.StringEquals(t, string(values[1]), "b")
+	if sw.Default == nil {
+		t.Error("expected a default case")
+	}
+}
+
+// Builds:
+//
+//	outer: if !p { goto done }
+//	inner: if !q { goto outer }
+//	       if p { goto inner } else { goto done }
+//	done:  r = 1
+//	       return r
+func makeNestedLoops() *flow.FlowFunc {
+	decl := &flow.FlowFunc{Register_Scope: &flow.Register_Scope{}}
+	b := flow.MakeGoFlowBuilder(decl)
+	p := b.MakeRegister("p", boolType)
+	q := b.MakeRegister("q", boolType)
+	r := b.MakeRegister("r", intType)
+	decl.Params = []*flow.Register{p, q}
+	decl.Results = []*flow.Register{r}
+
+	g := decl.CFG
+	outer := b.EmitOp(&flow.Switch{Cond: p})
+	inner := b.EmitOp(&flow.Switch{Cond: q})
+	body := b.EmitOp(&flow.Switch{Cond: p})
+	done := b.EmitOp(&flow.ConstantInt{Value: 1, Dst: r})
+	ret := b.EmitOp(&flow.Return{})
+
+	b.EmitConnection(g.Entry(), flow.NORMAL, outer)
+	b.EmitConnection(outer, flow.COND_TRUE, inner)
+	b.EmitConnection(outer, flow.COND_FALSE, done)
+	b.EmitConnection(inner, flow.COND_TRUE, body)
+	b.EmitConnection(inner, flow.COND_FALSE, outer)
+	b.EmitConnection(body, flow.COND_TRUE, inner)
+	b.EmitConnection(body, flow.COND_FALSE, done)
+	b.EmitConnection(done, flow.NORMAL, ret)
+	b.EmitConnection(ret, flow.RETURN, g.Exit())
+	return decl
+}
+
+func TestStructureNestedLoops(t *testing.T) {
+	decl := makeNestedLoops()
+	stmts := structureFunc(decl, makeTestLocals(decl))
+	checkNoGoto(stmts, t)
+
+	labels := map[string]bool{}
+	breaks := []string{}
+	continues := []string{}
+	for _, stmt := range allStmts(stmts) {
+		switch stmt := stmt.(type) {
+		case *tree.Label:
+			labels[stmt.Text] = true
+		case *tree.Break:
+			breaks = append(breaks, stmt.Text)
+		case *tree.Continue:
+			continues = append(continues, stmt.Text)
+		}
+	}
+	// Leaving the inner loop for the outer loop's header or for the code
+	// after both loops needs a label.
+	labeledBreak := false
+	for _, text := range breaks {
+		if text != "" {
+			labeledBreak = true
+			if !labels[text] {
+				t.Errorf("break to missing label %#v", text)
+			}
+		}
+	}
+	labeledContinue := false
+	for _, text := range continues {
+		if text != "" {
+			labeledContinue = true
+			if !labels[text] {
+				t.Errorf("continue to missing label %#v", text)
+			}
+		}
+	}
+	if !labeledBreak {
+		t.Errorf("expected a labeled break, got %#v", breaks)
+	}
+	if !labeledContinue {
+		t.Errorf("expected a labeled continue, got %#v", continues)
+	}
+}
@@ -217,6 +217,22 @@ type SetDiscard struct {
 func (node *SetDiscard) isTarget() {
 }
 
+type SetSelector struct {
+	Expr Expr
+	Text string
+}
+
+func (node *SetSelector) isTarget() {
+}
+
+type SetIndex struct {
+	Expr  Expr
+	Index Expr
+}
+
+func (node *SetIndex) isTarget() {
+}
+
 type UnaryExpr struct {
 	Op   string
 	Expr Expr
@@ -341,6 +357,44 @@ type For struct {
 func (node *For) isStmt() {
 }
 
+type Range struct {
+	Key   Target
+	Value Target
+	Expr  Expr
+	Block *Block
+}
+
+func (node *Range) isStmt() {
+}
+
+type TypeSwitchCase struct {
+	Types []TypeRef
+	Block *Block
+}
+
+type TypeSwitch struct {
+	Expr    Expr
+	Cases   []*TypeSwitchCase
+	Default *Block
+}
+
+func (node *TypeSwitch) isStmt() {
+}
+
+type SwitchCase struct {
+	Exprs []Expr
+	Block *Block
+}
+
+type Switch struct {
+	Expr    Expr
+	Cases   []*SwitchCase
+	Default *Block
+}
+
+func (node *Switch) isStmt() {
+}
+
 type Goto struct {
 	Text string
 }
@@ -348,6 +402,20 @@ type Goto struct {
 func (node *Goto) isStmt() {
 }
 
+type Break struct {
+	Text string
+}
+
+func (node *Break) isStmt() {
+}
+
+type Continue struct {
+	Text string
+}
+
+func (node *Continue) isStmt() {
+}
+
 type Label struct {
 	Text string
 }
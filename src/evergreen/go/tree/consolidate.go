@@ -75,6 +75,13 @@ func defUseTarget(expr Target, du *defUse) {
 	switch expr := expr.(type) {
 	case *SetLocal:
 		du.GetLocalInfo(expr.Info.Index).Defs += 1
+	case *SetDiscard:
+		// Leaf
+	case *SetSelector:
+		defUseExpr(expr.Expr, du)
+	case *SetIndex:
+		defUseExpr(expr.Expr, du)
+		defUseExpr(expr.Index, du)
 	default:
 		panic(du.decl.Name)
 	}
@@ -82,7 +89,7 @@ func defUseTarget(expr Target, du *defUse) {
 
 func defUseStmt(stmt Stmt, du *defUse) {
 	switch stmt := stmt.(type) {
-	case *Goto, *Label:
+	case *Goto, *Break, *Continue, *Label:
 		// Leaf
 	case *Assign:
 		defUseExprList(stmt.Sources, du)
@@ -102,6 +109,32 @@ func defUseStmt(stmt Stmt, du *defUse) {
 		}
 	case *For:
 		defUseBlock(stmt.Block, du)
+	case *Range:
+		if stmt.Key != nil {
+			defUseTarget(stmt.Key, du)
+		}
+		if stmt.Value != nil {
+			defUseTarget(stmt.Value, du)
+		}
+		defUseExpr(stmt.Expr, du)
+		defUseBlock(stmt.Block, du)
+	case *TypeSwitch:
+		defUseExpr(stmt.Expr, du)
+		for _, c := range stmt.Cases {
+			defUseBlock(c.Block, du)
+		}
+		if stmt.Default != nil {
+			defUseBlock(stmt.Default, du)
+		}
+	case *Switch:
+		defUseExpr(stmt.Expr, du)
+		for _, c := range stmt.Cases {
+			defUseExprList(c.Exprs, du)
+			defUseBlock(c.Block, du)
+		}
+		if stmt.Default != nil {
+			defUseBlock(stmt.Default, du)
+		}
 	case *BlockStmt:
 		defUseBlock(stmt.Block, du)
 	case *Return:
@@ -129,6 +162,10 @@ func defUseBlock(block *Block, du *defUse) {
 }
 
 func defUseParam(param *Param, input bool, du *defUse) {
+	if param.Info == nil {
+		// Unnamed result.
+		return
+	}
 	// Outputs are implicitly zeroed.
 	du.GetLocalInfo(param.Info.Index).Defs += 1
 	if !input {
@@ -174,6 +211,26 @@ func pullLocal(expr *GetLocal, du *defUse, out []Stmt) (Expr, []Stmt) {
 	return lastAssign.Sources[0], out[:n-1]
 }
 
+// Fold a negation into the comparison it negates, if possible.
+func negate(expr Expr) Expr {
+	switch inner := expr.(type) {
+	case *BinaryExpr:
+		switch inner.Op {
+		case "==":
+			inner.Op = "!="
+			return inner
+		case "!=":
+			inner.Op = "=="
+			return inner
+		}
+	case *UnaryExpr:
+		if inner.Op == "!" {
+			return inner.Expr
+		}
+	}
+	return &UnaryExpr{Op: "!", Expr: expr}
+}
+
 func consolidateExprList(exprs []Expr, du *defUse, out []Stmt) []Stmt {
 	for i := len(exprs) - 1; i >= 0; i-- {
 		exprs[i], out = consolidateExpr(exprs[i], du, out)
@@ -192,6 +249,9 @@ func consolidateExpr(expr Expr, du *defUse, out []Stmt) (Expr, []Stmt) {
 		// Leaf
 	case *UnaryExpr:
 		expr.Expr, out = consolidateExpr(expr.Expr, du, out)
+		if expr.Op == "!" {
+			return negate(expr.Expr), out
+		}
 	case *BinaryExpr:
 		expr.Right, out = consolidateExpr(expr.Right, du, out)
 		expr.Left, out = consolidateExpr(expr.Left, du, out)
@@ -221,7 +281,7 @@ func consolidateExpr(expr Expr, du *defUse, out []Stmt) (Expr, []Stmt) {
 
 func consolidateStmt(stmt Stmt, du *defUse, out []Stmt) []Stmt {
 	switch stmt := stmt.(type) {
-	case *Goto, *Label:
+	case *Goto, *Break, *Continue, *Label:
 		// Leaf
 	case *Assign:
 		for i := len(stmt.Sources) - 1; i >= 0; i-- {
@@ -237,6 +297,25 @@ func consolidateStmt(stmt Stmt, du *defUse, out []Stmt) []Stmt {
 		}
 	case *For:
 		stmt.Block = consolidateBlock(stmt.Block, du)
+	case *Range:
+		stmt.Expr, out = consolidateExpr(stmt.Expr, du, out)
+		stmt.Block = consolidateBlock(stmt.Block, du)
+	case *TypeSwitch:
+		stmt.Expr, out = consolidateExpr(stmt.Expr, du, out)
+		for _, c := range stmt.Cases {
+			c.Block = consolidateBlock(c.Block, du)
+		}
+		if stmt.Default != nil {
+			stmt.Default = consolidateBlock(stmt.Default, du)
+		}
+	case *Switch:
+		stmt.Expr, out = consolidateExpr(stmt.Expr, du, out)
+		for _, c := range stmt.Cases {
+			c.Block = consolidateBlock(c.Block, du)
+		}
+		if stmt.Default != nil {
+			stmt.Default = consolidateBlock(stmt.Default, du)
+		}
 	case *BlockStmt:
 		stmt.Block = consolidateBlock(stmt.Block, du)
 	case *Return:
@@ -152,6 +152,15 @@ func GenerateTarget(gen *textGenerator, expr Target) string {
 		return info.Name
 	case *SetName:
 		return expr.Text
+	case *SetDiscard:
+		return "_"
+	case *SetSelector:
+		base := GenerateSafeExpr(gen, expr.Expr, postfixPrec)
+		return fmt.Sprintf("%s.%s", base, expr.Text)
+	case *SetIndex:
+		base := GenerateSafeExpr(gen, expr.Expr, postfixPrec)
+		index := GenerateSafeExpr(gen, expr.Index, anyPrec)
+		return fmt.Sprintf("%s[%s]", base, index)
 	default:
 		panic(expr)
 	}
@@ -204,6 +213,32 @@ func GenerateStmt(gen *textGenerator, stmt Stmt, w *text.CodeWriter) {
 		w.Linef("for {")
 		GenerateBody(gen, stmt.Block, w)
 		w.Line("}")
+	case *Range:
+		expr := GenerateExpr(gen, stmt.Expr)
+		if stmt.Value != nil {
+			w.Linef("for %s, %s = range %s {", GenerateTarget(gen, stmt.Key), GenerateTarget(gen, stmt.Value), expr)
+		} else if stmt.Key != nil {
+			w.Linef("for %s = range %s {", GenerateTarget(gen, stmt.Key), expr)
+		} else {
+			w.Linef("for range %s {", expr)
+		}
+		GenerateBody(gen, stmt.Block, w)
+		w.Line("}")
+	case *TypeSwitch:
+		w.Linef("switch %s.(type) {", GenerateSafeExpr(gen, stmt.Expr, postfixPrec))
+		for _, c := range stmt.Cases {
+			types := make([]string, len(c.Types))
+			for i, t := range c.Types {
+				types[i] = GenerateType(t)
+			}
+			w.Linef("case %s:", strings.Join(types, ", "))
+			GenerateBody(gen, c.Block, w)
+		}
+		if stmt.Default != nil {
+			w.Line("default:")
+			GenerateBody(gen, stmt.Default, w)
+		}
+		w.Line("}")
 	case *Assign:
 		sources := GenerateExprList(gen, stmt.Sources)
 		targets := GenerateTargetList(gen, stmt.Targets)
@@ -215,8 +250,31 @@ func GenerateStmt(gen *textGenerator, stmt Stmt, w *text.CodeWriter) {
 		} else {
 			w.Linef("var %s %s", stmt.Name, t)
 		}
+	case *Switch:
+		w.Linef("switch %s {", GenerateExpr(gen, stmt.Expr))
+		for _, c := range stmt.Cases {
+			w.Linef("case %s:", GenerateExprList(gen, c.Exprs))
+			GenerateBody(gen, c.Block, w)
+		}
+		if stmt.Default != nil {
+			w.Line("default:")
+			GenerateBody(gen, stmt.Default, w)
+		}
+		w.Line("}")
 	case *Goto:
 		w.Linef("goto %s", stmt.Text)
+	case *Break:
+		if stmt.Text != "" {
+			w.Linef("break %s", stmt.Text)
+		} else {
+			w.Line("break")
+		}
+	case *Continue:
+		if stmt.Text != "" {
+			w.Linef("continue %s", stmt.Text)
+		} else {
+			w.Line("continue")
+		}
 	case *Label:
 		Dedent(w)
 		w.Linef("%s:", stmt.Text)
@@ -241,7 +299,7 @@ func GenerateType(t TypeRef) string {
 	case *SliceRef:
 		return fmt.Sprintf("[]%s", GenerateType(t.Element))
 	case *FuncTypeRef:
-		return GenerateFuncType(t)
+		return "func" + GenerateFuncType(t)
 	default:
 		panic(t)
 	}
@@ -325,7 +383,11 @@ func GenerateDecl(decl Decl, w *text.CodeWriter) {
 		w.Linef("type %s interface {", decl.Name)
 		w.AppendMargin(indent)
 		for _, field := range decl.Fields {
-			w.Linef("%s%s", field.Name, GenerateType(field.Type))
+			ft, ok := field.Type.(*FuncTypeRef)
+			if !ok {
+				panic(field.Type)
+			}
+			w.Linef("%s%s", field.Name, GenerateFuncType(ft))
 		}
 		w.RestoreMargin()
 		w.Line("}")
@@ -319,3 +319,80 @@ func TestFile(t *testing.T) {
 	checkCode(b.String(), expected, t)
 
 }
+
+func TestRangeAndSwitch(t *testing.T) {
+	decl := &FuncDecl{
+		Name: "walk",
+		Type: &FuncTypeRef{
+			Params: []*Param{
+				&Param{Name: "node", Type: &NameRef{Name: "Node"}},
+				&Param{Name: "visit", Type: &FuncTypeRef{
+					Params: []*Param{
+						&Param{Type: &NameRef{Name: "Node"}},
+					},
+					Results: []*Param{
+						&Param{Type: &NameRef{Name: "bool"}},
+					},
+				}},
+			},
+		},
+		Block: &Block{Body: []Stmt{
+			&TypeSwitch{
+				Expr: &GetName{Text: "node"},
+				Cases: []*TypeSwitchCase{
+					&TypeSwitchCase{
+						Types: []TypeRef{&PointerRef{Element: &NameRef{Name: "List"}}},
+						Block: &Block{Body: []Stmt{
+							&Range{
+								Key:   &SetName{Text: "i"},
+								Value: &SetName{Text: "e"},
+								Expr:  &Selector{Expr: &GetName{Text: "n"}, Text: "Items"},
+								Block: &Block{Body: []Stmt{
+									&Assign{
+										Sources: []Expr{&GetName{Text: "e"}},
+										Op:      "=",
+										Targets: []Target{
+											&SetIndex{
+												Expr:  &Selector{Expr: &GetName{Text: "n"}, Text: "Items"},
+												Index: &GetName{Text: "i"},
+											},
+										},
+									},
+								}},
+							},
+						}},
+					},
+					&TypeSwitchCase{
+						Types: []TypeRef{
+							&PointerRef{Element: &NameRef{Name: "A"}},
+							&PointerRef{Element: &NameRef{Name: "B"}},
+						},
+						Block: &Block{Body: []Stmt{
+							&Range{
+								Key:   &SetDiscard{},
+								Value: &SetName{Text: "e"},
+								Expr:  &GetName{Text: "l"},
+								Block: &Block{Body: []Stmt{}},
+							},
+							&Assign{
+								Sources: []Expr{&NilLiteral{}},
+								Op:      "=",
+								Targets: []Target{
+									&SetSelector{Expr: &GetName{Text: "n"}, Text: "Next"},
+								},
+							},
+						}},
+					},
+				},
+				Default: &Block{Body: []Stmt{
+					&Return{},
+				}},
+			},
+		}},
+		LocalInfo_Scope: &LocalInfo_Scope{},
+	}
+	b, w := text.BufferedCodeWriter()
+	gen := &textGenerator{decl: decl}
+	GenerateFunc(gen, decl, w)
+	checkCode(b.String(), "func walk(node Node, visit func(Node) bool) {\n\tswitch node.(type) {\n\tcase *List:\n\t\tfor i, e = range n.Items {\n\t\t\tn.Items[i] = e\n\t\t}\n\tcase *A, *B:\n\t\tfor _, e = range l {\n\t\t}\n\t\tn.Next = nil\n\tdefault:\n\t\treturn\n\t}\n}\n", t)
+}
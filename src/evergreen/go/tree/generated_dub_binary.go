@@ -0,0 +1,3512 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+	"evergreen/go/core"
+)
+
+func DecodeTypeRefBinary(d *runtime.BinaryDecoder) TypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/NameRef" {
+		return readNameRefBinary(d, o)
+	}
+	if t == "go/tree/PointerRef" {
+		return readPointerRefBinary(d, o)
+	}
+	if t == "go/tree/SliceRef" {
+		return readSliceRefBinary(d, o)
+	}
+	if t == "go/tree/FuncTypeRef" {
+		return readFuncTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NameRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/NameRef") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *NameRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.T = core.DecodeGoTypeBinary(d)
+}
+
+func readNameRefBinary(d *runtime.BinaryDecoder, o interface{}) *NameRef {
+	var node *NameRef
+	if o != nil {
+		return o.(*NameRef)
+	}
+	node = &NameRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NameRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6a7c29c48220557b")
+}
+
+func (node *NameRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6a7c29c48220557b", "go/tree/NameRef", node)
+}
+
+func DecodeNameRefBinary(d *runtime.BinaryDecoder) *NameRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/NameRef" {
+		return readNameRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *PointerRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/PointerRef") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Element)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *PointerRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Element = DecodeTypeRefBinary(d)
+	node.T = core.DecodeGoTypeBinary(d)
+}
+
+func readPointerRefBinary(d *runtime.BinaryDecoder, o interface{}) *PointerRef {
+	var node *PointerRef
+	if o != nil {
+		return o.(*PointerRef)
+	}
+	node = &PointerRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *PointerRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e0c46b499f17f55b")
+}
+
+func (node *PointerRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e0c46b499f17f55b", "go/tree/PointerRef", node)
+}
+
+func DecodePointerRefBinary(d *runtime.BinaryDecoder) *PointerRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/PointerRef" {
+		return readPointerRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SliceRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/SliceRef") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Element)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *SliceRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Element = DecodeTypeRefBinary(d)
+	node.T = core.DecodeGoTypeBinary(d)
+}
+
+func readSliceRefBinary(d *runtime.BinaryDecoder, o interface{}) *SliceRef {
+	var node *SliceRef
+	if o != nil {
+		return o.(*SliceRef)
+	}
+	node = &SliceRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SliceRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e0c46b499f17f55b")
+}
+
+func (node *SliceRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e0c46b499f17f55b", "go/tree/SliceRef", node)
+}
+
+func DecodeSliceRefBinary(d *runtime.BinaryDecoder) *SliceRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SliceRef" {
+		return readSliceRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Param) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Param") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+	node.Info.EncodeBinary(e)
+}
+
+func (node *Param) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeTypeRefBinary(d)
+	node.Info = DecodeLocalInfoBinary(d)
+}
+
+func readParamBinary(d *runtime.BinaryDecoder, o interface{}) *Param {
+	var node *Param
+	if o != nil {
+		return o.(*Param)
+	}
+	node = &Param{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Param) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e0c46b499f17f55b")
+}
+
+func (node *Param) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e0c46b499f17f55b", "go/tree/Param", node)
+}
+
+func DecodeParamBinary(d *runtime.BinaryDecoder) *Param {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Param" {
+		return readParamBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FuncTypeRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Param
+	var x1 *Param
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/FuncTypeRef") {
+		return
+	}
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x0 = range node.Params {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Results == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Results))
+		for _, x1 = range node.Results {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *FuncTypeRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Param
+	var n1 int
+	var s1 []*Param
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Param{}
+		for range n0 {
+			s0 = append(s0, DecodeParamBinary(d))
+		}
+	}
+	node.Params = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*Param{}
+		for range n1 {
+			s1 = append(s1, DecodeParamBinary(d))
+		}
+	}
+	node.Results = s1
+}
+
+func readFuncTypeRefBinary(d *runtime.BinaryDecoder, o interface{}) *FuncTypeRef {
+	var node *FuncTypeRef
+	if o != nil {
+		return o.(*FuncTypeRef)
+	}
+	node = &FuncTypeRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FuncTypeRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e0c46b499f17f55b")
+}
+
+func (node *FuncTypeRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e0c46b499f17f55b", "go/tree/FuncTypeRef", node)
+}
+
+func DecodeFuncTypeRefBinary(d *runtime.BinaryDecoder) *FuncTypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/FuncTypeRef" {
+		return readFuncTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeStmtBinary(d *runtime.BinaryDecoder) Stmt {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	if t == "go/tree/Float32Literal" {
+		return readFloat32LiteralBinary(d, o)
+	}
+	if t == "go/tree/BoolLiteral" {
+		return readBoolLiteralBinary(d, o)
+	}
+	if t == "go/tree/StringLiteral" {
+		return readStringLiteralBinary(d, o)
+	}
+	if t == "go/tree/RuneLiteral" {
+		return readRuneLiteralBinary(d, o)
+	}
+	if t == "go/tree/NilLiteral" {
+		return readNilLiteralBinary(d, o)
+	}
+	if t == "go/tree/StructLiteral" {
+		return readStructLiteralBinary(d, o)
+	}
+	if t == "go/tree/ListLiteral" {
+		return readListLiteralBinary(d, o)
+	}
+	if t == "go/tree/GetName" {
+		return readGetNameBinary(d, o)
+	}
+	if t == "go/tree/GetLocal" {
+		return readGetLocalBinary(d, o)
+	}
+	if t == "go/tree/GetGlobal" {
+		return readGetGlobalBinary(d, o)
+	}
+	if t == "go/tree/GetFunction" {
+		return readGetFunctionBinary(d, o)
+	}
+	if t == "go/tree/UnaryExpr" {
+		return readUnaryExprBinary(d, o)
+	}
+	if t == "go/tree/BinaryExpr" {
+		return readBinaryExprBinary(d, o)
+	}
+	if t == "go/tree/Selector" {
+		return readSelectorBinary(d, o)
+	}
+	if t == "go/tree/Index" {
+		return readIndexBinary(d, o)
+	}
+	if t == "go/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	if t == "go/tree/TypeAssert" {
+		return readTypeAssertBinary(d, o)
+	}
+	if t == "go/tree/TypeCoerce" {
+		return readTypeCoerceBinary(d, o)
+	}
+	if t == "go/tree/Assign" {
+		return readAssignBinary(d, o)
+	}
+	if t == "go/tree/Var" {
+		return readVarBinary(d, o)
+	}
+	if t == "go/tree/BlockStmt" {
+		return readBlockStmtBinary(d, o)
+	}
+	if t == "go/tree/If" {
+		return readIfBinary(d, o)
+	}
+	if t == "go/tree/For" {
+		return readForBinary(d, o)
+	}
+	if t == "go/tree/Range" {
+		return readRangeBinary(d, o)
+	}
+	if t == "go/tree/TypeSwitch" {
+		return readTypeSwitchBinary(d, o)
+	}
+	if t == "go/tree/Switch" {
+		return readSwitchBinary(d, o)
+	}
+	if t == "go/tree/Goto" {
+		return readGotoBinary(d, o)
+	}
+	if t == "go/tree/Break" {
+		return readBreakBinary(d, o)
+	}
+	if t == "go/tree/Continue" {
+		return readContinueBinary(d, o)
+	}
+	if t == "go/tree/Label" {
+		return readLabelBinary(d, o)
+	}
+	if t == "go/tree/Return" {
+		return readReturnBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeExprBinary(d *runtime.BinaryDecoder) Expr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	if t == "go/tree/Float32Literal" {
+		return readFloat32LiteralBinary(d, o)
+	}
+	if t == "go/tree/BoolLiteral" {
+		return readBoolLiteralBinary(d, o)
+	}
+	if t == "go/tree/StringLiteral" {
+		return readStringLiteralBinary(d, o)
+	}
+	if t == "go/tree/RuneLiteral" {
+		return readRuneLiteralBinary(d, o)
+	}
+	if t == "go/tree/NilLiteral" {
+		return readNilLiteralBinary(d, o)
+	}
+	if t == "go/tree/StructLiteral" {
+		return readStructLiteralBinary(d, o)
+	}
+	if t == "go/tree/ListLiteral" {
+		return readListLiteralBinary(d, o)
+	}
+	if t == "go/tree/GetName" {
+		return readGetNameBinary(d, o)
+	}
+	if t == "go/tree/GetLocal" {
+		return readGetLocalBinary(d, o)
+	}
+	if t == "go/tree/GetGlobal" {
+		return readGetGlobalBinary(d, o)
+	}
+	if t == "go/tree/GetFunction" {
+		return readGetFunctionBinary(d, o)
+	}
+	if t == "go/tree/UnaryExpr" {
+		return readUnaryExprBinary(d, o)
+	}
+	if t == "go/tree/BinaryExpr" {
+		return readBinaryExprBinary(d, o)
+	}
+	if t == "go/tree/Selector" {
+		return readSelectorBinary(d, o)
+	}
+	if t == "go/tree/Index" {
+		return readIndexBinary(d, o)
+	}
+	if t == "go/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	if t == "go/tree/TypeAssert" {
+		return readTypeAssertBinary(d, o)
+	}
+	if t == "go/tree/TypeCoerce" {
+		return readTypeCoerceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeTargetBinary(d *runtime.BinaryDecoder) Target {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SetName" {
+		return readSetNameBinary(d, o)
+	}
+	if t == "go/tree/SetLocal" {
+		return readSetLocalBinary(d, o)
+	}
+	if t == "go/tree/SetDiscard" {
+		return readSetDiscardBinary(d, o)
+	}
+	if t == "go/tree/SetSelector" {
+		return readSetSelectorBinary(d, o)
+	}
+	if t == "go/tree/SetIndex" {
+		return readSetIndexBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *IntLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/IntLiteral") {
+		return
+	}
+	e.WriteInt(node.Value)
+}
+
+func (node *IntLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadInt()
+}
+
+func readIntLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *IntLiteral {
+	var node *IntLiteral
+	if o != nil {
+		return o.(*IntLiteral)
+	}
+	node = &IntLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *IntLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6b4c5e7584fc5e5d")
+}
+
+func (node *IntLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6b4c5e7584fc5e5d", "go/tree/IntLiteral", node)
+}
+
+func DecodeIntLiteralBinary(d *runtime.BinaryDecoder) *IntLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Float32Literal) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Float32Literal") {
+		return
+	}
+	e.WriteFloat32(node.Value)
+}
+
+func (node *Float32Literal) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadFloat32()
+}
+
+func readFloat32LiteralBinary(d *runtime.BinaryDecoder, o interface{}) *Float32Literal {
+	var node *Float32Literal
+	if o != nil {
+		return o.(*Float32Literal)
+	}
+	node = &Float32Literal{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Float32Literal) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "d76f826b55c7b63d")
+}
+
+func (node *Float32Literal) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "d76f826b55c7b63d", "go/tree/Float32Literal", node)
+}
+
+func DecodeFloat32LiteralBinary(d *runtime.BinaryDecoder) *Float32Literal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Float32Literal" {
+		return readFloat32LiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BoolLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/BoolLiteral") {
+		return
+	}
+	e.WriteBool(node.Value)
+}
+
+func (node *BoolLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadBool()
+}
+
+func readBoolLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *BoolLiteral {
+	var node *BoolLiteral
+	if o != nil {
+		return o.(*BoolLiteral)
+	}
+	node = &BoolLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BoolLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "507655ac4ec26b81")
+}
+
+func (node *BoolLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "507655ac4ec26b81", "go/tree/BoolLiteral", node)
+}
+
+func DecodeBoolLiteralBinary(d *runtime.BinaryDecoder) *BoolLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/BoolLiteral" {
+		return readBoolLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StringLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/StringLiteral") {
+		return
+	}
+	e.WriteString(node.Value)
+}
+
+func (node *StringLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadString()
+}
+
+func readStringLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *StringLiteral {
+	var node *StringLiteral
+	if o != nil {
+		return o.(*StringLiteral)
+	}
+	node = &StringLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StringLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6e8f256372b4ffa7")
+}
+
+func (node *StringLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6e8f256372b4ffa7", "go/tree/StringLiteral", node)
+}
+
+func DecodeStringLiteralBinary(d *runtime.BinaryDecoder) *StringLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/StringLiteral" {
+		return readStringLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *RuneLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/RuneLiteral") {
+		return
+	}
+	e.WriteRuneValue(node.Value)
+}
+
+func (node *RuneLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Value = d.ReadRuneValue()
+}
+
+func readRuneLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *RuneLiteral {
+	var node *RuneLiteral
+	if o != nil {
+		return o.(*RuneLiteral)
+	}
+	node = &RuneLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *RuneLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e3e75b00ec213661")
+}
+
+func (node *RuneLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e3e75b00ec213661", "go/tree/RuneLiteral", node)
+}
+
+func DecodeRuneLiteralBinary(d *runtime.BinaryDecoder) *RuneLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/RuneLiteral" {
+		return readRuneLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NilLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/NilLiteral") {
+		return
+	}
+}
+
+func (node *NilLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readNilLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *NilLiteral {
+	var node *NilLiteral
+	if o != nil {
+		return o.(*NilLiteral)
+	}
+	node = &NilLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NilLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "35a2ce4e5b9ed9a8")
+}
+
+func (node *NilLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "35a2ce4e5b9ed9a8", "go/tree/NilLiteral", node)
+}
+
+func DecodeNilLiteralBinary(d *runtime.BinaryDecoder) *NilLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/NilLiteral" {
+		return readNilLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *KeywordExpr) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/KeywordExpr") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Expr)
+}
+
+func (node *KeywordExpr) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Expr = DecodeExprBinary(d)
+}
+
+func readKeywordExprBinary(d *runtime.BinaryDecoder, o interface{}) *KeywordExpr {
+	var node *KeywordExpr
+	if o != nil {
+		return o.(*KeywordExpr)
+	}
+	node = &KeywordExpr{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *KeywordExpr) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *KeywordExpr) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/KeywordExpr", node)
+}
+
+func DecodeKeywordExprBinary(d *runtime.BinaryDecoder) *KeywordExpr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/KeywordExpr" {
+		return readKeywordExprBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StructLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *KeywordExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/StructLiteral") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *StructLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*KeywordExpr
+	node.Type = DecodeTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*KeywordExpr{}
+		for range n {
+			s = append(s, DecodeKeywordExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readStructLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *StructLiteral {
+	var node *StructLiteral
+	if o != nil {
+		return o.(*StructLiteral)
+	}
+	node = &StructLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StructLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *StructLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/StructLiteral", node)
+}
+
+func DecodeStructLiteralBinary(d *runtime.BinaryDecoder) *StructLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/StructLiteral" {
+		return readStructLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ListLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Expr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/ListLiteral") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *ListLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Expr
+	node.Type = DecodeTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Expr{}
+		for range n {
+			s = append(s, DecodeExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readListLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *ListLiteral {
+	var node *ListLiteral
+	if o != nil {
+		return o.(*ListLiteral)
+	}
+	node = &ListLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ListLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *ListLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/ListLiteral", node)
+}
+
+func DecodeListLiteralBinary(d *runtime.BinaryDecoder) *ListLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/ListLiteral" {
+		return readListLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *LocalInfo) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/LocalInfo") {
+		return
+	}
+	e.WriteUint32(uint32(node.Index))
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.T)
+}
+
+func (node *LocalInfo) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Index = LocalInfo_Ref(d.ReadUint32())
+	node.Name = d.ReadString()
+	node.T = DecodeTypeRefBinary(d)
+}
+
+func readLocalInfoBinary(d *runtime.BinaryDecoder, o interface{}) *LocalInfo {
+	var node *LocalInfo
+	if o != nil {
+		return o.(*LocalInfo)
+	}
+	node = &LocalInfo{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *LocalInfo) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "e0c46b499f17f55b")
+}
+
+func (node *LocalInfo) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "e0c46b499f17f55b", "go/tree/LocalInfo", node)
+}
+
+func DecodeLocalInfoBinary(d *runtime.BinaryDecoder) *LocalInfo {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/LocalInfo" {
+		return readLocalInfoBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetName) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/GetName") {
+		return
+	}
+	e.WriteString(node.Text)
+}
+
+func (node *GetName) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+}
+
+func readGetNameBinary(d *runtime.BinaryDecoder, o interface{}) *GetName {
+	var node *GetName
+	if o != nil {
+		return o.(*GetName)
+	}
+	node = &GetName{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetName) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "136bc1fbc5661e7c")
+}
+
+func (node *GetName) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "136bc1fbc5661e7c", "go/tree/GetName", node)
+}
+
+func DecodeGetNameBinary(d *runtime.BinaryDecoder) *GetName {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/GetName" {
+		return readGetNameBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SetName) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/SetName") {
+		return
+	}
+	e.WriteString(node.Text)
+}
+
+func (node *SetName) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+}
+
+func readSetNameBinary(d *runtime.BinaryDecoder, o interface{}) *SetName {
+	var node *SetName
+	if o != nil {
+		return o.(*SetName)
+	}
+	node = &SetName{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SetName) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "90c07efe995e7d76")
+}
+
+func (node *SetName) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "90c07efe995e7d76", "go/tree/SetName", node)
+}
+
+func DecodeSetNameBinary(d *runtime.BinaryDecoder) *SetName {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SetName" {
+		return readSetNameBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetLocal) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/GetLocal") {
+		return
+	}
+	node.Info.EncodeBinary(e)
+}
+
+func (node *GetLocal) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Info = DecodeLocalInfoBinary(d)
+}
+
+func readGetLocalBinary(d *runtime.BinaryDecoder, o interface{}) *GetLocal {
+	var node *GetLocal
+	if o != nil {
+		return o.(*GetLocal)
+	}
+	node = &GetLocal{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetLocal) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "fdbdb0eb080224a9")
+}
+
+func (node *GetLocal) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "fdbdb0eb080224a9", "go/tree/GetLocal", node)
+}
+
+func DecodeGetLocalBinary(d *runtime.BinaryDecoder) *GetLocal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/GetLocal" {
+		return readGetLocalBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SetLocal) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/SetLocal") {
+		return
+	}
+	node.Info.EncodeBinary(e)
+}
+
+func (node *SetLocal) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Info = DecodeLocalInfoBinary(d)
+}
+
+func readSetLocalBinary(d *runtime.BinaryDecoder, o interface{}) *SetLocal {
+	var node *SetLocal
+	if o != nil {
+		return o.(*SetLocal)
+	}
+	node = &SetLocal{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SetLocal) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "530cd4982f85f489")
+}
+
+func (node *SetLocal) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "530cd4982f85f489", "go/tree/SetLocal", node)
+}
+
+func DecodeSetLocalBinary(d *runtime.BinaryDecoder) *SetLocal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SetLocal" {
+		return readSetLocalBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetGlobal) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/GetGlobal") {
+		return
+	}
+	e.WriteString(node.Text)
+}
+
+func (node *GetGlobal) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+}
+
+func readGetGlobalBinary(d *runtime.BinaryDecoder, o interface{}) *GetGlobal {
+	var node *GetGlobal
+	if o != nil {
+		return o.(*GetGlobal)
+	}
+	node = &GetGlobal{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetGlobal) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "bc0a6409599fe23c")
+}
+
+func (node *GetGlobal) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "bc0a6409599fe23c", "go/tree/GetGlobal", node)
+}
+
+func DecodeGetGlobalBinary(d *runtime.BinaryDecoder) *GetGlobal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/GetGlobal" {
+		return readGetGlobalBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetFunction) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/GetFunction") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Func)
+}
+
+func (node *GetFunction) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Func = core.DecodeCallableBinary(d)
+}
+
+func readGetFunctionBinary(d *runtime.BinaryDecoder, o interface{}) *GetFunction {
+	var node *GetFunction
+	if o != nil {
+		return o.(*GetFunction)
+	}
+	node = &GetFunction{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetFunction) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "0ebcbc9998dcbac3")
+}
+
+func (node *GetFunction) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "0ebcbc9998dcbac3", "go/tree/GetFunction", node)
+}
+
+func DecodeGetFunctionBinary(d *runtime.BinaryDecoder) *GetFunction {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/GetFunction" {
+		return readGetFunctionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SetDiscard) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/SetDiscard") {
+		return
+	}
+}
+
+func (node *SetDiscard) DecodeBinary(d *runtime.BinaryDecoder) {
+}
+
+func readSetDiscardBinary(d *runtime.BinaryDecoder, o interface{}) *SetDiscard {
+	var node *SetDiscard
+	if o != nil {
+		return o.(*SetDiscard)
+	}
+	node = &SetDiscard{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SetDiscard) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "c0b95f6b1db80a0e")
+}
+
+func (node *SetDiscard) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "c0b95f6b1db80a0e", "go/tree/SetDiscard", node)
+}
+
+func DecodeSetDiscardBinary(d *runtime.BinaryDecoder) *SetDiscard {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SetDiscard" {
+		return readSetDiscardBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SetSelector) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/SetSelector") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	e.WriteString(node.Text)
+}
+
+func (node *SetSelector) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeExprBinary(d)
+	node.Text = d.ReadString()
+}
+
+func readSetSelectorBinary(d *runtime.BinaryDecoder, o interface{}) *SetSelector {
+	var node *SetSelector
+	if o != nil {
+		return o.(*SetSelector)
+	}
+	node = &SetSelector{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SetSelector) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ad02e9c45b8a97a9")
+}
+
+func (node *SetSelector) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ad02e9c45b8a97a9", "go/tree/SetSelector", node)
+}
+
+func DecodeSetSelectorBinary(d *runtime.BinaryDecoder) *SetSelector {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SetSelector" {
+		return readSetSelectorBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SetIndex) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/SetIndex") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	runtime.EncodeBinary(e, node.Index)
+}
+
+func (node *SetIndex) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeExprBinary(d)
+	node.Index = DecodeExprBinary(d)
+}
+
+func readSetIndexBinary(d *runtime.BinaryDecoder, o interface{}) *SetIndex {
+	var node *SetIndex
+	if o != nil {
+		return o.(*SetIndex)
+	}
+	node = &SetIndex{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SetIndex) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "bf7b7d80f36a9511")
+}
+
+func (node *SetIndex) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "bf7b7d80f36a9511", "go/tree/SetIndex", node)
+}
+
+func DecodeSetIndexBinary(d *runtime.BinaryDecoder) *SetIndex {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SetIndex" {
+		return readSetIndexBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *UnaryExpr) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/UnaryExpr") {
+		return
+	}
+	e.WriteString(node.Op)
+	runtime.EncodeBinary(e, node.Expr)
+}
+
+func (node *UnaryExpr) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Op = d.ReadString()
+	node.Expr = DecodeExprBinary(d)
+}
+
+func readUnaryExprBinary(d *runtime.BinaryDecoder, o interface{}) *UnaryExpr {
+	var node *UnaryExpr
+	if o != nil {
+		return o.(*UnaryExpr)
+	}
+	node = &UnaryExpr{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *UnaryExpr) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *UnaryExpr) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/UnaryExpr", node)
+}
+
+func DecodeUnaryExprBinary(d *runtime.BinaryDecoder) *UnaryExpr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/UnaryExpr" {
+		return readUnaryExprBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BinaryExpr) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/BinaryExpr") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Left)
+	e.WriteString(node.Op)
+	runtime.EncodeBinary(e, node.Right)
+}
+
+func (node *BinaryExpr) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Left = DecodeExprBinary(d)
+	node.Op = d.ReadString()
+	node.Right = DecodeExprBinary(d)
+}
+
+func readBinaryExprBinary(d *runtime.BinaryDecoder, o interface{}) *BinaryExpr {
+	var node *BinaryExpr
+	if o != nil {
+		return o.(*BinaryExpr)
+	}
+	node = &BinaryExpr{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BinaryExpr) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *BinaryExpr) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/BinaryExpr", node)
+}
+
+func DecodeBinaryExprBinary(d *runtime.BinaryDecoder) *BinaryExpr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/BinaryExpr" {
+		return readBinaryExprBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Selector) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Selector") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	e.WriteString(node.Text)
+}
+
+func (node *Selector) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeExprBinary(d)
+	node.Text = d.ReadString()
+}
+
+func readSelectorBinary(d *runtime.BinaryDecoder, o interface{}) *Selector {
+	var node *Selector
+	if o != nil {
+		return o.(*Selector)
+	}
+	node = &Selector{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Selector) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *Selector) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/Selector", node)
+}
+
+func DecodeSelectorBinary(d *runtime.BinaryDecoder) *Selector {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Selector" {
+		return readSelectorBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Index) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Index") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	runtime.EncodeBinary(e, node.Index)
+}
+
+func (node *Index) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeExprBinary(d)
+	node.Index = DecodeExprBinary(d)
+}
+
+func readIndexBinary(d *runtime.BinaryDecoder, o interface{}) *Index {
+	var node *Index
+	if o != nil {
+		return o.(*Index)
+	}
+	node = &Index{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Index) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *Index) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/Index", node)
+}
+
+func DecodeIndexBinary(d *runtime.BinaryDecoder) *Index {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Index" {
+		return readIndexBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Call) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Expr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Call") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *Call) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Expr
+	node.Expr = DecodeExprBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Expr{}
+		for range n {
+			s = append(s, DecodeExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readCallBinary(d *runtime.BinaryDecoder, o interface{}) *Call {
+	var node *Call
+	if o != nil {
+		return o.(*Call)
+	}
+	node = &Call{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Call) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *Call) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/Call", node)
+}
+
+func DecodeCallBinary(d *runtime.BinaryDecoder) *Call {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeAssert) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/TypeAssert") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *TypeAssert) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeExprBinary(d)
+	node.Type = DecodeTypeRefBinary(d)
+}
+
+func readTypeAssertBinary(d *runtime.BinaryDecoder, o interface{}) *TypeAssert {
+	var node *TypeAssert
+	if o != nil {
+		return o.(*TypeAssert)
+	}
+	node = &TypeAssert{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeAssert) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *TypeAssert) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/TypeAssert", node)
+}
+
+func DecodeTypeAssertBinary(d *runtime.BinaryDecoder) *TypeAssert {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/TypeAssert" {
+		return readTypeAssertBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeCoerce) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/TypeCoerce") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	runtime.EncodeBinary(e, node.Expr)
+}
+
+func (node *TypeCoerce) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = DecodeTypeRefBinary(d)
+	node.Expr = DecodeExprBinary(d)
+}
+
+func readTypeCoerceBinary(d *runtime.BinaryDecoder, o interface{}) *TypeCoerce {
+	var node *TypeCoerce
+	if o != nil {
+		return o.(*TypeCoerce)
+	}
+	node = &TypeCoerce{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeCoerce) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "065142329cb2f519")
+}
+
+func (node *TypeCoerce) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "065142329cb2f519", "go/tree/TypeCoerce", node)
+}
+
+func DecodeTypeCoerceBinary(d *runtime.BinaryDecoder) *TypeCoerce {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/TypeCoerce" {
+		return readTypeCoerceBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Assign) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 Expr
+	var x1 Target
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Assign") {
+		return
+	}
+	if node.Sources == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Sources))
+		for _, x0 = range node.Sources {
+			runtime.EncodeBinary(e, x0)
+		}
+	}
+	e.WriteString(node.Op)
+	if node.Targets == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Targets))
+		for _, x1 = range node.Targets {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+}
+
+func (node *Assign) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []Expr
+	var n1 int
+	var s1 []Target
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []Expr{}
+		for range n0 {
+			s0 = append(s0, DecodeExprBinary(d))
+		}
+	}
+	node.Sources = s0
+	node.Op = d.ReadString()
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []Target{}
+		for range n1 {
+			s1 = append(s1, DecodeTargetBinary(d))
+		}
+	}
+	node.Targets = s1
+}
+
+func readAssignBinary(d *runtime.BinaryDecoder, o interface{}) *Assign {
+	var node *Assign
+	if o != nil {
+		return o.(*Assign)
+	}
+	node = &Assign{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Assign) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7da88a4bbac467ce")
+}
+
+func (node *Assign) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7da88a4bbac467ce", "go/tree/Assign", node)
+}
+
+func DecodeAssignBinary(d *runtime.BinaryDecoder) *Assign {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Assign" {
+		return readAssignBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Var) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Var") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+	runtime.EncodeBinary(e, node.Expr)
+	node.Info.EncodeBinary(e)
+}
+
+func (node *Var) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeTypeRefBinary(d)
+	node.Expr = DecodeExprBinary(d)
+	node.Info = DecodeLocalInfoBinary(d)
+}
+
+func readVarBinary(d *runtime.BinaryDecoder, o interface{}) *Var {
+	var node *Var
+	if o != nil {
+		return o.(*Var)
+	}
+	node = &Var{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Var) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "818f0898fdae9756")
+}
+
+func (node *Var) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "818f0898fdae9756", "go/tree/Var", node)
+}
+
+func DecodeVarBinary(d *runtime.BinaryDecoder) *Var {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Var" {
+		return readVarBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Block) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Stmt
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Block") {
+		return
+	}
+	if node.Body == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Body))
+		for _, x = range node.Body {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *Block) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Stmt
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Stmt{}
+		for range n {
+			s = append(s, DecodeStmtBinary(d))
+		}
+	}
+	node.Body = s
+}
+
+func readBlockBinary(d *runtime.BinaryDecoder, o interface{}) *Block {
+	var node *Block
+	if o != nil {
+		return o.(*Block)
+	}
+	node = &Block{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Block) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *Block) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/Block", node)
+}
+
+func DecodeBlockBinary(d *runtime.BinaryDecoder) *Block {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Block" {
+		return readBlockBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *BlockStmt) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/BlockStmt") {
+		return
+	}
+	node.Block.EncodeBinary(e)
+}
+
+func (node *BlockStmt) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Block = DecodeBlockBinary(d)
+}
+
+func readBlockStmtBinary(d *runtime.BinaryDecoder, o interface{}) *BlockStmt {
+	var node *BlockStmt
+	if o != nil {
+		return o.(*BlockStmt)
+	}
+	node = &BlockStmt{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *BlockStmt) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *BlockStmt) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/BlockStmt", node)
+}
+
+func DecodeBlockStmtBinary(d *runtime.BinaryDecoder) *BlockStmt {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/BlockStmt" {
+		return readBlockStmtBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *If) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/If") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Cond)
+	node.T.EncodeBinary(e)
+	node.F.EncodeBinary(e)
+}
+
+func (node *If) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Cond = DecodeExprBinary(d)
+	node.T = DecodeBlockBinary(d)
+	node.F = DecodeBlockBinary(d)
+}
+
+func readIfBinary(d *runtime.BinaryDecoder, o interface{}) *If {
+	var node *If
+	if o != nil {
+		return o.(*If)
+	}
+	node = &If{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *If) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *If) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/If", node)
+}
+
+func DecodeIfBinary(d *runtime.BinaryDecoder) *If {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/If" {
+		return readIfBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *For) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/For") {
+		return
+	}
+	node.Block.EncodeBinary(e)
+}
+
+func (node *For) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Block = DecodeBlockBinary(d)
+}
+
+func readForBinary(d *runtime.BinaryDecoder, o interface{}) *For {
+	var node *For
+	if o != nil {
+		return o.(*For)
+	}
+	node = &For{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *For) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *For) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/For", node)
+}
+
+func DecodeForBinary(d *runtime.BinaryDecoder) *For {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/For" {
+		return readForBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Range) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Range") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Key)
+	runtime.EncodeBinary(e, node.Value)
+	runtime.EncodeBinary(e, node.Expr)
+	node.Block.EncodeBinary(e)
+}
+
+func (node *Range) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Key = DecodeTargetBinary(d)
+	node.Value = DecodeTargetBinary(d)
+	node.Expr = DecodeExprBinary(d)
+	node.Block = DecodeBlockBinary(d)
+}
+
+func readRangeBinary(d *runtime.BinaryDecoder, o interface{}) *Range {
+	var node *Range
+	if o != nil {
+		return o.(*Range)
+	}
+	node = &Range{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Range) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *Range) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/Range", node)
+}
+
+func DecodeRangeBinary(d *runtime.BinaryDecoder) *Range {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Range" {
+		return readRangeBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeSwitchCase) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x TypeRef
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/TypeSwitchCase") {
+		return
+	}
+	if node.Types == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Types))
+		for _, x = range node.Types {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+	node.Block.EncodeBinary(e)
+}
+
+func (node *TypeSwitchCase) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []TypeRef
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []TypeRef{}
+		for range n {
+			s = append(s, DecodeTypeRefBinary(d))
+		}
+	}
+	node.Types = s
+	node.Block = DecodeBlockBinary(d)
+}
+
+func readTypeSwitchCaseBinary(d *runtime.BinaryDecoder, o interface{}) *TypeSwitchCase {
+	var node *TypeSwitchCase
+	if o != nil {
+		return o.(*TypeSwitchCase)
+	}
+	node = &TypeSwitchCase{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeSwitchCase) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *TypeSwitchCase) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/TypeSwitchCase", node)
+}
+
+func DecodeTypeSwitchCaseBinary(d *runtime.BinaryDecoder) *TypeSwitchCase {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/TypeSwitchCase" {
+		return readTypeSwitchCaseBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeSwitch) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *TypeSwitchCase
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/TypeSwitch") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	if node.Cases == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Cases))
+		for _, x = range node.Cases {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Default.EncodeBinary(e)
+}
+
+func (node *TypeSwitch) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*TypeSwitchCase
+	node.Expr = DecodeExprBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*TypeSwitchCase{}
+		for range n {
+			s = append(s, DecodeTypeSwitchCaseBinary(d))
+		}
+	}
+	node.Cases = s
+	node.Default = DecodeBlockBinary(d)
+}
+
+func readTypeSwitchBinary(d *runtime.BinaryDecoder, o interface{}) *TypeSwitch {
+	var node *TypeSwitch
+	if o != nil {
+		return o.(*TypeSwitch)
+	}
+	node = &TypeSwitch{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeSwitch) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *TypeSwitch) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/TypeSwitch", node)
+}
+
+func DecodeTypeSwitchBinary(d *runtime.BinaryDecoder) *TypeSwitch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/TypeSwitch" {
+		return readTypeSwitchBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SwitchCase) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Expr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/SwitchCase") {
+		return
+	}
+	if node.Exprs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Exprs))
+		for _, x = range node.Exprs {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+	node.Block.EncodeBinary(e)
+}
+
+func (node *SwitchCase) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Expr
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Expr{}
+		for range n {
+			s = append(s, DecodeExprBinary(d))
+		}
+	}
+	node.Exprs = s
+	node.Block = DecodeBlockBinary(d)
+}
+
+func readSwitchCaseBinary(d *runtime.BinaryDecoder, o interface{}) *SwitchCase {
+	var node *SwitchCase
+	if o != nil {
+		return o.(*SwitchCase)
+	}
+	node = &SwitchCase{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SwitchCase) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *SwitchCase) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/SwitchCase", node)
+}
+
+func DecodeSwitchCaseBinary(d *runtime.BinaryDecoder) *SwitchCase {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/SwitchCase" {
+		return readSwitchCaseBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Switch) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *SwitchCase
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Switch") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	if node.Cases == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Cases))
+		for _, x = range node.Cases {
+			x.EncodeBinary(e)
+		}
+	}
+	node.Default.EncodeBinary(e)
+}
+
+func (node *Switch) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*SwitchCase
+	node.Expr = DecodeExprBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*SwitchCase{}
+		for range n {
+			s = append(s, DecodeSwitchCaseBinary(d))
+		}
+	}
+	node.Cases = s
+	node.Default = DecodeBlockBinary(d)
+}
+
+func readSwitchBinary(d *runtime.BinaryDecoder, o interface{}) *Switch {
+	var node *Switch
+	if o != nil {
+		return o.(*Switch)
+	}
+	node = &Switch{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Switch) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "3a84a851eef6d2c4")
+}
+
+func (node *Switch) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "3a84a851eef6d2c4", "go/tree/Switch", node)
+}
+
+func DecodeSwitchBinary(d *runtime.BinaryDecoder) *Switch {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Switch" {
+		return readSwitchBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Goto) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Goto") {
+		return
+	}
+	e.WriteString(node.Text)
+}
+
+func (node *Goto) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+}
+
+func readGotoBinary(d *runtime.BinaryDecoder, o interface{}) *Goto {
+	var node *Goto
+	if o != nil {
+		return o.(*Goto)
+	}
+	node = &Goto{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Goto) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "c50cde4cdc0afd1f")
+}
+
+func (node *Goto) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "c50cde4cdc0afd1f", "go/tree/Goto", node)
+}
+
+func DecodeGotoBinary(d *runtime.BinaryDecoder) *Goto {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Goto" {
+		return readGotoBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Break) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Break") {
+		return
+	}
+	e.WriteString(node.Text)
+}
+
+func (node *Break) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+}
+
+func readBreakBinary(d *runtime.BinaryDecoder, o interface{}) *Break {
+	var node *Break
+	if o != nil {
+		return o.(*Break)
+	}
+	node = &Break{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Break) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "49905ddd9058fe51")
+}
+
+func (node *Break) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "49905ddd9058fe51", "go/tree/Break", node)
+}
+
+func DecodeBreakBinary(d *runtime.BinaryDecoder) *Break {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Break" {
+		return readBreakBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Continue) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Continue") {
+		return
+	}
+	e.WriteString(node.Text)
+}
+
+func (node *Continue) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+}
+
+func readContinueBinary(d *runtime.BinaryDecoder, o interface{}) *Continue {
+	var node *Continue
+	if o != nil {
+		return o.(*Continue)
+	}
+	node = &Continue{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Continue) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "4c4875380d313d95")
+}
+
+func (node *Continue) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "4c4875380d313d95", "go/tree/Continue", node)
+}
+
+func DecodeContinueBinary(d *runtime.BinaryDecoder) *Continue {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Continue" {
+		return readContinueBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Label) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Label") {
+		return
+	}
+	e.WriteString(node.Text)
+}
+
+func (node *Label) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Text = d.ReadString()
+}
+
+func readLabelBinary(d *runtime.BinaryDecoder, o interface{}) *Label {
+	var node *Label
+	if o != nil {
+		return o.(*Label)
+	}
+	node = &Label{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Label) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f95ebb6823985ae4")
+}
+
+func (node *Label) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f95ebb6823985ae4", "go/tree/Label", node)
+}
+
+func DecodeLabelBinary(d *runtime.BinaryDecoder) *Label {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Label" {
+		return readLabelBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Return) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Expr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Return") {
+		return
+	}
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *Return) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Expr
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Expr{}
+		for range n {
+			s = append(s, DecodeExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readReturnBinary(d *runtime.BinaryDecoder, o interface{}) *Return {
+	var node *Return
+	if o != nil {
+		return o.(*Return)
+	}
+	node = &Return{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Return) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "04b62e047aa3e636")
+}
+
+func (node *Return) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "04b62e047aa3e636", "go/tree/Return", node)
+}
+
+func DecodeReturnBinary(d *runtime.BinaryDecoder) *Return {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Return" {
+		return readReturnBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeDeclBinary(d *runtime.BinaryDecoder) Decl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/VarDecl" {
+		return readVarDeclBinary(d, o)
+	}
+	if t == "go/tree/FuncDecl" {
+		return readFuncDeclBinary(d, o)
+	}
+	if t == "go/tree/StructDecl" {
+		return readStructDeclBinary(d, o)
+	}
+	if t == "go/tree/InterfaceDecl" {
+		return readInterfaceDeclBinary(d, o)
+	}
+	if t == "go/tree/TypeDefDecl" {
+		return readTypeDefDeclBinary(d, o)
+	}
+	if t == "go/tree/OpaqueDecl" {
+		return readOpaqueDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *VarDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/VarDecl") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+	runtime.EncodeBinary(e, node.Expr)
+	e.WriteBool(node.Const)
+}
+
+func (node *VarDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeTypeRefBinary(d)
+	node.Expr = DecodeExprBinary(d)
+	node.Const = d.ReadBool()
+}
+
+func readVarDeclBinary(d *runtime.BinaryDecoder, o interface{}) *VarDecl {
+	var node *VarDecl
+	if o != nil {
+		return o.(*VarDecl)
+	}
+	node = &VarDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *VarDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "54404f477aa43b6a")
+}
+
+func (node *VarDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "54404f477aa43b6a", "go/tree/VarDecl", node)
+}
+
+func DecodeVarDeclBinary(d *runtime.BinaryDecoder) *VarDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/VarDecl" {
+		return readVarDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FuncDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var o *LocalInfo
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/FuncDecl") {
+		return
+	}
+	if node.LocalInfo_Scope == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.LocalInfo_Scope.objects))
+		for _, o = range node.LocalInfo_Scope.objects {
+			o.EncodeBinary(e)
+		}
+	}
+	e.WriteString(node.Name)
+	node.Recv.EncodeBinary(e)
+	node.Type.EncodeBinary(e)
+	node.Block.EncodeBinary(e)
+	node.Package.EncodeBinary(e)
+}
+
+func (node *FuncDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	n = d.ReadLength()
+	if n >= 0 {
+		node.LocalInfo_Scope = &LocalInfo_Scope{}
+		for range n {
+			node.LocalInfo_Scope.objects = append(node.LocalInfo_Scope.objects, DecodeLocalInfoBinary(d))
+		}
+	}
+	node.Name = d.ReadString()
+	node.Recv = DecodeParamBinary(d)
+	node.Type = DecodeFuncTypeRefBinary(d)
+	node.Block = DecodeBlockBinary(d)
+	node.Package = core.DecodePackageBinary(d)
+}
+
+func readFuncDeclBinary(d *runtime.BinaryDecoder, o interface{}) *FuncDecl {
+	var node *FuncDecl
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	node = &FuncDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FuncDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "de8223440f800a44")
+}
+
+func (node *FuncDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "de8223440f800a44", "go/tree/FuncDecl", node)
+}
+
+func DecodeFuncDeclBinary(d *runtime.BinaryDecoder) *FuncDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/FuncDecl" {
+		return readFuncDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FieldDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/FieldDecl") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *FieldDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeTypeRefBinary(d)
+}
+
+func readFieldDeclBinary(d *runtime.BinaryDecoder, o interface{}) *FieldDecl {
+	var node *FieldDecl
+	if o != nil {
+		return o.(*FieldDecl)
+	}
+	node = &FieldDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FieldDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "cf42cdb8fb235f26")
+}
+
+func (node *FieldDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "cf42cdb8fb235f26", "go/tree/FieldDecl", node)
+}
+
+func DecodeFieldDeclBinary(d *runtime.BinaryDecoder) *FieldDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/FieldDecl" {
+		return readFieldDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StructDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *FieldDecl
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/StructDecl") {
+		return
+	}
+	e.WriteString(node.Name)
+	if node.Fields == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Fields))
+		for _, x = range node.Fields {
+			x.EncodeBinary(e)
+		}
+	}
+	node.T.EncodeBinary(e)
+}
+
+func (node *StructDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*FieldDecl
+	node.Name = d.ReadString()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*FieldDecl{}
+		for range n {
+			s = append(s, DecodeFieldDeclBinary(d))
+		}
+	}
+	node.Fields = s
+	node.T = core.DecodeStructTypeBinary(d)
+}
+
+func readStructDeclBinary(d *runtime.BinaryDecoder, o interface{}) *StructDecl {
+	var node *StructDecl
+	if o != nil {
+		return o.(*StructDecl)
+	}
+	node = &StructDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StructDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "c38a251fa345b9c7")
+}
+
+func (node *StructDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "c38a251fa345b9c7", "go/tree/StructDecl", node)
+}
+
+func DecodeStructDeclBinary(d *runtime.BinaryDecoder) *StructDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/StructDecl" {
+		return readStructDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *InterfaceDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *FieldDecl
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/InterfaceDecl") {
+		return
+	}
+	e.WriteString(node.Name)
+	if node.Fields == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Fields))
+		for _, x = range node.Fields {
+			x.EncodeBinary(e)
+		}
+	}
+	node.T.EncodeBinary(e)
+}
+
+func (node *InterfaceDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*FieldDecl
+	node.Name = d.ReadString()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*FieldDecl{}
+		for range n {
+			s = append(s, DecodeFieldDeclBinary(d))
+		}
+	}
+	node.Fields = s
+	node.T = core.DecodeInterfaceTypeBinary(d)
+}
+
+func readInterfaceDeclBinary(d *runtime.BinaryDecoder, o interface{}) *InterfaceDecl {
+	var node *InterfaceDecl
+	if o != nil {
+		return o.(*InterfaceDecl)
+	}
+	node = &InterfaceDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *InterfaceDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "dc695c9fa085638b")
+}
+
+func (node *InterfaceDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "dc695c9fa085638b", "go/tree/InterfaceDecl", node)
+}
+
+func DecodeInterfaceDeclBinary(d *runtime.BinaryDecoder) *InterfaceDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/InterfaceDecl" {
+		return readInterfaceDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeDefDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/TypeDefDecl") {
+		return
+	}
+	e.WriteString(node.Name)
+	runtime.EncodeBinary(e, node.Type)
+	node.T.EncodeBinary(e)
+}
+
+func (node *TypeDefDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Type = DecodeTypeRefBinary(d)
+	node.T = core.DecodeTypeDefTypeBinary(d)
+}
+
+func readTypeDefDeclBinary(d *runtime.BinaryDecoder, o interface{}) *TypeDefDecl {
+	var node *TypeDefDecl
+	if o != nil {
+		return o.(*TypeDefDecl)
+	}
+	node = &TypeDefDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeDefDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "b0143d020365ae7e")
+}
+
+func (node *TypeDefDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "b0143d020365ae7e", "go/tree/TypeDefDecl", node)
+}
+
+func DecodeTypeDefDeclBinary(d *runtime.BinaryDecoder) *TypeDefDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/TypeDefDecl" {
+		return readTypeDefDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *OpaqueDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/OpaqueDecl") {
+		return
+	}
+	node.T.EncodeBinary(e)
+}
+
+func (node *OpaqueDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.T = core.DecodeExternalTypeBinary(d)
+}
+
+func readOpaqueDeclBinary(d *runtime.BinaryDecoder, o interface{}) *OpaqueDecl {
+	var node *OpaqueDecl
+	if o != nil {
+		return o.(*OpaqueDecl)
+	}
+	node = &OpaqueDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *OpaqueDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "2235b2d6438fa6ab")
+}
+
+func (node *OpaqueDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "2235b2d6438fa6ab", "go/tree/OpaqueDecl", node)
+}
+
+func DecodeOpaqueDeclBinary(d *runtime.BinaryDecoder) *OpaqueDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/OpaqueDecl" {
+		return readOpaqueDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Import) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/Import") {
+		return
+	}
+	e.WriteString(node.Name)
+	e.WriteString(node.Path)
+}
+
+func (node *Import) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = d.ReadString()
+	node.Path = d.ReadString()
+}
+
+func readImportBinary(d *runtime.BinaryDecoder, o interface{}) *Import {
+	var node *Import
+	if o != nil {
+		return o.(*Import)
+	}
+	node = &Import{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Import) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "41ce7965974a787c")
+}
+
+func (node *Import) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "41ce7965974a787c", "go/tree/Import", node)
+}
+
+func DecodeImportBinary(d *runtime.BinaryDecoder) *Import {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/Import" {
+		return readImportBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FileAST) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Import
+	var x1 Decl
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/FileAST") {
+		return
+	}
+	e.WriteString(node.Name)
+	e.WriteString(node.Package)
+	if node.Imports == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Imports))
+		for _, x0 = range node.Imports {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Decls == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Decls))
+		for _, x1 = range node.Decls {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+}
+
+func (node *FileAST) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Import
+	var n1 int
+	var s1 []Decl
+	node.Name = d.ReadString()
+	node.Package = d.ReadString()
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Import{}
+		for range n0 {
+			s0 = append(s0, DecodeImportBinary(d))
+		}
+	}
+	node.Imports = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []Decl{}
+		for range n1 {
+			s1 = append(s1, DecodeDeclBinary(d))
+		}
+	}
+	node.Decls = s1
+}
+
+func readFileASTBinary(d *runtime.BinaryDecoder, o interface{}) *FileAST {
+	var node *FileAST
+	if o != nil {
+		return o.(*FileAST)
+	}
+	node = &FileAST{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FileAST) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "5066d805af6fa8a5")
+}
+
+func (node *FileAST) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "5066d805af6fa8a5", "go/tree/FileAST", node)
+}
+
+func DecodeFileASTBinary(d *runtime.BinaryDecoder) *FileAST {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/FileAST" {
+		return readFileASTBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *PackageAST) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *FileAST
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/PackageAST") {
+		return
+	}
+	if node.Files == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Files))
+		for _, x = range node.Files {
+			x.EncodeBinary(e)
+		}
+	}
+	node.P.EncodeBinary(e)
+}
+
+func (node *PackageAST) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*FileAST
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*FileAST{}
+		for range n {
+			s = append(s, DecodeFileASTBinary(d))
+		}
+	}
+	node.Files = s
+	node.P = core.DecodePackageBinary(d)
+}
+
+func readPackageASTBinary(d *runtime.BinaryDecoder, o interface{}) *PackageAST {
+	var node *PackageAST
+	if o != nil {
+		return o.(*PackageAST)
+	}
+	node = &PackageAST{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *PackageAST) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "2064d717b6355079")
+}
+
+func (node *PackageAST) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "2064d717b6355079", "go/tree/PackageAST", node)
+}
+
+func DecodePackageASTBinary(d *runtime.BinaryDecoder) *PackageAST {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/PackageAST" {
+		return readPackageASTBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ProgramAST) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *PackageAST
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "go/tree/ProgramAST") {
+		return
+	}
+	node.Builtins.EncodeBinary(e)
+	if node.Packages == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Packages))
+		for _, x = range node.Packages {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *ProgramAST) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*PackageAST
+	node.Builtins = core.DecodeBuiltinTypeIndexBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*PackageAST{}
+		for range n {
+			s = append(s, DecodePackageASTBinary(d))
+		}
+	}
+	node.Packages = s
+}
+
+func readProgramASTBinary(d *runtime.BinaryDecoder, o interface{}) *ProgramAST {
+	var node *ProgramAST
+	if o != nil {
+		return o.(*ProgramAST)
+	}
+	node = &ProgramAST{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ProgramAST) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "8010f644fbedfcd3")
+}
+
+func (node *ProgramAST) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "8010f644fbedfcd3", "go/tree/ProgramAST", node)
+}
+
+func DecodeProgramASTBinary(d *runtime.BinaryDecoder) *ProgramAST {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "go/tree/ProgramAST" {
+		return readProgramASTBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
@@ -0,0 +1,4172 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func cloneTypeRef(c *runtime.Cloner, node TypeRef) TypeRef {
+	switch node.(type) {
+	case *NameRef:
+		return node.(*NameRef).clone(c)
+	case *PointerRef:
+		return node.(*PointerRef).clone(c)
+	case *SliceRef:
+		return node.(*SliceRef).clone(c)
+	case *FuncTypeRef:
+		return node.(*FuncTypeRef).clone(c)
+	}
+	return node
+}
+
+func equalTypeRef(c *runtime.Comparer, a TypeRef, b TypeRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *NameRef:
+		switch b.(type) {
+		case *NameRef:
+			return a.(*NameRef).equal(c, b.(*NameRef))
+		}
+	case *PointerRef:
+		switch b.(type) {
+		case *PointerRef:
+			return a.(*PointerRef).equal(c, b.(*PointerRef))
+		}
+	case *SliceRef:
+		switch b.(type) {
+		case *SliceRef:
+			return a.(*SliceRef).equal(c, b.(*SliceRef))
+		}
+	case *FuncTypeRef:
+		switch b.(type) {
+		case *FuncTypeRef:
+			return a.(*FuncTypeRef).equal(c, b.(*FuncTypeRef))
+		}
+	}
+	return a == b
+}
+
+func (node *NameRef) Clone() *NameRef {
+	var c *runtime.Cloner
+	var clone *NameRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NameRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NameRef) clone(c *runtime.Cloner) *NameRef {
+	var o interface{}
+	var clone *NameRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NameRef)
+	}
+	clone = &NameRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NameRef) cloneFields(c *runtime.Cloner, clone *NameRef) {
+	clone.Name = node.Name
+	clone.T = node.T
+}
+
+func (node *NameRef) Equal(other *NameRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NameRef) equal(c *runtime.Comparer, other *NameRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *PointerRef) Clone() *PointerRef {
+	var c *runtime.Cloner
+	var clone *PointerRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &PointerRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PointerRef) clone(c *runtime.Cloner) *PointerRef {
+	var o interface{}
+	var clone *PointerRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*PointerRef)
+	}
+	clone = &PointerRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PointerRef) cloneFields(c *runtime.Cloner, clone *PointerRef) {
+	clone.Element = cloneTypeRef(c, node.Element)
+	clone.T = node.T
+}
+
+func (node *PointerRef) Equal(other *PointerRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *PointerRef) equal(c *runtime.Comparer, other *PointerRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Element, other.Element) {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *SliceRef) Clone() *SliceRef {
+	var c *runtime.Cloner
+	var clone *SliceRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SliceRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SliceRef) clone(c *runtime.Cloner) *SliceRef {
+	var o interface{}
+	var clone *SliceRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SliceRef)
+	}
+	clone = &SliceRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SliceRef) cloneFields(c *runtime.Cloner, clone *SliceRef) {
+	clone.Element = cloneTypeRef(c, node.Element)
+	clone.T = node.T
+}
+
+func (node *SliceRef) Equal(other *SliceRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SliceRef) equal(c *runtime.Comparer, other *SliceRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Element, other.Element) {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *Param) Clone() *Param {
+	var c *runtime.Cloner
+	var clone *Param
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Param{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Param) clone(c *runtime.Cloner) *Param {
+	var o interface{}
+	var clone *Param
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Param)
+	}
+	clone = &Param{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Param) cloneFields(c *runtime.Cloner, clone *Param) {
+	clone.Name = node.Name
+	clone.Type = cloneTypeRef(c, node.Type)
+	clone.Info = node.Info.clone(c)
+}
+
+func (node *Param) Equal(other *Param) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Param) equal(c *runtime.Comparer, other *Param) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if !node.Info.equalRef(other.Info) {
+		return false
+	}
+	return true
+}
+
+func (node *FuncTypeRef) Clone() *FuncTypeRef {
+	var c *runtime.Cloner
+	var clone *FuncTypeRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FuncTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncTypeRef) clone(c *runtime.Cloner) *FuncTypeRef {
+	var o interface{}
+	var clone *FuncTypeRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FuncTypeRef)
+	}
+	clone = &FuncTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncTypeRef) cloneFields(c *runtime.Cloner, clone *FuncTypeRef) {
+	var s0 []*Param
+	var e0 *Param
+	var s1 []*Param
+	var e1 *Param
+	s0 = nil
+	if node.Params != nil {
+		s0 = []*Param{}
+		for _, e0 = range node.Params {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Params = s0
+	s1 = nil
+	if node.Results != nil {
+		s1 = []*Param{}
+		for _, e1 = range node.Results {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Results = s1
+}
+
+func (node *FuncTypeRef) Equal(other *FuncTypeRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FuncTypeRef) equal(c *runtime.Comparer, other *FuncTypeRef) bool {
+	var i0 int
+	var e0 *Param
+	var i1 int
+	var e1 *Param
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i0, e0 = range node.Params {
+		if !e0.equal(c, other.Params[i0]) {
+			return false
+		}
+	}
+	if len(node.Results) != len(other.Results) || node.Results == nil != (other.Results == nil) {
+		return false
+	}
+	for i1, e1 = range node.Results {
+		if !e1.equal(c, other.Results[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneStmt(c *runtime.Cloner, node Stmt) Stmt {
+	switch node.(type) {
+	case *IntLiteral:
+		return node.(*IntLiteral).clone(c)
+	case *Float32Literal:
+		return node.(*Float32Literal).clone(c)
+	case *BoolLiteral:
+		return node.(*BoolLiteral).clone(c)
+	case *StringLiteral:
+		return node.(*StringLiteral).clone(c)
+	case *RuneLiteral:
+		return node.(*RuneLiteral).clone(c)
+	case *NilLiteral:
+		return node.(*NilLiteral).clone(c)
+	case *StructLiteral:
+		return node.(*StructLiteral).clone(c)
+	case *ListLiteral:
+		return node.(*ListLiteral).clone(c)
+	case *GetName:
+		return node.(*GetName).clone(c)
+	case *GetLocal:
+		return node.(*GetLocal).clone(c)
+	case *GetGlobal:
+		return node.(*GetGlobal).clone(c)
+	case *GetFunction:
+		return node.(*GetFunction).clone(c)
+	case *UnaryExpr:
+		return node.(*UnaryExpr).clone(c)
+	case *BinaryExpr:
+		return node.(*BinaryExpr).clone(c)
+	case *Selector:
+		return node.(*Selector).clone(c)
+	case *Index:
+		return node.(*Index).clone(c)
+	case *Call:
+		return node.(*Call).clone(c)
+	case *TypeAssert:
+		return node.(*TypeAssert).clone(c)
+	case *TypeCoerce:
+		return node.(*TypeCoerce).clone(c)
+	case *Assign:
+		return node.(*Assign).clone(c)
+	case *Var:
+		return node.(*Var).clone(c)
+	case *BlockStmt:
+		return node.(*BlockStmt).clone(c)
+	case *If:
+		return node.(*If).clone(c)
+	case *For:
+		return node.(*For).clone(c)
+	case *Range:
+		return node.(*Range).clone(c)
+	case *TypeSwitch:
+		return node.(*TypeSwitch).clone(c)
+	case *Switch:
+		return node.(*Switch).clone(c)
+	case *Goto:
+		return node.(*Goto).clone(c)
+	case *Break:
+		return node.(*Break).clone(c)
+	case *Continue:
+		return node.(*Continue).clone(c)
+	case *Label:
+		return node.(*Label).clone(c)
+	case *Return:
+		return node.(*Return).clone(c)
+	}
+	return node
+}
+
+func equalStmt(c *runtime.Comparer, a Stmt, b Stmt) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *IntLiteral:
+		switch b.(type) {
+		case *IntLiteral:
+			return a.(*IntLiteral).equal(c, b.(*IntLiteral))
+		}
+	case *Float32Literal:
+		switch b.(type) {
+		case *Float32Literal:
+			return a.(*Float32Literal).equal(c, b.(*Float32Literal))
+		}
+	case *BoolLiteral:
+		switch b.(type) {
+		case *BoolLiteral:
+			return a.(*BoolLiteral).equal(c, b.(*BoolLiteral))
+		}
+	case *StringLiteral:
+		switch b.(type) {
+		case *StringLiteral:
+			return a.(*StringLiteral).equal(c, b.(*StringLiteral))
+		}
+	case *RuneLiteral:
+		switch b.(type) {
+		case *RuneLiteral:
+			return a.(*RuneLiteral).equal(c, b.(*RuneLiteral))
+		}
+	case *NilLiteral:
+		switch b.(type) {
+		case *NilLiteral:
+			return a.(*NilLiteral).equal(c, b.(*NilLiteral))
+		}
+	case *StructLiteral:
+		switch b.(type) {
+		case *StructLiteral:
+			return a.(*StructLiteral).equal(c, b.(*StructLiteral))
+		}
+	case *ListLiteral:
+		switch b.(type) {
+		case *ListLiteral:
+			return a.(*ListLiteral).equal(c, b.(*ListLiteral))
+		}
+	case *GetName:
+		switch b.(type) {
+		case *GetName:
+			return a.(*GetName).equal(c, b.(*GetName))
+		}
+	case *GetLocal:
+		switch b.(type) {
+		case *GetLocal:
+			return a.(*GetLocal).equal(c, b.(*GetLocal))
+		}
+	case *GetGlobal:
+		switch b.(type) {
+		case *GetGlobal:
+			return a.(*GetGlobal).equal(c, b.(*GetGlobal))
+		}
+	case *GetFunction:
+		switch b.(type) {
+		case *GetFunction:
+			return a.(*GetFunction).equal(c, b.(*GetFunction))
+		}
+	case *UnaryExpr:
+		switch b.(type) {
+		case *UnaryExpr:
+			return a.(*UnaryExpr).equal(c, b.(*UnaryExpr))
+		}
+	case *BinaryExpr:
+		switch b.(type) {
+		case *BinaryExpr:
+			return a.(*BinaryExpr).equal(c, b.(*BinaryExpr))
+		}
+	case *Selector:
+		switch b.(type) {
+		case *Selector:
+			return a.(*Selector).equal(c, b.(*Selector))
+		}
+	case *Index:
+		switch b.(type) {
+		case *Index:
+			return a.(*Index).equal(c, b.(*Index))
+		}
+	case *Call:
+		switch b.(type) {
+		case *Call:
+			return a.(*Call).equal(c, b.(*Call))
+		}
+	case *TypeAssert:
+		switch b.(type) {
+		case *TypeAssert:
+			return a.(*TypeAssert).equal(c, b.(*TypeAssert))
+		}
+	case *TypeCoerce:
+		switch b.(type) {
+		case *TypeCoerce:
+			return a.(*TypeCoerce).equal(c, b.(*TypeCoerce))
+		}
+	case *Assign:
+		switch b.(type) {
+		case *Assign:
+			return a.(*Assign).equal(c, b.(*Assign))
+		}
+	case *Var:
+		switch b.(type) {
+		case *Var:
+			return a.(*Var).equal(c, b.(*Var))
+		}
+	case *BlockStmt:
+		switch b.(type) {
+		case *BlockStmt:
+			return a.(*BlockStmt).equal(c, b.(*BlockStmt))
+		}
+	case *If:
+		switch b.(type) {
+		case *If:
+			return a.(*If).equal(c, b.(*If))
+		}
+	case *For:
+		switch b.(type) {
+		case *For:
+			return a.(*For).equal(c, b.(*For))
+		}
+	case *Range:
+		switch b.(type) {
+		case *Range:
+			return a.(*Range).equal(c, b.(*Range))
+		}
+	case *TypeSwitch:
+		switch b.(type) {
+		case *TypeSwitch:
+			return a.(*TypeSwitch).equal(c, b.(*TypeSwitch))
+		}
+	case *Switch:
+		switch b.(type) {
+		case *Switch:
+			return a.(*Switch).equal(c, b.(*Switch))
+		}
+	case *Goto:
+		switch b.(type) {
+		case *Goto:
+			return a.(*Goto).equal(c, b.(*Goto))
+		}
+	case *Break:
+		switch b.(type) {
+		case *Break:
+			return a.(*Break).equal(c, b.(*Break))
+		}
+	case *Continue:
+		switch b.(type) {
+		case *Continue:
+			return a.(*Continue).equal(c, b.(*Continue))
+		}
+	case *Label:
+		switch b.(type) {
+		case *Label:
+			return a.(*Label).equal(c, b.(*Label))
+		}
+	case *Return:
+		switch b.(type) {
+		case *Return:
+			return a.(*Return).equal(c, b.(*Return))
+		}
+	}
+	return a == b
+}
+
+func cloneExpr(c *runtime.Cloner, node Expr) Expr {
+	switch node.(type) {
+	case *IntLiteral:
+		return node.(*IntLiteral).clone(c)
+	case *Float32Literal:
+		return node.(*Float32Literal).clone(c)
+	case *BoolLiteral:
+		return node.(*BoolLiteral).clone(c)
+	case *StringLiteral:
+		return node.(*StringLiteral).clone(c)
+	case *RuneLiteral:
+		return node.(*RuneLiteral).clone(c)
+	case *NilLiteral:
+		return node.(*NilLiteral).clone(c)
+	case *StructLiteral:
+		return node.(*StructLiteral).clone(c)
+	case *ListLiteral:
+		return node.(*ListLiteral).clone(c)
+	case *GetName:
+		return node.(*GetName).clone(c)
+	case *GetLocal:
+		return node.(*GetLocal).clone(c)
+	case *GetGlobal:
+		return node.(*GetGlobal).clone(c)
+	case *GetFunction:
+		return node.(*GetFunction).clone(c)
+	case *UnaryExpr:
+		return node.(*UnaryExpr).clone(c)
+	case *BinaryExpr:
+		return node.(*BinaryExpr).clone(c)
+	case *Selector:
+		return node.(*Selector).clone(c)
+	case *Index:
+		return node.(*Index).clone(c)
+	case *Call:
+		return node.(*Call).clone(c)
+	case *TypeAssert:
+		return node.(*TypeAssert).clone(c)
+	case *TypeCoerce:
+		return node.(*TypeCoerce).clone(c)
+	}
+	return node
+}
+
+func equalExpr(c *runtime.Comparer, a Expr, b Expr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *IntLiteral:
+		switch b.(type) {
+		case *IntLiteral:
+			return a.(*IntLiteral).equal(c, b.(*IntLiteral))
+		}
+	case *Float32Literal:
+		switch b.(type) {
+		case *Float32Literal:
+			return a.(*Float32Literal).equal(c, b.(*Float32Literal))
+		}
+	case *BoolLiteral:
+		switch b.(type) {
+		case *BoolLiteral:
+			return a.(*BoolLiteral).equal(c, b.(*BoolLiteral))
+		}
+	case *StringLiteral:
+		switch b.(type) {
+		case *StringLiteral:
+			return a.(*StringLiteral).equal(c, b.(*StringLiteral))
+		}
+	case *RuneLiteral:
+		switch b.(type) {
+		case *RuneLiteral:
+			return a.(*RuneLiteral).equal(c, b.(*RuneLiteral))
+		}
+	case *NilLiteral:
+		switch b.(type) {
+		case *NilLiteral:
+			return a.(*NilLiteral).equal(c, b.(*NilLiteral))
+		}
+	case *StructLiteral:
+		switch b.(type) {
+		case *StructLiteral:
+			return a.(*StructLiteral).equal(c, b.(*StructLiteral))
+		}
+	case *ListLiteral:
+		switch b.(type) {
+		case *ListLiteral:
+			return a.(*ListLiteral).equal(c, b.(*ListLiteral))
+		}
+	case *GetName:
+		switch b.(type) {
+		case *GetName:
+			return a.(*GetName).equal(c, b.(*GetName))
+		}
+	case *GetLocal:
+		switch b.(type) {
+		case *GetLocal:
+			return a.(*GetLocal).equal(c, b.(*GetLocal))
+		}
+	case *GetGlobal:
+		switch b.(type) {
+		case *GetGlobal:
+			return a.(*GetGlobal).equal(c, b.(*GetGlobal))
+		}
+	case *GetFunction:
+		switch b.(type) {
+		case *GetFunction:
+			return a.(*GetFunction).equal(c, b.(*GetFunction))
+		}
+	case *UnaryExpr:
+		switch b.(type) {
+		case *UnaryExpr:
+			return a.(*UnaryExpr).equal(c, b.(*UnaryExpr))
+		}
+	case *BinaryExpr:
+		switch b.(type) {
+		case *BinaryExpr:
+			return a.(*BinaryExpr).equal(c, b.(*BinaryExpr))
+		}
+	case *Selector:
+		switch b.(type) {
+		case *Selector:
+			return a.(*Selector).equal(c, b.(*Selector))
+		}
+	case *Index:
+		switch b.(type) {
+		case *Index:
+			return a.(*Index).equal(c, b.(*Index))
+		}
+	case *Call:
+		switch b.(type) {
+		case *Call:
+			return a.(*Call).equal(c, b.(*Call))
+		}
+	case *TypeAssert:
+		switch b.(type) {
+		case *TypeAssert:
+			return a.(*TypeAssert).equal(c, b.(*TypeAssert))
+		}
+	case *TypeCoerce:
+		switch b.(type) {
+		case *TypeCoerce:
+			return a.(*TypeCoerce).equal(c, b.(*TypeCoerce))
+		}
+	}
+	return a == b
+}
+
+func cloneTarget(c *runtime.Cloner, node Target) Target {
+	switch node.(type) {
+	case *SetName:
+		return node.(*SetName).clone(c)
+	case *SetLocal:
+		return node.(*SetLocal).clone(c)
+	case *SetDiscard:
+		return node.(*SetDiscard).clone(c)
+	case *SetSelector:
+		return node.(*SetSelector).clone(c)
+	case *SetIndex:
+		return node.(*SetIndex).clone(c)
+	}
+	return node
+}
+
+func equalTarget(c *runtime.Comparer, a Target, b Target) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *SetName:
+		switch b.(type) {
+		case *SetName:
+			return a.(*SetName).equal(c, b.(*SetName))
+		}
+	case *SetLocal:
+		switch b.(type) {
+		case *SetLocal:
+			return a.(*SetLocal).equal(c, b.(*SetLocal))
+		}
+	case *SetDiscard:
+		switch b.(type) {
+		case *SetDiscard:
+			return a.(*SetDiscard).equal(c, b.(*SetDiscard))
+		}
+	case *SetSelector:
+		switch b.(type) {
+		case *SetSelector:
+			return a.(*SetSelector).equal(c, b.(*SetSelector))
+		}
+	case *SetIndex:
+		switch b.(type) {
+		case *SetIndex:
+			return a.(*SetIndex).equal(c, b.(*SetIndex))
+		}
+	}
+	return a == b
+}
+
+func (node *IntLiteral) Clone() *IntLiteral {
+	var c *runtime.Cloner
+	var clone *IntLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &IntLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntLiteral) clone(c *runtime.Cloner) *IntLiteral {
+	var o interface{}
+	var clone *IntLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*IntLiteral)
+	}
+	clone = &IntLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntLiteral) cloneFields(c *runtime.Cloner, clone *IntLiteral) {
+	clone.Value = node.Value
+}
+
+func (node *IntLiteral) Equal(other *IntLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *IntLiteral) equal(c *runtime.Comparer, other *IntLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *Float32Literal) Clone() *Float32Literal {
+	var c *runtime.Cloner
+	var clone *Float32Literal
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Float32Literal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Float32Literal) clone(c *runtime.Cloner) *Float32Literal {
+	var o interface{}
+	var clone *Float32Literal
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Float32Literal)
+	}
+	clone = &Float32Literal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Float32Literal) cloneFields(c *runtime.Cloner, clone *Float32Literal) {
+	clone.Value = node.Value
+}
+
+func (node *Float32Literal) Equal(other *Float32Literal) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Float32Literal) equal(c *runtime.Comparer, other *Float32Literal) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *BoolLiteral) Clone() *BoolLiteral {
+	var c *runtime.Cloner
+	var clone *BoolLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BoolLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BoolLiteral) clone(c *runtime.Cloner) *BoolLiteral {
+	var o interface{}
+	var clone *BoolLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BoolLiteral)
+	}
+	clone = &BoolLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BoolLiteral) cloneFields(c *runtime.Cloner, clone *BoolLiteral) {
+	clone.Value = node.Value
+}
+
+func (node *BoolLiteral) Equal(other *BoolLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BoolLiteral) equal(c *runtime.Comparer, other *BoolLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *StringLiteral) Clone() *StringLiteral {
+	var c *runtime.Cloner
+	var clone *StringLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StringLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteral) clone(c *runtime.Cloner) *StringLiteral {
+	var o interface{}
+	var clone *StringLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StringLiteral)
+	}
+	clone = &StringLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteral) cloneFields(c *runtime.Cloner, clone *StringLiteral) {
+	clone.Value = node.Value
+}
+
+func (node *StringLiteral) Equal(other *StringLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StringLiteral) equal(c *runtime.Comparer, other *StringLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *RuneLiteral) Clone() *RuneLiteral {
+	var c *runtime.Cloner
+	var clone *RuneLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &RuneLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneLiteral) clone(c *runtime.Cloner) *RuneLiteral {
+	var o interface{}
+	var clone *RuneLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*RuneLiteral)
+	}
+	clone = &RuneLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RuneLiteral) cloneFields(c *runtime.Cloner, clone *RuneLiteral) {
+	clone.Value = node.Value
+}
+
+func (node *RuneLiteral) Equal(other *RuneLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *RuneLiteral) equal(c *runtime.Comparer, other *RuneLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Value != other.Value {
+		return false
+	}
+	return true
+}
+
+func (node *NilLiteral) Clone() *NilLiteral {
+	var c *runtime.Cloner
+	var clone *NilLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NilLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NilLiteral) clone(c *runtime.Cloner) *NilLiteral {
+	var o interface{}
+	var clone *NilLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NilLiteral)
+	}
+	clone = &NilLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NilLiteral) cloneFields(c *runtime.Cloner, clone *NilLiteral) {
+}
+
+func (node *NilLiteral) Equal(other *NilLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NilLiteral) equal(c *runtime.Comparer, other *NilLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *KeywordExpr) Clone() *KeywordExpr {
+	var c *runtime.Cloner
+	var clone *KeywordExpr
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &KeywordExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *KeywordExpr) clone(c *runtime.Cloner) *KeywordExpr {
+	var o interface{}
+	var clone *KeywordExpr
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*KeywordExpr)
+	}
+	clone = &KeywordExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *KeywordExpr) cloneFields(c *runtime.Cloner, clone *KeywordExpr) {
+	clone.Name = node.Name
+	clone.Expr = cloneExpr(c, node.Expr)
+}
+
+func (node *KeywordExpr) Equal(other *KeywordExpr) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *KeywordExpr) equal(c *runtime.Comparer, other *KeywordExpr) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	return true
+}
+
+func (node *StructLiteral) Clone() *StructLiteral {
+	var c *runtime.Cloner
+	var clone *StructLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StructLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructLiteral) clone(c *runtime.Cloner) *StructLiteral {
+	var o interface{}
+	var clone *StructLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StructLiteral)
+	}
+	clone = &StructLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructLiteral) cloneFields(c *runtime.Cloner, clone *StructLiteral) {
+	var s []*KeywordExpr
+	var e *KeywordExpr
+	clone.Type = cloneTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []*KeywordExpr{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *StructLiteral) Equal(other *StructLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StructLiteral) equal(c *runtime.Comparer, other *StructLiteral) bool {
+	var i int
+	var e *KeywordExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equal(c, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *ListLiteral) Clone() *ListLiteral {
+	var c *runtime.Cloner
+	var clone *ListLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ListLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListLiteral) clone(c *runtime.Cloner) *ListLiteral {
+	var o interface{}
+	var clone *ListLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ListLiteral)
+	}
+	clone = &ListLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListLiteral) cloneFields(c *runtime.Cloner, clone *ListLiteral) {
+	var s []Expr
+	var e Expr
+	clone.Type = cloneTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []Expr{}
+		for _, e = range node.Args {
+			s = append(s, cloneExpr(c, e))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *ListLiteral) Equal(other *ListLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ListLiteral) equal(c *runtime.Comparer, other *ListLiteral) bool {
+	var i int
+	var e Expr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalExpr(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *LocalInfo) Clone() *LocalInfo {
+	var c *runtime.Cloner
+	var clone *LocalInfo
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &LocalInfo{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *LocalInfo) clone(c *runtime.Cloner) *LocalInfo {
+	var o interface{}
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*LocalInfo)
+	}
+	return node
+}
+
+func (node *LocalInfo) cloneFields(c *runtime.Cloner, clone *LocalInfo) {
+	clone.Index = node.Index
+	clone.Name = node.Name
+	clone.T = cloneTypeRef(c, node.T)
+}
+
+func (node *LocalInfo) Equal(other *LocalInfo) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *LocalInfo) equal(c *runtime.Comparer, other *LocalInfo) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalTypeRef(c, node.T, other.T) {
+		return false
+	}
+	return true
+}
+
+func (node *LocalInfo) equalRef(other *LocalInfo) bool {
+	if node == nil || other == nil {
+		return node == other
+	}
+	return node.Index == other.Index
+}
+
+func (node *GetName) Clone() *GetName {
+	var c *runtime.Cloner
+	var clone *GetName
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetName{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetName) clone(c *runtime.Cloner) *GetName {
+	var o interface{}
+	var clone *GetName
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetName)
+	}
+	clone = &GetName{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetName) cloneFields(c *runtime.Cloner, clone *GetName) {
+	clone.Text = node.Text
+}
+
+func (node *GetName) Equal(other *GetName) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetName) equal(c *runtime.Comparer, other *GetName) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *SetName) Clone() *SetName {
+	var c *runtime.Cloner
+	var clone *SetName
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SetName{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetName) clone(c *runtime.Cloner) *SetName {
+	var o interface{}
+	var clone *SetName
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SetName)
+	}
+	clone = &SetName{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetName) cloneFields(c *runtime.Cloner, clone *SetName) {
+	clone.Text = node.Text
+}
+
+func (node *SetName) Equal(other *SetName) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SetName) equal(c *runtime.Comparer, other *SetName) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *GetLocal) Clone() *GetLocal {
+	var c *runtime.Cloner
+	var clone *GetLocal
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetLocal) clone(c *runtime.Cloner) *GetLocal {
+	var o interface{}
+	var clone *GetLocal
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetLocal)
+	}
+	clone = &GetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetLocal) cloneFields(c *runtime.Cloner, clone *GetLocal) {
+	clone.Info = node.Info.clone(c)
+}
+
+func (node *GetLocal) Equal(other *GetLocal) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetLocal) equal(c *runtime.Comparer, other *GetLocal) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Info.equalRef(other.Info) {
+		return false
+	}
+	return true
+}
+
+func (node *SetLocal) Clone() *SetLocal {
+	var c *runtime.Cloner
+	var clone *SetLocal
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetLocal) clone(c *runtime.Cloner) *SetLocal {
+	var o interface{}
+	var clone *SetLocal
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SetLocal)
+	}
+	clone = &SetLocal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetLocal) cloneFields(c *runtime.Cloner, clone *SetLocal) {
+	clone.Info = node.Info.clone(c)
+}
+
+func (node *SetLocal) Equal(other *SetLocal) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SetLocal) equal(c *runtime.Comparer, other *SetLocal) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Info.equalRef(other.Info) {
+		return false
+	}
+	return true
+}
+
+func (node *GetGlobal) Clone() *GetGlobal {
+	var c *runtime.Cloner
+	var clone *GetGlobal
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetGlobal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetGlobal) clone(c *runtime.Cloner) *GetGlobal {
+	var o interface{}
+	var clone *GetGlobal
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetGlobal)
+	}
+	clone = &GetGlobal{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetGlobal) cloneFields(c *runtime.Cloner, clone *GetGlobal) {
+	clone.Text = node.Text
+}
+
+func (node *GetGlobal) Equal(other *GetGlobal) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetGlobal) equal(c *runtime.Comparer, other *GetGlobal) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *GetFunction) Clone() *GetFunction {
+	var c *runtime.Cloner
+	var clone *GetFunction
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetFunction) clone(c *runtime.Cloner) *GetFunction {
+	var o interface{}
+	var clone *GetFunction
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetFunction)
+	}
+	clone = &GetFunction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetFunction) cloneFields(c *runtime.Cloner, clone *GetFunction) {
+	clone.Func = node.Func
+}
+
+func (node *GetFunction) Equal(other *GetFunction) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetFunction) equal(c *runtime.Comparer, other *GetFunction) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Func != other.Func {
+		return false
+	}
+	return true
+}
+
+func (node *SetDiscard) Clone() *SetDiscard {
+	var c *runtime.Cloner
+	var clone *SetDiscard
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SetDiscard{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetDiscard) clone(c *runtime.Cloner) *SetDiscard {
+	var o interface{}
+	var clone *SetDiscard
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SetDiscard)
+	}
+	clone = &SetDiscard{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetDiscard) cloneFields(c *runtime.Cloner, clone *SetDiscard) {
+}
+
+func (node *SetDiscard) Equal(other *SetDiscard) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SetDiscard) equal(c *runtime.Comparer, other *SetDiscard) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	return true
+}
+
+func (node *SetSelector) Clone() *SetSelector {
+	var c *runtime.Cloner
+	var clone *SetSelector
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SetSelector{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetSelector) clone(c *runtime.Cloner) *SetSelector {
+	var o interface{}
+	var clone *SetSelector
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SetSelector)
+	}
+	clone = &SetSelector{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetSelector) cloneFields(c *runtime.Cloner, clone *SetSelector) {
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Text = node.Text
+}
+
+func (node *SetSelector) Equal(other *SetSelector) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SetSelector) equal(c *runtime.Comparer, other *SetSelector) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *SetIndex) Clone() *SetIndex {
+	var c *runtime.Cloner
+	var clone *SetIndex
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SetIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetIndex) clone(c *runtime.Cloner) *SetIndex {
+	var o interface{}
+	var clone *SetIndex
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SetIndex)
+	}
+	clone = &SetIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SetIndex) cloneFields(c *runtime.Cloner, clone *SetIndex) {
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Index = cloneExpr(c, node.Index)
+}
+
+func (node *SetIndex) Equal(other *SetIndex) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SetIndex) equal(c *runtime.Comparer, other *SetIndex) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if !equalExpr(c, node.Index, other.Index) {
+		return false
+	}
+	return true
+}
+
+func (node *UnaryExpr) Clone() *UnaryExpr {
+	var c *runtime.Cloner
+	var clone *UnaryExpr
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &UnaryExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *UnaryExpr) clone(c *runtime.Cloner) *UnaryExpr {
+	var o interface{}
+	var clone *UnaryExpr
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*UnaryExpr)
+	}
+	clone = &UnaryExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *UnaryExpr) cloneFields(c *runtime.Cloner, clone *UnaryExpr) {
+	clone.Op = node.Op
+	clone.Expr = cloneExpr(c, node.Expr)
+}
+
+func (node *UnaryExpr) Equal(other *UnaryExpr) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *UnaryExpr) equal(c *runtime.Comparer, other *UnaryExpr) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Op != other.Op {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	return true
+}
+
+func (node *BinaryExpr) Clone() *BinaryExpr {
+	var c *runtime.Cloner
+	var clone *BinaryExpr
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BinaryExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryExpr) clone(c *runtime.Cloner) *BinaryExpr {
+	var o interface{}
+	var clone *BinaryExpr
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BinaryExpr)
+	}
+	clone = &BinaryExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BinaryExpr) cloneFields(c *runtime.Cloner, clone *BinaryExpr) {
+	clone.Left = cloneExpr(c, node.Left)
+	clone.Op = node.Op
+	clone.Right = cloneExpr(c, node.Right)
+}
+
+func (node *BinaryExpr) Equal(other *BinaryExpr) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BinaryExpr) equal(c *runtime.Comparer, other *BinaryExpr) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Left, other.Left) {
+		return false
+	}
+	if node.Op != other.Op {
+		return false
+	}
+	if !equalExpr(c, node.Right, other.Right) {
+		return false
+	}
+	return true
+}
+
+func (node *Selector) Clone() *Selector {
+	var c *runtime.Cloner
+	var clone *Selector
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Selector{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Selector) clone(c *runtime.Cloner) *Selector {
+	var o interface{}
+	var clone *Selector
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Selector)
+	}
+	clone = &Selector{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Selector) cloneFields(c *runtime.Cloner, clone *Selector) {
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Text = node.Text
+}
+
+func (node *Selector) Equal(other *Selector) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Selector) equal(c *runtime.Comparer, other *Selector) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *Index) Clone() *Index {
+	var c *runtime.Cloner
+	var clone *Index
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Index{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Index) clone(c *runtime.Cloner) *Index {
+	var o interface{}
+	var clone *Index
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Index)
+	}
+	clone = &Index{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Index) cloneFields(c *runtime.Cloner, clone *Index) {
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Index = cloneExpr(c, node.Index)
+}
+
+func (node *Index) Equal(other *Index) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Index) equal(c *runtime.Comparer, other *Index) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if !equalExpr(c, node.Index, other.Index) {
+		return false
+	}
+	return true
+}
+
+func (node *Call) Clone() *Call {
+	var c *runtime.Cloner
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) clone(c *runtime.Cloner) *Call {
+	var o interface{}
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Call)
+	}
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) cloneFields(c *runtime.Cloner, clone *Call) {
+	var s []Expr
+	var e Expr
+	clone.Expr = cloneExpr(c, node.Expr)
+	s = nil
+	if node.Args != nil {
+		s = []Expr{}
+		for _, e = range node.Args {
+			s = append(s, cloneExpr(c, e))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *Call) Equal(other *Call) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Call) equal(c *runtime.Comparer, other *Call) bool {
+	var i int
+	var e Expr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalExpr(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *TypeAssert) Clone() *TypeAssert {
+	var c *runtime.Cloner
+	var clone *TypeAssert
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeAssert{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeAssert) clone(c *runtime.Cloner) *TypeAssert {
+	var o interface{}
+	var clone *TypeAssert
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeAssert)
+	}
+	clone = &TypeAssert{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeAssert) cloneFields(c *runtime.Cloner, clone *TypeAssert) {
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Type = cloneTypeRef(c, node.Type)
+}
+
+func (node *TypeAssert) Equal(other *TypeAssert) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeAssert) equal(c *runtime.Comparer, other *TypeAssert) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *TypeCoerce) Clone() *TypeCoerce {
+	var c *runtime.Cloner
+	var clone *TypeCoerce
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeCoerce{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeCoerce) clone(c *runtime.Cloner) *TypeCoerce {
+	var o interface{}
+	var clone *TypeCoerce
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeCoerce)
+	}
+	clone = &TypeCoerce{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeCoerce) cloneFields(c *runtime.Cloner, clone *TypeCoerce) {
+	clone.Type = cloneTypeRef(c, node.Type)
+	clone.Expr = cloneExpr(c, node.Expr)
+}
+
+func (node *TypeCoerce) Equal(other *TypeCoerce) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeCoerce) equal(c *runtime.Comparer, other *TypeCoerce) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	return true
+}
+
+func (node *Assign) Clone() *Assign {
+	var c *runtime.Cloner
+	var clone *Assign
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Assign{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Assign) clone(c *runtime.Cloner) *Assign {
+	var o interface{}
+	var clone *Assign
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Assign)
+	}
+	clone = &Assign{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Assign) cloneFields(c *runtime.Cloner, clone *Assign) {
+	var s0 []Expr
+	var e0 Expr
+	var s1 []Target
+	var e1 Target
+	s0 = nil
+	if node.Sources != nil {
+		s0 = []Expr{}
+		for _, e0 = range node.Sources {
+			s0 = append(s0, cloneExpr(c, e0))
+		}
+	}
+	clone.Sources = s0
+	clone.Op = node.Op
+	s1 = nil
+	if node.Targets != nil {
+		s1 = []Target{}
+		for _, e1 = range node.Targets {
+			s1 = append(s1, cloneTarget(c, e1))
+		}
+	}
+	clone.Targets = s1
+}
+
+func (node *Assign) Equal(other *Assign) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Assign) equal(c *runtime.Comparer, other *Assign) bool {
+	var i0 int
+	var e0 Expr
+	var i1 int
+	var e1 Target
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Sources) != len(other.Sources) || node.Sources == nil != (other.Sources == nil) {
+		return false
+	}
+	for i0, e0 = range node.Sources {
+		if !equalExpr(c, e0, other.Sources[i0]) {
+			return false
+		}
+	}
+	if node.Op != other.Op {
+		return false
+	}
+	if len(node.Targets) != len(other.Targets) || node.Targets == nil != (other.Targets == nil) {
+		return false
+	}
+	for i1, e1 = range node.Targets {
+		if !equalTarget(c, e1, other.Targets[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Var) Clone() *Var {
+	var c *runtime.Cloner
+	var clone *Var
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Var{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Var) clone(c *runtime.Cloner) *Var {
+	var o interface{}
+	var clone *Var
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Var)
+	}
+	clone = &Var{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Var) cloneFields(c *runtime.Cloner, clone *Var) {
+	clone.Name = node.Name
+	clone.Type = cloneTypeRef(c, node.Type)
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Info = node.Info.clone(c)
+}
+
+func (node *Var) Equal(other *Var) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Var) equal(c *runtime.Comparer, other *Var) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if !node.Info.equalRef(other.Info) {
+		return false
+	}
+	return true
+}
+
+func (node *Block) Clone() *Block {
+	var c *runtime.Cloner
+	var clone *Block
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Block{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Block) clone(c *runtime.Cloner) *Block {
+	var o interface{}
+	var clone *Block
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Block)
+	}
+	clone = &Block{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Block) cloneFields(c *runtime.Cloner, clone *Block) {
+	var s []Stmt
+	var e Stmt
+	s = nil
+	if node.Body != nil {
+		s = []Stmt{}
+		for _, e = range node.Body {
+			s = append(s, cloneStmt(c, e))
+		}
+	}
+	clone.Body = s
+}
+
+func (node *Block) Equal(other *Block) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Block) equal(c *runtime.Comparer, other *Block) bool {
+	var i int
+	var e Stmt
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Body) != len(other.Body) || node.Body == nil != (other.Body == nil) {
+		return false
+	}
+	for i, e = range node.Body {
+		if !equalStmt(c, e, other.Body[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *BlockStmt) Clone() *BlockStmt {
+	var c *runtime.Cloner
+	var clone *BlockStmt
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &BlockStmt{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BlockStmt) clone(c *runtime.Cloner) *BlockStmt {
+	var o interface{}
+	var clone *BlockStmt
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*BlockStmt)
+	}
+	clone = &BlockStmt{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *BlockStmt) cloneFields(c *runtime.Cloner, clone *BlockStmt) {
+	clone.Block = node.Block.clone(c)
+}
+
+func (node *BlockStmt) Equal(other *BlockStmt) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *BlockStmt) equal(c *runtime.Comparer, other *BlockStmt) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Block.equal(c, other.Block) {
+		return false
+	}
+	return true
+}
+
+func (node *If) Clone() *If {
+	var c *runtime.Cloner
+	var clone *If
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &If{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *If) clone(c *runtime.Cloner) *If {
+	var o interface{}
+	var clone *If
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*If)
+	}
+	clone = &If{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *If) cloneFields(c *runtime.Cloner, clone *If) {
+	clone.Cond = cloneExpr(c, node.Cond)
+	clone.T = node.T.clone(c)
+	clone.F = node.F.clone(c)
+}
+
+func (node *If) Equal(other *If) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *If) equal(c *runtime.Comparer, other *If) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Cond, other.Cond) {
+		return false
+	}
+	if !node.T.equal(c, other.T) {
+		return false
+	}
+	if !node.F.equal(c, other.F) {
+		return false
+	}
+	return true
+}
+
+func (node *For) Clone() *For {
+	var c *runtime.Cloner
+	var clone *For
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &For{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *For) clone(c *runtime.Cloner) *For {
+	var o interface{}
+	var clone *For
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*For)
+	}
+	clone = &For{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *For) cloneFields(c *runtime.Cloner, clone *For) {
+	clone.Block = node.Block.clone(c)
+}
+
+func (node *For) Equal(other *For) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *For) equal(c *runtime.Comparer, other *For) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Block.equal(c, other.Block) {
+		return false
+	}
+	return true
+}
+
+func (node *Range) Clone() *Range {
+	var c *runtime.Cloner
+	var clone *Range
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Range{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Range) clone(c *runtime.Cloner) *Range {
+	var o interface{}
+	var clone *Range
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Range)
+	}
+	clone = &Range{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Range) cloneFields(c *runtime.Cloner, clone *Range) {
+	clone.Key = cloneTarget(c, node.Key)
+	clone.Value = cloneTarget(c, node.Value)
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Block = node.Block.clone(c)
+}
+
+func (node *Range) Equal(other *Range) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Range) equal(c *runtime.Comparer, other *Range) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTarget(c, node.Key, other.Key) {
+		return false
+	}
+	if !equalTarget(c, node.Value, other.Value) {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if !node.Block.equal(c, other.Block) {
+		return false
+	}
+	return true
+}
+
+func (node *TypeSwitchCase) Clone() *TypeSwitchCase {
+	var c *runtime.Cloner
+	var clone *TypeSwitchCase
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeSwitchCase{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeSwitchCase) clone(c *runtime.Cloner) *TypeSwitchCase {
+	var o interface{}
+	var clone *TypeSwitchCase
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeSwitchCase)
+	}
+	clone = &TypeSwitchCase{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeSwitchCase) cloneFields(c *runtime.Cloner, clone *TypeSwitchCase) {
+	var s []TypeRef
+	var e TypeRef
+	s = nil
+	if node.Types != nil {
+		s = []TypeRef{}
+		for _, e = range node.Types {
+			s = append(s, cloneTypeRef(c, e))
+		}
+	}
+	clone.Types = s
+	clone.Block = node.Block.clone(c)
+}
+
+func (node *TypeSwitchCase) Equal(other *TypeSwitchCase) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeSwitchCase) equal(c *runtime.Comparer, other *TypeSwitchCase) bool {
+	var i int
+	var e TypeRef
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Types) != len(other.Types) || node.Types == nil != (other.Types == nil) {
+		return false
+	}
+	for i, e = range node.Types {
+		if !equalTypeRef(c, e, other.Types[i]) {
+			return false
+		}
+	}
+	if !node.Block.equal(c, other.Block) {
+		return false
+	}
+	return true
+}
+
+func (node *TypeSwitch) Clone() *TypeSwitch {
+	var c *runtime.Cloner
+	var clone *TypeSwitch
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeSwitch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeSwitch) clone(c *runtime.Cloner) *TypeSwitch {
+	var o interface{}
+	var clone *TypeSwitch
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeSwitch)
+	}
+	clone = &TypeSwitch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeSwitch) cloneFields(c *runtime.Cloner, clone *TypeSwitch) {
+	var s []*TypeSwitchCase
+	var e *TypeSwitchCase
+	clone.Expr = cloneExpr(c, node.Expr)
+	s = nil
+	if node.Cases != nil {
+		s = []*TypeSwitchCase{}
+		for _, e = range node.Cases {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Cases = s
+	clone.Default = node.Default.clone(c)
+}
+
+func (node *TypeSwitch) Equal(other *TypeSwitch) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeSwitch) equal(c *runtime.Comparer, other *TypeSwitch) bool {
+	var i int
+	var e *TypeSwitchCase
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if len(node.Cases) != len(other.Cases) || node.Cases == nil != (other.Cases == nil) {
+		return false
+	}
+	for i, e = range node.Cases {
+		if !e.equal(c, other.Cases[i]) {
+			return false
+		}
+	}
+	if !node.Default.equal(c, other.Default) {
+		return false
+	}
+	return true
+}
+
+func (node *SwitchCase) Clone() *SwitchCase {
+	var c *runtime.Cloner
+	var clone *SwitchCase
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SwitchCase{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SwitchCase) clone(c *runtime.Cloner) *SwitchCase {
+	var o interface{}
+	var clone *SwitchCase
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SwitchCase)
+	}
+	clone = &SwitchCase{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SwitchCase) cloneFields(c *runtime.Cloner, clone *SwitchCase) {
+	var s []Expr
+	var e Expr
+	s = nil
+	if node.Exprs != nil {
+		s = []Expr{}
+		for _, e = range node.Exprs {
+			s = append(s, cloneExpr(c, e))
+		}
+	}
+	clone.Exprs = s
+	clone.Block = node.Block.clone(c)
+}
+
+func (node *SwitchCase) Equal(other *SwitchCase) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SwitchCase) equal(c *runtime.Comparer, other *SwitchCase) bool {
+	var i int
+	var e Expr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Exprs) != len(other.Exprs) || node.Exprs == nil != (other.Exprs == nil) {
+		return false
+	}
+	for i, e = range node.Exprs {
+		if !equalExpr(c, e, other.Exprs[i]) {
+			return false
+		}
+	}
+	if !node.Block.equal(c, other.Block) {
+		return false
+	}
+	return true
+}
+
+func (node *Switch) Clone() *Switch {
+	var c *runtime.Cloner
+	var clone *Switch
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Switch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Switch) clone(c *runtime.Cloner) *Switch {
+	var o interface{}
+	var clone *Switch
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Switch)
+	}
+	clone = &Switch{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Switch) cloneFields(c *runtime.Cloner, clone *Switch) {
+	var s []*SwitchCase
+	var e *SwitchCase
+	clone.Expr = cloneExpr(c, node.Expr)
+	s = nil
+	if node.Cases != nil {
+		s = []*SwitchCase{}
+		for _, e = range node.Cases {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Cases = s
+	clone.Default = node.Default.clone(c)
+}
+
+func (node *Switch) Equal(other *Switch) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Switch) equal(c *runtime.Comparer, other *Switch) bool {
+	var i int
+	var e *SwitchCase
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if len(node.Cases) != len(other.Cases) || node.Cases == nil != (other.Cases == nil) {
+		return false
+	}
+	for i, e = range node.Cases {
+		if !e.equal(c, other.Cases[i]) {
+			return false
+		}
+	}
+	if !node.Default.equal(c, other.Default) {
+		return false
+	}
+	return true
+}
+
+func (node *Goto) Clone() *Goto {
+	var c *runtime.Cloner
+	var clone *Goto
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Goto{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Goto) clone(c *runtime.Cloner) *Goto {
+	var o interface{}
+	var clone *Goto
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Goto)
+	}
+	clone = &Goto{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Goto) cloneFields(c *runtime.Cloner, clone *Goto) {
+	clone.Text = node.Text
+}
+
+func (node *Goto) Equal(other *Goto) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Goto) equal(c *runtime.Comparer, other *Goto) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *Break) Clone() *Break {
+	var c *runtime.Cloner
+	var clone *Break
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Break{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Break) clone(c *runtime.Cloner) *Break {
+	var o interface{}
+	var clone *Break
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Break)
+	}
+	clone = &Break{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Break) cloneFields(c *runtime.Cloner, clone *Break) {
+	clone.Text = node.Text
+}
+
+func (node *Break) Equal(other *Break) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Break) equal(c *runtime.Comparer, other *Break) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *Continue) Clone() *Continue {
+	var c *runtime.Cloner
+	var clone *Continue
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Continue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Continue) clone(c *runtime.Cloner) *Continue {
+	var o interface{}
+	var clone *Continue
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Continue)
+	}
+	clone = &Continue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Continue) cloneFields(c *runtime.Cloner, clone *Continue) {
+	clone.Text = node.Text
+}
+
+func (node *Continue) Equal(other *Continue) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Continue) equal(c *runtime.Comparer, other *Continue) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *Label) Clone() *Label {
+	var c *runtime.Cloner
+	var clone *Label
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Label{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Label) clone(c *runtime.Cloner) *Label {
+	var o interface{}
+	var clone *Label
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Label)
+	}
+	clone = &Label{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Label) cloneFields(c *runtime.Cloner, clone *Label) {
+	clone.Text = node.Text
+}
+
+func (node *Label) Equal(other *Label) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Label) equal(c *runtime.Comparer, other *Label) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *Return) Clone() *Return {
+	var c *runtime.Cloner
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) clone(c *runtime.Cloner) *Return {
+	var o interface{}
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Return)
+	}
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) cloneFields(c *runtime.Cloner, clone *Return) {
+	var s []Expr
+	var e Expr
+	s = nil
+	if node.Args != nil {
+		s = []Expr{}
+		for _, e = range node.Args {
+			s = append(s, cloneExpr(c, e))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *Return) Equal(other *Return) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Return) equal(c *runtime.Comparer, other *Return) bool {
+	var i int
+	var e Expr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalExpr(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneDecl(c *runtime.Cloner, node Decl) Decl {
+	switch node.(type) {
+	case *VarDecl:
+		return node.(*VarDecl).clone(c)
+	case *FuncDecl:
+		return node.(*FuncDecl).clone(c)
+	case *StructDecl:
+		return node.(*StructDecl).clone(c)
+	case *InterfaceDecl:
+		return node.(*InterfaceDecl).clone(c)
+	case *TypeDefDecl:
+		return node.(*TypeDefDecl).clone(c)
+	case *OpaqueDecl:
+		return node.(*OpaqueDecl).clone(c)
+	}
+	return node
+}
+
+func equalDecl(c *runtime.Comparer, a Decl, b Decl) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *VarDecl:
+		switch b.(type) {
+		case *VarDecl:
+			return a.(*VarDecl).equal(c, b.(*VarDecl))
+		}
+	case *FuncDecl:
+		switch b.(type) {
+		case *FuncDecl:
+			return a.(*FuncDecl).equal(c, b.(*FuncDecl))
+		}
+	case *StructDecl:
+		switch b.(type) {
+		case *StructDecl:
+			return a.(*StructDecl).equal(c, b.(*StructDecl))
+		}
+	case *InterfaceDecl:
+		switch b.(type) {
+		case *InterfaceDecl:
+			return a.(*InterfaceDecl).equal(c, b.(*InterfaceDecl))
+		}
+	case *TypeDefDecl:
+		switch b.(type) {
+		case *TypeDefDecl:
+			return a.(*TypeDefDecl).equal(c, b.(*TypeDefDecl))
+		}
+	case *OpaqueDecl:
+		switch b.(type) {
+		case *OpaqueDecl:
+			return a.(*OpaqueDecl).equal(c, b.(*OpaqueDecl))
+		}
+	}
+	return a == b
+}
+
+func (node *VarDecl) Clone() *VarDecl {
+	var c *runtime.Cloner
+	var clone *VarDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &VarDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *VarDecl) clone(c *runtime.Cloner) *VarDecl {
+	var o interface{}
+	var clone *VarDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*VarDecl)
+	}
+	clone = &VarDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *VarDecl) cloneFields(c *runtime.Cloner, clone *VarDecl) {
+	clone.Name = node.Name
+	clone.Type = cloneTypeRef(c, node.Type)
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Const = node.Const
+}
+
+func (node *VarDecl) Equal(other *VarDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *VarDecl) equal(c *runtime.Comparer, other *VarDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if node.Const != other.Const {
+		return false
+	}
+	return true
+}
+
+func (node *FuncDecl) Clone() *FuncDecl {
+	var c *runtime.Cloner
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) clone(c *runtime.Cloner) *FuncDecl {
+	var o interface{}
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) cloneFields(c *runtime.Cloner, clone *FuncDecl) {
+	var o *LocalInfo
+	var x *LocalInfo
+	var i int
+	if node.LocalInfo_Scope != nil {
+		clone.LocalInfo_Scope = &LocalInfo_Scope{}
+		for _, o = range node.LocalInfo_Scope.objects {
+			x = &LocalInfo{}
+			c.Map(o, x)
+			clone.LocalInfo_Scope.objects = append(clone.LocalInfo_Scope.objects, x)
+		}
+		for i, o = range node.LocalInfo_Scope.objects {
+			o.cloneFields(c, clone.LocalInfo_Scope.objects[i])
+		}
+	}
+	clone.Name = node.Name
+	clone.Recv = node.Recv.clone(c)
+	clone.Type = node.Type.clone(c)
+	clone.Block = node.Block.clone(c)
+	clone.Package = node.Package
+}
+
+func (node *FuncDecl) Equal(other *FuncDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FuncDecl) equal(c *runtime.Comparer, other *FuncDecl) bool {
+	var i int
+	var o *LocalInfo
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.LocalInfo_Scope == nil != (other.LocalInfo_Scope == nil) {
+		return false
+	}
+	if node.LocalInfo_Scope != nil {
+		if len(node.LocalInfo_Scope.objects) != len(other.LocalInfo_Scope.objects) {
+			return false
+		}
+		for i, o = range node.LocalInfo_Scope.objects {
+			if !o.equal(c, other.LocalInfo_Scope.objects[i]) {
+				return false
+			}
+		}
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !node.Recv.equal(c, other.Recv) {
+		return false
+	}
+	if !node.Type.equal(c, other.Type) {
+		return false
+	}
+	if !node.Block.equal(c, other.Block) {
+		return false
+	}
+	if node.Package != other.Package {
+		return false
+	}
+	return true
+}
+
+func (node *FieldDecl) Clone() *FieldDecl {
+	var c *runtime.Cloner
+	var clone *FieldDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FieldDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldDecl) clone(c *runtime.Cloner) *FieldDecl {
+	var o interface{}
+	var clone *FieldDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FieldDecl)
+	}
+	clone = &FieldDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldDecl) cloneFields(c *runtime.Cloner, clone *FieldDecl) {
+	clone.Name = node.Name
+	clone.Type = cloneTypeRef(c, node.Type)
+}
+
+func (node *FieldDecl) Equal(other *FieldDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FieldDecl) equal(c *runtime.Comparer, other *FieldDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *StructDecl) Clone() *StructDecl {
+	var c *runtime.Cloner
+	var clone *StructDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StructDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructDecl) clone(c *runtime.Cloner) *StructDecl {
+	var o interface{}
+	var clone *StructDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StructDecl)
+	}
+	clone = &StructDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructDecl) cloneFields(c *runtime.Cloner, clone *StructDecl) {
+	var s []*FieldDecl
+	var e *FieldDecl
+	clone.Name = node.Name
+	s = nil
+	if node.Fields != nil {
+		s = []*FieldDecl{}
+		for _, e = range node.Fields {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Fields = s
+	clone.T = node.T
+}
+
+func (node *StructDecl) Equal(other *StructDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StructDecl) equal(c *runtime.Comparer, other *StructDecl) bool {
+	var i int
+	var e *FieldDecl
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Fields) != len(other.Fields) || node.Fields == nil != (other.Fields == nil) {
+		return false
+	}
+	for i, e = range node.Fields {
+		if !e.equal(c, other.Fields[i]) {
+			return false
+		}
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *InterfaceDecl) Clone() *InterfaceDecl {
+	var c *runtime.Cloner
+	var clone *InterfaceDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &InterfaceDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *InterfaceDecl) clone(c *runtime.Cloner) *InterfaceDecl {
+	var o interface{}
+	var clone *InterfaceDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*InterfaceDecl)
+	}
+	clone = &InterfaceDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *InterfaceDecl) cloneFields(c *runtime.Cloner, clone *InterfaceDecl) {
+	var s []*FieldDecl
+	var e *FieldDecl
+	clone.Name = node.Name
+	s = nil
+	if node.Fields != nil {
+		s = []*FieldDecl{}
+		for _, e = range node.Fields {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Fields = s
+	clone.T = node.T
+}
+
+func (node *InterfaceDecl) Equal(other *InterfaceDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *InterfaceDecl) equal(c *runtime.Comparer, other *InterfaceDecl) bool {
+	var i int
+	var e *FieldDecl
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Fields) != len(other.Fields) || node.Fields == nil != (other.Fields == nil) {
+		return false
+	}
+	for i, e = range node.Fields {
+		if !e.equal(c, other.Fields[i]) {
+			return false
+		}
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *TypeDefDecl) Clone() *TypeDefDecl {
+	var c *runtime.Cloner
+	var clone *TypeDefDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeDefDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeDefDecl) clone(c *runtime.Cloner) *TypeDefDecl {
+	var o interface{}
+	var clone *TypeDefDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeDefDecl)
+	}
+	clone = &TypeDefDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeDefDecl) cloneFields(c *runtime.Cloner, clone *TypeDefDecl) {
+	clone.Name = node.Name
+	clone.Type = cloneTypeRef(c, node.Type)
+	clone.T = node.T
+}
+
+func (node *TypeDefDecl) Equal(other *TypeDefDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeDefDecl) equal(c *runtime.Comparer, other *TypeDefDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *OpaqueDecl) Clone() *OpaqueDecl {
+	var c *runtime.Cloner
+	var clone *OpaqueDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &OpaqueDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *OpaqueDecl) clone(c *runtime.Cloner) *OpaqueDecl {
+	var o interface{}
+	var clone *OpaqueDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*OpaqueDecl)
+	}
+	clone = &OpaqueDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *OpaqueDecl) cloneFields(c *runtime.Cloner, clone *OpaqueDecl) {
+	clone.T = node.T
+}
+
+func (node *OpaqueDecl) Equal(other *OpaqueDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *OpaqueDecl) equal(c *runtime.Comparer, other *OpaqueDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.T != other.T {
+		return false
+	}
+	return true
+}
+
+func (node *Import) Clone() *Import {
+	var c *runtime.Cloner
+	var clone *Import
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Import{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Import) clone(c *runtime.Cloner) *Import {
+	var o interface{}
+	var clone *Import
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Import)
+	}
+	clone = &Import{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Import) cloneFields(c *runtime.Cloner, clone *Import) {
+	clone.Name = node.Name
+	clone.Path = node.Path
+}
+
+func (node *Import) Equal(other *Import) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Import) equal(c *runtime.Comparer, other *Import) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.Path != other.Path {
+		return false
+	}
+	return true
+}
+
+func (node *FileAST) Clone() *FileAST {
+	var c *runtime.Cloner
+	var clone *FileAST
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FileAST{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FileAST) clone(c *runtime.Cloner) *FileAST {
+	var o interface{}
+	var clone *FileAST
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FileAST)
+	}
+	clone = &FileAST{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FileAST) cloneFields(c *runtime.Cloner, clone *FileAST) {
+	var s0 []*Import
+	var e0 *Import
+	var s1 []Decl
+	var e1 Decl
+	clone.Name = node.Name
+	clone.Package = node.Package
+	s0 = nil
+	if node.Imports != nil {
+		s0 = []*Import{}
+		for _, e0 = range node.Imports {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Imports = s0
+	s1 = nil
+	if node.Decls != nil {
+		s1 = []Decl{}
+		for _, e1 = range node.Decls {
+			s1 = append(s1, cloneDecl(c, e1))
+		}
+	}
+	clone.Decls = s1
+}
+
+func (node *FileAST) Equal(other *FileAST) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FileAST) equal(c *runtime.Comparer, other *FileAST) bool {
+	var i0 int
+	var e0 *Import
+	var i1 int
+	var e1 Decl
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if node.Package != other.Package {
+		return false
+	}
+	if len(node.Imports) != len(other.Imports) || node.Imports == nil != (other.Imports == nil) {
+		return false
+	}
+	for i0, e0 = range node.Imports {
+		if !e0.equal(c, other.Imports[i0]) {
+			return false
+		}
+	}
+	if len(node.Decls) != len(other.Decls) || node.Decls == nil != (other.Decls == nil) {
+		return false
+	}
+	for i1, e1 = range node.Decls {
+		if !equalDecl(c, e1, other.Decls[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *PackageAST) Clone() *PackageAST {
+	var c *runtime.Cloner
+	var clone *PackageAST
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &PackageAST{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PackageAST) clone(c *runtime.Cloner) *PackageAST {
+	var o interface{}
+	var clone *PackageAST
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*PackageAST)
+	}
+	clone = &PackageAST{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *PackageAST) cloneFields(c *runtime.Cloner, clone *PackageAST) {
+	var s []*FileAST
+	var e *FileAST
+	s = nil
+	if node.Files != nil {
+		s = []*FileAST{}
+		for _, e = range node.Files {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Files = s
+	clone.P = node.P
+}
+
+func (node *PackageAST) Equal(other *PackageAST) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *PackageAST) equal(c *runtime.Comparer, other *PackageAST) bool {
+	var i int
+	var e *FileAST
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Files) != len(other.Files) || node.Files == nil != (other.Files == nil) {
+		return false
+	}
+	for i, e = range node.Files {
+		if !e.equal(c, other.Files[i]) {
+			return false
+		}
+	}
+	if node.P != other.P {
+		return false
+	}
+	return true
+}
+
+func (node *ProgramAST) Clone() *ProgramAST {
+	var c *runtime.Cloner
+	var clone *ProgramAST
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ProgramAST{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ProgramAST) clone(c *runtime.Cloner) *ProgramAST {
+	var o interface{}
+	var clone *ProgramAST
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ProgramAST)
+	}
+	clone = &ProgramAST{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ProgramAST) cloneFields(c *runtime.Cloner, clone *ProgramAST) {
+	var s []*PackageAST
+	var e *PackageAST
+	clone.Builtins = node.Builtins
+	s = nil
+	if node.Packages != nil {
+		s = []*PackageAST{}
+		for _, e = range node.Packages {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Packages = s
+}
+
+func (node *ProgramAST) Equal(other *ProgramAST) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ProgramAST) equal(c *runtime.Comparer, other *ProgramAST) bool {
+	var i int
+	var e *PackageAST
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Builtins != other.Builtins {
+		return false
+	}
+	if len(node.Packages) != len(other.Packages) || node.Packages == nil != (other.Packages == nil) {
+		return false
+	}
+	for i, e = range node.Packages {
+		if !e.equal(c, other.Packages[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,988 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *NameRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NameRef")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *NameRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *PointerRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("PointerRef")
+	d.AddField("Element", runtime.Describe(node.Element))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *PointerRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SliceRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SliceRef")
+	d.AddField("Element", runtime.Describe(node.Element))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *SliceRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Param) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Param")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Info", node.Info.DescribeRef())
+	return d
+}
+
+func (node *Param) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FuncTypeRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Param
+	var l1 *runtime.List
+	var e1 *Param
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FuncTypeRef")
+	l0 = runtime.MakeList("[]Param")
+	for _, e0 = range node.Params {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Params", l0)
+	l1 = runtime.MakeList("[]Param")
+	for _, e1 = range node.Results {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Results", l1)
+	return d
+}
+
+func (node *FuncTypeRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *IntLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("IntLiteral")
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *IntLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Float32Literal) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Float32Literal")
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *Float32Literal) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BoolLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BoolLiteral")
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *BoolLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StringLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StringLiteral")
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *StringLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *RuneLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("RuneLiteral")
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *RuneLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NilLiteral) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("NilLiteral")
+}
+
+func (node *NilLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *KeywordExpr) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("KeywordExpr")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	return d
+}
+
+func (node *KeywordExpr) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StructLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *KeywordExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StructLiteral")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]KeywordExpr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *StructLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ListLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Expr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ListLiteral")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]Expr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *ListLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *LocalInfo) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("LocalInfo")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *LocalInfo) DescribeRef() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.Ref("LocalInfo", int(node.Index))
+}
+
+func (node *LocalInfo) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetName) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetName")
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *GetName) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SetName) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SetName")
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *SetName) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetLocal) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetLocal")
+	d.AddField("Info", node.Info.DescribeRef())
+	return d
+}
+
+func (node *GetLocal) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SetLocal) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SetLocal")
+	d.AddField("Info", node.Info.DescribeRef())
+	return d
+}
+
+func (node *SetLocal) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetGlobal) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetGlobal")
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *GetGlobal) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetFunction) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetFunction")
+	d.AddField("Func", runtime.Describe(node.Func))
+	return d
+}
+
+func (node *GetFunction) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SetDiscard) Describe() runtime.Value {
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	return runtime.MakeStruct("SetDiscard")
+}
+
+func (node *SetDiscard) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SetSelector) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SetSelector")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *SetSelector) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SetIndex) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SetIndex")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Index", runtime.Describe(node.Index))
+	return d
+}
+
+func (node *SetIndex) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *UnaryExpr) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("UnaryExpr")
+	d.AddField("Op", runtime.Describe(node.Op))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	return d
+}
+
+func (node *UnaryExpr) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BinaryExpr) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BinaryExpr")
+	d.AddField("Left", runtime.Describe(node.Left))
+	d.AddField("Op", runtime.Describe(node.Op))
+	d.AddField("Right", runtime.Describe(node.Right))
+	return d
+}
+
+func (node *BinaryExpr) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Selector) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Selector")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Selector) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Index) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Index")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Index", runtime.Describe(node.Index))
+	return d
+}
+
+func (node *Index) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Call) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Expr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Call")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	l = runtime.MakeList("[]Expr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *Call) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeAssert) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeAssert")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *TypeAssert) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeCoerce) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeCoerce")
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	return d
+}
+
+func (node *TypeCoerce) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Assign) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 Expr
+	var l1 *runtime.List
+	var e1 Target
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Assign")
+	l0 = runtime.MakeList("[]Expr")
+	for _, e0 = range node.Sources {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Sources", l0)
+	d.AddField("Op", runtime.Describe(node.Op))
+	l1 = runtime.MakeList("[]Target")
+	for _, e1 = range node.Targets {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Targets", l1)
+	return d
+}
+
+func (node *Assign) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Var) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Var")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Info", node.Info.DescribeRef())
+	return d
+}
+
+func (node *Var) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Block) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Stmt
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Block")
+	l = runtime.MakeList("[]Stmt")
+	for _, e = range node.Body {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Body", l)
+	return d
+}
+
+func (node *Block) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *BlockStmt) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("BlockStmt")
+	d.AddField("Block", runtime.Describe(node.Block))
+	return d
+}
+
+func (node *BlockStmt) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *If) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("If")
+	d.AddField("Cond", runtime.Describe(node.Cond))
+	d.AddField("T", runtime.Describe(node.T))
+	d.AddField("F", runtime.Describe(node.F))
+	return d
+}
+
+func (node *If) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *For) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("For")
+	d.AddField("Block", runtime.Describe(node.Block))
+	return d
+}
+
+func (node *For) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Range) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Range")
+	d.AddField("Key", runtime.Describe(node.Key))
+	d.AddField("Value", runtime.Describe(node.Value))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Block", runtime.Describe(node.Block))
+	return d
+}
+
+func (node *Range) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeSwitchCase) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e TypeRef
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeSwitchCase")
+	l = runtime.MakeList("[]TypeRef")
+	for _, e = range node.Types {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Types", l)
+	d.AddField("Block", runtime.Describe(node.Block))
+	return d
+}
+
+func (node *TypeSwitchCase) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeSwitch) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *TypeSwitchCase
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeSwitch")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	l = runtime.MakeList("[]TypeSwitchCase")
+	for _, e = range node.Cases {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Cases", l)
+	d.AddField("Default", runtime.Describe(node.Default))
+	return d
+}
+
+func (node *TypeSwitch) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SwitchCase) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Expr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SwitchCase")
+	l = runtime.MakeList("[]Expr")
+	for _, e = range node.Exprs {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Exprs", l)
+	d.AddField("Block", runtime.Describe(node.Block))
+	return d
+}
+
+func (node *SwitchCase) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Switch) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *SwitchCase
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Switch")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	l = runtime.MakeList("[]SwitchCase")
+	for _, e = range node.Cases {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Cases", l)
+	d.AddField("Default", runtime.Describe(node.Default))
+	return d
+}
+
+func (node *Switch) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Goto) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Goto")
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Goto) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Break) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Break")
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Break) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Continue) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Continue")
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Continue) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Label) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Label")
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Label) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Return) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Expr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Return")
+	l = runtime.MakeList("[]Expr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *Return) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *VarDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("VarDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Const", runtime.Describe(node.Const))
+	return d
+}
+
+func (node *VarDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FuncDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FuncDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Recv", runtime.Describe(node.Recv))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Block", runtime.Describe(node.Block))
+	d.AddField("Package", node.Package.DescribeRef())
+	return d
+}
+
+func (node *FuncDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FieldDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FieldDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *FieldDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StructDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *FieldDecl
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StructDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l = runtime.MakeList("[]FieldDecl")
+	for _, e = range node.Fields {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Fields", l)
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *StructDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *InterfaceDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *FieldDecl
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("InterfaceDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l = runtime.MakeList("[]FieldDecl")
+	for _, e = range node.Fields {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Fields", l)
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *InterfaceDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeDefDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeDefDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *TypeDefDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *OpaqueDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("OpaqueDecl")
+	d.AddField("T", runtime.Describe(node.T))
+	return d
+}
+
+func (node *OpaqueDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Import) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Import")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Path", runtime.Describe(node.Path))
+	return d
+}
+
+func (node *Import) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FileAST) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Import
+	var l1 *runtime.List
+	var e1 Decl
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FileAST")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Package", runtime.Describe(node.Package))
+	l0 = runtime.MakeList("[]Import")
+	for _, e0 = range node.Imports {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Imports", l0)
+	l1 = runtime.MakeList("[]Decl")
+	for _, e1 = range node.Decls {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Decls", l1)
+	return d
+}
+
+func (node *FileAST) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *PackageAST) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *FileAST
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("PackageAST")
+	l = runtime.MakeList("[]FileAST")
+	for _, e = range node.Files {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Files", l)
+	d.AddField("P", node.P.DescribeRef())
+	return d
+}
+
+func (node *PackageAST) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ProgramAST) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *PackageAST
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ProgramAST")
+	d.AddField("Builtins", runtime.Describe(node.Builtins))
+	l = runtime.MakeList("[]PackageAST")
+	for _, e = range node.Packages {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Packages", l)
+	return d
+}
+
+func (node *ProgramAST) String() string {
+	return runtime.Format(node.Describe())
+}
@@ -62,8 +62,13 @@ func sweepTarget(expr Target, rewriter refRewriter) {
 	switch expr := expr.(type) {
 	case *SetLocal:
 		expr.Info = rewriter.rewriteLocalInfo(expr.Info)
-	case *SetName:
+	case *SetName, *SetDiscard:
 		// Leaf
+	case *SetSelector:
+		sweepExpr(expr.Expr, rewriter)
+	case *SetIndex:
+		sweepExpr(expr.Expr, rewriter)
+		sweepExpr(expr.Index, rewriter)
 	default:
 		panic(expr)
 	}
@@ -71,7 +76,7 @@ func sweepTarget(expr Target, rewriter refRewriter) {
 
 func sweepStmt(stmt Stmt, rewriter refRewriter) {
 	switch stmt := stmt.(type) {
-	case *Goto, *Label:
+	case *Goto, *Break, *Continue, *Label:
 		// Leaf
 	case *Assign:
 		for _, src := range stmt.Sources {
@@ -88,6 +93,32 @@ func sweepStmt(stmt Stmt, rewriter refRewriter) {
 		}
 	case *For:
 		sweepBlock(stmt.Block, rewriter)
+	case *Range:
+		if stmt.Key != nil {
+			sweepTarget(stmt.Key, rewriter)
+		}
+		if stmt.Value != nil {
+			sweepTarget(stmt.Value, rewriter)
+		}
+		sweepExpr(stmt.Expr, rewriter)
+		sweepBlock(stmt.Block, rewriter)
+	case *TypeSwitch:
+		sweepExpr(stmt.Expr, rewriter)
+		for _, c := range stmt.Cases {
+			sweepBlock(c.Block, rewriter)
+		}
+		if stmt.Default != nil {
+			sweepBlock(stmt.Default, rewriter)
+		}
+	case *Switch:
+		sweepExpr(stmt.Expr, rewriter)
+		for _, c := range stmt.Cases {
+			sweepExprList(c.Exprs, rewriter)
+			sweepBlock(c.Block, rewriter)
+		}
+		if stmt.Default != nil {
+			sweepBlock(stmt.Default, rewriter)
+		}
 	case *BlockStmt:
 		sweepBlock(stmt.Block, rewriter)
 	case *Return:
@@ -109,6 +140,9 @@ func sweepBlock(block *Block, rewriter refRewriter) {
 }
 
 func sweepParam(param *Param, rewriter refRewriter) {
+	if param.Info == nil {
+		return
+	}
 	param.Info = rewriter.rewriteLocalInfo(param.Info)
 }
 
@@ -56,6 +56,11 @@ func (info *FileInfo) LocalName(lcl *LocalInfo) string {
 }
 
 func nameifyParam(p *Param, info *FileInfo) {
+	if p.Info == nil {
+		// Anonymous parameter in a function type.
+		p.Type = nameifyType(p.Type, info)
+		return
+	}
 	p.Name = info.LocalName(p.Info)
 	p.Type = nameifyType(p.Info.T, info)
 }
@@ -140,8 +145,13 @@ func nameifyExpr(expr Expr, info *FileInfo) Expr {
 
 func nameifyTarget(expr Target, info *FileInfo) {
 	switch expr := expr.(type) {
-	case *SetName, *SetLocal:
+	case *SetName, *SetLocal, *SetDiscard:
 		// TODO
+	case *SetSelector:
+		expr.Expr = nameifyExpr(expr.Expr, info)
+	case *SetIndex:
+		expr.Expr = nameifyExpr(expr.Expr, info)
+		expr.Index = nameifyExpr(expr.Index, info)
 	default:
 		panic(expr)
 	}
@@ -170,13 +180,44 @@ func nameifyStmt(stmt Stmt, info *FileInfo) Stmt {
 		}
 	case *For:
 		nameifyBody(stmt.Block, info)
+	case *Range:
+		if stmt.Key != nil {
+			nameifyTarget(stmt.Key, info)
+		}
+		if stmt.Value != nil {
+			nameifyTarget(stmt.Value, info)
+		}
+		stmt.Expr = nameifyExpr(stmt.Expr, info)
+		nameifyBody(stmt.Block, info)
+	case *TypeSwitch:
+		stmt.Expr = nameifyExpr(stmt.Expr, info)
+		for _, c := range stmt.Cases {
+			for i, t := range c.Types {
+				c.Types[i] = nameifyType(t, info)
+			}
+			nameifyBody(c.Block, info)
+		}
+		if stmt.Default != nil {
+			nameifyBody(stmt.Default, info)
+		}
+	case *Switch:
+		stmt.Expr = nameifyExpr(stmt.Expr, info)
+		for _, c := range stmt.Cases {
+			for i, e := range c.Exprs {
+				c.Exprs[i] = nameifyExpr(e, info)
+			}
+			nameifyBody(c.Block, info)
+		}
+		if stmt.Default != nil {
+			nameifyBody(stmt.Default, info)
+		}
 	case *BlockStmt:
 		nameifyBody(stmt.Block, info)
 	case *Return:
 		for i, e := range stmt.Args {
 			stmt.Args[i] = nameifyExpr(e, info)
 		}
-	case *Goto:
+	case *Goto, *Break, *Continue:
 		// TODO
 	case *Label:
 		// TODO
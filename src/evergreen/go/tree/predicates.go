@@ -9,7 +9,7 @@ func NormalFlowMightExit(stmts []Stmt) bool {
 	}
 	last := stmts[n-1]
 	switch last := last.(type) {
-	case *Goto, *Return:
+	case *Goto, *Break, *Continue, *Return:
 		return false
 	default:
 		panic(last)
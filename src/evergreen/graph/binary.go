@@ -0,0 +1,98 @@
+package graph
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Graphs are serialized exactly, including dead nodes and edges and the order
+// of the edge lists, so that IDs stored outside the graph stay valid.
+
+func (g *Graph) MarshalBinary() ([]byte, error) {
+	data := []byte{}
+	data = binary.AppendUvarint(data, uint64(len(g.nodes)))
+	data = binary.AppendUvarint(data, uint64(len(g.edges)))
+	for _, n := range g.nodes {
+		for _, id := range []EdgeID{n.entries.head, n.entries.tail, n.exits.head, n.exits.tail} {
+			data = binary.AppendUvarint(data, uint64(id+1))
+		}
+	}
+	for _, e := range g.edges {
+		for _, id := range []NodeID{e.src, e.dst} {
+			data = binary.AppendUvarint(data, uint64(id+1))
+		}
+		for _, id := range []EdgeID{e.nextExit, e.prevExit, e.nextEntry, e.prevEntry} {
+			data = binary.AppendUvarint(data, uint64(id+1))
+		}
+	}
+	return data, nil
+}
+
+type graphReader struct {
+	data []byte
+	err  error
+}
+
+func (r *graphReader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(r.data)
+	if n <= 0 {
+		r.err = errors.New("truncated graph")
+		return 0
+	}
+	r.data = r.data[n:]
+	return v
+}
+
+// IDs are biased by one so that NoNode and NoEdge are encoded as zero.
+func (r *graphReader) id(limit int) uint32 {
+	v := r.uvarint()
+	if v > uint64(limit) {
+		r.err = errors.New("graph ID out of range")
+		return 0
+	}
+	return uint32(v) - 1
+}
+
+func (r *graphReader) count() int {
+	v := r.uvarint()
+	// Every node and edge takes at least one byte.
+	if v > uint64(len(r.data)) {
+		r.err = errors.New("graph is too large")
+		return 0
+	}
+	return int(v)
+}
+
+func (g *Graph) UnmarshalBinary(data []byte) error {
+	r := &graphReader{data: data}
+	numNodes := r.count()
+	numEdges := r.count()
+	if r.err != nil {
+		return r.err
+	}
+	g.nodes = make([]node, numNodes)
+	g.edges = make([]edge, numEdges)
+	for i := range g.nodes {
+		n := &g.nodes[i]
+		n.entries.head = EdgeID(r.id(numEdges))
+		n.entries.tail = EdgeID(r.id(numEdges))
+		n.exits.head = EdgeID(r.id(numEdges))
+		n.exits.tail = EdgeID(r.id(numEdges))
+	}
+	for i := range g.edges {
+		e := &g.edges[i]
+		e.src = NodeID(r.id(numNodes))
+		e.dst = NodeID(r.id(numNodes))
+		e.nextExit = EdgeID(r.id(numEdges))
+		e.prevExit = EdgeID(r.id(numEdges))
+		e.nextEntry = EdgeID(r.id(numEdges))
+		e.prevEntry = EdgeID(r.id(numEdges))
+	}
+	if r.err == nil && len(r.data) != 0 {
+		r.err = errors.New("trailing data after graph")
+	}
+	return r.err
+}
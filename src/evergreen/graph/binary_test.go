@@ -0,0 +1,57 @@
+package graph
+
+import (
+	"evergreen/assert"
+	"reflect"
+	"testing"
+)
+
+func TestBinaryRoundTrip(t *testing.T) {
+	g := CreateGraph()
+	n := g.CreateNode()
+	dead := g.CreateNode()
+	e0 := g.CreateEdge()
+	e1 := g.CreateEdge()
+	e2 := g.CreateEdge()
+	g.ConnectEdge(g.Entry(), e0, n)
+	g.ConnectEdge(n, e1, g.Exit())
+	g.ConnectEdge(g.Entry(), e2, dead)
+	g.KillEdge(e2)
+
+	data, err := g.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := &Graph{}
+	err = other.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(g, other) {
+		t.Fatalf("%#v != %#v", g, other)
+	}
+	if !g.Equal(other) || !g.Equal(g.Copy()) {
+		t.Fatal("graphs should be equal")
+	}
+	other.CreateNode()
+	if g.Equal(other) || g.Equal(nil) {
+		t.Fatal("graphs should differ")
+	}
+	checkExitEdges(other, other.nodes[other.Entry()].exits, []EdgeID{e0}, t)
+	checkEntryEdges(other, other.nodes[other.Exit()].entries, []EdgeID{e1}, t)
+}
+
+func TestBinaryCorrupt(t *testing.T) {
+	data, err := CreateGraph().MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &Graph{}
+	err = g.UnmarshalBinary(data[:len(data)-1])
+	assert.StringEquals(t, err.Error(), "truncated graph")
+
+	// A node with an entry edge that does not exist.
+	data[2] = 1
+	err = g.UnmarshalBinary(data)
+	assert.StringEquals(t, err.Error(), "graph ID out of range")
+}
@@ -0,0 +1,185 @@
+package graph
+
+// A lattice of dataflow facts, and how each node transforms them.  Join must
+// be monotonic and the lattice must have finite height, or the solver will
+// not terminate.
+type Lattice interface {
+	Bottom() interface{}
+	Join(a interface{}, b interface{}) interface{}
+	Equal(a interface{}, b interface{}) bool
+	// The facts that hold after a node executes, given the facts that held
+	// before.  For backward problems "after" is the start of the node.
+	Transfer(n NodeID, value interface{}) interface{}
+}
+
+// Transforms the facts flowing along an edge.
+type EdgeTransfer func(e EdgeID, value interface{}) interface{}
+
+type Direction int
+
+const (
+	Forward Direction = iota
+	Backward
+)
+
+type DataflowProblem struct {
+	Lattice   Lattice
+	Direction Direction
+	// The facts flowing into the entry of a forward problem, or out of the
+	// exit of a backward problem.  Bottom if nil.
+	Boundary interface{}
+	// The flow type of each edge, used to find edge transfers.
+	EdgeFlows []int
+	// Transfers applied to edges of a given flow type.  Edges without a
+	// transfer pass facts through unchanged.
+	EdgeTransfers map[int]EdgeTransfer
+}
+
+// The facts at the start and end of each node.  Nodes that cannot be reached
+// from the entry are left nil.
+type DataflowResult struct {
+	In  []interface{}
+	Out []interface{}
+}
+
+type dataflowSolver struct {
+	g       *Graph
+	problem *DataflowProblem
+	order   []NodeID
+	index   []int
+	pending []bool
+	result  *DataflowResult
+}
+
+func (s *dataflowSolver) edgeValue(e EdgeID, value interface{}) interface{} {
+	if s.problem.EdgeFlows != nil {
+		transfer, ok := s.problem.EdgeTransfers[s.problem.EdgeFlows[e]]
+		if ok {
+			return transfer(e, value)
+		}
+	}
+	return value
+}
+
+func (s *dataflowSolver) isBoundary(n NodeID) bool {
+	if s.problem.Direction == Forward {
+		return n == s.g.Entry()
+	}
+	return n == s.g.Exit()
+}
+
+// Joins the facts arriving at a node from its predecessors in the direction
+// of the analysis.
+func (s *dataflowSolver) gather(n NodeID) interface{} {
+	lattice := s.problem.Lattice
+	value := lattice.Bottom()
+	if s.isBoundary(n) && s.problem.Boundary != nil {
+		value = s.problem.Boundary
+	}
+	if s.problem.Direction == Forward {
+		it := s.g.EntryIterator(n)
+		for it.HasNext() {
+			src, e := it.GetNext()
+			if s.index[src] >= 0 {
+				value = lattice.Join(value, s.edgeValue(e, s.result.Out[src]))
+			}
+		}
+	} else {
+		it := s.g.ExitIterator(n)
+		for it.HasNext() {
+			e, dst := it.GetNext()
+			if s.index[dst] >= 0 {
+				value = lattice.Join(value, s.edgeValue(e, s.result.In[dst]))
+			}
+		}
+	}
+	return value
+}
+
+// Marks the nodes that depend on the facts produced by a node.
+func (s *dataflowSolver) markSuccessors(n NodeID) {
+	if s.problem.Direction == Forward {
+		it := s.g.ExitIterator(n)
+		for it.HasNext() {
+			_, dst := it.GetNext()
+			if s.index[dst] >= 0 {
+				s.pending[s.index[dst]] = true
+			}
+		}
+	} else {
+		it := s.g.EntryIterator(n)
+		for it.HasNext() {
+			src, _ := it.GetNext()
+			if s.index[src] >= 0 {
+				s.pending[s.index[src]] = true
+			}
+		}
+	}
+}
+
+func (s *dataflowSolver) run() {
+	lattice := s.problem.Lattice
+	for _, n := range s.order {
+		s.result.In[n] = lattice.Bottom()
+		s.result.Out[n] = lattice.Bottom()
+	}
+	for i := range s.pending {
+		s.pending[i] = true
+	}
+	// Each pass visits the pending nodes in order, so most facts are known
+	// before they are needed and loops are the only reason to repeat.
+	changed := true
+	for changed {
+		changed = false
+		for i, n := range s.order {
+			if !s.pending[i] {
+				continue
+			}
+			s.pending[i] = false
+			changed = true
+
+			var old interface{}
+			if s.problem.Direction == Forward {
+				s.result.In[n] = s.gather(n)
+				old = s.result.Out[n]
+				s.result.Out[n] = lattice.Transfer(n, s.result.In[n])
+				if lattice.Equal(old, s.result.Out[n]) {
+					continue
+				}
+			} else {
+				s.result.Out[n] = s.gather(n)
+				old = s.result.In[n]
+				s.result.In[n] = lattice.Transfer(n, s.result.Out[n])
+				if lattice.Equal(old, s.result.In[n]) {
+					continue
+				}
+			}
+			s.markSuccessors(n)
+		}
+	}
+}
+
+// Finds the least fixed point of a dataflow problem.  Forward problems are
+// solved in reverse postorder and backward problems in postorder.
+func SolveDataflow(g *Graph, problem *DataflowProblem) *DataflowResult {
+	order, index := ReversePostorder(g)
+	if problem.Direction == Backward {
+		ReverseOrder(order)
+		for i, n := range order {
+			index[n] = i
+		}
+	}
+	s := &dataflowSolver{
+		g:       g,
+		problem: problem,
+		order:   order,
+		index:   index,
+		pending: make([]bool, len(order)),
+		result: &DataflowResult{
+			In:  make([]interface{}, g.NumNodes()),
+			Out: make([]interface{}, g.NumNodes()),
+		},
+	}
+	s.run()
+	return s.result
+}
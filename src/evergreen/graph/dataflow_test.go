@@ -0,0 +1,119 @@
+package graph
+
+import (
+	"testing"
+)
+
+// Sets of up to 64 facts, with each node generating and killing some of them.
+type bitLattice struct {
+	gen  map[NodeID]uint64
+	kill map[NodeID]uint64
+}
+
+func (l *bitLattice) Bottom() interface{} {
+	return uint64(0)
+}
+
+func (l *bitLattice) Join(a interface{}, b interface{}) interface{} {
+	return a.(uint64) | b.(uint64)
+}
+
+func (l *bitLattice) Equal(a interface{}, b interface{}) bool {
+	return a.(uint64) == b.(uint64)
+}
+
+func (l *bitLattice) Transfer(n NodeID, value interface{}) interface{} {
+	return value.(uint64)&^l.kill[n] | l.gen[n]
+}
+
+func checkFacts(values []interface{}, n NodeID, expected uint64, t *testing.T) {
+	actual, ok := values[n].(uint64)
+	if !ok || actual != expected {
+		t.Errorf("%d: expected %#b, got %#v", n, expected, values[n])
+	}
+}
+
+// entry -> a -> b -> c -> b, b -> exit
+func makeLoop() (*Graph, NodeID, NodeID, NodeID) {
+	g := CreateGraph()
+	a := g.CreateNode()
+	b := g.CreateNode()
+	c := g.CreateNode()
+	emitFullEdge(g, g.Entry(), a)
+	emitFullEdge(g, a, b)
+	emitFullEdge(g, b, c)
+	emitFullEdge(g, c, b)
+	emitFullEdge(g, b, g.Exit())
+	return g, a, b, c
+}
+
+func TestDataflowForward(t *testing.T) {
+	g, a, b, c := makeLoop()
+	unreachable := g.CreateNode()
+	emitFullEdge(g, unreachable, b)
+
+	// Reaching definitions: a defines 0, c redefines it as 1.
+	lattice := &bitLattice{
+		gen:  map[NodeID]uint64{a: 1, c: 2, unreachable: 4},
+		kill: map[NodeID]uint64{c: 1},
+	}
+	result := SolveDataflow(g, &DataflowProblem{Lattice: lattice, Direction: Forward})
+	checkFacts(result.In, a, 0, t)
+	checkFacts(result.In, b, 3, t)
+	checkFacts(result.Out, c, 2, t)
+	checkFacts(result.In, g.Exit(), 3, t)
+	if result.In[unreachable] != nil {
+		t.Errorf("unreachable node should not be solved, got %#v", result.In[unreachable])
+	}
+}
+
+func TestDataflowBackward(t *testing.T) {
+	g, a, b, c := makeLoop()
+
+	// Liveness: a defines 0, b uses 0 and 1, c defines 1.
+	lattice := &bitLattice{
+		gen:  map[NodeID]uint64{b: 3},
+		kill: map[NodeID]uint64{a: 1, c: 2},
+	}
+	result := SolveDataflow(g, &DataflowProblem{Lattice: lattice, Direction: Backward})
+	checkFacts(result.In, a, 2, t)
+	checkFacts(result.Out, a, 3, t)
+	checkFacts(result.In, c, 1, t)
+	checkFacts(result.Out, b, 1, t)
+	checkFacts(result.Out, g.Exit(), 0, t)
+}
+
+func TestDataflowEdgeTransfers(t *testing.T) {
+	const (
+		normal = iota
+		fail
+	)
+	g := CreateGraph()
+	a := g.CreateNode()
+	b := g.CreateNode()
+	flows := make([]int, 4)
+	emitFullEdge(g, g.Entry(), a)
+	emitFullEdge(g, a, g.Exit())
+	flows[emitFullEdge(g, a, b)] = fail
+	emitFullEdge(g, b, g.Exit())
+
+	lattice := &bitLattice{
+		gen: map[NodeID]uint64{a: 1, b: 2},
+	}
+	result := SolveDataflow(g, &DataflowProblem{
+		Lattice:   lattice,
+		Direction: Forward,
+		Boundary:  uint64(8),
+		EdgeFlows: flows,
+		EdgeTransfers: map[int]EdgeTransfer{
+			// Failing discards everything.
+			fail: func(e EdgeID, value interface{}) interface{} {
+				return uint64(0)
+			},
+		},
+	})
+	checkFacts(result.In, g.Entry(), 8, t)
+	checkFacts(result.Out, a, 9, t)
+	checkFacts(result.In, b, 0, t)
+	checkFacts(result.In, g.Exit(), 11, t)
+}
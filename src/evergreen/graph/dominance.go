@@ -70,13 +70,15 @@ func intersectDom(idoms []NodeID, index []int, n0 NodeID, n1 NodeID) NodeID {
 	return n0
 }
 
-func FindDominators(g *Graph, order []NodeID, index []int) []NodeID {
+// Finds the immediate dominators of a graph, or if reversed the immediate
+// post dominators.
+func findDominators(g *Graph, root NodeID, order []NodeID, index []int, reversed bool) []NodeID {
 	numNodes := len(g.nodes)
 	idoms := make([]NodeID, numNodes)
 	for i := 0; i < numNodes; i++ {
 		idoms[i] = NoNode
 	}
-	idoms[g.Entry()] = g.Entry()
+	idoms[root] = root
 	changed := true
 	for changed {
 		changed = false
@@ -84,24 +86,29 @@ func FindDominators(g *Graph, order []NodeID, index []int) []NodeID {
 		for nit.HasNext() {
 			n := nit.GetNext()
 			newIdom := idoms[n]
-			eit := g.EntryIterator(n)
-			// Find initial dominator.
-			if newIdom == NoNode {
-				for eit.HasNext() {
-					e, _ := eit.GetNext()
+			intersect := func(other NodeID) {
+				// Ignore unprocessed nodes. (And by implication unreachable nodes.)
+				if idoms[other] == NoNode {
+					return
+				}
+				if newIdom == NoNode {
 					// Make the first processed node we find the inital domiator.
-					if idoms[e] != NoNode {
-						newIdom = e
-						break
-					}
+					newIdom = other
+				} else {
+					newIdom = intersectDom(idoms, index, newIdom, other)
 				}
 			}
-			// Intersect dominators.
-			for eit.HasNext() {
-				e, _ := eit.GetNext()
-				// Ignore unprocessed nodes. (And by implication unreachable nodes.)
-				if idoms[e] != NoNode {
-					newIdom = intersectDom(idoms, index, newIdom, e)
+			if reversed {
+				eit := g.ExitIterator(n)
+				for eit.HasNext() {
+					_, dst := eit.GetNext()
+					intersect(dst)
+				}
+			} else {
+				eit := g.EntryIterator(n)
+				for eit.HasNext() {
+					src, _ := eit.GetNext()
+					intersect(src)
 				}
 			}
 			// Update.
@@ -114,6 +121,10 @@ func FindDominators(g *Graph, order []NodeID, index []int) []NodeID {
 	return idoms
 }
 
+func FindDominators(g *Graph, order []NodeID, index []int) []NodeID {
+	return findDominators(g, g.Entry(), order, index, false)
+}
+
 // Assumes no dead entries.
 func FindDominanceFrontiers(g *Graph, idoms []NodeID) [][]NodeID {
 	n := len(g.nodes)
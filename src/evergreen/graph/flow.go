@@ -432,6 +432,15 @@ func (g *Graph) MoveEdgeEntry(src NodeID, e EdgeID) {
 	g.nodes[src].exits.Append(g, e)
 }
 
+func (g *Graph) MoveEdgeExit(e EdgeID, dst NodeID) {
+	original := g.edges[e].dst
+	if original != NoNode {
+		g.nodes[original].entries.Remove(g, e)
+	}
+	g.edges[e].dst = dst
+	g.nodes[dst].entries.Append(g, e)
+}
+
 func (g *Graph) ConnectEdgeExit(e EdgeID, dst NodeID) {
 	g.setEdgeExit(e, dst)
 }
@@ -513,6 +522,28 @@ func (g *Graph) Copy() *Graph {
 	}
 }
 
+// Equal reports if two graphs have identical nodes and edges, including dead
+// ones.
+func (g *Graph) Equal(other *Graph) bool {
+	if g == nil || other == nil {
+		return g == other
+	}
+	if len(g.nodes) != len(other.nodes) || len(g.edges) != len(other.edges) {
+		return false
+	}
+	for i, n := range g.nodes {
+		if n != other.nodes[i] {
+			return false
+		}
+	}
+	for i, e := range g.edges {
+		if e != other.edges[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func CreateGraph() *Graph {
 	g := &Graph{}
 	// Entry
@@ -713,3 +713,25 @@ func TestDiamond(t *testing.T) {
 		[]NodeID{}, []NodeID{}, []NodeID{}, []NodeID{n4}, []NodeID{n4}, []NodeID{},
 	}, t)
 }
+
+func TestMoveEdge(t *testing.T) {
+	g := CreateGraph()
+	n1 := g.CreateNode()
+	n2 := g.CreateNode()
+	n3 := g.CreateNode()
+	e1 := emitFullEdge(g, n1, n2)
+	e2 := emitFullEdge(g, n1, n3)
+
+	g.MoveEdgeExit(e1, n3)
+	checkTopology(g, n1, []NodeID{}, []NodeID{n3, n3}, t)
+	checkTopology(g, n2, []NodeID{}, []NodeID{}, t)
+	checkTopology(g, n3, []NodeID{n1, n1}, []NodeID{}, t)
+
+	g.MoveEdgeEntry(n2, e2)
+	checkTopology(g, n1, []NodeID{}, []NodeID{n3}, t)
+	checkTopology(g, n2, []NodeID{}, []NodeID{n3}, t)
+	// Moved edges are appended.
+	checkTopology(g, n3, []NodeID{n2, n1}, []NodeID{}, t)
+	checkEdge(g, e1, n1, n3, t)
+	checkEdge(g, e2, n2, n3, t)
+}
@@ -0,0 +1,325 @@
+package graph
+
+import (
+	"sort"
+)
+
+type Loop struct {
+	Header NodeID
+	// The innermost loop containing this loop, or nil.
+	Parent   *Loop
+	Children []*Loop
+	// Every node in the loop, including the header and nested loops, in
+	// reverse postorder.
+	Body []NodeID
+	// Edges leaving the loop.
+	Exits []EdgeID
+	// Outermost loops have a depth of one.
+	Depth int
+	// The loop can be entered somewhere other than the header.
+	Irreducible bool
+}
+
+type LoopForest struct {
+	// Outer loops come before the loops nested inside them.
+	Loops     []*Loop
+	Roots     []*Loop
+	innermost []*Loop
+}
+
+// The innermost loop containing a node, or nil.
+func (forest *LoopForest) LoopOf(n NodeID) *Loop {
+	return forest.innermost[n]
+}
+
+func (forest *LoopForest) Contains(loop *Loop, n NodeID) bool {
+	for l := forest.innermost[n]; l != nil; l = l.Parent {
+		if l == loop {
+			return true
+		}
+	}
+	return false
+}
+
+// The node that leads into the loop header from outside the loop, if there is
+// only one and it does not lead anywhere else.  Otherwise NoNode, and a
+// preheader would need to be inserted.
+func (forest *LoopForest) Preheader(g *Graph, loop *Loop) NodeID {
+	preheader := NoNode
+	it := g.EntryIterator(loop.Header)
+	for it.HasNext() {
+		src, _ := it.GetNext()
+		if forest.Contains(loop, src) {
+			continue
+		}
+		if preheader != NoNode || g.HasMultipleExits(src) {
+			return NoNode
+		}
+		preheader = src
+	}
+	return preheader
+}
+
+// Finds the loops in a graph and how they nest.  Irreducible loops are
+// reported with the header that was found first, and marked as such.
+func FindLoops(g *Graph) *LoopForest {
+	// The analysis kills dead edges, so run it on a copy.
+	info, _, postorder := AnalyzeStructure(g.Copy())
+
+	forest := &LoopForest{
+		innermost: make([]*Loop, g.NumNodes()),
+	}
+	headed := make([]*Loop, g.NumNodes())
+	for i := len(postorder) - 1; i >= 0; i-- {
+		n := postorder[i]
+		if !info[n].IsHead {
+			continue
+		}
+		loop := &Loop{Header: n, Irreducible: info[n].IsIrreducible, Depth: 1}
+		if head := info[n].LoopHead; head != NoNode {
+			loop.Parent = headed[head]
+			loop.Depth = loop.Parent.Depth + 1
+			loop.Parent.Children = append(loop.Parent.Children, loop)
+		} else {
+			forest.Roots = append(forest.Roots, loop)
+		}
+		headed[n] = loop
+		forest.Loops = append(forest.Loops, loop)
+	}
+
+	for i := len(postorder) - 1; i >= 0; i-- {
+		n := postorder[i]
+		loop := headed[n]
+		if loop == nil && info[n].LoopHead != NoNode {
+			loop = headed[info[n].LoopHead]
+		}
+		forest.innermost[n] = loop
+		for l := loop; l != nil; l = l.Parent {
+			l.Body = append(l.Body, n)
+		}
+	}
+
+	for _, loop := range forest.Loops {
+		for _, n := range loop.Body {
+			it := g.ExitIterator(n)
+			for it.HasNext() {
+				e, dst := it.GetNext()
+				if !forest.Contains(loop, dst) {
+					loop.Exits = append(loop.Exits, e)
+				}
+			}
+		}
+	}
+	return forest
+}
+
+type reducer struct {
+	g          *Graph
+	index      []int
+	nodeOrigin []NodeID
+	edgeOrigin []EdgeID
+}
+
+func (r *reducer) isLive(n NodeID) bool {
+	return r.index[r.nodeOrigin[n]] >= 0
+}
+
+// Tarjan's algorithm, restricted to a region and ignoring edges into the
+// region's header.
+type sccSearch struct {
+	g        *Graph
+	inRegion map[NodeID]bool
+	header   NodeID
+	index    map[NodeID]int
+	lowlink  map[NodeID]int
+	onStack  map[NodeID]bool
+	stack    []NodeID
+	sccs     [][]NodeID
+}
+
+func (s *sccSearch) search(n NodeID) {
+	s.index[n] = len(s.index)
+	s.lowlink[n] = s.index[n]
+	s.stack = append(s.stack, n)
+	s.onStack[n] = true
+
+	it := s.g.ExitIterator(n)
+	for it.HasNext() {
+		_, dst := it.GetNext()
+		if !s.inRegion[dst] || dst == s.header {
+			continue
+		}
+		if _, visited := s.index[dst]; !visited {
+			s.search(dst)
+			if s.lowlink[dst] < s.lowlink[n] {
+				s.lowlink[n] = s.lowlink[dst]
+			}
+		} else if s.onStack[dst] && s.index[dst] < s.lowlink[n] {
+			s.lowlink[n] = s.index[dst]
+		}
+	}
+
+	if s.lowlink[n] == s.index[n] {
+		scc := []NodeID{}
+		for {
+			top := s.stack[len(s.stack)-1]
+			s.stack = s.stack[:len(s.stack)-1]
+			s.onStack[top] = false
+			scc = append(scc, top)
+			if top == n {
+				break
+			}
+		}
+		s.sccs = append(s.sccs, scc)
+	}
+}
+
+func (r *reducer) findSCCs(region []NodeID, inRegion map[NodeID]bool, header NodeID) [][]NodeID {
+	s := &sccSearch{
+		g:        r.g,
+		inRegion: inRegion,
+		header:   header,
+		index:    map[NodeID]int{},
+		lowlink:  map[NodeID]int{},
+		onStack:  map[NodeID]bool{},
+	}
+	for _, n := range region {
+		if _, visited := s.index[n]; !visited && n != header {
+			s.search(n)
+		}
+	}
+	return s.sccs
+}
+
+func (r *reducer) isCycle(scc []NodeID) bool {
+	if len(scc) > 1 {
+		return true
+	}
+	n := scc[0]
+	it := r.g.ExitIterator(n)
+	for it.HasNext() {
+		_, dst := it.GetNext()
+		if dst == n {
+			return true
+		}
+	}
+	return false
+}
+
+// Copies a set of nodes.  Edges between the nodes are copied to connect the
+// copies, and edges leaving the set are copied to leave from the copies.  The
+// graph is stitched onto itself, so every incoming edge must be mapped before
+// any edges are added.
+func (r *reducer) duplicate(nodes []NodeID, inSet map[NodeID]bool) map[NodeID]NodeID {
+	g := r.g
+	stitcher := MakeEdgeStitcher(g, g)
+	copies := map[NodeID]NodeID{}
+	for _, n := range nodes {
+		copies[n] = g.CreateNode()
+		r.nodeOrigin = append(r.nodeOrigin, r.nodeOrigin[n])
+		stitcher.MapIncomingEdges(n, copies[n])
+	}
+	// Destinations outside of the set map onto themselves.
+	outside := map[NodeID]bool{}
+	for _, n := range nodes {
+		it := g.ExitIterator(n)
+		for it.HasNext() {
+			_, dst := it.GetNext()
+			if !inSet[dst] && !outside[dst] {
+				outside[dst] = true
+				stitcher.MapIncomingEdges(dst, dst)
+			}
+		}
+	}
+	for _, n := range nodes {
+		it := g.ExitIterator(n)
+		for it.HasNext() {
+			e, _ := it.GetNext()
+			copied := g.CreateEdge()
+			r.edgeOrigin = append(r.edgeOrigin, r.edgeOrigin[e])
+			g.ConnectEdgeEntry(copies[n], copied)
+			stitcher.MapEdge(e, copied)
+		}
+	}
+	return copies
+}
+
+// Makes every cycle in a region have a single entry, splitting nodes as
+// needed, then does the same for the cycles nested inside each cycle.
+func (r *reducer) normalize(region []NodeID, header NodeID) {
+	g := r.g
+	inRegion := map[NodeID]bool{}
+	for _, n := range region {
+		inRegion[n] = true
+	}
+	for _, scc := range r.findSCCs(region, inRegion, header) {
+		if !r.isCycle(scc) {
+			continue
+		}
+		inSCC := map[NodeID]bool{}
+		for _, n := range scc {
+			inSCC[n] = true
+		}
+		entries := []NodeID{}
+		for _, n := range scc {
+			it := g.EntryIterator(n)
+			for it.HasNext() {
+				src, _ := it.GetNext()
+				if !inSCC[src] && r.isLive(src) {
+					entries = append(entries, n)
+					break
+				}
+			}
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return r.index[r.nodeOrigin[entries[i]]] < r.index[r.nodeOrigin[entries[j]]]
+		})
+		if len(entries) == 0 {
+			continue
+		}
+
+		// Keep the first entry, and give every other entry its own copy of
+		// the cycle.
+		for _, entry := range entries[1:] {
+			copies := r.duplicate(scc, inSCC)
+			redirect := []EdgeID{}
+			it := g.EntryIterator(entry)
+			for it.HasNext() {
+				src, e := it.GetNext()
+				if !inSCC[src] && r.isLive(src) {
+					redirect = append(redirect, e)
+				}
+			}
+			for _, e := range redirect {
+				g.MoveEdgeExit(e, copies[entry])
+			}
+			copied := make([]NodeID, len(scc))
+			for i, n := range scc {
+				copied[i] = copies[n]
+			}
+			r.normalize(copied, copies[entry])
+		}
+		r.normalize(scc, entries[0])
+	}
+}
+
+// Splits nodes until every loop has a single entry.  New nodes and edges are
+// appended to the graph.  Returns the original node of each node and the
+// original edge of each edge, so the IR can copy the ops and flows.
+func MakeReducible(g *Graph) ([]NodeID, []EdgeID) {
+	order, index := ReversePostorder(g)
+	r := &reducer{
+		g:          g,
+		index:      index,
+		nodeOrigin: make([]NodeID, g.NumNodes()),
+		edgeOrigin: make([]EdgeID, g.NumEdges()),
+	}
+	for i := range r.nodeOrigin {
+		r.nodeOrigin[i] = NodeID(i)
+	}
+	for i := range r.edgeOrigin {
+		r.edgeOrigin[i] = EdgeID(i)
+	}
+	r.normalize(order, NoNode)
+	return r.nodeOrigin, r.edgeOrigin
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"evergreen/assert"
+	"testing"
+)
+
+func checkEdgeList(actual []EdgeID, expected []EdgeID, t *testing.T) {
+	assert.IntEquals(t, len(actual), len(expected))
+	for i := 0; i < len(expected); i++ {
+		if actual[i] != expected[i] {
+			t.Errorf("%d: %v vs %v", i, actual[i], expected[i])
+		}
+	}
+}
+
+func TestFindLoopsNested(t *testing.T) {
+	g := CreateGraph()
+	e := g.Entry()
+	x := g.Exit()
+	n1 := g.CreateNode()
+	n2 := g.CreateNode()
+	n3 := g.CreateNode()
+	n4 := g.CreateNode()
+
+	emitFullEdge(g, e, n1)
+	emitFullEdge(g, n1, n2)
+	emitFullEdge(g, n2, n3)
+	emitFullEdge(g, n3, n2)
+	e34 := emitFullEdge(g, n3, n4)
+	emitFullEdge(g, n4, n1)
+	e4x := emitFullEdge(g, n4, x)
+
+	forest := FindLoops(g)
+	assert.IntEquals(t, len(forest.Loops), 2)
+	assert.IntEquals(t, len(forest.Roots), 1)
+
+	outer := forest.Loops[0]
+	if outer.Header != n1 || outer.Parent != nil || outer.Depth != 1 || outer.Irreducible {
+		t.Errorf("bad outer loop %#v", outer)
+	}
+	checkNodeList(outer.Body, []NodeID{n1, n2, n3, n4}, t)
+	checkEdgeList(outer.Exits, []EdgeID{e4x}, t)
+
+	inner := forest.Loops[1]
+	if inner.Header != n2 || inner.Parent != outer || inner.Depth != 2 {
+		t.Errorf("bad inner loop %#v", inner)
+	}
+	assert.IntEquals(t, len(outer.Children), 1)
+	checkNodeList(inner.Body, []NodeID{n2, n3}, t)
+	checkEdgeList(inner.Exits, []EdgeID{e34}, t)
+
+	if forest.LoopOf(n3) != inner || forest.LoopOf(n4) != outer || forest.LoopOf(x) != nil {
+		t.Error("bad innermost loops")
+	}
+	if !forest.Contains(outer, n3) || forest.Contains(inner, n4) {
+		t.Error("bad containment")
+	}
+	if p := forest.Preheader(g, outer); p != e {
+		t.Errorf("expected entry to be the outer preheader, got %v", p)
+	}
+	if p := forest.Preheader(g, inner); p != n1 {
+		t.Errorf("expected %v to be the inner preheader, got %v", n1, p)
+	}
+
+	nodeOrigin, _ := MakeReducible(g)
+	assert.IntEquals(t, len(nodeOrigin), 6)
+}
+
+func hasIrreducibleLoop(g *Graph) bool {
+	for _, loop := range FindLoops(g).Loops {
+		if loop.Irreducible {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMakeReducible(t *testing.T) {
+	g := CreateGraph()
+	e := g.Entry()
+	n1 := g.CreateNode()
+	n2 := g.CreateNode()
+	n3 := g.CreateNode()
+	n4 := g.CreateNode()
+	n5 := g.CreateNode()
+	n6 := g.CreateNode()
+
+	emitFullEdge(g, e, n6)
+	emitFullEdge(g, n6, n5)
+	emitFullEdge(g, n6, n4)
+	emitFullEdge(g, n5, n1)
+	emitFullEdge(g, n4, n2)
+	emitFullEdge(g, n4, n3)
+	emitFullEdge(g, n3, n2)
+	emitFullEdge(g, n2, n1)
+	emitFullEdge(g, n2, n3)
+	emitFullEdge(g, n1, n2)
+	emitFullEdge(g, n1, g.Exit())
+
+	original := g.Copy()
+	if !hasIrreducibleLoop(g) {
+		t.Fatal("expected an irreducible loop")
+	}
+
+	nodeOrigin, edgeOrigin := MakeReducible(g)
+	assert.IntEquals(t, len(nodeOrigin), g.NumNodes())
+	assert.IntEquals(t, len(edgeOrigin), g.NumEdges())
+	if g.NumNodes() == original.NumNodes() {
+		t.Fatal("expected nodes to be split")
+	}
+	if hasIrreducibleLoop(g) {
+		t.Fatal("graph is still irreducible")
+	}
+
+	// Every edge is a copy of an edge between the same original nodes.
+	order, _ := ReversePostorder(g)
+	for _, n := range order {
+		it := g.ExitIterator(n)
+		for it.HasNext() {
+			e, dst := it.GetNext()
+			o := edgeOrigin[e]
+			if original.EdgeEntry(o) != nodeOrigin[n] || original.EdgeExit(o) != nodeOrigin[dst] {
+				t.Errorf("edge %v from %v to %v is not a copy of %v", e, n, dst, o)
+			}
+		}
+	}
+
+	// Reducible graphs are left alone.
+	nodeOrigin, _ = MakeReducible(g)
+	assert.IntEquals(t, len(nodeOrigin), g.NumNodes())
+	for i, o := range nodeOrigin {
+		if o != NodeID(i) {
+			t.Fatalf("reducible graph was modified at %v", i)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package graph
+
+type reverseRPOSearch struct {
+	graph *Graph
+	order []NodeID
+	index []int
+}
+
+func (s *reverseRPOSearch) search(n NodeID) {
+	if s.index[n] != 0 {
+		return
+	}
+	s.index[n] = 1
+
+	iter := s.graph.EntryIterator(n)
+	for iter.HasNext() {
+		src, _ := iter.GetNext()
+		s.search(src)
+	}
+	s.order = append(s.order, n)
+	s.index[n] = len(s.order)
+}
+
+// The reverse postorder of the graph with every edge flipped, starting from
+// the exit.  Nodes that cannot reach the exit are not included, except for
+// the entry which is always the last node.
+func ReversePostorderFromExit(g *Graph) ([]NodeID, []int) {
+	numNodes := len(g.nodes)
+	s := &reverseRPOSearch{
+		graph: g,
+		order: make([]NodeID, 1, numNodes),
+		index: make([]int, numNodes),
+	}
+	s.order[0] = g.Entry()
+	s.index[g.Entry()] = 1
+	s.search(g.Exit())
+
+	ReverseOrder(s.order)
+	o := len(s.order)
+	for i := 0; i < numNodes; i++ {
+		if s.index[i] != 0 {
+			s.index[i] = o - s.index[i]
+		} else {
+			s.index[i] = -1
+		}
+	}
+	return s.order, s.index
+}
+
+// Finds the immediate post dominator of each node, given the order from
+// ReversePostorderFromExit.  Every edge into the exit is considered, so
+// functions that return from several places share a single post dominator
+// tree rooted at the exit.  Nodes that cannot reach the exit have no post
+// dominator.
+func FindPostDominators(g *Graph, order []NodeID, index []int) []NodeID {
+	return findDominators(g, g.Exit(), order, index, true)
+}
+
+// For each node, the branches that decide if it will be executed.  Nodes that
+// cannot reach the exit are ignored.
+func FindPostDominanceFrontiers(g *Graph, ipdoms []NodeID) [][]NodeID {
+	frontiers := make([][]NodeID, len(g.nodes))
+	nit := g.NodeIterator()
+	for nit.HasNext() {
+		n := nit.GetNext()
+		target := ipdoms[n]
+		if target == NoNode || !g.HasMultipleExits(n) {
+			continue
+		}
+		eit := g.ExitIterator(n)
+		for eit.HasNext() {
+			_, runner := eit.GetNext()
+			for runner != target && runner != NoNode {
+				// Several exits may lead to the same node.
+				f := frontiers[runner]
+				if len(f) == 0 || f[len(f)-1] != n {
+					frontiers[runner] = append(f, n)
+				}
+				runner = ipdoms[runner]
+			}
+		}
+	}
+	return frontiers
+}
+
+// A node is control dependent on an edge leaving a branch if taking the edge
+// guarantees the node will execute, but taking another edge may not.
+type ControlDependence struct {
+	Branch NodeID
+	Edge   EdgeID
+}
+
+// Builds the control dependence graph, listing the branch edges each node
+// depends on.  Nodes that only depend on the function being entered have no
+// dependencies.
+func FindControlDependence(g *Graph, ipdoms []NodeID) [][]ControlDependence {
+	deps := make([][]ControlDependence, len(g.nodes))
+	nit := g.NodeIterator()
+	for nit.HasNext() {
+		n := nit.GetNext()
+		target := ipdoms[n]
+		if target == NoNode {
+			continue
+		}
+		eit := g.ExitIterator(n)
+		for eit.HasNext() {
+			e, runner := eit.GetNext()
+			for runner != target && runner != NoNode {
+				deps[runner] = append(deps[runner], ControlDependence{Branch: n, Edge: e})
+				runner = ipdoms[runner]
+			}
+		}
+	}
+	return deps
+}
@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"evergreen/assert"
+	"testing"
+)
+
+func checkDependence(actual []ControlDependence, expected []ControlDependence, t *testing.T) {
+	assert.IntEquals(t, len(actual), len(expected))
+	for i, e := range expected {
+		if actual[i] != e {
+			t.Errorf("%d: %v vs %v", i, actual[i], e)
+		}
+	}
+}
+
+//   0
+//   |
+//   1
+//  / \
+// 2   3
+//  \ /
+//   4
+//   |
+//   5
+func TestPostDominatorDiamond(t *testing.T) {
+	g := CreateGraph()
+	e := g.Entry()
+	x := g.Exit()
+	n1 := g.CreateNode()
+	n2 := g.CreateNode()
+	n3 := g.CreateNode()
+	n4 := g.CreateNode()
+
+	emitFullEdge(g, e, n1)
+	e12 := emitFullEdge(g, n1, n2)
+	e13 := emitFullEdge(g, n1, n3)
+	emitFullEdge(g, n2, n4)
+	emitFullEdge(g, n3, n4)
+	emitFullEdge(g, n4, x)
+
+	order, index := ReversePostorderFromExit(g)
+	checkNodeList(order, []NodeID{x, n4, n3, n2, n1, e}, t)
+
+	ipdoms := FindPostDominators(g, order, index)
+	checkNodeList(ipdoms, []NodeID{n1, x, n4, n4, n4, x}, t)
+
+	pdf := FindPostDominanceFrontiers(g, ipdoms)
+	checkNodeListList(pdf, [][]NodeID{
+		[]NodeID{}, []NodeID{}, []NodeID{}, []NodeID{n1}, []NodeID{n1}, []NodeID{},
+	}, t)
+
+	deps := FindControlDependence(g, ipdoms)
+	checkDependence(deps[n1], []ControlDependence{}, t)
+	checkDependence(deps[n2], []ControlDependence{{Branch: n1, Edge: e12}}, t)
+	checkDependence(deps[n3], []ControlDependence{{Branch: n1, Edge: e13}}, t)
+	checkDependence(deps[n4], []ControlDependence{}, t)
+}
+
+// Returning from two places, and looping back.
+func TestPostDominatorMultipleExits(t *testing.T) {
+	g := CreateGraph()
+	e := g.Entry()
+	x := g.Exit()
+	n1 := g.CreateNode()
+	n2 := g.CreateNode()
+	n3 := g.CreateNode()
+
+	emitFullEdge(g, e, n1)
+	emitFullEdge(g, n1, x)
+	e12 := emitFullEdge(g, n1, n2)
+	e23 := emitFullEdge(g, n2, n3)
+	emitFullEdge(g, n2, x)
+	emitFullEdge(g, n3, n1)
+
+	order, index := ReversePostorderFromExit(g)
+	ipdoms := FindPostDominators(g, order, index)
+	checkNodeList(ipdoms, []NodeID{n1, x, x, x, n1}, t)
+
+	pdf := FindPostDominanceFrontiers(g, ipdoms)
+	checkNodeListList(pdf, [][]NodeID{
+		[]NodeID{}, []NodeID{}, []NodeID{n2}, []NodeID{n1}, []NodeID{n2},
+	}, t)
+
+	deps := FindControlDependence(g, ipdoms)
+	checkDependence(deps[n1], []ControlDependence{{Branch: n2, Edge: e23}}, t)
+	checkDependence(deps[n2], []ControlDependence{{Branch: n1, Edge: e12}}, t)
+	checkDependence(deps[n3], []ControlDependence{{Branch: n2, Edge: e23}}, t)
+}
+
+// Nodes stuck in an infinite loop have no post dominator.
+func TestPostDominatorInfiniteLoop(t *testing.T) {
+	g := CreateGraph()
+	e := g.Entry()
+	x := g.Exit()
+	n1 := g.CreateNode()
+	n2 := g.CreateNode()
+	n3 := g.CreateNode()
+
+	emitFullEdge(g, e, n1)
+	e12 := emitFullEdge(g, n1, n2)
+	emitFullEdge(g, n1, x)
+	emitFullEdge(g, n2, n3)
+	emitFullEdge(g, n3, n2)
+
+	order, index := ReversePostorderFromExit(g)
+	checkNodeList(order, []NodeID{x, n1, e}, t)
+	assert.IntListEquals(t, index, []int{2, 0, 1, -1, -1})
+
+	ipdoms := FindPostDominators(g, order, index)
+	checkNodeList(ipdoms, []NodeID{n1, x, x, NoNode, NoNode}, t)
+
+	deps := FindControlDependence(g, ipdoms)
+	checkDependence(deps[n2], []ControlDependence{{Branch: n1, Edge: e12}}, t)
+	checkDependence(deps[n3], []ControlDependence{}, t)
+}
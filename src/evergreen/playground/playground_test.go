@@ -1,6 +1,7 @@
 package test
 
 import (
+	"encoding/json"
 	"evergreen/assert"
 	"evergreen/dub/runtime"
 	"generated/playground"
@@ -35,6 +36,13 @@ func TestFooProxy(t *testing.T) {
 	assert.IntEquals(t, result, 37)
 }
 
+func TestExportedProxy(t *testing.T) {
+	state := &runtime.State{}
+	result := playground.ExportedProxy(state)
+	assert.IntEquals(t, state.Flow, runtime.NORMAL)
+	assert.IntEquals(t, result, 5)
+}
+
 func TestExplicitSpecialization(t *testing.T) {
 	state := &runtime.State{}
 	result := playground.ExplicitSpecialization(state)
@@ -48,3 +56,227 @@ func TestStringAddition(t *testing.T) {
 	assert.IntEquals(t, state.Flow, runtime.NORMAL)
 	assert.StringEquals(t, result, "foobar")
 }
+
+func TestTwoDigits(t *testing.T) {
+	state := &runtime.State{Stream: []rune("42")}
+	result := playground.TwoDigits(state)
+	assert.IntEquals(t, state.Flow, runtime.NORMAL)
+	assert.IntEquals(t, result, 42)
+
+	state = &runtime.State{Stream: []rune("4x")}
+	playground.TwoDigits(state)
+	assert.IntEquals(t, state.Flow, runtime.FAIL)
+}
+
+func TestIsEven(t *testing.T) {
+	state := &runtime.State{}
+	assert.BoolEquals(t, playground.IsEven(state, 6), true)
+	assert.IntEquals(t, state.Flow, runtime.NORMAL)
+	assert.BoolEquals(t, playground.IsEven(state, 7), false)
+	assert.IntEquals(t, state.Flow, runtime.NORMAL)
+}
+
+func makeShape() playground.Shape {
+	return &playground.Branch{
+		Left: &playground.Leaf{Value: 1},
+		Groups: []*playground.Group{
+			&playground.Group{
+				Children: []playground.Shape{
+					&playground.Leaf{Value: 2},
+					&playground.Branch{Right: &playground.Leaf{Value: 3}},
+				},
+			},
+			nil,
+		},
+	}
+}
+
+func TestWalkShape(t *testing.T) {
+	values := []int{}
+	playground.WalkShape(makeShape(), func(s playground.Shape) bool {
+		switch s := s.(type) {
+		case *playground.Leaf:
+			values = append(values, s.Value)
+		case *playground.Branch:
+			return s.Right == nil
+		}
+		return true
+	})
+	assert.IntListEquals(t, values, []int{1, 2})
+}
+
+func TestRewriteShape(t *testing.T) {
+	order := []int{}
+	result := playground.RewriteShape(makeShape(), func(s playground.Shape) playground.Shape {
+		switch s := s.(type) {
+		case *playground.Leaf:
+			order = append(order, s.Value)
+			return &playground.Leaf{Value: s.Value * 10}
+		case *playground.Branch:
+			order = append(order, 0)
+			if s.Left == nil {
+				return s.Right
+			}
+		}
+		return s
+	})
+	assert.IntListEquals(t, order, []int{1, 2, 3, 0, 0})
+
+	b := result.(*playground.Branch)
+	assert.IntEquals(t, b.Left.(*playground.Leaf).Value, 10)
+	children := b.Groups[0].Children
+	assert.IntEquals(t, children[0].(*playground.Leaf).Value, 20)
+	assert.IntEquals(t, children[1].(*playground.Leaf).Value, 30)
+}
+
+func TestDescribeShape(t *testing.T) {
+	b := &playground.Branch{
+		Left:   &playground.Leaf{Value: 1},
+		Groups: []*playground.Group{&playground.Group{Label: "g"}},
+	}
+	assert.StringEquals(t, b.String(), `Branch{
+  Left: Leaf{
+    Value: 1
+  }
+  Right: nil
+  Groups: []Group{
+    Group{
+      Label: "g"
+      Children: []Shape{}
+    }
+  }
+}`)
+
+	expected := runtime.MakeStruct("Branch",
+		runtime.MakeField("Left", runtime.MakeStruct("Leaf", runtime.MakeField("Value", runtime.Describe(2)))),
+	)
+	d := runtime.Diff(expected, b.Describe())
+	if d == nil {
+		t.Fatal("expected a difference")
+	}
+	assert.StringEquals(t, d.String(), "expected 2, got 1 at path Left.Value")
+}
+
+func TestJSONShape(t *testing.T) {
+	data, err := json.Marshal(makeShape())
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, string(data), `{"$type":"Branch","Left":{"$type":"Leaf","Value":1},"Right":null,"Groups":[{"$type":"Group","Label":"","Children":[{"$type":"Leaf","Value":2},{"$type":"Branch","Left":null,"Right":{"$type":"Leaf","Value":3},"Groups":null}]},null]}`)
+
+	b := &playground.Branch{}
+	err = json.Unmarshal(data, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := runtime.Diff(runtime.Describe(makeShape()), b.Describe())
+	if d != nil {
+		t.Fatal(d)
+	}
+}
+
+func TestJSONNetwork(t *testing.T) {
+	text := `{"$type":"Network","Entry":1,"Closed":[0,null],"Station_Scope":[{"$type":"Station","Name":"a","Next":null},{"$type":"Station","Name":"b","Next":0}]}`
+	n := &playground.Network{}
+	err := json.Unmarshal([]byte(text), n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, n.Entry.Name, "b")
+	if n.Entry.Next != n.Closed[0] || n.Closed[1] != nil {
+		t.Fatal("references were not resolved")
+	}
+	assert.StringEquals(t, n.Entry.Next.Name, "a")
+
+	data, err := json.Marshal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, string(data), text)
+}
+
+func TestJSONErrors(t *testing.T) {
+	n := &playground.Network{}
+	err := json.Unmarshal([]byte(`{"$type":"Network","Entry":2,"Station_Scope":[]}`), n)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	assert.StringEquals(t, err.Error(), "Entry: cannot resolve Station#2")
+
+	b := &playground.Group{}
+	err = json.Unmarshal([]byte(`{"$type":"Group","Children":[{"$type":"Circle"}]}`), b)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	assert.StringEquals(t, err.Error(), "Children[0]: unexpected Circle")
+}
+
+func TestBinaryNetwork(t *testing.T) {
+	text := `{"$type":"Network","Entry":0,"Closed":[1,1,null],"Station_Scope":[{"$type":"Station","Name":"a","Next":1},{"$type":"Station","Name":"b","Next":0}]}`
+	n := &playground.Network{}
+	err := json.Unmarshal([]byte(text), n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := n.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &playground.Network{}
+	err = decoded.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := decoded.Entry.Next
+	assert.StringEquals(t, b.Name, "b")
+	if b.Next != decoded.Entry || decoded.Closed[0] != b || decoded.Closed[1] != b || decoded.Closed[2] != nil {
+		t.Fatal("references were not preserved")
+	}
+
+	// The scope survives with its indexes.
+	data, err = json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, string(data), text)
+}
+
+func TestCloneNetwork(t *testing.T) {
+	text := `{"$type":"Network","Entry":0,"Closed":[1],"Station_Scope":[{"$type":"Station","Name":"a","Next":1},{"$type":"Station","Name":"b","Next":0}]}`
+	n := &playground.Network{}
+	err := json.Unmarshal([]byte(text), n)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := n.Clone()
+	if !c.Equal(n) {
+		t.Fatal("clone should be equal")
+	}
+	if c.Entry == n.Entry || c.Entry.Next.Next != c.Entry || c.Closed[0] != c.Entry.Next {
+		t.Fatal("references were not redirected to the copies")
+	}
+
+	c.Entry.Next.Name = "c"
+	assert.StringEquals(t, n.Entry.Next.Name, "b")
+	if c.Equal(n) {
+		t.Fatal("clone should differ")
+	}
+}
+
+func TestCloneShape(t *testing.T) {
+	s := makeShape().(*playground.Branch)
+	c := s.Clone()
+	if !c.Equal(s) {
+		t.Fatal("clone should be equal")
+	}
+	if c.Groups[0] == s.Groups[0] || c.Groups[1] != nil {
+		t.Fatal("groups were not copied")
+	}
+	c.Groups[0].Children[0].(*playground.Leaf).Value = 5
+	assert.IntEquals(t, s.Groups[0].Children[0].(*playground.Leaf).Value, 2)
+	if c.Equal(s) {
+		t.Fatal("clone should differ")
+	}
+}
@@ -0,0 +1,480 @@
+package asm
+
+import (
+	"evergreen/compiler"
+	"evergreen/trap/interpreter"
+	"evergreen/trap/transform"
+	"fmt"
+	"strconv"
+)
+
+// Indexed by BinOp.
+var binOpMnemonics = []string{"add", "sub", "mul", "div", "rem", "eq", "ne", "lt", "le", "gt", "ge"}
+
+var builtinTypes = map[string]interpreter.Type{
+	"i32":    interpreter.I32Type,
+	"i64":    interpreter.I64Type,
+	"f32":    interpreter.F32Type,
+	"f64":    interpreter.F64Type,
+	"bool":   interpreter.BoolType,
+	"string": interpreter.StringType,
+}
+
+type assembler struct {
+	status   compiler.PassStatus
+	natives  *transform.Registry
+	numFuncs int
+	// Functions with the same name can only be called by index.
+	funcs   map[string][]int
+	structs map[string]*interpreter.StructType
+}
+
+type functionAssembler struct {
+	a      *assembler
+	f      *interpreter.Function
+	labels map[string]int
+}
+
+func operandPos(o Operand) int {
+	switch o := o.(type) {
+	case *RegisterOperand:
+		return o.Pos
+	case *ConstantOperand:
+		return o.Pos
+	case *IntOperand:
+		return o.Pos
+	case *NameOperand:
+		return o.Name.Pos
+	case *ListOperand:
+		return o.Pos
+	case *SumOperand:
+		return o.Pos
+	default:
+		panic(o)
+	}
+}
+
+func (fa *functionAssembler) error(pos int, format string, args ...interface{}) {
+	fa.a.status.LocationError(pos, fmt.Sprintf(format, args...))
+}
+
+func (fa *functionAssembler) reg(o Operand) int {
+	r, ok := o.(*RegisterOperand)
+	if !ok {
+		fa.error(operandPos(o), "Expected a register")
+		return 0
+	}
+	index, err := strconv.Atoi(r.Index)
+	if err != nil || index >= fa.f.NumLocals {
+		fa.error(r.Pos, "r%s is not one of the %d locals", r.Index, fa.f.NumLocals)
+		return 0
+	}
+	return index
+}
+
+func (fa *functionAssembler) regs(operands []Operand) interpreter.Locals {
+	out := make(interpreter.Locals, len(operands))
+	for i, o := range operands {
+		out[i] = fa.reg(o)
+	}
+	return out
+}
+
+func (fa *functionAssembler) constant(o Operand) int {
+	c, ok := o.(*ConstantOperand)
+	if !ok {
+		fa.error(operandPos(o), "Expected a constant")
+		return 0
+	}
+	index, err := strconv.Atoi(c.Index)
+	if err != nil || index >= len(fa.f.Constants) {
+		fa.error(c.Pos, "k%s is not one of the %d constants", c.Index, len(fa.f.Constants))
+		return 0
+	}
+	return index
+}
+
+func (fa *functionAssembler) integer(o Operand) int {
+	i, ok := o.(*IntOperand)
+	if !ok {
+		fa.error(operandPos(o), "Expected an integer")
+		return 0
+	}
+	value, err := strconv.Atoi(i.Text)
+	if err != nil {
+		fa.error(i.Pos, "%s is not a valid integer", i.Text)
+		return 0
+	}
+	return value
+}
+
+func (fa *functionAssembler) label(o Operand) int {
+	n, ok := o.(*NameOperand)
+	if !ok {
+		fa.error(operandPos(o), "Expected a label")
+		return 0
+	}
+	location, ok := fa.labels[n.Name.Text]
+	if !ok {
+		fa.error(n.Name.Pos, "Unknown label %s", n.Name.Text)
+		return 0
+	}
+	return location
+}
+
+func (fa *functionAssembler) function(o Operand) int {
+	switch o := o.(type) {
+	case *IntOperand:
+		uid := fa.integer(o)
+		if uid < 0 || uid >= fa.a.numFuncs {
+			fa.error(o.Pos, "There is no function %d", uid)
+			return 0
+		}
+		return uid
+	case *NameOperand:
+		uids := fa.a.funcs[o.Name.Text]
+		if len(uids) != 1 {
+			if len(uids) == 0 {
+				fa.error(o.Name.Pos, "Unknown function %s", o.Name.Text)
+			} else {
+				fa.error(o.Name.Pos, "%s is ambiguous, call it by index", o.Name.Text)
+			}
+			return 0
+		}
+		return uids[0]
+	default:
+		fa.error(operandPos(o), "Expected a function")
+		return 0
+	}
+}
+
+func (fa *functionAssembler) typeOf(o Operand) interpreter.Type {
+	switch o := o.(type) {
+	case *NameOperand:
+		name := o.Name.Text
+		if t, ok := builtinTypes[name]; ok {
+			return t
+		}
+		st, ok := fa.a.structs[name]
+		if !ok {
+			st = &interpreter.StructType{StructName: name}
+			fa.a.structs[name] = st
+		}
+		return st
+	case *ListOperand:
+		return &interpreter.ListType{Element: fa.typeOf(o.Element)}
+	case *SumOperand:
+		sum := &interpreter.SumType{}
+		for _, v := range o.Variants {
+			sum.Variants = append(sum.Variants, fa.typeOf(v))
+		}
+		return sum
+	default:
+		fa.error(operandPos(o), "Expected a type")
+		return interpreter.I32Type
+	}
+}
+
+func (fa *functionAssembler) structType(o Operand) *interpreter.StructType {
+	st, ok := fa.typeOf(o).(*interpreter.StructType)
+	if !ok {
+		fa.error(operandPos(o), "Expected a struct type")
+		return &interpreter.StructType{}
+	}
+	return st
+}
+
+func (fa *functionAssembler) listType(o Operand) *interpreter.ListType {
+	lt, ok := fa.typeOf(o).(*interpreter.ListType)
+	if !ok {
+		fa.error(operandPos(o), "Expected a list type")
+		return &interpreter.ListType{Element: interpreter.I32Type}
+	}
+	return lt
+}
+
+func (fa *functionAssembler) sumType(o Operand) *interpreter.SumType {
+	sum, ok := fa.typeOf(o).(*interpreter.SumType)
+	if !ok {
+		fa.error(operandPos(o), "Expected a sum type")
+		return &interpreter.SumType{}
+	}
+	return sum
+}
+
+// Checks the number of operands.  A negative count allows any number of
+// operands past the minimum of -count-1.
+func (fa *functionAssembler) arity(inst *Instruction, args int, targets int) bool {
+	check := func(actual int, expected int, what string) bool {
+		if expected >= 0 && actual != expected {
+			fa.error(inst.Op.Pos, "%s takes %d %s, got %d", inst.Op.Text, expected, what, actual)
+			return false
+		}
+		if expected < 0 && actual < -expected-1 {
+			fa.error(inst.Op.Pos, "%s takes at least %d %s, got %d", inst.Op.Text, -expected-1, what, actual)
+			return false
+		}
+		return true
+	}
+	return check(len(inst.Args), args, "arguments") && check(len(inst.Targets), targets, "targets")
+}
+
+func (fa *functionAssembler) assembleOp(inst *Instruction) interpreter.Op {
+	args := inst.Args
+	targets := inst.Targets
+	for i, name := range binOpMnemonics {
+		if inst.Op.Text == name {
+			if !fa.arity(inst, 2, 1) {
+				return nil
+			}
+			return &interpreter.BinaryOp{Op: interpreter.BinOp(i), Left: fa.reg(args[0]), Right: fa.reg(args[1]), Target: fa.reg(targets[0])}
+		}
+	}
+	switch inst.Op.Text {
+	case "storeconst":
+		if fa.arity(inst, 1, 1) {
+			return &interpreter.StoreConst{Const: fa.constant(args[0]), Target: fa.reg(targets[0])}
+		}
+	case "copy":
+		if fa.arity(inst, 1, 1) {
+			return &interpreter.Copy{Src: fa.reg(args[0]), Target: fa.reg(targets[0])}
+		}
+	case "return":
+		if fa.arity(inst, -1, 0) {
+			return &interpreter.Return{Args: fa.regs(args)}
+		}
+	case "jumpif":
+		if fa.arity(inst, 2, 0) {
+			return &interpreter.ConditionalJump{Arg: fa.reg(args[0]), Location: fa.label(args[1])}
+		}
+	case "jump":
+		if fa.arity(inst, 1, 0) {
+			return &interpreter.Jump{Location: fa.label(args[0])}
+		}
+	case "call":
+		if fa.arity(inst, -2, -1) {
+			return &interpreter.Call{Func: fa.function(args[0]), Args: fa.regs(args[1:]), Targets: fa.regs(targets)}
+		}
+	case "getattr":
+		if fa.arity(inst, 2, 1) {
+			return &interpreter.GetAttr{Expr: fa.reg(args[0]), Slot: fa.integer(args[1]), Target: fa.reg(targets[0])}
+		}
+	case "setattr":
+		if fa.arity(inst, 3, 0) {
+			return &interpreter.SetAttr{Expr: fa.reg(args[0]), Slot: fa.integer(args[1]), Value: fa.reg(args[2])}
+		}
+	case "createstruct":
+		if fa.arity(inst, -2, 1) {
+			return &interpreter.CreateStruct{Type: fa.structType(args[0]), Args: fa.regs(args[1:]), Target: fa.reg(targets[0])}
+		}
+	case "createlist":
+		if fa.arity(inst, -2, 1) {
+			return &interpreter.CreateList{Type: fa.listType(args[0]), Args: fa.regs(args[1:]), Target: fa.reg(targets[0])}
+		}
+	case "getindex":
+		if fa.arity(inst, 2, 1) {
+			return &interpreter.GetIndex{List: fa.reg(args[0]), Index: fa.reg(args[1]), Target: fa.reg(targets[0])}
+		}
+	case "setindex":
+		if fa.arity(inst, 3, 0) {
+			return &interpreter.SetIndex{List: fa.reg(args[0]), Index: fa.reg(args[1]), Value: fa.reg(args[2])}
+		}
+	case "append":
+		if fa.arity(inst, 2, 0) {
+			return &interpreter.Append{List: fa.reg(args[0]), Value: fa.reg(args[1])}
+		}
+	case "length":
+		if fa.arity(inst, 1, 1) {
+			return &interpreter.Length{List: fa.reg(args[0]), Target: fa.reg(targets[0])}
+		}
+	case "testtype":
+		if fa.arity(inst, 2, 1) {
+			return &interpreter.TestType{Arg: fa.reg(args[0]), Type: fa.typeOf(args[1]), Target: fa.reg(targets[0])}
+		}
+	case "gettag":
+		if fa.arity(inst, 2, 1) {
+			return &interpreter.GetTag{Arg: fa.reg(args[0]), Sum: fa.sumType(args[1]), Target: fa.reg(targets[0])}
+		}
+	case "fail":
+		if fa.arity(inst, 0, 0) {
+			return &interpreter.Fail{}
+		}
+	case "checkpoint":
+		if fa.arity(inst, 0, 1) {
+			return &interpreter.Checkpoint{Target: fa.reg(targets[0])}
+		}
+	case "recover":
+		if fa.arity(inst, 1, 0) {
+			return &interpreter.Recover{Checkpoint: fa.reg(args[0])}
+		}
+	case "peek":
+		if fa.arity(inst, 0, 1) {
+			return &interpreter.Peek{Target: fa.reg(targets[0])}
+		}
+	case "consume":
+		if fa.arity(inst, 0, 0) {
+			return &interpreter.Consume{}
+		}
+	case "slice":
+		if fa.arity(inst, 2, 1) {
+			return &interpreter.Slice{Begin: fa.reg(args[0]), End: fa.reg(args[1]), Target: fa.reg(targets[0])}
+		}
+	case "convert":
+		if fa.arity(inst, 2, 1) {
+			return &interpreter.Convert{Src: fa.reg(args[0]), Type: fa.typeOf(args[1]), Target: fa.reg(targets[0])}
+		}
+	default:
+		fa.error(inst.Op.Pos, "Unknown op %s", inst.Op.Text)
+	}
+	return nil
+}
+
+func (fa *functionAssembler) assembleConst(c *Const) interpreter.Object {
+	t := c.Type.Text
+	if t == "nil" {
+		if c.Value != nil {
+			fa.error(c.Type.Pos, "nil does not take a value")
+		}
+		return nil
+	}
+	var text string
+	pos := c.Type.Pos
+	switch v := c.Value.(type) {
+	case *NumberLiteral:
+		text, pos = v.Text, v.Pos
+	case *StringLiteral:
+		text, pos = v.Text, v.Pos
+	case *WordLiteral:
+		text, pos = v.Text, v.Pos
+	case nil:
+		fa.error(pos, "%s constant needs a value", t)
+		return nil
+	default:
+		panic(v)
+	}
+
+	var o interpreter.Object
+	var err error
+	switch t {
+	case "i32":
+		var value int64
+		value, err = strconv.ParseInt(text, 10, 32)
+		o = &interpreter.I32{Value: int32(value)}
+	case "i64":
+		var value int64
+		value, err = strconv.ParseInt(text, 10, 64)
+		o = &interpreter.I64{Value: value}
+	case "f32":
+		var value float64
+		value, err = strconv.ParseFloat(text, 32)
+		o = &interpreter.F32{Value: float32(value)}
+	case "f64":
+		var value float64
+		value, err = strconv.ParseFloat(text, 64)
+		o = &interpreter.F64{Value: value}
+	case "bool":
+		var value bool
+		value, err = strconv.ParseBool(text)
+		o = &interpreter.Bool{Value: value}
+	case "string":
+		var value string
+		value, err = strconv.Unquote(text)
+		o = &interpreter.String{Value: value}
+	default:
+		fa.error(c.Type.Pos, "Cannot make a constant of type %s", t)
+		return nil
+	}
+	if err != nil {
+		fa.error(pos, "%s is not a valid %s", text, t)
+	}
+	return o
+}
+
+func (fa *functionAssembler) count(token *Token) int {
+	value, err := strconv.Atoi(token.Text)
+	if err != nil {
+		fa.error(token.Pos, "%s is not a valid count", token.Text)
+	}
+	return value
+}
+
+func (a *assembler) assembleNative(decl *FuncDecl) *interpreter.Function {
+	f := a.natives.Lookup(decl.Name.Text)
+	if f == nil {
+		a.status.LocationError(decl.Name.Pos, fmt.Sprintf("Unknown native %s", decl.Name.Text))
+		return &interpreter.Function{Name: decl.Name.Text}
+	}
+	params, err := strconv.Atoi(decl.Params.Text)
+	if err != nil || params != f.NumParams {
+		a.status.LocationError(decl.Params.Pos, fmt.Sprintf("%s has %d params", f.Name, f.NumParams))
+	}
+	return f
+}
+
+func (a *assembler) assembleFunction(decl *FuncDecl) *interpreter.Function {
+	fa := &functionAssembler{
+		a: a,
+		f: &interpreter.Function{
+			Name:      decl.Name.Text,
+			Constants: []interpreter.Object{},
+			Body:      []interpreter.Op{},
+		},
+		labels: map[string]int{},
+	}
+	f := fa.f
+	f.NumParams = fa.count(decl.Params)
+	f.NumLocals = fa.count(decl.Locals)
+	if f.NumParams > f.NumLocals {
+		fa.error(decl.Params.Pos, "%s has %d params but only %d locals", f.Name, f.NumParams, f.NumLocals)
+	}
+	for _, c := range decl.Consts {
+		f.Constants = append(f.Constants, fa.assembleConst(c))
+	}
+
+	for location, inst := range decl.Body {
+		for _, label := range inst.Labels {
+			if _, ok := fa.labels[label.Text]; ok {
+				fa.error(label.Pos, "Label %s is already defined", label.Text)
+			}
+			fa.labels[label.Text] = location
+		}
+	}
+
+	for location, inst := range decl.Body {
+		f.Body = append(f.Body, fa.assembleOp(inst))
+		if inst.Handler != nil {
+			if f.FailHandlers == nil {
+				f.FailHandlers = map[int]int{}
+			}
+			f.FailHandlers[location] = fa.label(&NameOperand{Name: inst.Handler})
+		}
+	}
+	return f
+}
+
+// Converts parsed assembly into interpreter functions, in the order they are
+// declared.  Native declarations are looked up in the registry by name.
+func Assemble(file *File, natives *transform.Registry, status compiler.PassStatus) []*interpreter.Function {
+	status.Begin()
+	defer status.End()
+
+	a := &assembler{
+		status:   status,
+		natives:  natives,
+		numFuncs: len(file.Funcs),
+		funcs:    map[string][]int{},
+		structs:  map[string]*interpreter.StructType{},
+	}
+	for i, decl := range file.Funcs {
+		a.funcs[decl.Name.Text] = append(a.funcs[decl.Name.Text], i)
+	}
+	funcs := make([]*interpreter.Function, len(file.Funcs))
+	for i, decl := range file.Funcs {
+		if decl.Native {
+			funcs[i] = a.assembleNative(decl)
+		} else {
+			funcs[i] = a.assembleFunction(decl)
+		}
+	}
+	return funcs
+}
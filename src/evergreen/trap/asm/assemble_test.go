@@ -0,0 +1,264 @@
+package asm
+
+import (
+	"evergreen/assert"
+	"evergreen/compiler"
+	"evergreen/dub/flow"
+	dubtransform "evergreen/dub/transform"
+	"evergreen/dub/transform/trap"
+	dubtree "evergreen/dub/tree"
+	"evergreen/trap/interpreter"
+	"evergreen/trap/transform"
+	"evergreen/trap/tree"
+	"testing"
+)
+
+func assembleSource(src string, natives *transform.Registry) ([]*interpreter.Function, compiler.CompileStatus) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	offset := p.AddFile("test.trapasm", []rune(src))
+
+	pass := status.Pass("asm")
+	pass.Begin()
+	defer pass.End()
+	file := ParseAsm([]byte(src), offset, pass.Task("parse"))
+	if file == nil {
+		return nil, status
+	}
+	return Assemble(file, natives, pass.Pass("assemble")), status
+}
+
+func mustAssemble(src string, natives *transform.Registry, t *testing.T) []*interpreter.Function {
+	funcs, status := assembleSource(src, natives)
+	if status.ShouldHalt() {
+		t.Fatalf("could not assemble %#v", src)
+	}
+	return funcs
+}
+
+func lowerSource(src string, t *testing.T) []*interpreter.Function {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+
+	pass := status.Pass("trap")
+	pass.Begin()
+	defer pass.End()
+	program := tree.TrapSourceFrontend(pass.Pass("frontend"), p, "test.trap", []byte(src), nil)
+	if program == nil {
+		t.Fatalf("could not check %#v", src)
+	}
+	funcs := transform.LowerProgram(program, nil, pass.Pass("lower"))
+	if pass.ShouldHalt() {
+		t.Fatalf("could not lower %#v", src)
+	}
+	return funcs
+}
+
+// Disassembling and assembling again should not change the text.
+func checkRoundTrip(funcs []*interpreter.Function, t *testing.T) []*interpreter.Function {
+	text := Disassemble(funcs)
+	again := mustAssemble(text, nil, t)
+	assert.IntEquals(t, len(again), len(funcs))
+	assert.StringEquals(t, Disassemble(again), text)
+	return again
+}
+
+func run(i *interpreter.Interpreter, uid int, input string, args []interpreter.Object, t *testing.T) {
+	i.SetStream([]rune(input))
+	i.SetTemp(args)
+	if err := i.Call(uid); err != nil {
+		t.Fatalf("%s: %s", i.Funcs[uid].Name, err)
+	}
+}
+
+const sample = `func Sum params 1 locals 5 {
+  const i32 0
+  const i32 1
+  storeconst k0 -> r1
+  storeconst k0 -> r2
+  length r0 -> r3
+L3:
+  lt r2, r3 -> r4
+  jumpif r4, L6
+  return r1
+L6:
+  getindex r0, r2 -> r4
+  add r1, r4 -> r1
+  storeconst k1 -> r4
+  add r2, r4 -> r2
+  jump L3
+}
+
+func Main params 0 locals 5 {
+  const i32 2
+  const i32 3
+  const string "a\tb"
+  storeconst k0 -> r0
+  storeconst k1 -> r1
+  createlist []i32, r0, r1, r1 -> r2
+  call Sum, r2 -> r3
+  createstruct Pair, r3, r2 -> r4
+  return r4
+}
+
+func Try params 0 locals 2 {
+  const bool false
+  checkpoint -> r0
+  peek -> r1 else L4
+  consume
+  return r1
+L4:
+  recover r0
+  storeconst k0 -> r1
+  return r1
+}
+`
+
+func TestRoundTrip(t *testing.T) {
+	funcs := mustAssemble(sample, nil, t)
+	assert.StringEquals(t, Disassemble(funcs), sample)
+	assert.IntEquals(t, len(funcs[2].FailHandlers), 1)
+
+	i := interpreter.CreateInterpreter(funcs)
+	run(i, 1, "", []interpreter.Object{}, t)
+	assert.IntEquals(t, i.TempLen, 1)
+	pair := i.Temp[0].(*interpreter.Struct)
+	assert.StringEquals(t, pair.T.Name(), "Pair")
+	assert.IntEquals(t, int(pair.Slots[0].(*interpreter.I32).Value), 8)
+
+	run(i, 2, "x", []interpreter.Object{}, t)
+	assert.IntEquals(t, int(i.Temp[0].(*interpreter.I32).Value), 'x')
+	assert.IntEquals(t, i.Index, 1)
+
+	// The handler recovers the input and returns normally.
+	run(i, 2, "", []interpreter.Object{}, t)
+	assert.IntEquals(t, int(i.Flow), int(interpreter.NORMAL))
+	assert.IntEquals(t, i.Index, 0)
+
+	// Functions named like registers are called by index.
+	funcs = checkRoundTrip(lowerSource("func r1() i32 {return 1}\nfunc F() i32 {return r1()}\n", t), t)
+	assert.StringEquals(t, DisassembleOp(funcs, 1, 0), "call 0 -> r0")
+	assert.StringEquals(t, DisassembleOp(funcs, 1, len(funcs[1].Body)), "<end of function>")
+}
+
+func TestNatives(t *testing.T) {
+	r := transform.CreateRegistry()
+	err := r.Register("len([]T) i32", func(items []interpreter.Object) int32 {
+		return int32(len(items))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := `native len params 1
+
+func Main params 0 locals 2 {
+  createlist []i32, r0, r0 -> r0
+  call len, r0 -> r1
+  return r1
+}
+`
+	funcs := mustAssemble(src, r, t)
+	assert.StringEquals(t, Disassemble(funcs), src)
+
+	i := interpreter.CreateInterpreter(funcs)
+	run(i, 1, "", []interpreter.Object{}, t)
+	assert.IntEquals(t, int(i.Temp[0].(*interpreter.I32).Value), 2)
+
+	for _, src := range []string{"native size params 1\n", "native len params 2\n"} {
+		_, status := assembleSource(src, r)
+		if status.ErrorCount() == 0 {
+			t.Errorf("expected an error for %#v", src)
+		}
+	}
+}
+
+func TestAssembleErrors(t *testing.T) {
+	tests := []struct {
+		Name string
+		Src  string
+	}{
+		{"UnknownLabel", "func F params 0 locals 0 {\n  jump nowhere\n}\n"},
+		{"BadRegister", "func F params 0 locals 1 {\n  return r1\n}\n"},
+		{"BadConstant", "func F params 0 locals 1 {\n  storeconst k0 -> r0\n}\n"},
+		{"UnknownOp", "func F params 0 locals 0 {\n  frobnicate\n}\n"},
+		{"MissingTarget", "func F params 0 locals 2 {\n  add r0, r1\n}\n"},
+		{"UnknownFunc", "func F params 0 locals 0 {\n  call G\n}\n"},
+		{"AmbiguousFunc", "func F params 0 locals 0 {\n  call F\n}\n\nfunc F params 0 locals 0 {\n  return\n}\n"},
+		{"TooManyParams", "func F params 2 locals 1 {\n  return\n}\n"},
+		{"Syntax", "func F params 0 locals 0 {\n  return r0 r1\n}\n"},
+		{"Truncated", "func F params 0 locals 0 {"},
+	}
+	for _, test := range tests {
+		_, status := assembleSource(test.Src, nil)
+		if status.ErrorCount() == 0 {
+			t.Errorf("%s: expected an error", test.Name)
+		}
+	}
+}
+
+func TestDisassembleLowered(t *testing.T) {
+	src := `
+type Point struct {
+	x i64
+	y i64
+}
+
+func Area(p Point) i64 {
+	return p.x * p.y
+}
+
+func Corners(a Point, b Point) []Point {
+	corners := []Point{a, b, a}
+	corners[2] = Point{x: a.x, y: b.y}
+	return corners
+}
+
+func Count(points []Point) i32 {
+	n := 0
+	for p in points {
+		if p.x > p.y {
+			continue
+		}
+		n = n + 1
+	}
+	return n
+}
+
+func Main() i64 {
+	return Area(Point{x: 3, y: 4})
+}
+`
+	checkRoundTrip(lowerSource(src, t), t)
+}
+
+// The dub grammars exercise most of the instruction set.
+func TestDisassembleGrammars(t *testing.T) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	program, coreProg := dubtree.DubProgramFrontend(status.Pass("dub_frontend"), p, "../../../../dubsrc/evergreen")
+	if status.ShouldHalt() {
+		t.Fatal("frontend failed")
+	}
+	flowProgram := dubtransform.LowerProgram(status.Pass("lower"), program, coreProg)
+	flow.TrimFlow(status.Pass("trim_flow"), flowProgram)
+	trapProgram := trap.GenerateTrap(status.Pass("dub_to_trap"), flowProgram)
+	if status.ShouldHalt() {
+		t.Fatal("translation failed")
+	}
+	funcs := checkRoundTrip(trapProgram.Funcs, t)
+
+	uid := -1
+	for i, f := range funcs {
+		if f.Name == "ParseOperand" {
+			uid = i
+		}
+	}
+	if uid < 0 {
+		t.Fatal("no ParseOperand")
+	}
+	i := interpreter.CreateInterpreter(funcs)
+	run(i, uid, "r12", []interpreter.Object{}, t)
+	assert.IntEquals(t, int(i.Flow), int(interpreter.NORMAL))
+	assert.IntEquals(t, i.Index, 3)
+	assert.StringEquals(t, i.Temp[0].(*interpreter.Struct).T.Name(), "RegisterOperand")
+}
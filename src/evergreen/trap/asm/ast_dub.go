@@ -0,0 +1,111 @@
+package asm
+
+type Token struct {
+	Pos  int
+	Text string
+}
+
+type Operand interface {
+	isOperand()
+}
+
+type RegisterOperand struct {
+	Pos   int
+	Index string
+}
+
+func (node *RegisterOperand) isOperand() {
+}
+
+type ConstantOperand struct {
+	Pos   int
+	Index string
+}
+
+func (node *ConstantOperand) isOperand() {
+}
+
+type IntOperand struct {
+	Pos  int
+	Text string
+}
+
+func (node *IntOperand) isOperand() {
+}
+
+type NameOperand struct {
+	Name *Token
+}
+
+func (node *NameOperand) isOperand() {
+}
+
+type ListOperand struct {
+	Pos     int
+	Element Operand
+}
+
+func (node *ListOperand) isOperand() {
+}
+
+type SumOperand struct {
+	Pos      int
+	Variants []Operand
+}
+
+func (node *SumOperand) isOperand() {
+}
+
+type Literal interface {
+	isLiteral()
+}
+
+type NumberLiteral struct {
+	Pos  int
+	Text string
+}
+
+func (node *NumberLiteral) isLiteral() {
+}
+
+type StringLiteral struct {
+	Pos  int
+	Text string
+}
+
+func (node *StringLiteral) isLiteral() {
+}
+
+type WordLiteral struct {
+	Pos  int
+	Text string
+}
+
+func (node *WordLiteral) isLiteral() {
+}
+
+type Const struct {
+	Type  *Token
+	Value Literal
+}
+
+type Instruction struct {
+	Labels  []*Token
+	Op      *Token
+	Args    []Operand
+	Targets []Operand
+	Handler *Token
+}
+
+type FuncDecl struct {
+	Name   *Token
+	Native bool
+	Params *Token
+	Locals *Token
+	Consts []*Const
+	Body   []*Instruction
+}
+
+type File struct {
+	Funcs []*FuncDecl
+}
@@ -0,0 +1,228 @@
+package asm
+
+import (
+	"bytes"
+	"evergreen/trap/interpreter"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var identifier = regexp.MustCompile("^[a-zA-Z_][a-zA-Z_0-9]*$")
+
+// Names that would be read back as registers or constants.
+var operandName = regexp.MustCompile("^[rk][0-9]+$")
+
+// Reports whether a call can refer to a function by this name.
+func callableName(name string) bool {
+	return identifier.MatchString(name) && !operandName.MatchString(name) && name != "else"
+}
+
+type disassembler struct {
+	// How calls refer to each function.
+	funcNames []string
+	buf       bytes.Buffer
+}
+
+func reg(index int) string {
+	return fmt.Sprintf("r%d", index)
+}
+
+func regs(locals interpreter.Locals) []string {
+	out := make([]string, len(locals))
+	for i, lcl := range locals {
+		out[i] = reg(lcl)
+	}
+	return out
+}
+
+func label(location int) string {
+	return fmt.Sprintf("L%d", location)
+}
+
+func typeText(t interpreter.Type) string {
+	switch t := t.(type) {
+	case *interpreter.ListType:
+		return "[]" + typeText(t.Element)
+	case *interpreter.SumType:
+		variants := make([]string, len(t.Variants))
+		for i, v := range t.Variants {
+			variants[i] = typeText(v)
+		}
+		return "(" + strings.Join(variants, " | ") + ")"
+	default:
+		return t.Name()
+	}
+}
+
+func constText(o interpreter.Object) string {
+	switch o := o.(type) {
+	case nil:
+		return "nil"
+	case *interpreter.I32:
+		return "i32 " + strconv.FormatInt(int64(o.Value), 10)
+	case *interpreter.I64:
+		return "i64 " + strconv.FormatInt(o.Value, 10)
+	case *interpreter.F32:
+		return "f32 " + strconv.FormatFloat(float64(o.Value), 'g', -1, 32)
+	case *interpreter.F64:
+		return "f64 " + strconv.FormatFloat(o.Value, 'g', -1, 64)
+	case *interpreter.Bool:
+		return "bool " + strconv.FormatBool(o.Value)
+	case *interpreter.String:
+		return "string " + strconv.Quote(o.Value)
+	default:
+		panic(o)
+	}
+}
+
+// Returns the mnemonic, arguments, and targets of an op.
+func (d *disassembler) opText(op interpreter.Op) (string, []string, []string) {
+	switch op := op.(type) {
+	case *interpreter.BinaryOp:
+		return binOpMnemonics[op.Op], []string{reg(op.Left), reg(op.Right)}, []string{reg(op.Target)}
+	case *interpreter.StoreConst:
+		return "storeconst", []string{fmt.Sprintf("k%d", op.Const)}, []string{reg(op.Target)}
+	case *interpreter.Copy:
+		return "copy", []string{reg(op.Src)}, []string{reg(op.Target)}
+	case *interpreter.Return:
+		return "return", regs(op.Args), nil
+	case *interpreter.ConditionalJump:
+		return "jumpif", []string{reg(op.Arg), label(op.Location)}, nil
+	case *interpreter.Jump:
+		return "jump", []string{label(op.Location)}, nil
+	case *interpreter.Call:
+		return "call", append([]string{d.funcNames[op.Func]}, regs(op.Args)...), regs(op.Targets)
+	case *interpreter.GetAttr:
+		return "getattr", []string{reg(op.Expr), strconv.Itoa(op.Slot)}, []string{reg(op.Target)}
+	case *interpreter.SetAttr:
+		return "setattr", []string{reg(op.Expr), strconv.Itoa(op.Slot), reg(op.Value)}, nil
+	case *interpreter.CreateStruct:
+		return "createstruct", append([]string{typeText(op.Type)}, regs(op.Args)...), []string{reg(op.Target)}
+	case *interpreter.CreateList:
+		return "createlist", append([]string{typeText(op.Type)}, regs(op.Args)...), []string{reg(op.Target)}
+	case *interpreter.GetIndex:
+		return "getindex", []string{reg(op.List), reg(op.Index)}, []string{reg(op.Target)}
+	case *interpreter.SetIndex:
+		return "setindex", []string{reg(op.List), reg(op.Index), reg(op.Value)}, nil
+	case *interpreter.Append:
+		return "append", []string{reg(op.List), reg(op.Value)}, nil
+	case *interpreter.Length:
+		return "length", []string{reg(op.List)}, []string{reg(op.Target)}
+	case *interpreter.TestType:
+		return "testtype", []string{reg(op.Arg), typeText(op.Type)}, []string{reg(op.Target)}
+	case *interpreter.GetTag:
+		return "gettag", []string{reg(op.Arg), typeText(op.Sum)}, []string{reg(op.Target)}
+	case *interpreter.Fail:
+		return "fail", nil, nil
+	case *interpreter.Checkpoint:
+		return "checkpoint", nil, []string{reg(op.Target)}
+	case *interpreter.Recover:
+		return "recover", []string{reg(op.Checkpoint)}, nil
+	case *interpreter.Peek:
+		return "peek", nil, []string{reg(op.Target)}
+	case *interpreter.Consume:
+		return "consume", nil, nil
+	case *interpreter.Slice:
+		return "slice", []string{reg(op.Begin), reg(op.End)}, []string{reg(op.Target)}
+	case *interpreter.Convert:
+		return "convert", []string{reg(op.Src), typeText(op.Type)}, []string{reg(op.Target)}
+	default:
+		panic(op)
+	}
+}
+
+// The locations that need labels.
+func jumpTargets(f *interpreter.Function) map[int]bool {
+	targets := map[int]bool{}
+	for _, op := range f.Body {
+		switch op := op.(type) {
+		case *interpreter.ConditionalJump:
+			targets[op.Location] = true
+		case *interpreter.Jump:
+			targets[op.Location] = true
+		}
+	}
+	for _, handler := range f.FailHandlers {
+		targets[handler] = true
+	}
+	return targets
+}
+
+func (d *disassembler) instruction(f *interpreter.Function, location int) string {
+	mnemonic, args, results := d.opText(f.Body[location])
+	text := mnemonic
+	if len(args) > 0 {
+		text += " " + strings.Join(args, ", ")
+	}
+	if len(results) > 0 {
+		text += " -> " + strings.Join(results, ", ")
+	}
+	if handler, ok := f.FailHandlers[location]; ok {
+		text += " else " + label(handler)
+	}
+	return text
+}
+
+func (d *disassembler) function(f *interpreter.Function) {
+	if f.Native != nil {
+		fmt.Fprintf(&d.buf, "native %s params %d\n", f.Name, f.NumParams)
+		return
+	}
+	fmt.Fprintf(&d.buf, "func %s params %d locals %d {\n", f.Name, f.NumParams, f.NumLocals)
+	for _, c := range f.Constants {
+		fmt.Fprintf(&d.buf, "  const %s\n", constText(c))
+	}
+	targets := jumpTargets(f)
+	for location := range f.Body {
+		if targets[location] {
+			fmt.Fprintf(&d.buf, "%s:\n", label(location))
+		}
+		d.buf.WriteString("  ")
+		d.buf.WriteString(d.instruction(f, location))
+		d.buf.WriteString("\n")
+	}
+	d.buf.WriteString("}\n")
+}
+
+func createDisassembler(funcs []*interpreter.Function) *disassembler {
+	d := &disassembler{funcNames: make([]string, len(funcs))}
+	count := map[string]int{}
+	for _, f := range funcs {
+		count[f.Name] += 1
+	}
+	for i, f := range funcs {
+		if count[f.Name] == 1 && callableName(f.Name) {
+			d.funcNames[i] = f.Name
+		} else {
+			d.funcNames[i] = strconv.Itoa(i)
+		}
+	}
+	return d
+}
+
+// Writes functions in the assembly syntax Assemble reads.  Calls refer to
+// functions by name, unless the name is shared or would be read as something
+// else, and struct types are written by name, so distinct struct types with
+// the same name are merged when the output is assembled again.
+func Disassemble(funcs []*interpreter.Function) string {
+	d := createDisassembler(funcs)
+	for i, f := range funcs {
+		if i > 0 {
+			d.buf.WriteString("\n")
+		}
+		d.function(f)
+	}
+	return d.buf.String()
+}
+
+// Formats a single op of funcs[uid] the way Disassemble would, for showing
+// where a program is stopped.  A function without a final return can stop
+// past its last op.
+func DisassembleOp(funcs []*interpreter.Function, uid int, location int) string {
+	if location >= len(funcs[uid].Body) {
+		return "<end of function>"
+	}
+	return createDisassembler(funcs).instruction(funcs[uid], location)
+}
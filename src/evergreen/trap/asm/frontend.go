@@ -0,0 +1,30 @@
+package asm
+
+import (
+	"evergreen/compiler"
+	"evergreen/trap/interpreter"
+	"evergreen/trap/transform"
+	"io/ioutil"
+)
+
+func AssemblyFrontend(status compiler.PassStatus, p compiler.LocationProvider, filename string, natives *transform.Registry) []*interpreter.Function {
+	status.Begin()
+	defer status.End()
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		status.GlobalError(err.Error())
+		return nil
+	}
+	offset := p.AddFile(filename, []rune(string(data)))
+	file := ParseAsm(data, offset, status.Task("parse"))
+	if status.ShouldHalt() {
+		return nil
+	}
+
+	funcs := Assemble(file, natives, status.Pass("assemble"))
+	if status.ShouldHalt() {
+		return nil
+	}
+	return funcs
+}
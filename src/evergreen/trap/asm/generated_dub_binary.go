@@ -0,0 +1,890 @@
+package asm
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *Token) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/Token") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *Token) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readTokenBinary(d *runtime.BinaryDecoder, o interface{}) *Token {
+	var node *Token
+	if o != nil {
+		return o.(*Token)
+	}
+	node = &Token{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Token) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "43b8743951c6d823")
+}
+
+func (node *Token) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "43b8743951c6d823", "trap/asm/Token", node)
+}
+
+func DecodeTokenBinary(d *runtime.BinaryDecoder) *Token {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/Token" {
+		return readTokenBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeOperandBinary(d *runtime.BinaryDecoder) Operand {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/RegisterOperand" {
+		return readRegisterOperandBinary(d, o)
+	}
+	if t == "trap/asm/ConstantOperand" {
+		return readConstantOperandBinary(d, o)
+	}
+	if t == "trap/asm/IntOperand" {
+		return readIntOperandBinary(d, o)
+	}
+	if t == "trap/asm/NameOperand" {
+		return readNameOperandBinary(d, o)
+	}
+	if t == "trap/asm/ListOperand" {
+		return readListOperandBinary(d, o)
+	}
+	if t == "trap/asm/SumOperand" {
+		return readSumOperandBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *RegisterOperand) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/RegisterOperand") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Index)
+}
+
+func (node *RegisterOperand) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Index = d.ReadString()
+}
+
+func readRegisterOperandBinary(d *runtime.BinaryDecoder, o interface{}) *RegisterOperand {
+	var node *RegisterOperand
+	if o != nil {
+		return o.(*RegisterOperand)
+	}
+	node = &RegisterOperand{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *RegisterOperand) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7fb37d941b690dce")
+}
+
+func (node *RegisterOperand) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7fb37d941b690dce", "trap/asm/RegisterOperand", node)
+}
+
+func DecodeRegisterOperandBinary(d *runtime.BinaryDecoder) *RegisterOperand {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/RegisterOperand" {
+		return readRegisterOperandBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ConstantOperand) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/ConstantOperand") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Index)
+}
+
+func (node *ConstantOperand) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Index = d.ReadString()
+}
+
+func readConstantOperandBinary(d *runtime.BinaryDecoder, o interface{}) *ConstantOperand {
+	var node *ConstantOperand
+	if o != nil {
+		return o.(*ConstantOperand)
+	}
+	node = &ConstantOperand{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ConstantOperand) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ea4fe31b685f3c41")
+}
+
+func (node *ConstantOperand) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ea4fe31b685f3c41", "trap/asm/ConstantOperand", node)
+}
+
+func DecodeConstantOperandBinary(d *runtime.BinaryDecoder) *ConstantOperand {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/ConstantOperand" {
+		return readConstantOperandBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *IntOperand) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/IntOperand") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *IntOperand) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readIntOperandBinary(d *runtime.BinaryDecoder, o interface{}) *IntOperand {
+	var node *IntOperand
+	if o != nil {
+		return o.(*IntOperand)
+	}
+	node = &IntOperand{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *IntOperand) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9a9b574f18be513d")
+}
+
+func (node *IntOperand) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9a9b574f18be513d", "trap/asm/IntOperand", node)
+}
+
+func DecodeIntOperandBinary(d *runtime.BinaryDecoder) *IntOperand {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/IntOperand" {
+		return readIntOperandBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NameOperand) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/NameOperand") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+}
+
+func (node *NameOperand) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeTokenBinary(d)
+}
+
+func readNameOperandBinary(d *runtime.BinaryDecoder, o interface{}) *NameOperand {
+	var node *NameOperand
+	if o != nil {
+		return o.(*NameOperand)
+	}
+	node = &NameOperand{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NameOperand) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "fe3b6ebf54dd1125")
+}
+
+func (node *NameOperand) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "fe3b6ebf54dd1125", "trap/asm/NameOperand", node)
+}
+
+func DecodeNameOperandBinary(d *runtime.BinaryDecoder) *NameOperand {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/NameOperand" {
+		return readNameOperandBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ListOperand) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/ListOperand") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	runtime.EncodeBinary(e, node.Element)
+}
+
+func (node *ListOperand) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Element = DecodeOperandBinary(d)
+}
+
+func readListOperandBinary(d *runtime.BinaryDecoder, o interface{}) *ListOperand {
+	var node *ListOperand
+	if o != nil {
+		return o.(*ListOperand)
+	}
+	node = &ListOperand{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ListOperand) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "1c21a43777f37fcc")
+}
+
+func (node *ListOperand) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "1c21a43777f37fcc", "trap/asm/ListOperand", node)
+}
+
+func DecodeListOperandBinary(d *runtime.BinaryDecoder) *ListOperand {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/ListOperand" {
+		return readListOperandBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SumOperand) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Operand
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/SumOperand") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	if node.Variants == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Variants))
+		for _, x = range node.Variants {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *SumOperand) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Operand
+	node.Pos = d.ReadInt()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Operand{}
+		for range n {
+			s = append(s, DecodeOperandBinary(d))
+		}
+	}
+	node.Variants = s
+}
+
+func readSumOperandBinary(d *runtime.BinaryDecoder, o interface{}) *SumOperand {
+	var node *SumOperand
+	if o != nil {
+		return o.(*SumOperand)
+	}
+	node = &SumOperand{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SumOperand) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "1c21a43777f37fcc")
+}
+
+func (node *SumOperand) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "1c21a43777f37fcc", "trap/asm/SumOperand", node)
+}
+
+func DecodeSumOperandBinary(d *runtime.BinaryDecoder) *SumOperand {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/SumOperand" {
+		return readSumOperandBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeLiteralBinary(d *runtime.BinaryDecoder) Literal {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/NumberLiteral" {
+		return readNumberLiteralBinary(d, o)
+	}
+	if t == "trap/asm/StringLiteral" {
+		return readStringLiteralBinary(d, o)
+	}
+	if t == "trap/asm/WordLiteral" {
+		return readWordLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NumberLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/NumberLiteral") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *NumberLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readNumberLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *NumberLiteral {
+	var node *NumberLiteral
+	if o != nil {
+		return o.(*NumberLiteral)
+	}
+	node = &NumberLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NumberLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f027cabcbf44f8b7")
+}
+
+func (node *NumberLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f027cabcbf44f8b7", "trap/asm/NumberLiteral", node)
+}
+
+func DecodeNumberLiteralBinary(d *runtime.BinaryDecoder) *NumberLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/NumberLiteral" {
+		return readNumberLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StringLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/StringLiteral") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *StringLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readStringLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *StringLiteral {
+	var node *StringLiteral
+	if o != nil {
+		return o.(*StringLiteral)
+	}
+	node = &StringLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StringLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "874b6f19f3f05663")
+}
+
+func (node *StringLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "874b6f19f3f05663", "trap/asm/StringLiteral", node)
+}
+
+func DecodeStringLiteralBinary(d *runtime.BinaryDecoder) *StringLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/StringLiteral" {
+		return readStringLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *WordLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/WordLiteral") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *WordLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readWordLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *WordLiteral {
+	var node *WordLiteral
+	if o != nil {
+		return o.(*WordLiteral)
+	}
+	node = &WordLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *WordLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "6644daa280860736")
+}
+
+func (node *WordLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "6644daa280860736", "trap/asm/WordLiteral", node)
+}
+
+func DecodeWordLiteralBinary(d *runtime.BinaryDecoder) *WordLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/WordLiteral" {
+		return readWordLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Const) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/Const") {
+		return
+	}
+	node.Type.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Value)
+}
+
+func (node *Const) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = DecodeTokenBinary(d)
+	node.Value = DecodeLiteralBinary(d)
+}
+
+func readConstBinary(d *runtime.BinaryDecoder, o interface{}) *Const {
+	var node *Const
+	if o != nil {
+		return o.(*Const)
+	}
+	node = &Const{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Const) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "0f047b37870f44ad")
+}
+
+func (node *Const) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "0f047b37870f44ad", "trap/asm/Const", node)
+}
+
+func DecodeConstBinary(d *runtime.BinaryDecoder) *Const {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/Const" {
+		return readConstBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Instruction) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Token
+	var x1 Operand
+	var x2 Operand
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/Instruction") {
+		return
+	}
+	if node.Labels == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Labels))
+		for _, x0 = range node.Labels {
+			x0.EncodeBinary(e)
+		}
+	}
+	node.Op.EncodeBinary(e)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x1 = range node.Args {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+	if node.Targets == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Targets))
+		for _, x2 = range node.Targets {
+			runtime.EncodeBinary(e, x2)
+		}
+	}
+	node.Handler.EncodeBinary(e)
+}
+
+func (node *Instruction) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Token
+	var n1 int
+	var s1 []Operand
+	var n2 int
+	var s2 []Operand
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Token{}
+		for range n0 {
+			s0 = append(s0, DecodeTokenBinary(d))
+		}
+	}
+	node.Labels = s0
+	node.Op = DecodeTokenBinary(d)
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []Operand{}
+		for range n1 {
+			s1 = append(s1, DecodeOperandBinary(d))
+		}
+	}
+	node.Args = s1
+	n2 = d.ReadLength()
+	s2 = nil
+	if n2 >= 0 {
+		s2 = []Operand{}
+		for range n2 {
+			s2 = append(s2, DecodeOperandBinary(d))
+		}
+	}
+	node.Targets = s2
+	node.Handler = DecodeTokenBinary(d)
+}
+
+func readInstructionBinary(d *runtime.BinaryDecoder, o interface{}) *Instruction {
+	var node *Instruction
+	if o != nil {
+		return o.(*Instruction)
+	}
+	node = &Instruction{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Instruction) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7dc186d5d79b8193")
+}
+
+func (node *Instruction) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7dc186d5d79b8193", "trap/asm/Instruction", node)
+}
+
+func DecodeInstructionBinary(d *runtime.BinaryDecoder) *Instruction {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/Instruction" {
+		return readInstructionBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FuncDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Const
+	var x1 *Instruction
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/FuncDecl") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	e.WriteBool(node.Native)
+	node.Params.EncodeBinary(e)
+	node.Locals.EncodeBinary(e)
+	if node.Consts == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Consts))
+		for _, x0 = range node.Consts {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.Body == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Body))
+		for _, x1 = range node.Body {
+			x1.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *FuncDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Const
+	var n1 int
+	var s1 []*Instruction
+	node.Name = DecodeTokenBinary(d)
+	node.Native = d.ReadBool()
+	node.Params = DecodeTokenBinary(d)
+	node.Locals = DecodeTokenBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Const{}
+		for range n0 {
+			s0 = append(s0, DecodeConstBinary(d))
+		}
+	}
+	node.Consts = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []*Instruction{}
+		for range n1 {
+			s1 = append(s1, DecodeInstructionBinary(d))
+		}
+	}
+	node.Body = s1
+}
+
+func readFuncDeclBinary(d *runtime.BinaryDecoder, o interface{}) *FuncDecl {
+	var node *FuncDecl
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	node = &FuncDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FuncDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "80eaf757e9f689ab")
+}
+
+func (node *FuncDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "80eaf757e9f689ab", "trap/asm/FuncDecl", node)
+}
+
+func DecodeFuncDeclBinary(d *runtime.BinaryDecoder) *FuncDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/FuncDecl" {
+		return readFuncDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *File) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *FuncDecl
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/asm/File") {
+		return
+	}
+	if node.Funcs == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Funcs))
+		for _, x = range node.Funcs {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *File) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*FuncDecl
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*FuncDecl{}
+		for range n {
+			s = append(s, DecodeFuncDeclBinary(d))
+		}
+	}
+	node.Funcs = s
+}
+
+func readFileBinary(d *runtime.BinaryDecoder, o interface{}) *File {
+	var node *File
+	if o != nil {
+		return o.(*File)
+	}
+	node = &File{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *File) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "364c32b351ec38c9")
+}
+
+func (node *File) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "364c32b351ec38c9", "trap/asm/File", node)
+}
+
+func DecodeFileBinary(d *runtime.BinaryDecoder) *File {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/asm/File" {
+		return readFileBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
@@ -0,0 +1,1028 @@
+package asm
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *Token) Clone() *Token {
+	var c *runtime.Cloner
+	var clone *Token
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Token{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Token) clone(c *runtime.Cloner) *Token {
+	var o interface{}
+	var clone *Token
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Token)
+	}
+	clone = &Token{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Token) cloneFields(c *runtime.Cloner, clone *Token) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *Token) Equal(other *Token) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Token) equal(c *runtime.Comparer, other *Token) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func cloneOperand(c *runtime.Cloner, node Operand) Operand {
+	switch node.(type) {
+	case *RegisterOperand:
+		return node.(*RegisterOperand).clone(c)
+	case *ConstantOperand:
+		return node.(*ConstantOperand).clone(c)
+	case *IntOperand:
+		return node.(*IntOperand).clone(c)
+	case *NameOperand:
+		return node.(*NameOperand).clone(c)
+	case *ListOperand:
+		return node.(*ListOperand).clone(c)
+	case *SumOperand:
+		return node.(*SumOperand).clone(c)
+	}
+	return node
+}
+
+func equalOperand(c *runtime.Comparer, a Operand, b Operand) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *RegisterOperand:
+		switch b.(type) {
+		case *RegisterOperand:
+			return a.(*RegisterOperand).equal(c, b.(*RegisterOperand))
+		}
+	case *ConstantOperand:
+		switch b.(type) {
+		case *ConstantOperand:
+			return a.(*ConstantOperand).equal(c, b.(*ConstantOperand))
+		}
+	case *IntOperand:
+		switch b.(type) {
+		case *IntOperand:
+			return a.(*IntOperand).equal(c, b.(*IntOperand))
+		}
+	case *NameOperand:
+		switch b.(type) {
+		case *NameOperand:
+			return a.(*NameOperand).equal(c, b.(*NameOperand))
+		}
+	case *ListOperand:
+		switch b.(type) {
+		case *ListOperand:
+			return a.(*ListOperand).equal(c, b.(*ListOperand))
+		}
+	case *SumOperand:
+		switch b.(type) {
+		case *SumOperand:
+			return a.(*SumOperand).equal(c, b.(*SumOperand))
+		}
+	}
+	return a == b
+}
+
+func (node *RegisterOperand) Clone() *RegisterOperand {
+	var c *runtime.Cloner
+	var clone *RegisterOperand
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &RegisterOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RegisterOperand) clone(c *runtime.Cloner) *RegisterOperand {
+	var o interface{}
+	var clone *RegisterOperand
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*RegisterOperand)
+	}
+	clone = &RegisterOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *RegisterOperand) cloneFields(c *runtime.Cloner, clone *RegisterOperand) {
+	clone.Pos = node.Pos
+	clone.Index = node.Index
+}
+
+func (node *RegisterOperand) Equal(other *RegisterOperand) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *RegisterOperand) equal(c *runtime.Comparer, other *RegisterOperand) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+func (node *ConstantOperand) Clone() *ConstantOperand {
+	var c *runtime.Cloner
+	var clone *ConstantOperand
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ConstantOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantOperand) clone(c *runtime.Cloner) *ConstantOperand {
+	var o interface{}
+	var clone *ConstantOperand
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ConstantOperand)
+	}
+	clone = &ConstantOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ConstantOperand) cloneFields(c *runtime.Cloner, clone *ConstantOperand) {
+	clone.Pos = node.Pos
+	clone.Index = node.Index
+}
+
+func (node *ConstantOperand) Equal(other *ConstantOperand) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ConstantOperand) equal(c *runtime.Comparer, other *ConstantOperand) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Index != other.Index {
+		return false
+	}
+	return true
+}
+
+func (node *IntOperand) Clone() *IntOperand {
+	var c *runtime.Cloner
+	var clone *IntOperand
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &IntOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntOperand) clone(c *runtime.Cloner) *IntOperand {
+	var o interface{}
+	var clone *IntOperand
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*IntOperand)
+	}
+	clone = &IntOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntOperand) cloneFields(c *runtime.Cloner, clone *IntOperand) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *IntOperand) Equal(other *IntOperand) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *IntOperand) equal(c *runtime.Comparer, other *IntOperand) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *NameOperand) Clone() *NameOperand {
+	var c *runtime.Cloner
+	var clone *NameOperand
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NameOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NameOperand) clone(c *runtime.Cloner) *NameOperand {
+	var o interface{}
+	var clone *NameOperand
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NameOperand)
+	}
+	clone = &NameOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NameOperand) cloneFields(c *runtime.Cloner, clone *NameOperand) {
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *NameOperand) Equal(other *NameOperand) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NameOperand) equal(c *runtime.Comparer, other *NameOperand) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *ListOperand) Clone() *ListOperand {
+	var c *runtime.Cloner
+	var clone *ListOperand
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ListOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListOperand) clone(c *runtime.Cloner) *ListOperand {
+	var o interface{}
+	var clone *ListOperand
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ListOperand)
+	}
+	clone = &ListOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListOperand) cloneFields(c *runtime.Cloner, clone *ListOperand) {
+	clone.Pos = node.Pos
+	clone.Element = cloneOperand(c, node.Element)
+}
+
+func (node *ListOperand) Equal(other *ListOperand) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ListOperand) equal(c *runtime.Comparer, other *ListOperand) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if !equalOperand(c, node.Element, other.Element) {
+		return false
+	}
+	return true
+}
+
+func (node *SumOperand) Clone() *SumOperand {
+	var c *runtime.Cloner
+	var clone *SumOperand
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SumOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SumOperand) clone(c *runtime.Cloner) *SumOperand {
+	var o interface{}
+	var clone *SumOperand
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SumOperand)
+	}
+	clone = &SumOperand{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SumOperand) cloneFields(c *runtime.Cloner, clone *SumOperand) {
+	var s []Operand
+	var e Operand
+	clone.Pos = node.Pos
+	s = nil
+	if node.Variants != nil {
+		s = []Operand{}
+		for _, e = range node.Variants {
+			s = append(s, cloneOperand(c, e))
+		}
+	}
+	clone.Variants = s
+}
+
+func (node *SumOperand) Equal(other *SumOperand) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SumOperand) equal(c *runtime.Comparer, other *SumOperand) bool {
+	var i int
+	var e Operand
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if len(node.Variants) != len(other.Variants) || node.Variants == nil != (other.Variants == nil) {
+		return false
+	}
+	for i, e = range node.Variants {
+		if !equalOperand(c, e, other.Variants[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneLiteral(c *runtime.Cloner, node Literal) Literal {
+	switch node.(type) {
+	case *NumberLiteral:
+		return node.(*NumberLiteral).clone(c)
+	case *StringLiteral:
+		return node.(*StringLiteral).clone(c)
+	case *WordLiteral:
+		return node.(*WordLiteral).clone(c)
+	}
+	return node
+}
+
+func equalLiteral(c *runtime.Comparer, a Literal, b Literal) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *NumberLiteral:
+		switch b.(type) {
+		case *NumberLiteral:
+			return a.(*NumberLiteral).equal(c, b.(*NumberLiteral))
+		}
+	case *StringLiteral:
+		switch b.(type) {
+		case *StringLiteral:
+			return a.(*StringLiteral).equal(c, b.(*StringLiteral))
+		}
+	case *WordLiteral:
+		switch b.(type) {
+		case *WordLiteral:
+			return a.(*WordLiteral).equal(c, b.(*WordLiteral))
+		}
+	}
+	return a == b
+}
+
+func (node *NumberLiteral) Clone() *NumberLiteral {
+	var c *runtime.Cloner
+	var clone *NumberLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NumberLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NumberLiteral) clone(c *runtime.Cloner) *NumberLiteral {
+	var o interface{}
+	var clone *NumberLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NumberLiteral)
+	}
+	clone = &NumberLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NumberLiteral) cloneFields(c *runtime.Cloner, clone *NumberLiteral) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *NumberLiteral) Equal(other *NumberLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NumberLiteral) equal(c *runtime.Comparer, other *NumberLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *StringLiteral) Clone() *StringLiteral {
+	var c *runtime.Cloner
+	var clone *StringLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StringLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteral) clone(c *runtime.Cloner) *StringLiteral {
+	var o interface{}
+	var clone *StringLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StringLiteral)
+	}
+	clone = &StringLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StringLiteral) cloneFields(c *runtime.Cloner, clone *StringLiteral) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *StringLiteral) Equal(other *StringLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StringLiteral) equal(c *runtime.Comparer, other *StringLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *WordLiteral) Clone() *WordLiteral {
+	var c *runtime.Cloner
+	var clone *WordLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &WordLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *WordLiteral) clone(c *runtime.Cloner) *WordLiteral {
+	var o interface{}
+	var clone *WordLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*WordLiteral)
+	}
+	clone = &WordLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *WordLiteral) cloneFields(c *runtime.Cloner, clone *WordLiteral) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *WordLiteral) Equal(other *WordLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *WordLiteral) equal(c *runtime.Comparer, other *WordLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *Const) Clone() *Const {
+	var c *runtime.Cloner
+	var clone *Const
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Const{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Const) clone(c *runtime.Cloner) *Const {
+	var o interface{}
+	var clone *Const
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Const)
+	}
+	clone = &Const{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Const) cloneFields(c *runtime.Cloner, clone *Const) {
+	clone.Type = node.Type.clone(c)
+	clone.Value = cloneLiteral(c, node.Value)
+}
+
+func (node *Const) Equal(other *Const) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Const) equal(c *runtime.Comparer, other *Const) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Type.equal(c, other.Type) {
+		return false
+	}
+	if !equalLiteral(c, node.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+func (node *Instruction) Clone() *Instruction {
+	var c *runtime.Cloner
+	var clone *Instruction
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Instruction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Instruction) clone(c *runtime.Cloner) *Instruction {
+	var o interface{}
+	var clone *Instruction
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Instruction)
+	}
+	clone = &Instruction{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Instruction) cloneFields(c *runtime.Cloner, clone *Instruction) {
+	var s0 []*Token
+	var e0 *Token
+	var s1 []Operand
+	var e1 Operand
+	var s2 []Operand
+	var e2 Operand
+	s0 = nil
+	if node.Labels != nil {
+		s0 = []*Token{}
+		for _, e0 = range node.Labels {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Labels = s0
+	clone.Op = node.Op.clone(c)
+	s1 = nil
+	if node.Args != nil {
+		s1 = []Operand{}
+		for _, e1 = range node.Args {
+			s1 = append(s1, cloneOperand(c, e1))
+		}
+	}
+	clone.Args = s1
+	s2 = nil
+	if node.Targets != nil {
+		s2 = []Operand{}
+		for _, e2 = range node.Targets {
+			s2 = append(s2, cloneOperand(c, e2))
+		}
+	}
+	clone.Targets = s2
+	clone.Handler = node.Handler.clone(c)
+}
+
+func (node *Instruction) Equal(other *Instruction) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Instruction) equal(c *runtime.Comparer, other *Instruction) bool {
+	var i0 int
+	var e0 *Token
+	var i1 int
+	var e1 Operand
+	var i2 int
+	var e2 Operand
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Labels) != len(other.Labels) || node.Labels == nil != (other.Labels == nil) {
+		return false
+	}
+	for i0, e0 = range node.Labels {
+		if !e0.equal(c, other.Labels[i0]) {
+			return false
+		}
+	}
+	if !node.Op.equal(c, other.Op) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i1, e1 = range node.Args {
+		if !equalOperand(c, e1, other.Args[i1]) {
+			return false
+		}
+	}
+	if len(node.Targets) != len(other.Targets) || node.Targets == nil != (other.Targets == nil) {
+		return false
+	}
+	for i2, e2 = range node.Targets {
+		if !equalOperand(c, e2, other.Targets[i2]) {
+			return false
+		}
+	}
+	if !node.Handler.equal(c, other.Handler) {
+		return false
+	}
+	return true
+}
+
+func (node *FuncDecl) Clone() *FuncDecl {
+	var c *runtime.Cloner
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) clone(c *runtime.Cloner) *FuncDecl {
+	var o interface{}
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) cloneFields(c *runtime.Cloner, clone *FuncDecl) {
+	var s0 []*Const
+	var e0 *Const
+	var s1 []*Instruction
+	var e1 *Instruction
+	clone.Name = node.Name.clone(c)
+	clone.Native = node.Native
+	clone.Params = node.Params.clone(c)
+	clone.Locals = node.Locals.clone(c)
+	s0 = nil
+	if node.Consts != nil {
+		s0 = []*Const{}
+		for _, e0 = range node.Consts {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Consts = s0
+	s1 = nil
+	if node.Body != nil {
+		s1 = []*Instruction{}
+		for _, e1 = range node.Body {
+			s1 = append(s1, e1.clone(c))
+		}
+	}
+	clone.Body = s1
+}
+
+func (node *FuncDecl) Equal(other *FuncDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FuncDecl) equal(c *runtime.Comparer, other *FuncDecl) bool {
+	var i0 int
+	var e0 *Const
+	var i1 int
+	var e1 *Instruction
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if node.Native != other.Native {
+		return false
+	}
+	if !node.Params.equal(c, other.Params) {
+		return false
+	}
+	if !node.Locals.equal(c, other.Locals) {
+		return false
+	}
+	if len(node.Consts) != len(other.Consts) || node.Consts == nil != (other.Consts == nil) {
+		return false
+	}
+	for i0, e0 = range node.Consts {
+		if !e0.equal(c, other.Consts[i0]) {
+			return false
+		}
+	}
+	if len(node.Body) != len(other.Body) || node.Body == nil != (other.Body == nil) {
+		return false
+	}
+	for i1, e1 = range node.Body {
+		if !e1.equal(c, other.Body[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *File) Clone() *File {
+	var c *runtime.Cloner
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) clone(c *runtime.Cloner) *File {
+	var o interface{}
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*File)
+	}
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) cloneFields(c *runtime.Cloner, clone *File) {
+	var s []*FuncDecl
+	var e *FuncDecl
+	s = nil
+	if node.Funcs != nil {
+		s = []*FuncDecl{}
+		for _, e = range node.Funcs {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Funcs = s
+}
+
+func (node *File) Equal(other *File) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *File) equal(c *runtime.Comparer, other *File) bool {
+	var i int
+	var e *FuncDecl
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Funcs) != len(other.Funcs) || node.Funcs == nil != (other.Funcs == nil) {
+		return false
+	}
+	for i, e = range node.Funcs {
+		if !e.equal(c, other.Funcs[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,262 @@
+package asm
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *Token) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Token")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Token) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *RegisterOperand) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("RegisterOperand")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Index", runtime.Describe(node.Index))
+	return d
+}
+
+func (node *RegisterOperand) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ConstantOperand) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ConstantOperand")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Index", runtime.Describe(node.Index))
+	return d
+}
+
+func (node *ConstantOperand) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *IntOperand) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("IntOperand")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *IntOperand) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NameOperand) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NameOperand")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *NameOperand) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ListOperand) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ListOperand")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Element", runtime.Describe(node.Element))
+	return d
+}
+
+func (node *ListOperand) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SumOperand) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Operand
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SumOperand")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	l = runtime.MakeList("[]Operand")
+	for _, e = range node.Variants {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Variants", l)
+	return d
+}
+
+func (node *SumOperand) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NumberLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NumberLiteral")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *NumberLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StringLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StringLiteral")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *StringLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *WordLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("WordLiteral")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *WordLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Const) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Const")
+	d.AddField("Type", runtime.Describe(node.Type))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *Const) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Instruction) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Token
+	var l1 *runtime.List
+	var e1 Operand
+	var l2 *runtime.List
+	var e2 Operand
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Instruction")
+	l0 = runtime.MakeList("[]Token")
+	for _, e0 = range node.Labels {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Labels", l0)
+	d.AddField("Op", runtime.Describe(node.Op))
+	l1 = runtime.MakeList("[]Operand")
+	for _, e1 = range node.Args {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Args", l1)
+	l2 = runtime.MakeList("[]Operand")
+	for _, e2 = range node.Targets {
+		l2.Append(runtime.Describe(e2))
+	}
+	d.AddField("Targets", l2)
+	d.AddField("Handler", runtime.Describe(node.Handler))
+	return d
+}
+
+func (node *Instruction) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FuncDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Const
+	var l1 *runtime.List
+	var e1 *Instruction
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FuncDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Native", runtime.Describe(node.Native))
+	d.AddField("Params", runtime.Describe(node.Params))
+	d.AddField("Locals", runtime.Describe(node.Locals))
+	l0 = runtime.MakeList("[]Const")
+	for _, e0 = range node.Consts {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Consts", l0)
+	l1 = runtime.MakeList("[]Instruction")
+	for _, e1 = range node.Body {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Body", l1)
+	return d
+}
+
+func (node *FuncDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *File) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *FuncDecl
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("File")
+	l = runtime.MakeList("[]FuncDecl")
+	for _, e = range node.Funcs {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Funcs", l)
+	return d
+}
+
+func (node *File) String() string {
+	return runtime.Format(node.Describe())
+}
@@ -0,0 +1,1758 @@
+package asm
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func EOL(frame *runtime.State) {
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '\n' {
+			frame.Consume()
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		if c1 == '\r' {
+			frame.Consume()
+			c2 = frame.Peek()
+			if frame.Flow == 0 {
+				if c2 == '\n' {
+					frame.Consume()
+					return
+				}
+				frame.Fail()
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint)
+	c3 = frame.Peek()
+	if frame.Flow == 0 {
+		if c3 == '\r' {
+			frame.Consume()
+		} else {
+			frame.Fail()
+		}
+	}
+}
+
+func SingleLineComment(frame *runtime.State) {
+	var c0 rune
+	var c1 rune
+	var checkpoint int
+	var c2 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '/' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == '/' {
+					frame.Consume()
+				loop0:
+					for {
+						checkpoint = frame.Checkpoint()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							switch c2 {
+							case '\n', '\r':
+								frame.Fail()
+							default:
+								frame.Consume()
+								continue loop0
+							}
+						}
+						frame.Recover(checkpoint)
+						return
+					}
+				}
+				frame.Fail()
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+}
+
+func HS(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var c rune
+loop0:
+	for {
+		checkpoint0 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			switch c {
+			case ' ', '\t':
+				frame.Consume()
+				continue loop0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint1)
+		SingleLineComment(frame)
+		if frame.Flow != 0 {
+			frame.Recover(checkpoint0)
+			return
+		}
+	}
+}
+
+func S(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var c rune
+	var checkpoint2 int
+	var checkpoint3 int
+loop0:
+	for {
+		checkpoint0 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		HS(frame)
+		EOL(frame)
+		if frame.Flow != 0 {
+			frame.Recover(checkpoint1)
+			c = frame.Peek()
+			if frame.Flow == 0 {
+				switch c {
+				case ' ', '\t':
+					frame.Consume()
+					continue loop0
+				default:
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint0)
+			checkpoint2 = frame.Checkpoint()
+			HS(frame)
+			checkpoint3 = frame.LookaheadBegin()
+			frame.Peek()
+			if frame.Flow == 0 {
+				frame.Consume()
+				frame.LookaheadFail(checkpoint3)
+				frame.Recover(checkpoint2)
+				return
+			}
+			frame.LookaheadNormal(checkpoint3)
+			return
+		}
+	}
+}
+
+func EOI(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var checkpoint2 int
+	var c rune
+	var checkpoint3 int
+	HS(frame)
+	checkpoint0 = frame.Checkpoint()
+	EOL(frame)
+	if frame.Flow != 0 {
+		frame.Recover(checkpoint0)
+		checkpoint1 = frame.Checkpoint()
+		checkpoint2 = frame.LookaheadBegin()
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			if c == '}' {
+				frame.Consume()
+				frame.LookaheadNormal(checkpoint2)
+				return
+			}
+			frame.Fail()
+		}
+		frame.LookaheadFail(checkpoint2)
+		frame.Recover(checkpoint1)
+		checkpoint3 = frame.LookaheadBegin()
+		frame.Peek()
+		if frame.Flow == 0 {
+			frame.Consume()
+			frame.LookaheadFail(checkpoint3)
+		} else {
+			frame.LookaheadNormal(checkpoint3)
+		}
+	}
+}
+
+func EndKeyword(frame *runtime.State) {
+	var checkpoint int
+	var c rune
+	var cond0 bool
+	var cond1 bool
+	checkpoint = frame.LookaheadBegin()
+	c = frame.Peek()
+	cond1 = frame.Flow == 0
+block1:
+	for {
+		if cond1 {
+			cond0 = c >= 'a'
+		block0:
+			for {
+				if cond0 {
+					if c <= 'z' {
+						break block0
+					}
+				}
+				if c >= 'A' {
+					if c <= 'Z' {
+						break block0
+					}
+				}
+				if c != '_' {
+					if c >= '0' {
+						if c <= '9' {
+							break block0
+						}
+					}
+					frame.Fail()
+					break block1
+				}
+				break
+			}
+			frame.Consume()
+			frame.LookaheadFail(checkpoint)
+			return
+		}
+		break
+	}
+	frame.LookaheadNormal(checkpoint)
+}
+
+func NotReserved(frame *runtime.State) {
+	var checkpoint0 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var checkpoint1 int
+	var c4 rune
+	var cond0 bool
+	var cond1 bool
+	var cond2 bool
+	checkpoint0 = frame.LookaheadBegin()
+	c0 = frame.Peek()
+	cond1 = frame.Flow == 0
+block2:
+	for {
+		if cond1 {
+			if c0 == 'e' {
+				frame.Consume()
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == 'l' {
+						frame.Consume()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							if c2 == 's' {
+								frame.Consume()
+								c3 = frame.Peek()
+								if frame.Flow == 0 {
+									if c3 == 'e' {
+										frame.Consume()
+										checkpoint1 = frame.LookaheadBegin()
+										c4 = frame.Peek()
+										cond2 = frame.Flow == 0
+									block1:
+										for {
+											if cond2 {
+												cond0 = c4 >= 'a'
+											block0:
+												for {
+													if cond0 {
+														if c4 <= 'z' {
+															break block0
+														}
+													}
+													if c4 >= 'A' {
+														if c4 <= 'Z' {
+															break block0
+														}
+													}
+													if c4 != '_' {
+														if c4 >= '0' {
+															if c4 <= '9' {
+																break block0
+															}
+														}
+														frame.Fail()
+														break block1
+													}
+													break
+												}
+												frame.Consume()
+												frame.LookaheadFail(checkpoint1)
+												break block2
+											}
+											break
+										}
+										frame.LookaheadNormal(checkpoint1)
+										frame.LookaheadFail(checkpoint0)
+										return
+									}
+									frame.Fail()
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		break
+	}
+	frame.LookaheadNormal(checkpoint0)
+}
+
+func Id(frame *runtime.State) (ret *Token) {
+	var p int
+	var begin int
+	var c0 rune
+	var cond0 bool
+	var checkpoint int
+	var c1 rune
+	var cond1 bool
+	var cond2 bool
+	p = frame.Checkpoint()
+	NotReserved(frame)
+	if frame.Flow == 0 {
+		begin = frame.Checkpoint()
+		c0 = frame.Peek()
+		if frame.Flow == 0 {
+			cond0 = c0 >= 'a'
+		block0:
+			for {
+				if cond0 {
+					if c0 <= 'z' {
+						break block0
+					}
+				}
+				if c0 >= 'A' {
+					if c0 <= 'Z' {
+						break block0
+					}
+				}
+				if c0 != '_' {
+					frame.Fail()
+					return
+				}
+				break
+			}
+			frame.Consume()
+		loop3:
+			for {
+				checkpoint = frame.Checkpoint()
+				c1 = frame.Peek()
+				cond2 = frame.Flow == 0
+			block2:
+				for {
+					if cond2 {
+						cond1 = c1 >= 'a'
+					block1:
+						for {
+							if cond1 {
+								if c1 <= 'z' {
+									break block1
+								}
+							}
+							if c1 >= 'A' {
+								if c1 <= 'Z' {
+									break block1
+								}
+							}
+							if c1 != '_' {
+								if c1 >= '0' {
+									if c1 <= '9' {
+										break block1
+									}
+								}
+								frame.Fail()
+								break block2
+							}
+							break
+						}
+						frame.Consume()
+						continue loop3
+					}
+					break
+				}
+				frame.Recover(checkpoint)
+				ret = &Token{Pos: p, Text: frame.Slice(begin, frame.Checkpoint())}
+				return
+			}
+		}
+		return
+	}
+	return
+}
+
+func Number(frame *runtime.State) (ret *Token) {
+	var p int
+	var begin int
+	var c0 rune
+	var checkpoint int
+	var c1 rune
+	var slice string
+	p = frame.Checkpoint()
+	begin = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 >= '0' {
+			if c0 <= '9' {
+				frame.Consume()
+			loop0:
+				for {
+					checkpoint = frame.Checkpoint()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 >= '0' {
+							if c1 <= '9' {
+								frame.Consume()
+								continue loop0
+							}
+						}
+						frame.Fail()
+					}
+					frame.Recover(checkpoint)
+					slice = frame.Slice(begin, frame.Checkpoint())
+					EndKeyword(frame)
+					if frame.Flow == 0 {
+						ret = &Token{Pos: p, Text: slice}
+						return
+					}
+					return
+				}
+			}
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseOperand(frame *runtime.State) (ret Operand) {
+	var p int
+	var checkpoint0 int
+	var c0 rune
+	var begin0 int
+	var c1 rune
+	var checkpoint1 int
+	var c2 rune
+	var slice0 string
+	var c3 rune
+	var begin1 int
+	var c4 rune
+	var checkpoint2 int
+	var c5 rune
+	var slice1 string
+	var begin2 int
+	var checkpoint3 int
+	var c6 rune
+	var c7 rune
+	var checkpoint4 int
+	var c8 rune
+	var slice2 string
+	var c9 rune
+	var c10 rune
+	var r0 Operand
+	var c11 rune
+	var r1 Operand
+	var variants0 []Operand
+	var c12 rune
+	var r2 Operand
+	var variants1 []Operand
+	var checkpoint5 int
+	var c13 rune
+	var r3 Operand
+	var c14 rune
+	var r4 *Token
+	var cond0 bool
+	var cond1 bool
+	var cond2 bool
+	var cond3 bool
+	var cond4 bool
+	p = frame.Checkpoint()
+	checkpoint0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond0 = frame.Flow == 0
+block1:
+	for {
+		if cond0 {
+			if c0 == 'r' {
+				frame.Consume()
+				begin0 = frame.Checkpoint()
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 >= '0' {
+						if c1 <= '9' {
+							frame.Consume()
+						loop0:
+							for {
+								checkpoint1 = frame.Checkpoint()
+								c2 = frame.Peek()
+								if frame.Flow == 0 {
+									if c2 >= '0' {
+										if c2 <= '9' {
+											frame.Consume()
+											continue loop0
+										}
+									}
+									frame.Fail()
+								}
+								frame.Recover(checkpoint1)
+								slice0 = frame.Slice(begin0, frame.Checkpoint())
+								EndKeyword(frame)
+								if frame.Flow == 0 {
+									ret = &RegisterOperand{Pos: p, Index: slice0}
+									return
+								}
+								break block1
+							}
+						}
+					}
+					frame.Fail()
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	c3 = frame.Peek()
+	cond1 = frame.Flow == 0
+block3:
+	for {
+		if cond1 {
+			if c3 == 'k' {
+				frame.Consume()
+				begin1 = frame.Checkpoint()
+				c4 = frame.Peek()
+				if frame.Flow == 0 {
+					if c4 >= '0' {
+						if c4 <= '9' {
+							frame.Consume()
+						loop2:
+							for {
+								checkpoint2 = frame.Checkpoint()
+								c5 = frame.Peek()
+								if frame.Flow == 0 {
+									if c5 >= '0' {
+										if c5 <= '9' {
+											frame.Consume()
+											continue loop2
+										}
+									}
+									frame.Fail()
+								}
+								frame.Recover(checkpoint2)
+								slice1 = frame.Slice(begin1, frame.Checkpoint())
+								EndKeyword(frame)
+								if frame.Flow == 0 {
+									ret = &ConstantOperand{Pos: p, Index: slice1}
+									return
+								}
+								break block3
+							}
+						}
+					}
+					frame.Fail()
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	begin2 = frame.Checkpoint()
+	checkpoint3 = frame.Checkpoint()
+	c6 = frame.Peek()
+	cond2 = frame.Flow == 0
+block4:
+	for {
+		if cond2 {
+			if c6 == '-' {
+				frame.Consume()
+				break block4
+			}
+			frame.Fail()
+		}
+		frame.Recover(checkpoint3)
+		break
+	}
+	c7 = frame.Peek()
+	cond3 = frame.Flow == 0
+block6:
+	for {
+		if cond3 {
+			if c7 >= '0' {
+				if c7 <= '9' {
+					frame.Consume()
+				loop5:
+					for {
+						checkpoint4 = frame.Checkpoint()
+						c8 = frame.Peek()
+						if frame.Flow == 0 {
+							if c8 >= '0' {
+								if c8 <= '9' {
+									frame.Consume()
+									continue loop5
+								}
+							}
+							frame.Fail()
+						}
+						frame.Recover(checkpoint4)
+						slice2 = frame.Slice(begin2, frame.Checkpoint())
+						EndKeyword(frame)
+						if frame.Flow == 0 {
+							ret = &IntOperand{Pos: p, Text: slice2}
+							return
+						}
+						break block6
+					}
+				}
+			}
+			frame.Fail()
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	c9 = frame.Peek()
+	if frame.Flow == 0 {
+		if c9 == '[' {
+			frame.Consume()
+			c10 = frame.Peek()
+			if frame.Flow == 0 {
+				if c10 == ']' {
+					frame.Consume()
+					r0 = ParseOperand(frame)
+					if frame.Flow == 0 {
+						ret = &ListOperand{Pos: p, Element: r0}
+						return
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c11 = frame.Peek()
+	cond4 = frame.Flow == 0
+block8:
+	for {
+		if cond4 {
+			if c11 == '(' {
+				frame.Consume()
+				HS(frame)
+				r1 = ParseOperand(frame)
+				if frame.Flow == 0 {
+					variants0 = []Operand{r1}
+					HS(frame)
+					c12 = frame.Peek()
+					if frame.Flow == 0 {
+						if c12 == '|' {
+							frame.Consume()
+							HS(frame)
+							r2 = ParseOperand(frame)
+							if frame.Flow == 0 {
+								variants1 = append(variants0, r2)
+							loop7:
+								for {
+									checkpoint5 = frame.Checkpoint()
+									HS(frame)
+									c13 = frame.Peek()
+									if frame.Flow == 0 {
+										if c13 == '|' {
+											frame.Consume()
+											HS(frame)
+											r3 = ParseOperand(frame)
+											if frame.Flow == 0 {
+												variants1 = append(variants1, r3)
+												continue loop7
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+									frame.Recover(checkpoint5)
+									HS(frame)
+									c14 = frame.Peek()
+									if frame.Flow == 0 {
+										if c14 == ')' {
+											frame.Consume()
+											ret = &SumOperand{Pos: p, Variants: variants1}
+											return
+										}
+										frame.Fail()
+										break block8
+									}
+									break block8
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	r4 = Id(frame)
+	if frame.Flow == 0 {
+		ret = &NameOperand{Name: r4}
+		return
+	}
+	return
+}
+
+func ParseOperandList(frame *runtime.State) (ret []Operand) {
+	var r0 Operand
+	var operands []Operand
+	var checkpoint int
+	var c rune
+	var r1 Operand
+	r0 = ParseOperand(frame)
+	if frame.Flow == 0 {
+		operands = []Operand{r0}
+	loop0:
+		for {
+			checkpoint = frame.Checkpoint()
+			HS(frame)
+			c = frame.Peek()
+			if frame.Flow == 0 {
+				if c == ',' {
+					frame.Consume()
+					HS(frame)
+					r1 = ParseOperand(frame)
+					if frame.Flow == 0 {
+						operands = append(operands, r1)
+						continue loop0
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint)
+			ret = operands
+			return
+		}
+	}
+	return
+}
+
+func ParseLiteral(frame *runtime.State) (ret Literal) {
+	var p int
+	var checkpoint0 int
+	var begin0 int
+	var checkpoint1 int
+	var c0 rune
+	var c1 rune
+	var checkpoint2 int
+	var c2 rune
+	var checkpoint3 int
+	var c3 rune
+	var c4 rune
+	var checkpoint4 int
+	var c5 rune
+	var checkpoint5 int
+	var c6 rune
+	var checkpoint6 int
+	var c7 rune
+	var c8 rune
+	var checkpoint7 int
+	var c9 rune
+	var begin1 int
+	var c10 rune
+	var checkpoint8 int
+	var checkpoint9 int
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var c14 rune
+	var begin2 int
+	var c15 rune
+	var cond0 bool
+	var checkpoint10 int
+	var c16 rune
+	var cond1 bool
+	var cond2 bool
+	var cond3 bool
+	var cond4 bool
+	var cond5 bool
+	var cond6 bool
+	var cond7 bool
+	p = frame.Checkpoint()
+	checkpoint0 = frame.Checkpoint()
+	begin0 = frame.Checkpoint()
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond2 = frame.Flow == 0
+block0:
+	for {
+		if cond2 {
+			if c0 == '-' {
+				frame.Consume()
+				break block0
+			}
+			frame.Fail()
+		}
+		frame.Recover(checkpoint1)
+		break
+	}
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		if c1 >= '0' {
+			if c1 <= '9' {
+				frame.Consume()
+			loop1:
+				for {
+					checkpoint2 = frame.Checkpoint()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 >= '0' {
+							if c2 <= '9' {
+								frame.Consume()
+								continue loop1
+							}
+						}
+						frame.Fail()
+					}
+					frame.Recover(checkpoint2)
+					checkpoint3 = frame.Checkpoint()
+					c3 = frame.Peek()
+					cond3 = frame.Flow == 0
+				block3:
+					for {
+						if cond3 {
+							if c3 == '.' {
+								frame.Consume()
+								c4 = frame.Peek()
+								if frame.Flow == 0 {
+									if c4 >= '0' {
+										if c4 <= '9' {
+											frame.Consume()
+										loop2:
+											for {
+												checkpoint4 = frame.Checkpoint()
+												c5 = frame.Peek()
+												if frame.Flow == 0 {
+													if c5 >= '0' {
+														if c5 <= '9' {
+															frame.Consume()
+															continue loop2
+														}
+													}
+													frame.Fail()
+												}
+												frame.Recover(checkpoint4)
+												break block3
+											}
+										}
+									}
+									frame.Fail()
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+						frame.Recover(checkpoint3)
+						break
+					}
+					checkpoint5 = frame.Checkpoint()
+					c6 = frame.Peek()
+					cond4 = frame.Flow == 0
+				block6:
+					for {
+						if cond4 {
+							switch c6 {
+							case 'e', 'E':
+								frame.Consume()
+								checkpoint6 = frame.Checkpoint()
+								c7 = frame.Peek()
+								cond5 = frame.Flow == 0
+							block4:
+								for {
+									if cond5 {
+										switch c7 {
+										case '+', '-':
+											frame.Consume()
+											break block4
+										default:
+											frame.Fail()
+										}
+									}
+									frame.Recover(checkpoint6)
+									break
+								}
+								c8 = frame.Peek()
+								if frame.Flow == 0 {
+									if c8 >= '0' {
+										if c8 <= '9' {
+											frame.Consume()
+										loop5:
+											for {
+												checkpoint7 = frame.Checkpoint()
+												c9 = frame.Peek()
+												if frame.Flow == 0 {
+													if c9 >= '0' {
+														if c9 <= '9' {
+															frame.Consume()
+															continue loop5
+														}
+													}
+													frame.Fail()
+												}
+												frame.Recover(checkpoint7)
+												break block6
+											}
+										}
+									}
+									frame.Fail()
+								}
+							default:
+								frame.Fail()
+							}
+						}
+						frame.Recover(checkpoint5)
+						break
+					}
+					ret = &NumberLiteral{Pos: p, Text: frame.Slice(begin0, frame.Checkpoint())}
+					return
+				}
+			}
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint0)
+	begin1 = frame.Checkpoint()
+	c10 = frame.Peek()
+	cond6 = frame.Flow == 0
+block8:
+	for {
+		if cond6 {
+			if c10 == '"' {
+				frame.Consume()
+			loop7:
+				for {
+					checkpoint8 = frame.Checkpoint()
+					checkpoint9 = frame.Checkpoint()
+					c11 = frame.Peek()
+					if frame.Flow == 0 {
+						switch c11 {
+						case '"', '\\', '\n', '\r':
+							frame.Fail()
+						default:
+							frame.Consume()
+							continue loop7
+						}
+					}
+					frame.Recover(checkpoint9)
+					c12 = frame.Peek()
+					if frame.Flow == 0 {
+						if c12 == '\\' {
+							frame.Consume()
+							c13 = frame.Peek()
+							if frame.Flow == 0 {
+								switch c13 {
+								case '\n', '\r':
+									frame.Fail()
+								default:
+									frame.Consume()
+									continue loop7
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+					frame.Recover(checkpoint8)
+					c14 = frame.Peek()
+					if frame.Flow == 0 {
+						if c14 == '"' {
+							frame.Consume()
+							ret = &StringLiteral{Pos: p, Text: frame.Slice(begin1, frame.Checkpoint())}
+							return
+						}
+						frame.Fail()
+						break block8
+					}
+					break block8
+				}
+			}
+			frame.Fail()
+		}
+		break
+	}
+	frame.Recover(checkpoint0)
+	begin2 = frame.Checkpoint()
+	c15 = frame.Peek()
+	if frame.Flow == 0 {
+		cond0 = c15 >= 'a'
+	block9:
+		for {
+			if cond0 {
+				if c15 <= 'z' {
+					break block9
+				}
+			}
+			if c15 >= 'A' {
+				if c15 <= 'Z' {
+					break block9
+				}
+			}
+			frame.Fail()
+			return
+		}
+		frame.Consume()
+	loop12:
+		for {
+			checkpoint10 = frame.Checkpoint()
+			c16 = frame.Peek()
+			cond7 = frame.Flow == 0
+		block11:
+			for {
+				if cond7 {
+					cond1 = c16 >= 'a'
+				block10:
+					for {
+						if cond1 {
+							if c16 <= 'z' {
+								break block10
+							}
+						}
+						if c16 >= 'A' {
+							if c16 <= 'Z' {
+								break block10
+							}
+						}
+						frame.Fail()
+						break block11
+					}
+					frame.Consume()
+					continue loop12
+				}
+				break
+			}
+			frame.Recover(checkpoint10)
+			ret = &WordLiteral{Pos: p, Text: frame.Slice(begin2, frame.Checkpoint())}
+			return
+		}
+	}
+	return
+}
+
+func ParseConst(frame *runtime.State) (ret *Const) {
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var t *Token
+	var value0 Literal
+	var checkpoint int
+	var value1 Literal
+	var value2 Literal
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'c' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'o' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'n' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 's' {
+									frame.Consume()
+									c4 = frame.Peek()
+									if frame.Flow == 0 {
+										if c4 == 't' {
+											frame.Consume()
+											EndKeyword(frame)
+											if frame.Flow == 0 {
+												HS(frame)
+												t = Id(frame)
+												if frame.Flow == 0 {
+													value0 = nil
+													checkpoint = frame.Checkpoint()
+													HS(frame)
+													value1 = ParseLiteral(frame)
+													if frame.Flow == 0 {
+														value2 = value1
+													} else {
+														frame.Recover(checkpoint)
+														value2 = value0
+													}
+													EOI(frame)
+													if frame.Flow == 0 {
+														ret = &Const{Type: t, Value: value2}
+														return
+													}
+													return
+												}
+												return
+											}
+											return
+										}
+										frame.Fail()
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseInstruction(frame *runtime.State) (ret *Instruction) {
+	var labels0 []*Token
+	var checkpoint0 int
+	var label *Token
+	var c0 rune
+	var labels1 []*Token
+	var labels2 []*Token
+	var op *Token
+	var args0 []Operand
+	var targets0 []Operand
+	var handler0 *Token
+	var checkpoint1 int
+	var args1 []Operand
+	var args2 []Operand
+	var checkpoint2 int
+	var c1 rune
+	var c2 rune
+	var targets1 []Operand
+	var targets2 []Operand
+	var checkpoint3 int
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var handler1 *Token
+	var handler2 *Token
+	var cond0 bool
+	var cond1 bool
+	labels0 = []*Token{}
+loop0:
+	for {
+		checkpoint0 = frame.Checkpoint()
+		label = Id(frame)
+		if frame.Flow == 0 {
+			c0 = frame.Peek()
+			if frame.Flow == 0 {
+				if c0 == ':' {
+					frame.Consume()
+					labels1 = append(labels0, label)
+					S(frame)
+					labels0 = labels1
+					continue loop0
+				}
+				frame.Fail()
+				labels2 = labels0
+			} else {
+				labels2 = labels0
+			}
+		} else {
+			labels2 = labels0
+		}
+		frame.Recover(checkpoint0)
+		op = Id(frame)
+		if frame.Flow == 0 {
+			args0 = []Operand{}
+			targets0 = []Operand{}
+			handler0 = nil
+			checkpoint1 = frame.Checkpoint()
+			HS(frame)
+			args1 = ParseOperandList(frame)
+			if frame.Flow == 0 {
+				args2 = args1
+			} else {
+				frame.Recover(checkpoint1)
+				args2 = args0
+			}
+			checkpoint2 = frame.Checkpoint()
+			HS(frame)
+			c1 = frame.Peek()
+			cond0 = frame.Flow == 0
+		block1:
+			for {
+				if cond0 {
+					if c1 == '-' {
+						frame.Consume()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							if c2 == '>' {
+								frame.Consume()
+								HS(frame)
+								targets1 = ParseOperandList(frame)
+								if frame.Flow == 0 {
+									targets2 = targets1
+									break block1
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint2)
+				targets2 = targets0
+				break
+			}
+			checkpoint3 = frame.Checkpoint()
+			HS(frame)
+			c3 = frame.Peek()
+			cond1 = frame.Flow == 0
+		block2:
+			for {
+				if cond1 {
+					if c3 == 'e' {
+						frame.Consume()
+						c4 = frame.Peek()
+						if frame.Flow == 0 {
+							if c4 == 'l' {
+								frame.Consume()
+								c5 = frame.Peek()
+								if frame.Flow == 0 {
+									if c5 == 's' {
+										frame.Consume()
+										c6 = frame.Peek()
+										if frame.Flow == 0 {
+											if c6 == 'e' {
+												frame.Consume()
+												EndKeyword(frame)
+												if frame.Flow == 0 {
+													HS(frame)
+													handler1 = Id(frame)
+													if frame.Flow == 0 {
+														handler2 = handler1
+														break block2
+													}
+												}
+											} else {
+												frame.Fail()
+											}
+										}
+									} else {
+										frame.Fail()
+									}
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint3)
+				handler2 = handler0
+				break
+			}
+			EOI(frame)
+			if frame.Flow == 0 {
+				ret = &Instruction{Labels: labels2, Op: op, Args: args2, Targets: targets2, Handler: handler2}
+				return
+			}
+			return
+		}
+		return
+	}
+}
+
+func ParseFuncDecl(frame *runtime.State) (ret *FuncDecl) {
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var name *Token
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	var params *Token
+	var c10 rune
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var c14 rune
+	var c15 rune
+	var locals *Token
+	var c16 rune
+	var consts0 []*Const
+	var checkpoint0 int
+	var r0 *Const
+	var consts1 []*Const
+	var consts2 []*Const
+	var body0 []*Instruction
+	var checkpoint1 int
+	var r1 *Instruction
+	var body1 []*Instruction
+	var body2 []*Instruction
+	var c17 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'f' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'u' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'n' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'c' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										name = Id(frame)
+										if frame.Flow == 0 {
+											S(frame)
+											c4 = frame.Peek()
+											if frame.Flow == 0 {
+												if c4 == 'p' {
+													frame.Consume()
+													c5 = frame.Peek()
+													if frame.Flow == 0 {
+														if c5 == 'a' {
+															frame.Consume()
+															c6 = frame.Peek()
+															if frame.Flow == 0 {
+																if c6 == 'r' {
+																	frame.Consume()
+																	c7 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c7 == 'a' {
+																			frame.Consume()
+																			c8 = frame.Peek()
+																			if frame.Flow == 0 {
+																				if c8 == 'm' {
+																					frame.Consume()
+																					c9 = frame.Peek()
+																					if frame.Flow == 0 {
+																						if c9 == 's' {
+																							frame.Consume()
+																							EndKeyword(frame)
+																							if frame.Flow == 0 {
+																								S(frame)
+																								params = Number(frame)
+																								if frame.Flow == 0 {
+																									S(frame)
+																									c10 = frame.Peek()
+																									if frame.Flow == 0 {
+																										if c10 == 'l' {
+																											frame.Consume()
+																											c11 = frame.Peek()
+																											if frame.Flow == 0 {
+																												if c11 == 'o' {
+																													frame.Consume()
+																													c12 = frame.Peek()
+																													if frame.Flow == 0 {
+																														if c12 == 'c' {
+																															frame.Consume()
+																															c13 = frame.Peek()
+																															if frame.Flow == 0 {
+																																if c13 == 'a' {
+																																	frame.Consume()
+																																	c14 = frame.Peek()
+																																	if frame.Flow == 0 {
+																																		if c14 == 'l' {
+																																			frame.Consume()
+																																			c15 = frame.Peek()
+																																			if frame.Flow == 0 {
+																																				if c15 == 's' {
+																																					frame.Consume()
+																																					EndKeyword(frame)
+																																					if frame.Flow == 0 {
+																																						S(frame)
+																																						locals = Number(frame)
+																																						if frame.Flow == 0 {
+																																							S(frame)
+																																							c16 = frame.Peek()
+																																							if frame.Flow == 0 {
+																																								if c16 == '{' {
+																																									frame.Consume()
+																																									S(frame)
+																																									consts0 = []*Const{}
+																																									for {
+																																										checkpoint0 = frame.Checkpoint()
+																																										r0 = ParseConst(frame)
+																																										if frame.Flow == 0 {
+																																											consts1 = append(consts0, r0)
+																																											S(frame)
+																																											consts0 = consts1
+																																										} else {
+																																											consts2 = consts0
+																																											frame.Recover(checkpoint0)
+																																											body0 = []*Instruction{}
+																																											for {
+																																												checkpoint1 = frame.Checkpoint()
+																																												r1 = ParseInstruction(frame)
+																																												if frame.Flow == 0 {
+																																													body1 = append(body0, r1)
+																																													S(frame)
+																																													body0 = body1
+																																												} else {
+																																													body2 = body0
+																																													frame.Recover(checkpoint1)
+																																													c17 = frame.Peek()
+																																													if frame.Flow == 0 {
+																																														if c17 == '}' {
+																																															frame.Consume()
+																																															ret = &FuncDecl{Name: name, Params: params, Locals: locals, Consts: consts2, Body: body2}
+																																															return
+																																														}
+																																														frame.Fail()
+																																														return
+																																													}
+																																													return
+																																												}
+																																											}
+																																										}
+																																									}
+																																								}
+																																								frame.Fail()
+																																								return
+																																							}
+																																							return
+																																						}
+																																						return
+																																					}
+																																					return
+																																				}
+																																				frame.Fail()
+																																				return
+																																			}
+																																			return
+																																		}
+																																		frame.Fail()
+																																		return
+																																	}
+																																	return
+																																}
+																																frame.Fail()
+																																return
+																															}
+																															return
+																														}
+																														frame.Fail()
+																														return
+																													}
+																													return
+																												}
+																												frame.Fail()
+																												return
+																											}
+																											return
+																										}
+																										frame.Fail()
+																										return
+																									}
+																									return
+																								}
+																								return
+																							}
+																							return
+																						}
+																						frame.Fail()
+																						return
+																					}
+																					return
+																				}
+																				frame.Fail()
+																				return
+																			}
+																			return
+																		}
+																		frame.Fail()
+																		return
+																	}
+																	return
+																}
+																frame.Fail()
+																return
+															}
+															return
+														}
+														frame.Fail()
+														return
+													}
+													return
+												}
+												frame.Fail()
+												return
+											}
+											return
+										}
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseNativeDecl(frame *runtime.State) (ret *FuncDecl) {
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var name *Token
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	var c10 rune
+	var c11 rune
+	var params *Token
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'n' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'a' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 't' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'i' {
+									frame.Consume()
+									c4 = frame.Peek()
+									if frame.Flow == 0 {
+										if c4 == 'v' {
+											frame.Consume()
+											c5 = frame.Peek()
+											if frame.Flow == 0 {
+												if c5 == 'e' {
+													frame.Consume()
+													EndKeyword(frame)
+													if frame.Flow == 0 {
+														S(frame)
+														name = Id(frame)
+														if frame.Flow == 0 {
+															S(frame)
+															c6 = frame.Peek()
+															if frame.Flow == 0 {
+																if c6 == 'p' {
+																	frame.Consume()
+																	c7 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c7 == 'a' {
+																			frame.Consume()
+																			c8 = frame.Peek()
+																			if frame.Flow == 0 {
+																				if c8 == 'r' {
+																					frame.Consume()
+																					c9 = frame.Peek()
+																					if frame.Flow == 0 {
+																						if c9 == 'a' {
+																							frame.Consume()
+																							c10 = frame.Peek()
+																							if frame.Flow == 0 {
+																								if c10 == 'm' {
+																									frame.Consume()
+																									c11 = frame.Peek()
+																									if frame.Flow == 0 {
+																										if c11 == 's' {
+																											frame.Consume()
+																											EndKeyword(frame)
+																											if frame.Flow == 0 {
+																												S(frame)
+																												params = Number(frame)
+																												if frame.Flow == 0 {
+																													EOI(frame)
+																													if frame.Flow == 0 {
+																														ret = &FuncDecl{Name: name, Native: true, Params: params, Consts: []*Const{}, Body: []*Instruction{}}
+																														return
+																													}
+																													return
+																												}
+																												return
+																											}
+																											return
+																										}
+																										frame.Fail()
+																										return
+																									}
+																									return
+																								}
+																								frame.Fail()
+																								return
+																							}
+																							return
+																						}
+																						frame.Fail()
+																						return
+																					}
+																					return
+																				}
+																				frame.Fail()
+																				return
+																			}
+																			return
+																		}
+																		frame.Fail()
+																		return
+																	}
+																	return
+																}
+																frame.Fail()
+																return
+															}
+															return
+														}
+														return
+													}
+													return
+												}
+												frame.Fail()
+												return
+											}
+											return
+										}
+										frame.Fail()
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseFile(frame *runtime.State) (ret *File) {
+	var funcs0 []*FuncDecl
+	var funcs1 []*FuncDecl
+	var checkpoint0 int
+	var checkpoint1 int
+	var r0 *FuncDecl
+	var funcs2 []*FuncDecl
+	var r1 *FuncDecl
+	var funcs3 []*FuncDecl
+	var checkpoint2 int
+	funcs0 = []*FuncDecl{}
+	S(frame)
+	funcs1 = funcs0
+	for {
+		checkpoint0 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		r0 = ParseFuncDecl(frame)
+		if frame.Flow == 0 {
+			funcs2 = append(funcs1, r0)
+		} else {
+			frame.Recover(checkpoint1)
+			r1 = ParseNativeDecl(frame)
+			if frame.Flow == 0 {
+				funcs2 = append(funcs1, r1)
+			} else {
+				funcs3 = funcs1
+				frame.Recover(checkpoint0)
+				checkpoint2 = frame.LookaheadBegin()
+				frame.Peek()
+				if frame.Flow == 0 {
+					frame.Consume()
+					frame.LookaheadFail(checkpoint2)
+					return
+				}
+				frame.LookaheadNormal(checkpoint2)
+				ret = &File{Funcs: funcs3}
+				return
+			}
+		}
+		S(frame)
+		funcs1 = funcs2
+	}
+}
@@ -0,0 +1,201 @@
+package interpreter
+
+import (
+	"fmt"
+)
+
+type StopReason int
+
+const (
+	// A single step finished.
+	STOP_STEP StopReason = iota
+	// The next op has a breakpoint.
+	STOP_BREAKPOINT
+	// A watched slot was changed by the last op.
+	STOP_WATCHPOINT
+	// The outermost frame returned or failed.
+	STOP_DONE
+)
+
+var stopReasonNames = []string{
+	"step",
+	"breakpoint",
+	"watchpoint",
+	"done",
+}
+
+func (reason StopReason) String() string {
+	return stopReasonNames[reason]
+}
+
+type Breakpoint struct {
+	Func     int
+	Location int
+}
+
+// Watches a slot of a particular struct, rather than every struct of a type.
+type Watchpoint struct {
+	Struct *Struct
+	Slot   int
+	// The value of the slot when it was last checked.
+	Value Object
+}
+
+type Stop struct {
+	Reason StopReason
+	// The watchpoint that triggered, and the value it had before.
+	Watch *Watchpoint
+	Old   Object
+}
+
+// Runs an interpreter one op at a time so it can be inspected between ops.
+// Call Invoke on the interpreter before stepping.
+type Debugger struct {
+	I           *Interpreter
+	breakpoints map[Breakpoint]bool
+	Watchpoints []*Watchpoint
+}
+
+func (d *Debugger) SetBreakpoint(uid int, location int) error {
+	if uid < 0 || uid >= len(d.I.Funcs) {
+		return fmt.Errorf("no function %d", uid)
+	}
+	f := d.I.Funcs[uid]
+	if location < 0 || location >= len(f.Body) {
+		return fmt.Errorf("location %d is outside of %s", location, f.Name)
+	}
+	d.breakpoints[Breakpoint{Func: uid, Location: location}] = true
+	return nil
+}
+
+// Returns false if there was no such breakpoint.
+func (d *Debugger) ClearBreakpoint(uid int, location int) bool {
+	bp := Breakpoint{Func: uid, Location: location}
+	if !d.breakpoints[bp] {
+		return false
+	}
+	delete(d.breakpoints, bp)
+	return true
+}
+
+func (d *Debugger) Breakpoints() []Breakpoint {
+	out := []Breakpoint{}
+	for uid := range d.I.Funcs {
+		for location := range d.I.Funcs[uid].Body {
+			bp := Breakpoint{Func: uid, Location: location}
+			if d.breakpoints[bp] {
+				out = append(out, bp)
+			}
+		}
+	}
+	return out
+}
+
+// Reports whether the next op has a breakpoint.  Functions are identified by
+// uid, but frames only know their function.
+func (d *Debugger) AtBreakpoint() bool {
+	frame := d.I.Frame
+	if frame == nil {
+		return false
+	}
+	for bp := range d.breakpoints {
+		if bp.Location == frame.Location && d.I.Funcs[bp.Func] == frame.F {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *Debugger) Watch(o Object, slot int) (*Watchpoint, error) {
+	s, err := toSlot(o, slot)
+	if err != nil {
+		return nil, err
+	}
+	w := &Watchpoint{Struct: s, Slot: slot, Value: s.Slots[slot]}
+	d.Watchpoints = append(d.Watchpoints, w)
+	return w, nil
+}
+
+func (d *Debugger) Unwatch(w *Watchpoint) bool {
+	for idx, other := range d.Watchpoints {
+		if other == w {
+			d.Watchpoints = append(d.Watchpoints[:idx], d.Watchpoints[idx+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Scalars are compared by value, so storing an equal value is not a change.
+// Structs and lists are compared by identity.
+func changed(old Object, current Object) bool {
+	if old == nil || current == nil {
+		return old != current
+	}
+	eq, err := binop(EQ, old, current)
+	return err != nil || !eq.(*Bool).Value
+}
+
+// Returns the first watchpoint that changed, and updates all of them.
+func (d *Debugger) checkWatchpoints() *Stop {
+	var stop *Stop
+	for _, w := range d.Watchpoints {
+		current := w.Struct.Slots[w.Slot]
+		if changed(w.Value, current) && stop == nil {
+			stop = &Stop{Reason: STOP_WATCHPOINT, Watch: w, Old: w.Value}
+		}
+		w.Value = current
+	}
+	return stop
+}
+
+// The frames that are running, innermost first.
+func (d *Debugger) Stack() []*StackFrame {
+	frames := []*StackFrame{}
+	for frame := d.I.Frame; frame != nil; frame = frame.Parent {
+		frames = append(frames, frame)
+	}
+	return frames
+}
+
+// Executes a single op.  If a RuntimeError is returned, the stack has been
+// unwound.
+func (d *Debugger) Step() (*Stop, error) {
+	if d.I.Frame == nil {
+		return nil, fmt.Errorf("nothing is running")
+	}
+	done, err := d.I.step()
+	if err != nil {
+		return nil, d.I.raise(err)
+	}
+	stop := d.checkWatchpoints()
+	if done {
+		return &Stop{Reason: STOP_DONE}, nil
+	}
+	if stop != nil {
+		return stop, nil
+	}
+	return &Stop{Reason: STOP_STEP}, nil
+}
+
+// Runs until the next breakpoint or watchpoint, or until the outermost frame
+// returns or fails.  The op the debugger is stopped at is always executed, so
+// continuing from a breakpoint does not stop at it again.
+func (d *Debugger) Continue() (*Stop, error) {
+	for {
+		stop, err := d.Step()
+		if err != nil || stop.Reason != STOP_STEP {
+			return stop, err
+		}
+		if d.AtBreakpoint() {
+			return &Stop{Reason: STOP_BREAKPOINT}, nil
+		}
+	}
+}
+
+func CreateDebugger(i *Interpreter) *Debugger {
+	return &Debugger{
+		I:           i,
+		breakpoints: map[Breakpoint]bool{},
+	}
+}
@@ -0,0 +1,156 @@
+package interpreter
+
+import (
+	"evergreen/assert"
+	"testing"
+)
+
+func TestStepAndBreakpoints(t *testing.T) {
+	b := CreateProgramBuilder()
+	i := CreateInterpreter(makeMatcher(b))
+	d := CreateDebugger(i)
+
+	if err := d.SetBreakpoint(0, 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.SetBreakpoint(0, 12); err == nil {
+		t.Error("Expected a breakpoint past the end to be rejected")
+	}
+	assert.IntEquals(t, len(d.Breakpoints()), 1)
+
+	i.SetStream([]rune("ab"))
+	i.SetTemp([]Object{})
+	if err := i.Invoke(1); err != nil {
+		t.Fatal(err)
+	}
+	stop, err := d.Step()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, stop.Reason.String(), "step")
+	assert.IntEquals(t, i.Frame.Location, 1)
+
+	// Stops inside the call, before consuming 'a'.
+	stop, err = d.Continue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, stop.Reason.String(), "breakpoint")
+	stack := d.Stack()
+	assert.IntEquals(t, len(stack), 2)
+	assert.StringEquals(t, stack[0].F.Name, "AB")
+	assert.IntEquals(t, stack[0].Location, 4)
+	assert.StringEquals(t, stack[1].F.Name, "Try")
+	assert.IntEquals(t, stack[1].Location, 1)
+	assert.IntEquals(t, int(stack[0].Locals[0].(*I32).Value), 'a')
+	assert.IntEquals(t, i.Index, 0)
+
+	// Continuing does not stop at the same breakpoint again.
+	if !d.ClearBreakpoint(0, 4) {
+		t.Error("Expected the breakpoint to be cleared")
+	}
+	if d.ClearBreakpoint(0, 4) {
+		t.Error("Expected the breakpoint to be gone")
+	}
+	stop, err = d.Continue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, stop.Reason.String(), "done")
+	assert.IntEquals(t, i.TempLen, 1)
+	assert.IntEquals(t, int(i.Temp[0].(*I32).Value), 1)
+
+	if _, err := d.Step(); err == nil {
+		t.Error("Expected stepping a finished program to fail")
+	}
+}
+
+func TestWatchpoint(t *testing.T) {
+	b := CreateProgramBuilder()
+	point := &StructType{StructName: "Point"}
+	funcs := []*Function{
+		&Function{
+			Name:      "Move",
+			NumParams: 1,
+			NumLocals: 2,
+			Constants: []Object{
+				b.i32(7),
+				b.i32(2),
+			},
+			Body: []Op{
+				// Storing an equal value is not a change.
+				&StoreConst{Const: 1, Target: 1},
+				&SetAttr{Expr: 0, Slot: 1, Value: 1},
+				&StoreConst{Const: 0, Target: 1},
+				&SetAttr{Expr: 0, Slot: 0, Value: 1},
+				&SetAttr{Expr: 0, Slot: 1, Value: 1},
+				&Return{Args: Locals{}},
+			},
+		},
+	}
+	i := CreateInterpreter(funcs)
+	d := CreateDebugger(i)
+	p := &Struct{T: point, Slots: []Object{b.i32(1), b.i32(2)}}
+	if _, err := d.Watch(p, 2); err == nil {
+		t.Error("Expected watching a missing slot to fail")
+	}
+	w, err := d.Watch(p, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i.SetTemp([]Object{p})
+	if err := i.Invoke(0); err != nil {
+		t.Fatal(err)
+	}
+	stop, err := d.Continue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, stop.Reason.String(), "watchpoint")
+	if stop.Watch != w {
+		t.Error("Expected the watchpoint to be reported")
+	}
+	assert.IntEquals(t, int(stop.Old.(*I32).Value), 2)
+	assert.IntEquals(t, int(w.Value.(*I32).Value), 7)
+	// The op that changed the slot has run.
+	assert.IntEquals(t, i.Frame.Location, 5)
+
+	if !d.Unwatch(w) {
+		t.Error("Expected the watchpoint to be removed")
+	}
+	stop, err = d.Continue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, stop.Reason.String(), "done")
+}
+
+func TestDebuggerError(t *testing.T) {
+	b := CreateProgramBuilder()
+	funcs := []*Function{
+		&Function{
+			Name:      "Div",
+			NumParams: 2,
+			NumLocals: 3,
+			Constants: []Object{},
+			Body: []Op{
+				&BinaryOp{Op: DIV, Left: 0, Right: 1, Target: 2},
+				&Return{Args: Locals{2}},
+			},
+		},
+	}
+	i := CreateInterpreter(funcs)
+	d := CreateDebugger(i)
+	i.SetTemp([]Object{b.i32(1), b.i32(0)})
+	if err := i.Invoke(0); err != nil {
+		t.Fatal(err)
+	}
+	_, err := d.Continue()
+	rtErr, ok := err.(*RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %v", err)
+	}
+	assert.IntEquals(t, int(rtErr.Kind), int(DIVIDE_BY_ZERO))
+	assert.IntEquals(t, len(d.Stack()), 0)
+}
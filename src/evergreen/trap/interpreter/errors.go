@@ -0,0 +1,67 @@
+package interpreter
+
+import (
+	"fmt"
+	"strings"
+)
+
+type ErrorKind int
+
+const (
+	TYPE_ERROR ErrorKind = iota
+	DIVIDE_BY_ZERO
+	BAD_ACCESS
+	BAD_CALL
+	BAD_OP
+	CALL_DEPTH_EXCEEDED
+	STEP_LIMIT_EXCEEDED
+	NATIVE_ERROR
+)
+
+var errorKindNames = []string{
+	"type error",
+	"divide by zero",
+	"bad access",
+	"bad call",
+	"bad op",
+	"call depth exceeded",
+	"step limit exceeded",
+	"native error",
+}
+
+func (kind ErrorKind) String() string {
+	return errorKindNames[kind]
+}
+
+type TraceEntry struct {
+	Function string
+	Location int
+}
+
+// An error raised while running trap code.  The trap stack is unwound when it
+// is raised, so the trace is the only record of where it happened.
+type RuntimeError struct {
+	Kind    ErrorKind
+	Message string
+	// The innermost frame comes first.
+	Trace []TraceEntry
+}
+
+func (e *RuntimeError) Error() string {
+	lines := []string{fmt.Sprintf("%s: %s", e.Kind, e.Message)}
+	for _, entry := range e.Trace {
+		lines = append(lines, fmt.Sprintf("    at %s:%d", entry.Function, entry.Location))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func runtimeError(kind ErrorKind, format string, args ...interface{}) *RuntimeError {
+	return &RuntimeError{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
+func typeName(o Object) string {
+	if o == nil {
+		return "uninitialized value"
+	}
+	return o.Type().Name()
+}
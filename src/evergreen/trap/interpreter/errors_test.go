@@ -0,0 +1,146 @@
+package interpreter
+
+import (
+	"evergreen/assert"
+	"testing"
+)
+
+func callAndFail(i *Interpreter, f int, args []Object, kind ErrorKind, t *testing.T) *RuntimeError {
+	i.SetTemp(args)
+	err := i.Invoke(f)
+	if err == nil {
+		err = i.Run()
+	}
+	rerr, ok := err.(*RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error, got %#v", err)
+	}
+	if rerr.Kind != kind {
+		t.Errorf("Expected %s, got %s", kind, rerr)
+	}
+	if i.Frame != nil {
+		t.Error("Stack was not unwound")
+	}
+	return rerr
+}
+
+func checkTrace(actual []TraceEntry, expected []TraceEntry, t *testing.T) {
+	assert.IntEquals(t, len(actual), len(expected))
+	for i, e := range expected {
+		if actual[i] != e {
+			t.Errorf("%d: %v vs %v", i, actual[i], e)
+		}
+	}
+}
+
+func TestDivideByZero(t *testing.T) {
+	b := CreateProgramBuilder()
+	funcs := []*Function{
+		&Function{
+			Name:      "Div",
+			NumParams: 2,
+			NumLocals: 2,
+			Constants: []Object{},
+			Body: []Op{
+				&BinaryOp{Op: DIV, Left: 0, Right: 1, Target: 0},
+				&Return{Args: Locals{0}},
+			},
+		},
+		&Function{
+			Name:      "Main",
+			NumParams: 1,
+			NumLocals: 2,
+			Constants: []Object{b.i32(12)},
+			Body: []Op{
+				&StoreConst{Const: 0, Target: 1},
+				&Call{Func: 0, Args: Locals{1, 0}, Targets: Locals{0}},
+				&Return{Args: Locals{0}},
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	err := callAndFail(i, 1, []Object{b.i32(0)}, DIVIDE_BY_ZERO, t)
+	checkTrace(err.Trace, []TraceEntry{{Function: "Div", Location: 0}, {Function: "Main", Location: 1}}, t)
+
+	// The interpreter can still be used.
+	callAndReturnInt(i, 1, []Object{b.i32(4)}, 3, t)
+}
+
+func TestBadAccess(t *testing.T) {
+	b := CreateProgramBuilder()
+	funcs := []*Function{
+		&Function{
+			Name:      "Get1",
+			NumParams: 1,
+			NumLocals: 2,
+			Constants: []Object{},
+			Body: []Op{
+				&GetAttr{Expr: 0, Slot: 1, Target: 1},
+				&Return{Args: Locals{1}},
+			},
+		},
+		&Function{
+			Name:      "Add",
+			NumParams: 2,
+			NumLocals: 2,
+			Constants: []Object{},
+			Body: []Op{
+				&BinaryOp{Op: ADD, Left: 0, Right: 1, Target: 0},
+				&Return{Args: Locals{0}},
+			},
+		},
+		&Function{
+			Name:      "Bad",
+			NumParams: 0,
+			NumLocals: 0,
+			Constants: []Object{},
+			Body: []Op{
+				"not an op",
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	callAndFail(i, 0, []Object{b.i32(1)}, TYPE_ERROR, t)
+	callAndFail(i, 0, []Object{&Struct{Slots: []Object{b.i32(1)}}}, BAD_ACCESS, t)
+	callAndFail(i, 1, []Object{b.i32(1), b.str("1")}, TYPE_ERROR, t)
+	callAndFail(i, 1, []Object{b.boolean(true), b.boolean(false)}, TYPE_ERROR, t)
+	callAndFail(i, 1, []Object{b.i32(1)}, BAD_CALL, t)
+	callAndFail(i, 2, []Object{}, BAD_OP, t)
+	callAndFail(i, 3, []Object{}, BAD_CALL, t)
+}
+
+func TestLimits(t *testing.T) {
+	funcs := []*Function{
+		&Function{
+			Name:      "Recurse",
+			NumParams: 0,
+			NumLocals: 0,
+			Constants: []Object{},
+			Body: []Op{
+				&Call{Func: 0, Args: Locals{}, Targets: Locals{}},
+				&Return{Args: Locals{}},
+			},
+		},
+		&Function{
+			Name:      "Spin",
+			NumParams: 0,
+			NumLocals: 0,
+			Constants: []Object{},
+			Body: []Op{
+				&Jump{Location: 0},
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	i.MaxCallDepth = 100
+	i.MaxSteps = 1000
+	err := callAndFail(i, 0, []Object{}, CALL_DEPTH_EXCEEDED, t)
+	assert.IntEquals(t, len(err.Trace), 100)
+
+	i.Steps = 0
+	callAndFail(i, 1, []Object{}, STEP_LIMIT_EXCEEDED, t)
+	assert.IntEquals(t, i.Steps, 1000)
+}
@@ -7,6 +7,22 @@ func (b *ProgramBuilder) i32(value int32) Object {
 	return &I32{Value: value}
 }
 
+func (b *ProgramBuilder) i64(value int64) Object {
+	return &I64{Value: value}
+}
+
+func (b *ProgramBuilder) f64(value float64) Object {
+	return &F64{Value: value}
+}
+
+func (b *ProgramBuilder) boolean(value bool) Object {
+	return &Bool{Value: value}
+}
+
+func (b *ProgramBuilder) str(value string) Object {
+	return &String{Value: value}
+}
+
 func CreateProgramBuilder() *ProgramBuilder {
 	return &ProgramBuilder{}
 }
@@ -23,41 +39,268 @@ type StackFrame struct {
 	Locals   []Object
 	Targets  Locals
 	Parent   *StackFrame
+	// The outermost frame has a depth of one.
+	Depth int
 }
 
-func toBool(o Object) bool {
+func toBool(o Object) (bool, *RuntimeError) {
 	switch o := o.(type) {
+	case *Bool:
+		return o.Value, nil
 	case *I32:
-		return o.Value != 0
+		return o.Value != 0, nil
 	default:
-		panic(o)
+		return false, runtimeError(TYPE_ERROR, "cannot branch on %s", typeName(o))
 	}
 }
 
-func binop(op BinOp, l Object, r Object) Object {
-	switch l := l.(type) {
+func toIndex(o Object, length int) (int, *RuntimeError) {
+	var index int64
+	switch o := o.(type) {
 	case *I32:
-		switch r := r.(type) {
-		case *I32:
-			switch op {
-			case ADD:
-				return &I32{Value: l.Value + r.Value}
-			case SUB:
-				return &I32{Value: l.Value - r.Value}
-			case MUL:
-				return &I32{Value: l.Value * r.Value}
-			case DIV:
-				return &I32{Value: l.Value / r.Value}
-			case REM:
-				return &I32{Value: l.Value % r.Value}
-			default:
-				panic(op)
+		index = int64(o.Value)
+	case *I64:
+		index = o.Value
+	default:
+		return 0, runtimeError(TYPE_ERROR, "cannot index with %s", typeName(o))
+	}
+	if index < 0 || index >= int64(length) {
+		return 0, runtimeError(BAD_ACCESS, "index %d out of range for length %d", index, length)
+	}
+	return int(index), nil
+}
+
+func toList(o Object) (*List, *RuntimeError) {
+	list, ok := o.(*List)
+	if !ok {
+		return nil, runtimeError(TYPE_ERROR, "expected a list, got %s", typeName(o))
+	}
+	return list, nil
+}
+
+func toSlot(o Object, slot int) (*Struct, *RuntimeError) {
+	s, ok := o.(*Struct)
+	if !ok {
+		return nil, runtimeError(TYPE_ERROR, "expected a struct, got %s", typeName(o))
+	}
+	if slot < 0 || slot >= len(s.Slots) {
+		return nil, runtimeError(BAD_ACCESS, "slot %d out of range for %s", slot, typeName(o))
+	}
+	return s, nil
+}
+
+// Converts a number to the given numeric type, or a list of runes to a
+// string.
+func convert(o Object, t Type) (Object, *RuntimeError) {
+	if list, ok := o.(*List); ok && t == StringType {
+		runes := make([]rune, len(list.Items))
+		for idx, item := range list.Items {
+			r, ok := item.(*I32)
+			if !ok {
+				return nil, runtimeError(TYPE_ERROR, "cannot convert %s to %s", typeName(item), t.Name())
 			}
+			runes[idx] = r.Value
+		}
+		return &String{Value: string(runes)}, nil
+	}
+	var i int64
+	var f float64
+	isFloat := false
+	switch o := o.(type) {
+	case *I32:
+		i = int64(o.Value)
+	case *I64:
+		i = o.Value
+	case *F32:
+		f = float64(o.Value)
+		isFloat = true
+	case *F64:
+		f = o.Value
+		isFloat = true
+	default:
+		return nil, runtimeError(TYPE_ERROR, "cannot convert %s to %s", typeName(o), t.Name())
+	}
+	if isFloat {
+		i = int64(f)
+	} else {
+		f = float64(i)
+	}
+	switch t {
+	case I32Type:
+		return &I32{Value: int32(i)}, nil
+	case I64Type:
+		return &I64{Value: i}, nil
+	case F32Type:
+		return &F32{Value: float32(f)}, nil
+	case F64Type:
+		return &F64{Value: f}, nil
+	default:
+		return nil, runtimeError(TYPE_ERROR, "cannot convert %s to %s", typeName(o), t.Name())
+	}
+}
+
+func compareInt(l int64, r int64) int {
+	if l < r {
+		return -1
+	} else if l > r {
+		return 1
+	}
+	return 0
+}
+
+func compareFloat(l float64, r float64) int {
+	if l < r {
+		return -1
+	} else if l > r {
+		return 1
+	}
+	return 0
+}
+
+func compareString(l string, r string) int {
+	if l < r {
+		return -1
+	} else if l > r {
+		return 1
+	}
+	return 0
+}
+
+// Turns the result of a three way comparison into the result of a comparison
+// op.
+func comparison(op BinOp, c int) (Object, bool) {
+	switch op {
+	case EQ:
+		return &Bool{Value: c == 0}, true
+	case NE:
+		return &Bool{Value: c != 0}, true
+	case LT:
+		return &Bool{Value: c < 0}, true
+	case LE:
+		return &Bool{Value: c <= 0}, true
+	case GT:
+		return &Bool{Value: c > 0}, true
+	case GE:
+		return &Bool{Value: c >= 0}, true
+	default:
+		return nil, false
+	}
+}
+
+func intOp(op BinOp, l int64, r int64) (int64, Object, *RuntimeError) {
+	switch op {
+	case ADD:
+		return l + r, nil, nil
+	case SUB:
+		return l - r, nil, nil
+	case MUL:
+		return l * r, nil, nil
+	case DIV, REM:
+		if r == 0 {
+			return 0, nil, runtimeError(DIVIDE_BY_ZERO, "integer divide by zero")
+		}
+		if op == DIV {
+			return l / r, nil, nil
+		}
+		return l % r, nil, nil
+	default:
+		o, _ := comparison(op, compareInt(l, r))
+		return 0, o, nil
+	}
+}
+
+func floatOp(op BinOp, l float64, r float64) (float64, Object, bool) {
+	switch op {
+	case ADD:
+		return l + r, nil, true
+	case SUB:
+		return l - r, nil, true
+	case MUL:
+		return l * r, nil, true
+	case DIV:
+		return l / r, nil, true
+	default:
+		o, ok := comparison(op, compareFloat(l, r))
+		return 0, o, ok
+	}
+}
+
+func unsupportedOp(op BinOp, o Object) *RuntimeError {
+	return runtimeError(TYPE_ERROR, "%s is not defined for %s", op, typeName(o))
+}
+
+func binop(op BinOp, l Object, r Object) (Object, *RuntimeError) {
+	if op < ADD || op > GE {
+		return nil, runtimeError(BAD_OP, "unknown binary op %d", int(op))
+	}
+	if l == nil || r == nil || !SameType(l.Type(), r.Type()) {
+		if (op == EQ || op == NE) && l != nil && r != nil {
+			// Values of a sum type may have different types.
+			return &Bool{Value: op == NE}, nil
+		}
+		return nil, runtimeError(TYPE_ERROR, "mismatched types %s and %s", typeName(l), typeName(r))
+	}
+	switch l := l.(type) {
+	case *I32:
+		value, o, err := intOp(op, int64(l.Value), int64(r.(*I32).Value))
+		if err != nil || o != nil {
+			return o, err
+		}
+		return &I32{Value: int32(value)}, nil
+	case *I64:
+		value, o, err := intOp(op, l.Value, r.(*I64).Value)
+		if err != nil || o != nil {
+			return o, err
+		}
+		return &I64{Value: value}, nil
+	case *F32:
+		value, o, ok := floatOp(op, float64(l.Value), float64(r.(*F32).Value))
+		if !ok {
+			return nil, unsupportedOp(op, l)
+		}
+		if o != nil {
+			return o, nil
+		}
+		return &F32{Value: float32(value)}, nil
+	case *F64:
+		value, o, ok := floatOp(op, l.Value, r.(*F64).Value)
+		if !ok {
+			return nil, unsupportedOp(op, l)
+		}
+		if o != nil {
+			return o, nil
+		}
+		return &F64{Value: value}, nil
+	case *String:
+		r := r.(*String)
+		if op == ADD {
+			return &String{Value: l.Value + r.Value}, nil
+		}
+		o, ok := comparison(op, compareString(l.Value, r.Value))
+		if !ok {
+			return nil, unsupportedOp(op, l)
+		}
+		return o, nil
+	case *Bool:
+		r := r.(*Bool)
+		switch op {
+		case EQ:
+			return &Bool{Value: l.Value == r.Value}, nil
+		case NE:
+			return &Bool{Value: l.Value != r.Value}, nil
 		default:
-			panic(r)
+			return nil, unsupportedOp(op, l)
 		}
 	default:
-		panic(l)
+		// Structs and lists are compared by identity.
+		switch op {
+		case EQ:
+			return &Bool{Value: l == r}, nil
+		case NE:
+			return &Bool{Value: l != r}, nil
+		default:
+			return nil, unsupportedOp(op, l)
+		}
 	}
 }
 
@@ -67,9 +310,26 @@ type Interpreter struct {
 	Temp    []Object
 	TempLen int
 	Funcs   []*Function
+
+	// The input consumed by Peek and Consume.
+	Stream []rune
+	Index  int
+
+	// Limits for running untrusted code.  Zero means unlimited.
+	MaxCallDepth int
+	MaxSteps     int
+	// The number of ops executed so far.
+	Steps int
+}
+
+func (i *Interpreter) growTemp(n int) {
+	if n > len(i.Temp) {
+		i.Temp = append(i.Temp, make([]Object, n-len(i.Temp))...)
+	}
 }
 
 func (i *Interpreter) GatherTemp(args Locals) {
+	i.growTemp(len(args))
 	for idx, lcl := range args {
 		i.Temp[idx] = i.Frame.Locals[lcl]
 	}
@@ -83,16 +343,65 @@ func (i *Interpreter) ScatterTemp(targets Locals) {
 }
 
 func (i *Interpreter) SetTemp(args []Object) {
+	i.growTemp(len(args))
 	for idx, o := range args {
 		i.Temp[idx] = o
 	}
 	i.TempLen = len(args)
 }
 
-func (i *Interpreter) Invoke(uid int) {
+func (i *Interpreter) SetStream(stream []rune) {
+	i.Stream = stream
+	i.Index = 0
+}
+
+// Branches to the failure handler of the current op.  If there is no handler,
+// the frame fails and the search continues at the call in the parent frame.
+// Returns true when the outermost frame fails.
+func (i *Interpreter) fail() bool {
+	i.Flow = FAIL
+	for i.Frame != nil {
+		frame := i.Frame
+		handler, ok := frame.F.FailHandlers[frame.Location]
+		if ok {
+			frame.Location = handler
+			return false
+		}
+		i.Frame = frame.Parent
+	}
+	i.TempLen = 0
+	return true
+}
+
+// Attaches a trace of the current stack to an error, and unwinds the stack.
+func (i *Interpreter) raise(err *RuntimeError) *RuntimeError {
+	for frame := i.Frame; frame != nil; frame = frame.Parent {
+		err.Trace = append(err.Trace, TraceEntry{Function: frame.F.Name, Location: frame.Location})
+	}
+	i.Frame = nil
+	return err
+}
+
+func (i *Interpreter) invoke(uid int) *RuntimeError {
+	if uid < 0 || uid >= len(i.Funcs) {
+		return runtimeError(BAD_CALL, "no function %d", uid)
+	}
 	f := i.Funcs[uid]
 	if i.TempLen != f.NumParams {
-		panic(i.TempLen)
+		return runtimeError(BAD_CALL, "%s expects %d arguments, got %d", f.Name, f.NumParams, i.TempLen)
+	}
+	depth := 1
+	if i.Frame != nil {
+		depth = i.Frame.Depth + 1
+	} else {
+		// A fresh call from the host.
+		i.Flow = NORMAL
+	}
+	if f.Native != nil {
+		return i.callNative(f)
+	}
+	if i.MaxCallDepth > 0 && depth > i.MaxCallDepth {
+		return runtimeError(CALL_DEPTH_EXCEEDED, "calling %s exceeds the call depth limit of %d", f.Name, i.MaxCallDepth)
 	}
 
 	// Create the new stack frame.
@@ -101,63 +410,235 @@ func (i *Interpreter) Invoke(uid int) {
 		Location: 0,
 		Locals:   make([]Object, f.NumLocals),
 		Parent:   i.Frame,
+		Depth:    depth,
 	}
 
 	// Set the function arguments.
 	for idx := 0; idx < i.TempLen; idx++ {
 		i.Frame.Locals[idx] = i.Temp[idx]
 	}
+	return nil
 }
 
-func (i *Interpreter) Run() {
-	for {
-		switch op := i.Frame.F.Body[i.Frame.Location].(type) {
-		case *ConditionalJump:
-			if toBool(i.Frame.Locals[op.Arg]) {
-				i.Frame.Location = op.Location
-				continue
-			}
-		case *Jump:
-			i.Frame.Location = op.Location
-			continue
-		case *StoreConst:
-			i.Frame.Locals[op.Target] = i.Frame.F.Constants[op.Const]
-		case *BinaryOp:
-			i.Frame.Locals[op.Target] = binop(op.Op, i.Frame.Locals[op.Left], i.Frame.Locals[op.Right])
-		case *GetAttr:
-			expr := i.Frame.Locals[op.Expr]
-			switch expr := expr.(type) {
-			case *Struct:
-				i.Frame.Locals[op.Target] = expr.Slots[op.Slot]
-			default:
-				panic(expr)
-			}
-		case *SetAttr:
-			expr := i.Frame.Locals[op.Expr]
-			value := i.Frame.Locals[op.Value]
-			switch expr := expr.(type) {
-			case *Struct:
-				expr.Slots[op.Slot] = value
-			default:
-				panic(expr)
-			}
-		case *Call:
-			i.GatherTemp(op.Args)
-			i.Frame.Targets = op.Targets
-			i.Invoke(op.Func)
-			continue
-		case *Return:
-			i.GatherTemp(op.Args)
-			i.Frame = i.Frame.Parent
-			if i.Frame == nil {
-				// Returned off end of stack.
-				return
-			}
-			i.ScatterTemp(i.Frame.Targets)
-		default:
-			panic(op)
+// Natives run immediately and leave their results in Temp, without pushing a
+// frame.
+func (i *Interpreter) callNative(f *Function) *RuntimeError {
+	args := make([]Object, i.TempLen)
+	copy(args, i.Temp[:i.TempLen])
+	results, err := f.Native(args)
+	if err != nil {
+		if rtErr, ok := err.(*RuntimeError); ok {
+			return rtErr
 		}
+		return runtimeError(NATIVE_ERROR, "%s: %s", f.Name, err)
+	}
+	i.SetTemp(results)
+	return nil
+}
+
+func (i *Interpreter) Invoke(uid int) error {
+	if err := i.invoke(uid); err != nil {
+		return i.raise(err)
+	}
+	return nil
+}
+
+// Invokes a function with the arguments in temp and runs it to completion.
+func (i *Interpreter) Call(uid int) error {
+	if err := i.Invoke(uid); err != nil {
+		return err
+	}
+	return i.Run()
+}
+
+// Executes a single op in the current frame.  Returns true when the outermost
+// frame returns.
+func (i *Interpreter) step() (bool, *RuntimeError) {
+	frame := i.Frame
+	if frame.Location < 0 || frame.Location >= len(frame.F.Body) {
+		return false, runtimeError(BAD_OP, "location %d is outside of %s", frame.Location, frame.F.Name)
+	}
+	if i.MaxSteps > 0 && i.Steps >= i.MaxSteps {
+		return false, runtimeError(STEP_LIMIT_EXCEEDED, "exceeded the limit of %d steps", i.MaxSteps)
+	}
+	i.Steps += 1
+
+	locals := frame.Locals
+	switch op := frame.F.Body[frame.Location].(type) {
+	case *ConditionalJump:
+		cond, err := toBool(locals[op.Arg])
+		if err != nil {
+			return false, err
+		}
+		if cond {
+			frame.Location = op.Location
+			return false, nil
+		}
+	case *Jump:
+		frame.Location = op.Location
+		return false, nil
+	case *Copy:
+		locals[op.Target] = locals[op.Src]
+	case *StoreConst:
+		locals[op.Target] = frame.F.Constants[op.Const]
+	case *BinaryOp:
+		o, err := binop(op.Op, locals[op.Left], locals[op.Right])
+		if err != nil {
+			return false, err
+		}
+		locals[op.Target] = o
+	case *GetAttr:
+		s, err := toSlot(locals[op.Expr], op.Slot)
+		if err != nil {
+			return false, err
+		}
+		locals[op.Target] = s.Slots[op.Slot]
+	case *SetAttr:
+		s, err := toSlot(locals[op.Expr], op.Slot)
+		if err != nil {
+			return false, err
+		}
+		s.Slots[op.Slot] = locals[op.Value]
+	case *CreateStruct:
+		slots := make([]Object, len(op.Args))
+		for idx, lcl := range op.Args {
+			slots[idx] = locals[lcl]
+		}
+		locals[op.Target] = &Struct{T: op.Type, Slots: slots}
+	case *CreateList:
+		items := make([]Object, len(op.Args))
+		for idx, lcl := range op.Args {
+			items[idx] = locals[lcl]
+		}
+		locals[op.Target] = &List{T: op.Type, Items: items}
+	case *GetIndex:
+		list, err := toList(locals[op.List])
+		if err != nil {
+			return false, err
+		}
+		index, err := toIndex(locals[op.Index], len(list.Items))
+		if err != nil {
+			return false, err
+		}
+		locals[op.Target] = list.Items[index]
+	case *SetIndex:
+		list, err := toList(locals[op.List])
+		if err != nil {
+			return false, err
+		}
+		index, err := toIndex(locals[op.Index], len(list.Items))
+		if err != nil {
+			return false, err
+		}
+		list.Items[index] = locals[op.Value]
+	case *Append:
+		list, err := toList(locals[op.List])
+		if err != nil {
+			return false, err
+		}
+		list.Items = append(list.Items, locals[op.Value])
+	case *Length:
+		list, err := toList(locals[op.List])
+		if err != nil {
+			return false, err
+		}
+		locals[op.Target] = &I32{Value: int32(len(list.Items))}
+	case *TestType:
+		o := locals[op.Arg]
+		locals[op.Target] = &Bool{Value: o != nil && SameType(o.Type(), op.Type)}
+	case *GetTag:
+		o := locals[op.Arg]
+		tag := -1
+		if o != nil {
+			tag = op.Sum.Tag(o)
+		}
+		locals[op.Target] = &I32{Value: int32(tag)}
+	case *Fail:
+		return i.fail(), nil
+	case *Checkpoint:
+		locals[op.Target] = &I32{Value: int32(i.Index)}
+	case *Recover:
+		index, err := toIndex(locals[op.Checkpoint], len(i.Stream)+1)
+		if err != nil {
+			return false, err
+		}
+		i.Index = index
+		i.Flow = NORMAL
+	case *Peek:
+		if i.Index >= len(i.Stream) {
+			return i.fail(), nil
+		}
+		locals[op.Target] = &I32{Value: int32(i.Stream[i.Index])}
+	case *Consume:
+		if i.Index >= len(i.Stream) {
+			return i.fail(), nil
+		}
+		i.Index += 1
+	case *Slice:
+		end, err := toIndex(locals[op.End], len(i.Stream)+1)
+		if err != nil {
+			return false, err
+		}
+		begin, err := toIndex(locals[op.Begin], end+1)
+		if err != nil {
+			return false, err
+		}
+		locals[op.Target] = &String{Value: string(i.Stream[begin:end])}
+	case *Convert:
+		o, err := convert(locals[op.Src], op.Type)
+		if err != nil {
+			return false, err
+		}
+		locals[op.Target] = o
+	case *Call:
+		i.GatherTemp(op.Args)
+		frame.Targets = op.Targets
+		if err := i.invoke(op.Func); err != nil {
+			return false, err
+		}
+		if i.Frame != frame {
+			return false, nil
+		}
+		// A native function has already returned.
+		if len(op.Targets) > i.TempLen {
+			return false, runtimeError(BAD_CALL, "%s returned %d values, expected %d", i.Funcs[op.Func].Name, i.TempLen, len(op.Targets))
+		}
+		i.ScatterTemp(op.Targets)
+	case *Return:
+		i.GatherTemp(op.Args)
+		i.Frame = frame.Parent
+		if i.Frame == nil {
+			// Returned off end of stack.
+			return true, nil
+		}
+		if len(i.Frame.Targets) > i.TempLen {
+			return false, runtimeError(BAD_CALL, "%s returned %d values, expected %d", frame.F.Name, i.TempLen, len(i.Frame.Targets))
+		}
+		i.ScatterTemp(i.Frame.Targets)
 		i.Frame.Location += 1
+		return false, nil
+	default:
+		return false, runtimeError(BAD_OP, "unknown op %T", op)
+	}
+	frame.Location += 1
+	return false, nil
+}
+
+// Runs until the outermost frame returns or fails.  The flow tells which one
+// happened.  If a RuntimeError is returned, the stack has been unwound.
+func (i *Interpreter) Run() error {
+	// A native invoked by the host has already returned.
+	if i.Frame == nil {
+		return nil
+	}
+	for {
+		done, err := i.step()
+		if err != nil {
+			return i.raise(err)
+		}
+		if done {
+			return nil
+		}
 	}
 }
 
@@ -7,7 +7,9 @@ import (
 func callAndReturnInt(i *Interpreter, f int, args []Object, value int32, t *testing.T) {
 	i.SetTemp(args)
 	i.Invoke(f)
-	i.Run()
+	if err := i.Run(); err != nil {
+		t.Fatal(err)
+	}
 	if i.Flow != NORMAL {
 		t.Errorf("Expected normal flow, got %d", i.Flow)
 	}
@@ -145,3 +147,289 @@ func TestSetAttr(t *testing.T) {
 	o := &Struct{Slots: []Object{b.i32(13), b.i32(17)}}
 	callAndReturnInt(i, 0, []Object{o}, 15, t)
 }
+
+func callAndReturn(i *Interpreter, f int, args []Object, t *testing.T) Object {
+	i.SetTemp(args)
+	i.Invoke(f)
+	if err := i.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if i.TempLen != 1 {
+		t.Fatalf("Expected 1 return value, got %d", i.TempLen)
+	}
+	return i.Temp[0]
+}
+
+func TestCompare(t *testing.T) {
+	b := CreateProgramBuilder()
+	funcs := []*Function{
+		&Function{
+			Name:      "Less",
+			NumParams: 2,
+			NumLocals: 3,
+			Constants: []Object{},
+			Body: []Op{
+				&BinaryOp{Op: LT, Left: 0, Right: 1, Target: 2},
+				&Return{Args: Locals{2}},
+			},
+		},
+		&Function{
+			Name:      "Equal",
+			NumParams: 2,
+			NumLocals: 3,
+			Constants: []Object{},
+			Body: []Op{
+				&BinaryOp{Op: EQ, Left: 0, Right: 1, Target: 2},
+				&Return{Args: Locals{2}},
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	checkBool := func(f int, l Object, r Object, expected bool) {
+		o := callAndReturn(i, f, []Object{l, r}, t)
+		if v, ok := o.(*Bool); !ok || v.Value != expected {
+			t.Errorf("%s(%#v, %#v): expected %v, got %#v", funcs[f].Name, l, r, expected, o)
+		}
+	}
+	checkBool(0, b.i32(1), b.i32(2), true)
+	checkBool(0, b.i64(3), b.i64(2), false)
+	checkBool(0, b.f64(-0.5), b.f64(0.5), true)
+	checkBool(0, b.str("abc"), b.str("abd"), true)
+	checkBool(1, b.boolean(true), b.boolean(true), true)
+	checkBool(1, b.str("x"), b.str("y"), false)
+	// Values of a sum type may have different types.
+	checkBool(1, b.i32(1), b.str("1"), false)
+}
+
+func TestConcat(t *testing.T) {
+	b := CreateProgramBuilder()
+	funcs := []*Function{
+		&Function{
+			Name:      "Greet",
+			NumParams: 1,
+			NumLocals: 2,
+			Constants: []Object{b.str("hello, ")},
+			Body: []Op{
+				&StoreConst{Const: 0, Target: 1},
+				&BinaryOp{Op: ADD, Left: 1, Right: 0, Target: 1},
+				&Return{Args: Locals{1}},
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	o := callAndReturn(i, 0, []Object{b.str("world")}, t)
+	if s, ok := o.(*String); !ok || s.Value != "hello, world" {
+		t.Errorf("Got %#v", o)
+	}
+}
+
+func TestLists(t *testing.T) {
+	b := CreateProgramBuilder()
+	lt := &ListType{Element: I32Type}
+	funcs := []*Function{
+		&Function{
+			Name:      "Build",
+			NumParams: 2,
+			NumLocals: 5,
+			Constants: []Object{b.i32(0), b.i32(1)},
+			Body: []Op{
+				// [a, a]
+				&CreateList{Type: lt, Args: Locals{0, 0}, Target: 2},
+				// [a, a, b]
+				&Append{List: 2, Value: 1},
+				// [b, a, b]
+				&StoreConst{Const: 0, Target: 3},
+				&SetIndex{List: 2, Index: 3, Value: 1},
+				// list[1] * len(list)
+				&StoreConst{Const: 1, Target: 3},
+				&GetIndex{List: 2, Index: 3, Target: 4},
+				&Length{List: 2, Target: 3},
+				&BinaryOp{Op: MUL, Left: 4, Right: 3, Target: 4},
+				&BinaryOp{Op: ADD, Left: 4, Right: 1, Target: 4},
+				&Return{Args: Locals{4}},
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	callAndReturnInt(i, 0, []Object{b.i32(5), b.i32(7)}, 22, t)
+}
+
+func TestTags(t *testing.T) {
+	b := CreateProgramBuilder()
+	point := &StructType{StructName: "Point"}
+	sum := &SumType{Variants: []Type{I32Type, &ListType{Element: I32Type}, point}}
+	funcs := []*Function{
+		&Function{
+			Name:      "Tag",
+			NumParams: 1,
+			NumLocals: 2,
+			Constants: []Object{},
+			Body: []Op{
+				&GetTag{Arg: 0, Sum: sum, Target: 1},
+				&Return{Args: Locals{1}},
+			},
+		},
+		&Function{
+			Name:      "IsList",
+			NumParams: 1,
+			NumLocals: 2,
+			Constants: []Object{},
+			Body: []Op{
+				&TestType{Arg: 0, Type: &ListType{Element: I32Type}, Target: 1},
+				&Return{Args: Locals{1}},
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	list := &List{T: &ListType{Element: I32Type}}
+	callAndReturnInt(i, 0, []Object{b.i32(3)}, 0, t)
+	callAndReturnInt(i, 0, []Object{list}, 1, t)
+	callAndReturnInt(i, 0, []Object{&Struct{T: point}}, 2, t)
+	callAndReturnInt(i, 0, []Object{b.str("x")}, -1, t)
+
+	if o := callAndReturn(i, 1, []Object{list}, t); !o.(*Bool).Value {
+		t.Error("Expected a list")
+	}
+	if o := callAndReturn(i, 1, []Object{b.i32(3)}, t); o.(*Bool).Value {
+		t.Error("Did not expect a list")
+	}
+}
+
+// Builds a function that matches "ab" at the start of the stream and a
+// function that tries it, backtracking on failure.
+func makeMatcher(b *ProgramBuilder) []*Function {
+	return []*Function{
+		&Function{
+			Name:      "AB",
+			NumParams: 0,
+			NumLocals: 2,
+			Constants: []Object{
+				b.i32('a'),
+				b.i32('b'),
+			},
+			Body: []Op{
+				&Peek{Target: 0},
+				&StoreConst{Const: 0, Target: 1},
+				&BinaryOp{Op: NE, Left: 0, Right: 1, Target: 1},
+				&ConditionalJump{Arg: 1, Location: 11},
+				&Consume{},
+				&Peek{Target: 0},
+				&StoreConst{Const: 1, Target: 1},
+				&BinaryOp{Op: NE, Left: 0, Right: 1, Target: 1},
+				&ConditionalJump{Arg: 1, Location: 11},
+				&Consume{},
+				&Return{Args: Locals{}},
+				&Fail{},
+			},
+		},
+		&Function{
+			Name:      "Try",
+			NumParams: 0,
+			NumLocals: 2,
+			Constants: []Object{
+				b.i32(1),
+				b.i32(0),
+			},
+			Body: []Op{
+				&Checkpoint{Target: 0},
+				&Call{Func: 0, Args: Locals{}, Targets: Locals{}},
+				&StoreConst{Const: 0, Target: 1},
+				&Return{Args: Locals{1}},
+				&Recover{Checkpoint: 0},
+				&StoreConst{Const: 1, Target: 1},
+				&Return{Args: Locals{1}},
+			},
+			FailHandlers: map[int]int{1: 4},
+		},
+	}
+}
+
+func TestFailHandler(t *testing.T) {
+	b := CreateProgramBuilder()
+	i := CreateInterpreter(makeMatcher(b))
+
+	i.SetStream([]rune("abc"))
+	callAndReturnInt(i, 1, []Object{}, 1, t)
+	if i.Index != 2 {
+		t.Errorf("Expected index 2, got %d", i.Index)
+	}
+
+	for _, input := range []string{"ax", "a", ""} {
+		i.SetStream([]rune(input))
+		callAndReturnInt(i, 1, []Object{}, 0, t)
+		if i.Index != 0 {
+			t.Errorf("Expected %#v to be rewound, got index %d", input, i.Index)
+		}
+	}
+}
+
+func TestFailOutermost(t *testing.T) {
+	b := CreateProgramBuilder()
+	i := CreateInterpreter(makeMatcher(b))
+
+	i.SetStream([]rune("ax"))
+	i.SetTemp([]Object{})
+	i.Invoke(0)
+	if err := i.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if i.Flow != FAIL {
+		t.Errorf("Expected fail flow, got %d", i.Flow)
+	}
+	if i.Frame != nil {
+		t.Error("Expected the stack to be unwound")
+	}
+	if i.TempLen != 0 {
+		t.Errorf("Expected no return values, got %d", i.TempLen)
+	}
+
+	// The next call starts with normal flow.
+	i.SetStream([]rune("ab"))
+	callAndReturnInt(i, 1, []Object{}, 1, t)
+}
+
+func TestSliceAndConvert(t *testing.T) {
+	b := CreateProgramBuilder()
+	funcs := []*Function{
+		&Function{
+			Name:      "Word",
+			NumParams: 0,
+			NumLocals: 3,
+			Constants: []Object{},
+			Body: []Op{
+				&Checkpoint{Target: 0},
+				&Consume{},
+				&Consume{},
+				&Checkpoint{Target: 1},
+				&Slice{Begin: 0, End: 1, Target: 2},
+				&Return{Args: Locals{2}},
+			},
+		},
+		&Function{
+			Name:      "Half",
+			NumParams: 1,
+			NumLocals: 2,
+			Constants: []Object{
+				b.f64(0.5),
+			},
+			Body: []Op{
+				&Convert{Src: 0, Type: F64Type, Target: 0},
+				&StoreConst{Const: 0, Target: 1},
+				&BinaryOp{Op: MUL, Left: 0, Right: 1, Target: 0},
+				&Convert{Src: 0, Type: I32Type, Target: 0},
+				&Return{Args: Locals{0}},
+			},
+		},
+	}
+
+	i := CreateInterpreter(funcs)
+	i.SetStream([]rune("hello"))
+	if o := callAndReturn(i, 0, []Object{}, t); o.(*String).Value != "he" {
+		t.Errorf("Expected \"he\", got %#v", o)
+	}
+	callAndReturnInt(i, 1, []Object{b.i32(9)}, 4, t)
+}
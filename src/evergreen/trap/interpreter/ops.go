@@ -10,6 +10,11 @@ type StoreConst struct {
 	Target int
 }
 
+type Copy struct {
+	Src    int
+	Target int
+}
+
 type Return struct {
 	Args Locals
 }
@@ -37,8 +42,20 @@ const (
 	MUL
 	DIV
 	REM
+	EQ
+	NE
+	LT
+	LE
+	GT
+	GE
 )
 
+var binOpNames = []string{"+", "-", "*", "/", "%", "==", "!=", "<", "<=", ">", ">="}
+
+func (op BinOp) String() string {
+	return binOpNames[op]
+}
+
 type BinaryOp struct {
 	Op     BinOp
 	Left   int
@@ -58,10 +75,109 @@ type SetAttr struct {
 	Value int
 }
 
+// Creates a struct with the arguments as its slots.
+type CreateStruct struct {
+	Type   *StructType
+	Args   Locals
+	Target int
+}
+
+type CreateList struct {
+	Type   *ListType
+	Args   Locals
+	Target int
+}
+
+type GetIndex struct {
+	List   int
+	Index  int
+	Target int
+}
+
+type SetIndex struct {
+	List  int
+	Index int
+	Value int
+}
+
+// Appends to the list in place.
+type Append struct {
+	List  int
+	Value int
+}
+
+type Length struct {
+	List   int
+	Target int
+}
+
+// Stores true if the argument has exactly the given type.
+type TestType struct {
+	Arg    int
+	Type   Type
+	Target int
+}
+
+// Stores the index of the sum type variant the argument belongs to.
+type GetTag struct {
+	Arg    int
+	Sum    *SumType
+	Target int
+}
+
+// Sets the flow to FAIL and branches to the failure handler.
+type Fail struct {
+}
+
+// Stores the current position in the input stream, so it can be restored by
+// Recover.
+type Checkpoint struct {
+	Target int
+}
+
+// Rewinds the input stream to a checkpoint and resets the flow to NORMAL.
+type Recover struct {
+	Checkpoint int
+}
+
+// Stores the next rune of the input stream as an i32, or fails at the end of
+// the stream.
+type Peek struct {
+	Target int
+}
+
+// Advances past the next rune of the input stream, or fails at the end of the
+// stream.
+type Consume struct {
+}
+
+// Stores the runes of the input stream between two checkpoints as a string.
+type Slice struct {
+	Begin  int
+	End    int
+	Target int
+}
+
+// Converts a number to another numeric type, or a list of runes to a string.
+type Convert struct {
+	Src    int
+	Type   Type
+	Target int
+}
+
+// A function implemented by the host.  The results must match the signature
+// it was registered with.
+type NativeFunc func(args []Object) ([]Object, error)
+
 type Function struct {
 	Name      string
 	NumParams int
 	NumLocals int
 	Constants []Object
 	Body      []Op
+	// Maps the location of an op that can fail to the location of its failure
+	// handler.  If a failing op has no handler, the function fails.
+	FailHandlers map[int]int
+	// Set for functions implemented by the host, which have no body.
+	Native NativeFunc
 }
@@ -1,34 +1,163 @@
 package interpreter
 
 type Type interface {
+	Name() string
 }
 
 type Object interface {
 	Type() Type
 }
 
-type I32Type struct {
+type BuiltinType struct {
+	name string
 }
 
-var i32Type Type = &I32Type{}
+func (t *BuiltinType) Name() string {
+	return t.name
+}
+
+var I32Type Type = &BuiltinType{name: "i32"}
+var I64Type Type = &BuiltinType{name: "i64"}
+var F32Type Type = &BuiltinType{name: "f32"}
+var F64Type Type = &BuiltinType{name: "f64"}
+var BoolType Type = &BuiltinType{name: "bool"}
+var StringType Type = &BuiltinType{name: "string"}
 
 type I32 struct {
 	Value int32
 }
 
 func (o *I32) Type() Type {
-	return i32Type
+	return I32Type
+}
+
+type I64 struct {
+	Value int64
+}
+
+func (o *I64) Type() Type {
+	return I64Type
+}
+
+type F32 struct {
+	Value float32
+}
+
+func (o *F32) Type() Type {
+	return F32Type
+}
+
+type F64 struct {
+	Value float64
+}
+
+func (o *F64) Type() Type {
+	return F64Type
+}
+
+type Bool struct {
+	Value bool
+}
+
+func (o *Bool) Type() Type {
+	return BoolType
+}
+
+type String struct {
+	Value string
 }
 
+func (o *String) Type() Type {
+	return StringType
+}
+
+// Each struct declaration has its own type, so structs with the same slots can
+// be told apart.
 type StructType struct {
+	StructName string
 }
 
-var structType Type = &StructType{}
+func (t *StructType) Name() string {
+	return t.StructName
+}
 
 type Struct struct {
+	T     *StructType
 	Slots []Object
 }
 
+// The type of structs that were created without one, such as by the host.
+var anonymousStructType = &StructType{StructName: "struct"}
+
 func (o *Struct) Type() Type {
-	return structType
+	if o.T == nil {
+		return anonymousStructType
+	}
+	return o.T
+}
+
+type ListType struct {
+	Element Type
+}
+
+func (t *ListType) Name() string {
+	return "[]" + t.Element.Name()
+}
+
+// Every item in a list has the list's element type.
+type List struct {
+	T     *ListType
+	Items []Object
+}
+
+func (o *List) Type() Type {
+	return o.T
+}
+
+// Values of a sum type are not wrapped, their own type is their variant tag.
+type SumType struct {
+	Variants []Type
+}
+
+func (t *SumType) Name() string {
+	name := ""
+	for i, v := range t.Variants {
+		if i > 0 {
+			name += " | "
+		}
+		name += v.Name()
+	}
+	return name
+}
+
+// The index of the variant an object belongs to, or -1 if it does not belong
+// to the sum type.
+func (t *SumType) Tag(o Object) int {
+	for i, v := range t.Variants {
+		if SameType(o.Type(), v) {
+			return i
+		}
+	}
+	return -1
+}
+
+func SameType(a Type, b Type) bool {
+	switch a := a.(type) {
+	case *ListType:
+		b, ok := b.(*ListType)
+		return ok && SameType(a.Element, b.Element)
+	case *SumType:
+		b, ok := b.(*SumType)
+		if !ok || len(a.Variants) != len(b.Variants) {
+			return false
+		}
+		for i, v := range a.Variants {
+			if !SameType(v, b.Variants[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
 }
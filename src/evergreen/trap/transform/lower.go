@@ -0,0 +1,430 @@
+package transform
+
+import (
+	"evergreen/compiler"
+	"evergreen/trap/interpreter"
+	"evergreen/trap/tree"
+	"fmt"
+	"strconv"
+)
+
+// Passed instead of a local when an expression may put its value anywhere.
+const noTarget = -1
+
+var binOps = map[string]interpreter.BinOp{
+	"+":  interpreter.ADD,
+	"-":  interpreter.SUB,
+	"*":  interpreter.MUL,
+	"/":  interpreter.DIV,
+	"%":  interpreter.REM,
+	"==": interpreter.EQ,
+	"!=": interpreter.NE,
+	"<":  interpreter.LT,
+	"<=": interpreter.LE,
+	">":  interpreter.GT,
+	">=": interpreter.GE,
+}
+
+var builtinTypes = map[tree.Type]interpreter.Type{
+	tree.I32Type:    interpreter.I32Type,
+	tree.I64Type:    interpreter.I64Type,
+	tree.F32Type:    interpreter.F32Type,
+	tree.F64Type:    interpreter.F64Type,
+	tree.BoolType:   interpreter.BoolType,
+	tree.StringType: interpreter.StringType,
+}
+
+type functionLowerer struct {
+	program   *tree.Program
+	funcs     map[*tree.FunctionInfo]int
+	structs   map[*tree.StructType]*interpreter.StructType
+	status    compiler.PassStatus
+	f         *interpreter.Function
+	constants map[interface{}]int
+	loops     []*loopJumps
+}
+
+// The jumps out of a loop, which are patched once the loop is lowered.
+type loopJumps struct {
+	breaks    []*interpreter.Jump
+	continues []*interpreter.Jump
+}
+
+func (l *functionLowerer) emit(op interpreter.Op) {
+	l.f.Body = append(l.f.Body, op)
+}
+
+// The location of the next op.
+func (l *functionLowerer) location() int {
+	return len(l.f.Body)
+}
+
+// Emits a jump to a location that may not be known yet.
+func (l *functionLowerer) jump(location int) *interpreter.Jump {
+	op := &interpreter.Jump{Location: location}
+	l.emit(op)
+	return op
+}
+
+func patch(jumps []*interpreter.Jump, location int) {
+	for _, op := range jumps {
+		op.Location = location
+	}
+}
+
+func (l *functionLowerer) allocTemp() int {
+	temp := l.f.NumLocals
+	l.f.NumLocals += 1
+	return temp
+}
+
+func (l *functionLowerer) dst(target int) int {
+	if target == noTarget {
+		return l.allocTemp()
+	}
+	return target
+}
+
+func (l *functionLowerer) move(src int, target int) int {
+	if target == noTarget || target == src {
+		return src
+	}
+	l.emit(&interpreter.Copy{Src: src, Target: target})
+	return target
+}
+
+// Adds a constant to the pool, unless an equal constant is already there.
+// The key is the constant's Go value.
+func (l *functionLowerer) constant(key interface{}, o interpreter.Object) int {
+	index, ok := l.constants[key]
+	if !ok {
+		index = len(l.f.Constants)
+		l.f.Constants = append(l.f.Constants, o)
+		l.constants[key] = index
+	}
+	return index
+}
+
+// The type values will have at runtime, or nil if the interpreter cannot
+// represent the type.
+func (l *functionLowerer) runtimeType(t tree.Type) interpreter.Type {
+	switch t := t.(type) {
+	case *tree.BuiltinType:
+		rt, ok := builtinTypes[t]
+		if !ok {
+			return nil
+		}
+		return rt
+	case *tree.StructType:
+		st, ok := l.structs[t]
+		if !ok {
+			st = &interpreter.StructType{StructName: t.Name}
+			l.structs[t] = st
+		}
+		return st
+	case *tree.ListType:
+		element := l.runtimeType(t.Type)
+		if element == nil {
+			return nil
+		}
+		return &interpreter.ListType{Element: element}
+	case *tree.SumType:
+		sum := &interpreter.SumType{}
+		for _, v := range t.Types {
+			rt := l.runtimeType(v)
+			if rt == nil {
+				return nil
+			}
+			sum.Variants = append(sum.Variants, rt)
+		}
+		return sum
+	default:
+		panic(t)
+	}
+}
+
+func (l *functionLowerer) unsupported(expr tree.Expr, what string, target int) int {
+	l.status.LocationError(tree.ExprPos(expr), fmt.Sprintf("%s is not supported by the interpreter", what))
+	return l.dst(target)
+}
+
+func (l *functionLowerer) lowerIntLiteral(expr *tree.IntLiteral, target int) int {
+	t := l.program.ExprTypes[expr]
+	var bits int
+	switch t {
+	case tree.I32Type:
+		bits = 32
+	case tree.I64Type:
+		bits = 64
+	default:
+		return l.unsupported(expr, tree.TypeName(t), target)
+	}
+	value, err := strconv.ParseInt(expr.Text, 10, bits)
+	if err != nil {
+		l.status.LocationError(expr.Pos, fmt.Sprintf("%s does not fit in %s", expr.Text, tree.TypeName(t)))
+	}
+	var index int
+	if bits == 32 {
+		index = l.constant(int32(value), &interpreter.I32{Value: int32(value)})
+	} else {
+		index = l.constant(value, &interpreter.I64{Value: value})
+	}
+	d := l.dst(target)
+	l.emit(&interpreter.StoreConst{Const: index, Target: d})
+	return d
+}
+
+func (l *functionLowerer) lowerAssignOp(expr *tree.AssignOp, target int) int {
+	switch t := expr.Target.(type) {
+	case *tree.GetName:
+		slot := l.lowerExpr(expr.Value, l.program.Names[t].Index)
+		return l.move(slot, target)
+	case *tree.GetAttr:
+		obj := l.lowerExpr(t.Expr, noTarget)
+		value := l.lowerExpr(expr.Value, noTarget)
+		l.emit(&interpreter.SetAttr{Expr: obj, Slot: l.program.Fields[t].Slot, Value: value})
+		return l.move(value, target)
+	case *tree.GetIndex:
+		list := l.lowerExpr(t.Expr, noTarget)
+		index := l.lowerExpr(t.Index, noTarget)
+		value := l.lowerExpr(expr.Value, noTarget)
+		l.emit(&interpreter.SetIndex{List: list, Index: index, Value: value})
+		return l.move(value, target)
+	default:
+		panic(t)
+	}
+}
+
+func (l *functionLowerer) lowerCreateStruct(expr *tree.CreateStruct, target int) int {
+	st, ok := l.program.ExprTypes[expr].(*tree.StructType)
+	if !ok {
+		// An empty list.
+		return l.lowerList(expr, l.program.ExprTypes[expr], []tree.Expr{}, target)
+	}
+	args := make(interpreter.Locals, len(st.Fields))
+	for _, arg := range expr.Args {
+		args[st.Field(arg.Name.Text).Slot] = l.lowerExpr(arg.Value, noTarget)
+	}
+	d := l.dst(target)
+	l.emit(&interpreter.CreateStruct{Type: l.runtimeType(st).(*interpreter.StructType), Args: args, Target: d})
+	return d
+}
+
+func (l *functionLowerer) lowerList(expr tree.Expr, t tree.Type, items []tree.Expr, target int) int {
+	lt, ok := l.runtimeType(t).(*interpreter.ListType)
+	if !ok {
+		return l.unsupported(expr, tree.TypeName(t), target)
+	}
+	args := make(interpreter.Locals, len(items))
+	for i, item := range items {
+		args[i] = l.lowerExpr(item, noTarget)
+	}
+	d := l.dst(target)
+	l.emit(&interpreter.CreateList{Type: lt, Args: args, Target: d})
+	return d
+}
+
+func (l *functionLowerer) lowerCall(expr *tree.Call, target int) int {
+	callee := l.program.Calls[expr]
+	args := make(interpreter.Locals, len(expr.Args))
+	for i, arg := range expr.Args {
+		args[i] = l.lowerExpr(arg, noTarget)
+	}
+	targets := interpreter.Locals{}
+	d := noTarget
+	if len(callee.Results) == 1 {
+		d = l.dst(target)
+		targets = interpreter.Locals{d}
+	}
+	l.emit(&interpreter.Call{Func: l.funcs[callee], Args: args, Targets: targets})
+	return d
+}
+
+// Returns the local holding the value of the expression.  If a target is
+// given, the value will be put there.
+func (l *functionLowerer) lowerExpr(expr tree.Expr, target int) int {
+	switch expr := expr.(type) {
+	case *tree.IntLiteral:
+		return l.lowerIntLiteral(expr, target)
+	case *tree.GetName:
+		return l.move(l.program.Names[expr].Index, target)
+	case *tree.InfixOp:
+		op, ok := binOps[expr.Op.Text]
+		if !ok {
+			return l.unsupported(expr, fmt.Sprintf("operator %s", expr.Op.Text), target)
+		}
+		if t := l.program.ExprTypes[expr.Left]; l.runtimeType(t) == nil {
+			return l.unsupported(expr, fmt.Sprintf("operator %s on %s", expr.Op.Text, tree.TypeName(t)), target)
+		}
+		left := l.lowerExpr(expr.Left, noTarget)
+		right := l.lowerExpr(expr.Right, noTarget)
+		d := l.dst(target)
+		l.emit(&interpreter.BinaryOp{Op: op, Left: left, Right: right, Target: d})
+		return d
+	case *tree.GetAttr:
+		obj := l.lowerExpr(expr.Expr, noTarget)
+		d := l.dst(target)
+		l.emit(&interpreter.GetAttr{Expr: obj, Slot: l.program.Fields[expr].Slot, Target: d})
+		return d
+	case *tree.AssignOp:
+		return l.lowerAssignOp(expr, target)
+	case *tree.CreateStruct:
+		return l.lowerCreateStruct(expr, target)
+	case *tree.Call:
+		return l.lowerCall(expr, target)
+	case *tree.GetIndex:
+		list := l.lowerExpr(expr.Expr, noTarget)
+		index := l.lowerExpr(expr.Index, noTarget)
+		d := l.dst(target)
+		l.emit(&interpreter.GetIndex{List: list, Index: index, Target: d})
+		return d
+	case *tree.CreateList:
+		return l.lowerList(expr, l.program.ExprTypes[expr], expr.Args, target)
+	default:
+		panic(expr)
+	}
+}
+
+func (l *functionLowerer) lowerBlock(body []tree.Stmt) {
+	for _, stmt := range body {
+		l.lowerStmt(stmt)
+	}
+}
+
+// The jump is taken when the condition is true, so the else block comes first.
+func (l *functionLowerer) lowerIf(stmt *tree.If) {
+	branch := &interpreter.ConditionalJump{Arg: l.lowerExpr(stmt.Cond, noTarget)}
+	l.emit(branch)
+	l.lowerBlock(stmt.Else)
+	var end *interpreter.Jump
+	if !tree.Terminates(stmt.Else) {
+		end = l.jump(0)
+	}
+	branch.Location = l.location()
+	l.lowerBlock(stmt.Body)
+	if end != nil {
+		end.Location = l.location()
+	}
+}
+
+// Returns the breaks and continues in the body, for the caller to patch.
+func (l *functionLowerer) lowerLoopBody(body []tree.Stmt) *loopJumps {
+	jumps := &loopJumps{}
+	l.loops = append(l.loops, jumps)
+	l.lowerBlock(body)
+	l.loops = l.loops[:len(l.loops)-1]
+	return jumps
+}
+
+func (l *functionLowerer) lowerWhile(stmt *tree.While) {
+	top := l.location()
+	branch := &interpreter.ConditionalJump{Arg: l.lowerExpr(stmt.Cond, noTarget)}
+	l.emit(branch)
+	exit := l.jump(0)
+	branch.Location = l.location()
+	jumps := l.lowerLoopBody(stmt.Body)
+	patch(jumps.continues, top)
+	l.jump(top)
+	patch(append(jumps.breaks, exit), l.location())
+}
+
+// Iterates with a hidden index.  The list is copied first, so assigning to the
+// variable it came from does not change what is iterated over.
+func (l *functionLowerer) lowerFor(stmt *tree.For) {
+	list := l.lowerExpr(stmt.Expr, l.allocTemp())
+	index := l.allocTemp()
+	l.emit(&interpreter.StoreConst{Const: l.constant(int32(0), &interpreter.I32{Value: 0}), Target: index})
+
+	top := l.location()
+	length := l.allocTemp()
+	l.emit(&interpreter.Length{List: list, Target: length})
+	more := l.allocTemp()
+	l.emit(&interpreter.BinaryOp{Op: interpreter.LT, Left: index, Right: length, Target: more})
+	branch := &interpreter.ConditionalJump{Arg: more}
+	l.emit(branch)
+	exit := l.jump(0)
+	branch.Location = l.location()
+	l.emit(&interpreter.GetIndex{List: list, Index: index, Target: l.program.ForLocals[stmt].Index})
+
+	jumps := l.lowerLoopBody(stmt.Body)
+	patch(jumps.continues, l.location())
+	one := l.allocTemp()
+	l.emit(&interpreter.StoreConst{Const: l.constant(int32(1), &interpreter.I32{Value: 1}), Target: one})
+	l.emit(&interpreter.BinaryOp{Op: interpreter.ADD, Left: index, Right: one, Target: index})
+	l.jump(top)
+	patch(append(jumps.breaks, exit), l.location())
+}
+
+func (l *functionLowerer) lowerStmt(stmt tree.Stmt) {
+	switch stmt := stmt.(type) {
+	case *tree.Block:
+		l.lowerBlock(stmt.Body)
+	case *tree.If:
+		l.lowerIf(stmt)
+	case *tree.While:
+		l.lowerWhile(stmt)
+	case *tree.For:
+		l.lowerFor(stmt)
+	case *tree.Break:
+		loop := l.loops[len(l.loops)-1]
+		loop.breaks = append(loop.breaks, l.jump(0))
+	case *tree.Continue:
+		loop := l.loops[len(l.loops)-1]
+		loop.continues = append(loop.continues, l.jump(0))
+	case *tree.Return:
+		args := interpreter.Locals{}
+		if stmt.Expr != nil {
+			args = interpreter.Locals{l.lowerExpr(stmt.Expr, noTarget)}
+		}
+		l.emit(&interpreter.Return{Args: args})
+	case tree.Expr:
+		l.lowerExpr(stmt, noTarget)
+	default:
+		panic(stmt)
+	}
+}
+
+func (l *functionLowerer) lowerFunction(info *tree.FunctionInfo) *interpreter.Function {
+	l.f = &interpreter.Function{
+		Name:      info.Name,
+		NumParams: len(info.Params),
+		NumLocals: len(info.Locals),
+		Constants: []interpreter.Object{},
+	}
+	l.constants = map[interface{}]int{}
+
+	body := info.Decl.Body
+	l.lowerBlock(body)
+	if !tree.Terminates(body) {
+		l.emit(&interpreter.Return{Args: interpreter.Locals{}})
+	}
+	return l.f
+}
+
+// Compiles a checked trap program into interpreter functions.  The functions
+// are in the same order as the program's functions, so calls can refer to
+// them by index.  The natives must be the registry the program was checked
+// against.
+func LowerProgram(program *tree.Program, natives *Registry, status compiler.PassStatus) []*interpreter.Function {
+	status.Begin()
+	defer status.End()
+
+	l := &functionLowerer{
+		program: program,
+		funcs:   map[*tree.FunctionInfo]int{},
+		structs: map[*tree.StructType]*interpreter.StructType{},
+		status:  status,
+	}
+	for i, f := range program.Funcs {
+		l.funcs[f] = i
+	}
+	funcs := make([]*interpreter.Function, len(program.Funcs))
+	for i, f := range program.Funcs {
+		if f.Decl == nil {
+			funcs[i] = natives.function(f)
+		} else {
+			funcs[i] = l.lowerFunction(f)
+		}
+	}
+	return funcs
+}
@@ -0,0 +1,203 @@
+package transform
+
+import (
+	"evergreen/assert"
+	"evergreen/compiler"
+	"evergreen/trap/interpreter"
+	"evergreen/trap/tree"
+	"testing"
+)
+
+func i32(value int32) interpreter.Object {
+	return &interpreter.I32{Value: value}
+}
+
+func i64(value int64) interpreter.Object {
+	return &interpreter.I64{Value: value}
+}
+
+func str(value string) interpreter.Object {
+	return &interpreter.String{Value: value}
+}
+
+func call(i *interpreter.Interpreter, uid int, args []interpreter.Object) error {
+	i.SetTemp(args)
+	return i.Call(uid)
+}
+
+func callAndReturn(i *interpreter.Interpreter, uid int, args []interpreter.Object, t *testing.T) interpreter.Object {
+	if err := call(i, uid, args); err != nil {
+		t.Fatal(err)
+	}
+	if i.Flow != interpreter.NORMAL || i.TempLen != 1 {
+		t.Fatalf("Expected 1 return value, got flow %d and %d values", i.Flow, i.TempLen)
+	}
+	return i.Temp[0]
+}
+
+func callAndReturnInt(i *interpreter.Interpreter, uid int, args []interpreter.Object, value int32, t *testing.T) {
+	o := callAndReturn(i, uid, args, t)
+	if v, ok := o.(*interpreter.I32); !ok || v.Value != value {
+		t.Errorf("Expected %d, got %#v", value, o)
+	}
+}
+
+func callAndFail(i *interpreter.Interpreter, uid int, args []interpreter.Object, kind interpreter.ErrorKind, t *testing.T) *interpreter.RuntimeError {
+	rtErr, ok := call(i, uid, args).(*interpreter.RuntimeError)
+	if !ok {
+		t.Fatalf("Expected a runtime error")
+	}
+	if rtErr.Kind != kind {
+		t.Errorf("Expected %s, got %s", kind, rtErr)
+	}
+	return rtErr
+}
+
+func lowerWithNatives(src string, natives *Registry, t *testing.T) []*interpreter.Function {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+
+	pass := status.Pass("trap")
+	pass.Begin()
+	defer pass.End()
+	program := tree.TrapSourceFrontend(pass.Pass("frontend"), p, "test.trap", []byte(src), natives.Declarations())
+	if program == nil {
+		t.Fatalf("could not check %#v", src)
+	}
+	funcs := LowerProgram(program, natives, pass.Pass("lower"))
+	if pass.ShouldHalt() {
+		t.Fatalf("could not lower %#v", src)
+	}
+	return funcs
+}
+
+func lowerSource(src string, t *testing.T) []*interpreter.Function {
+	return lowerWithNatives(src, nil, t)
+}
+
+func TestLowerArithmetic(t *testing.T) {
+	funcs := lowerSource(`
+func Poly(x i32) i32 {
+	y := x * x
+	y = y + 3 * x
+	return y - 3 + 3
+}
+`, t)
+	assert.IntEquals(t, len(funcs), 1)
+	assert.IntEquals(t, funcs[0].NumParams, 1)
+	// The constant 3 is only stored once.
+	assert.IntEquals(t, len(funcs[0].Constants), 1)
+
+	i := interpreter.CreateInterpreter(funcs)
+	callAndReturnInt(i, 0, []interpreter.Object{i32(2)}, 10, t)
+	callAndReturnInt(i, 0, []interpreter.Object{i32(-5)}, 10, t)
+}
+
+func TestLowerStructsAndCalls(t *testing.T) {
+	funcs := lowerSource(`
+type Point struct {
+	x i32
+	y i32
+}
+
+func Dot(a Point, b Point) i32 {
+	return a.x * b.x + a.y * b.y
+}
+
+func Flip(p Point) {
+	t := p.x
+	p.x = p.y
+	p.y = t
+}
+
+func Main(x i32, y i32) i32 {
+	p := Point{y: y, x: x}
+	Flip(p)
+	return Dot(p, Point{x: 10, y: 1})
+}
+`, t)
+	assert.IntEquals(t, len(funcs), 3)
+
+	i := interpreter.CreateInterpreter(funcs)
+	callAndReturnInt(i, 2, []interpreter.Object{i32(3), i32(4)}, 43, t)
+}
+
+func TestLowerListsAndComparisons(t *testing.T) {
+	funcs := lowerSource(`
+func Pick(a i64, b i64) i64 {
+	values := []i64{a, b, 0}
+	values[2] = values[0] + values[1]
+	return values[2]
+}
+
+func Less(a i64, b i64) bool {
+	return a < b
+}
+`, t)
+	i := interpreter.CreateInterpreter(funcs)
+	o := callAndReturn(i, 0, []interpreter.Object{i64(4), i64(5)}, t)
+	if v, ok := o.(*interpreter.I64); !ok || v.Value != 9 {
+		t.Errorf("Expected 9, got %#v", o)
+	}
+	o = callAndReturn(i, 1, []interpreter.Object{i64(4), i64(5)}, t)
+	if v, ok := o.(*interpreter.Bool); !ok || !v.Value {
+		t.Errorf("Expected true, got %#v", o)
+	}
+}
+
+func TestLowerControlFlow(t *testing.T) {
+	funcs := lowerSource(`
+func Sum(items []i32, limit i32) i32 {
+	total := 0
+	for item in items {
+		if item > limit {
+			break
+		} else if item == 0 {
+			continue
+		}
+		total = total + item
+	}
+	return total
+}
+
+func Fib(n i32) i32 {
+	a := 0
+	b := 1
+	while n > 0 {
+		c := a + b
+		a = b
+		b = c
+		n = n - 1
+	}
+	return a
+}
+
+func Max(a i32, b i32) i32 {
+	if a < b {
+		return b
+	} else {
+		return a
+	}
+}
+
+func Clamp(x i32) i32 {
+	if x > 10 {
+		x = 10
+	}
+	return x
+}
+`, t)
+	items := &interpreter.List{T: &interpreter.ListType{Element: interpreter.I32Type}, Items: []interpreter.Object{i32(1), i32(0), i32(5), i32(20), i32(2)}}
+	i := interpreter.CreateInterpreter(funcs)
+	callAndReturnInt(i, 0, []interpreter.Object{items, i32(10)}, 6, t)
+	callAndReturnInt(i, 0, []interpreter.Object{items, i32(100)}, 28, t)
+	callAndReturnInt(i, 1, []interpreter.Object{i32(10)}, 55, t)
+	callAndReturnInt(i, 2, []interpreter.Object{i32(3), i32(4)}, 4, t)
+	callAndReturnInt(i, 2, []interpreter.Object{i32(4), i32(3)}, 4, t)
+	callAndReturnInt(i, 3, []interpreter.Object{i32(12)}, 10, t)
+	callAndReturnInt(i, 3, []interpreter.Object{i32(7)}, 7, t)
+
+	// Both branches of Max return, so nothing follows them.
+	_, ok := funcs[2].Body[len(funcs[2].Body)-1].(*interpreter.Return)
+	assert.BoolEquals(t, ok, true)
+}
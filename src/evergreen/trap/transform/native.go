@@ -0,0 +1,294 @@
+package transform
+
+import (
+	"evergreen/trap/interpreter"
+	"evergreen/trap/tree"
+	"fmt"
+	"reflect"
+)
+
+// The native functions a host makes available to trap programs.  They come
+// before a program's own functions, in the order they were registered.
+type Registry struct {
+	decls []*tree.FunctionInfo
+	impls map[*tree.FunctionInfo]interpreter.NativeFunc
+}
+
+func (r *Registry) add(info *tree.FunctionInfo, impl interpreter.NativeFunc) error {
+	for _, other := range r.decls {
+		if other.Name == info.Name {
+			return fmt.Errorf("%s is already registered", info.Name)
+		}
+	}
+	r.decls = append(r.decls, info)
+	r.impls[info] = impl
+	return nil
+}
+
+func (r *Registry) RegisterNative(signature string, impl interpreter.NativeFunc) error {
+	info, err := tree.ParseNativeSignature(signature)
+	if err != nil {
+		return err
+	}
+	return r.add(info, impl)
+}
+
+// Registers a Go function, converting arguments and results between objects
+// and Go values.  Integers, floats, bools and strings convert to the Go type of
+// the same size, lists convert to slices, and everything else, including type
+// variables, is passed as an Object.  The function may return an error after
+// its results.
+func (r *Registry) Register(signature string, fn interface{}) error {
+	info, err := tree.ParseNativeSignature(signature)
+	if err != nil {
+		return err
+	}
+	impl, err := wrapNative(info, fn)
+	if err != nil {
+		return fmt.Errorf("%s: %s", info.Name, err)
+	}
+	return r.add(info, impl)
+}
+
+// The signatures of the natives, for the semantic pass.
+func (r *Registry) Declarations() []*tree.FunctionInfo {
+	if r == nil {
+		return nil
+	}
+	return r.decls
+}
+
+// The interpreter function for a native, or nil if there is none by that name.
+func (r *Registry) Lookup(name string) *interpreter.Function {
+	for _, info := range r.Declarations() {
+		if info.Name == name {
+			return r.function(info)
+		}
+	}
+	return nil
+}
+
+func (r *Registry) function(info *tree.FunctionInfo) *interpreter.Function {
+	return &interpreter.Function{
+		Name:      info.Name,
+		NumParams: len(info.Params),
+		Constants: []interpreter.Object{},
+		Body:      []interpreter.Op{},
+		Native:    r.impls[info],
+	}
+}
+
+func CreateRegistry() *Registry {
+	return &Registry{impls: map[*tree.FunctionInfo]interpreter.NativeFunc{}}
+}
+
+var objectType = reflect.TypeOf((*interpreter.Object)(nil)).Elem()
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// The Go type a trap type converts to.
+func goType(t tree.Type) (reflect.Type, error) {
+	switch t := t.(type) {
+	case *tree.BuiltinType:
+		switch t {
+		case tree.I32Type:
+			return reflect.TypeOf(int32(0)), nil
+		case tree.I64Type:
+			return reflect.TypeOf(int64(0)), nil
+		case tree.F32Type:
+			return reflect.TypeOf(float32(0)), nil
+		case tree.F64Type:
+			return reflect.TypeOf(float64(0)), nil
+		case tree.BoolType:
+			return reflect.TypeOf(false), nil
+		case tree.StringType:
+			return reflect.TypeOf(""), nil
+		default:
+			return nil, fmt.Errorf("%s is not supported by the interpreter", t.Name)
+		}
+	case *tree.ListType:
+		element, err := goType(t.Type)
+		if err != nil {
+			return nil, err
+		}
+		return reflect.SliceOf(element), nil
+	default:
+		return objectType, nil
+	}
+}
+
+// The runtime type of a list a native returns.  The element type must be
+// known without looking at the arguments.
+func nativeListType(t *tree.ListType) (*interpreter.ListType, error) {
+	switch element := t.Type.(type) {
+	case *tree.BuiltinType:
+		rt, ok := builtinTypes[element]
+		if !ok {
+			return nil, fmt.Errorf("%s is not supported by the interpreter", element.Name)
+		}
+		return &interpreter.ListType{Element: rt}, nil
+	case *tree.ListType:
+		rt, err := nativeListType(element)
+		if err != nil {
+			return nil, err
+		}
+		return &interpreter.ListType{Element: rt}, nil
+	default:
+		return nil, fmt.Errorf("cannot return %s", tree.TypeName(t))
+	}
+}
+
+func typeName(o interpreter.Object) string {
+	if o == nil {
+		return "uninitialized value"
+	}
+	return o.Type().Name()
+}
+
+func toGo(o interpreter.Object, t reflect.Type) (reflect.Value, *interpreter.RuntimeError) {
+	if t == objectType {
+		v := reflect.New(objectType).Elem()
+		if o != nil {
+			v.Set(reflect.ValueOf(o))
+		}
+		return v, nil
+	}
+	switch o := o.(type) {
+	case *interpreter.I32:
+		if t.Kind() == reflect.Int32 {
+			return reflect.ValueOf(o.Value), nil
+		}
+	case *interpreter.I64:
+		if t.Kind() == reflect.Int64 {
+			return reflect.ValueOf(o.Value), nil
+		}
+	case *interpreter.F32:
+		if t.Kind() == reflect.Float32 {
+			return reflect.ValueOf(o.Value), nil
+		}
+	case *interpreter.F64:
+		if t.Kind() == reflect.Float64 {
+			return reflect.ValueOf(o.Value), nil
+		}
+	case *interpreter.Bool:
+		if t.Kind() == reflect.Bool {
+			return reflect.ValueOf(o.Value), nil
+		}
+	case *interpreter.String:
+		if t.Kind() == reflect.String {
+			return reflect.ValueOf(o.Value), nil
+		}
+	case *interpreter.List:
+		if t.Kind() == reflect.Slice {
+			v := reflect.MakeSlice(t, len(o.Items), len(o.Items))
+			for idx, item := range o.Items {
+				element, err := toGo(item, t.Elem())
+				if err != nil {
+					return v, err
+				}
+				v.Index(idx).Set(element)
+			}
+			return v, nil
+		}
+	}
+	return reflect.Value{}, &interpreter.RuntimeError{
+		Kind:    interpreter.TYPE_ERROR,
+		Message: fmt.Sprintf("cannot pass %s as %s", typeName(o), t),
+	}
+}
+
+func fromGo(v reflect.Value, t tree.Type) interpreter.Object {
+	switch t := t.(type) {
+	case *tree.BuiltinType:
+		switch t {
+		case tree.I32Type:
+			return &interpreter.I32{Value: int32(v.Int())}
+		case tree.I64Type:
+			return &interpreter.I64{Value: v.Int()}
+		case tree.F32Type:
+			return &interpreter.F32{Value: float32(v.Float())}
+		case tree.F64Type:
+			return &interpreter.F64{Value: v.Float()}
+		case tree.BoolType:
+			return &interpreter.Bool{Value: v.Bool()}
+		case tree.StringType:
+			return &interpreter.String{Value: v.String()}
+		default:
+			panic(t.Name)
+		}
+	case *tree.ListType:
+		// Checked when the native was registered.
+		lt, _ := nativeListType(t)
+		items := make([]interpreter.Object, v.Len())
+		for idx := range items {
+			items[idx] = fromGo(v.Index(idx), t.Type)
+		}
+		return &interpreter.List{T: lt, Items: items}
+	default:
+		if v.IsNil() {
+			return nil
+		}
+		return v.Interface().(interpreter.Object)
+	}
+}
+
+// Adapts a Go function to a NativeFunc, after checking its Go type matches the
+// signature.
+func wrapNative(info *tree.FunctionInfo, value interface{}) (interpreter.NativeFunc, error) {
+	fn := reflect.ValueOf(value)
+	if fn.Kind() != reflect.Func {
+		return nil, fmt.Errorf("expected a function, got %T", value)
+	}
+	ft := fn.Type()
+	if ft.IsVariadic() || ft.NumIn() != len(info.Params) {
+		return nil, fmt.Errorf("expected %d parameters, got %s", len(info.Params), ft)
+	}
+	for idx, p := range info.Params {
+		gt, err := goType(p)
+		if err != nil {
+			return nil, err
+		}
+		if ft.In(idx) != gt {
+			return nil, fmt.Errorf("parameter %d is %s, expected %s", idx, ft.In(idx), gt)
+		}
+	}
+	returnsError := ft.NumOut() == len(info.Results)+1 && ft.Out(len(info.Results)) == errorType
+	if ft.NumOut() != len(info.Results) && !returnsError {
+		return nil, fmt.Errorf("expected %d results, got %s", len(info.Results), ft)
+	}
+	for idx, r := range info.Results {
+		gt, err := goType(r)
+		if err != nil {
+			return nil, err
+		}
+		if lt, ok := r.(*tree.ListType); ok {
+			if _, err := nativeListType(lt); err != nil {
+				return nil, err
+			}
+		}
+		if ft.Out(idx) != gt {
+			return nil, fmt.Errorf("result %d is %s, expected %s", idx, ft.Out(idx), gt)
+		}
+	}
+
+	return func(args []interpreter.Object) ([]interpreter.Object, error) {
+		in := make([]reflect.Value, len(args))
+		for idx, arg := range args {
+			v, err := toGo(arg, ft.In(idx))
+			if err != nil {
+				return nil, err
+			}
+			in[idx] = v
+		}
+		out := fn.Call(in)
+		if returnsError {
+			if err := out[len(out)-1]; !err.IsNil() {
+				return nil, err.Interface().(error)
+			}
+		}
+		results := make([]interpreter.Object, len(info.Results))
+		for idx, r := range info.Results {
+			results[idx] = fromGo(out[idx], r)
+		}
+		return results, nil
+	}, nil
+}
@@ -0,0 +1,102 @@
+package transform
+
+import (
+	"errors"
+	"evergreen/assert"
+	"evergreen/trap/interpreter"
+	"testing"
+)
+
+func TestNatives(t *testing.T) {
+	printed := []int32{}
+	r := CreateRegistry()
+	err := r.Register("print(i32)", func(value int32) {
+		printed = append(printed, value)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Register("len([]T) i32", func(items []interpreter.Object) int32 {
+		return int32(len(items))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Register("digits(i64) []i64", func(n int64) []int64 {
+		out := []int64{}
+		for ; n > 0; n /= 10 {
+			out = append(out, n%10)
+		}
+		return out
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.Register("check(bool) bool", func(ok bool) (bool, error) {
+		if !ok {
+			return false, errors.New("check failed")
+		}
+		return true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	funcs := lowerWithNatives(`
+func Main(n i64) i32 {
+	d := digits(n)
+	print(len(d))
+	print(7)
+	return len(d) + len([]bool{})
+}
+
+func Check(a i32, b i32) bool {
+	return check(a == b)
+}
+`, r, t)
+	assert.IntEquals(t, len(funcs), 6)
+	i := interpreter.CreateInterpreter(funcs)
+	callAndReturnInt(i, 4, []interpreter.Object{i64(1234)}, 4, t)
+	assert.IntListEquals(t, []int{int(printed[0]), int(printed[1])}, []int{4, 7})
+
+	o := callAndReturn(i, 5, []interpreter.Object{i32(1), i32(1)}, t)
+	assert.BoolEquals(t, o.(*interpreter.Bool).Value, true)
+	rtErr := callAndFail(i, 5, []interpreter.Object{i32(1), i32(2)}, interpreter.NATIVE_ERROR, t)
+	assert.IntEquals(t, len(rtErr.Trace), 1)
+	assert.StringEquals(t, rtErr.Trace[0].Function, "Check")
+	assert.IntEquals(t, rtErr.Trace[0].Location, 1)
+
+	// Natives can be invoked by the host.
+	callAndReturnInt(i, 1, []interpreter.Object{&interpreter.List{T: &interpreter.ListType{Element: interpreter.I32Type}, Items: []interpreter.Object{i32(1)}}}, 1, t)
+	callAndFail(i, 0, []interpreter.Object{str("x")}, interpreter.TYPE_ERROR, t)
+}
+
+func TestRegisterErrors(t *testing.T) {
+	tests := []struct {
+		Signature string
+		Fn        interface{}
+	}{
+		{"f(i32)", 3},
+		{"f(i32)", func() {}},
+		{"f(i32)", func(x int64) {}},
+		{"f(u32)", func(x uint32) {}},
+		{"f() i32", func() {}},
+		{"f() i32", func() (int32, int32) { return 0, 0 }},
+		{"f([]T) []T", func(x []interpreter.Object) []interpreter.Object { return x }},
+		{"f(", func() {}},
+	}
+	for _, test := range tests {
+		r := CreateRegistry()
+		if err := r.Register(test.Signature, test.Fn); err == nil {
+			t.Errorf("Expected %#v to be rejected", test.Signature)
+		}
+	}
+
+	r := CreateRegistry()
+	if err := r.Register("f()", func() {}); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Register("f(i32)", func(x int32) {}); err == nil {
+		t.Error("Expected f to be registered only once")
+	}
+}
@@ -0,0 +1,264 @@
+package tree
+
+type Token struct {
+	Pos  int
+	Text string
+}
+
+type TypeRef interface {
+	isTypeRef()
+}
+
+type NamedTypeRef struct {
+	Name *Token
+}
+
+func (node *NamedTypeRef) isTypeRef() {
+}
+
+type ListTypeRef struct {
+	Type TypeRef
+}
+
+func (node *ListTypeRef) isTypeRef() {
+}
+
+type SumTypeRef struct {
+	Types []TypeRef
+}
+
+func (node *SumTypeRef) isTypeRef() {
+}
+
+type FieldDecl struct {
+	Name *Token
+	Type TypeRef
+}
+
+type TypeImpl interface {
+	isTypeImpl()
+}
+
+type StructDecl struct {
+	Fields []*FieldDecl
+}
+
+func (node *StructDecl) isTypeImpl() {
+}
+
+type TypeAliasDecl struct {
+	Type TypeRef
+}
+
+func (node *TypeAliasDecl) isTypeImpl() {
+}
+
+type Decl interface {
+	isDecl()
+}
+
+type TypeDecl struct {
+	Name *Token
+	Decl TypeImpl
+}
+
+func (node *TypeDecl) isDecl() {
+}
+
+type Stmt interface {
+	isStmt()
+}
+
+type Expr interface {
+	isExpr()
+	isStmt()
+}
+
+type GetName struct {
+	Name *Token
+}
+
+func (node *GetName) isStmt() {
+}
+
+func (node *GetName) isExpr() {
+}
+
+type IntLiteral struct {
+	Pos  int
+	Text string
+}
+
+func (node *IntLiteral) isStmt() {
+}
+
+func (node *IntLiteral) isExpr() {
+}
+
+type InfixOp struct {
+	Left  Expr
+	Op    *Token
+	Right Expr
+}
+
+func (node *InfixOp) isStmt() {
+}
+
+func (node *InfixOp) isExpr() {
+}
+
+type GetAttr struct {
+	Expr Expr
+	Attr *Token
+}
+
+func (node *GetAttr) isStmt() {
+}
+
+func (node *GetAttr) isExpr() {
+}
+
+type GetIndex struct {
+	Expr  Expr
+	Index Expr
+}
+
+func (node *GetIndex) isStmt() {
+}
+
+func (node *GetIndex) isExpr() {
+}
+
+type Call struct {
+	Expr Expr
+	Args []Expr
+}
+
+func (node *Call) isStmt() {
+}
+
+func (node *Call) isExpr() {
+}
+
+type AssignOp struct {
+	Target Expr
+	Op     *Token
+	Value  Expr
+}
+
+func (node *AssignOp) isStmt() {
+}
+
+func (node *AssignOp) isExpr() {
+}
+
+type NamedExpr struct {
+	Name  *Token
+	Value Expr
+}
+
+type CreateStruct struct {
+	Type TypeRef
+	Args []*NamedExpr
+}
+
+func (node *CreateStruct) isStmt() {
+}
+
+func (node *CreateStruct) isExpr() {
+}
+
+type CreateList struct {
+	Type TypeRef
+	Args []Expr
+}
+
+func (node *CreateList) isStmt() {
+}
+
+func (node *CreateList) isExpr() {
+}
+
+type Return struct {
+	Pos  int
+	Expr Expr
+}
+
+func (node *Return) isStmt() {
+}
+
+type Block struct {
+	Body []Stmt
+}
+
+func (node *Block) isStmt() {
+}
+
+type If struct {
+	Pos  int
+	Cond Expr
+	Body []Stmt
+	Else []Stmt
+}
+
+func (node *If) isStmt() {
+}
+
+type While struct {
+	Pos  int
+	Cond Expr
+	Body []Stmt
+}
+
+func (node *While) isStmt() {
+}
+
+type For struct {
+	Pos  int
+	Name *Token
+	Expr Expr
+	Body []Stmt
+}
+
+func (node *For) isStmt() {
+}
+
+type Break struct {
+	Pos int
+}
+
+func (node *Break) isStmt() {
+}
+
+type Continue struct {
+	Pos int
+}
+
+func (node *Continue) isStmt() {
+}
+
+type Parameter struct {
+	Name *Token
+	Type TypeRef
+}
+
+type FuncDecl struct {
+	Name        *Token
+	Parameters  []*Parameter
+	ReturnTypes []TypeRef
+	Body        []Stmt
+}
+
+func (node *FuncDecl) isDecl() {
+}
+
+type Signature struct {
+	Name        *Token
+	Params      []TypeRef
+	ReturnTypes []TypeRef
+}
+
+type File struct {
+	Name  string
+	Decls []Decl
+}
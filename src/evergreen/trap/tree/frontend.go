@@ -0,0 +1,42 @@
+package tree
+
+import (
+	"evergreen/compiler"
+	"io/ioutil"
+	"path/filepath"
+)
+
+func TrapProgramFrontend(status compiler.PassStatus, p compiler.LocationProvider, filename string, natives []*FunctionInfo) *Program {
+	status.Begin()
+	defer status.End()
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		status.GlobalError(err.Error())
+		return nil
+	}
+	return frontend(status, p, filename, data, natives)
+}
+
+// Like TrapProgramFrontend, for source that is already in memory.
+func TrapSourceFrontend(status compiler.PassStatus, p compiler.LocationProvider, filename string, data []byte, natives []*FunctionInfo) *Program {
+	status.Begin()
+	defer status.End()
+
+	return frontend(status, p, filename, data, natives)
+}
+
+func frontend(status compiler.PassStatus, p compiler.LocationProvider, filename string, data []byte, natives []*FunctionInfo) *Program {
+	offset := p.AddFile(filename, []rune(string(data)))
+	file := ParseTrap(data, offset, status.Task("parse"))
+	if status.ShouldHalt() {
+		return nil
+	}
+	file.Name = filepath.Base(filename)
+
+	program := SemanticPass(file, natives, status.Pass("semantic"))
+	if status.ShouldHalt() {
+		return nil
+	}
+	return program
+}
@@ -0,0 +1,1896 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *Token) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Token") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *Token) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readTokenBinary(d *runtime.BinaryDecoder, o interface{}) *Token {
+	var node *Token
+	if o != nil {
+		return o.(*Token)
+	}
+	node = &Token{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Token) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "73026134241243bc")
+}
+
+func (node *Token) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "73026134241243bc", "trap/tree/Token", node)
+}
+
+func DecodeTokenBinary(d *runtime.BinaryDecoder) *Token {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Token" {
+		return readTokenBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeTypeRefBinary(d *runtime.BinaryDecoder) TypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/NamedTypeRef" {
+		return readNamedTypeRefBinary(d, o)
+	}
+	if t == "trap/tree/ListTypeRef" {
+		return readListTypeRefBinary(d, o)
+	}
+	if t == "trap/tree/SumTypeRef" {
+		return readSumTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NamedTypeRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/NamedTypeRef") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+}
+
+func (node *NamedTypeRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeTokenBinary(d)
+}
+
+func readNamedTypeRefBinary(d *runtime.BinaryDecoder, o interface{}) *NamedTypeRef {
+	var node *NamedTypeRef
+	if o != nil {
+		return o.(*NamedTypeRef)
+	}
+	node = &NamedTypeRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NamedTypeRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "7a6fdec8863e5e7b")
+}
+
+func (node *NamedTypeRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "7a6fdec8863e5e7b", "trap/tree/NamedTypeRef", node)
+}
+
+func DecodeNamedTypeRefBinary(d *runtime.BinaryDecoder) *NamedTypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/NamedTypeRef" {
+		return readNamedTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *ListTypeRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/ListTypeRef") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *ListTypeRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = DecodeTypeRefBinary(d)
+}
+
+func readListTypeRefBinary(d *runtime.BinaryDecoder, o interface{}) *ListTypeRef {
+	var node *ListTypeRef
+	if o != nil {
+		return o.(*ListTypeRef)
+	}
+	node = &ListTypeRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *ListTypeRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "cce071aa102650a7")
+}
+
+func (node *ListTypeRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "cce071aa102650a7", "trap/tree/ListTypeRef", node)
+}
+
+func DecodeListTypeRefBinary(d *runtime.BinaryDecoder) *ListTypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/ListTypeRef" {
+		return readListTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *SumTypeRef) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x TypeRef
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/SumTypeRef") {
+		return
+	}
+	if node.Types == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Types))
+		for _, x = range node.Types {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *SumTypeRef) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []TypeRef
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []TypeRef{}
+		for range n {
+			s = append(s, DecodeTypeRefBinary(d))
+		}
+	}
+	node.Types = s
+}
+
+func readSumTypeRefBinary(d *runtime.BinaryDecoder, o interface{}) *SumTypeRef {
+	var node *SumTypeRef
+	if o != nil {
+		return o.(*SumTypeRef)
+	}
+	node = &SumTypeRef{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *SumTypeRef) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "cce071aa102650a7")
+}
+
+func (node *SumTypeRef) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "cce071aa102650a7", "trap/tree/SumTypeRef", node)
+}
+
+func DecodeSumTypeRefBinary(d *runtime.BinaryDecoder) *SumTypeRef {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/SumTypeRef" {
+		return readSumTypeRefBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FieldDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/FieldDecl") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *FieldDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeTokenBinary(d)
+	node.Type = DecodeTypeRefBinary(d)
+}
+
+func readFieldDeclBinary(d *runtime.BinaryDecoder, o interface{}) *FieldDecl {
+	var node *FieldDecl
+	if o != nil {
+		return o.(*FieldDecl)
+	}
+	node = &FieldDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FieldDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "713f8d3ff3714909")
+}
+
+func (node *FieldDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "713f8d3ff3714909", "trap/tree/FieldDecl", node)
+}
+
+func DecodeFieldDeclBinary(d *runtime.BinaryDecoder) *FieldDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/FieldDecl" {
+		return readFieldDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeTypeImplBinary(d *runtime.BinaryDecoder) TypeImpl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/StructDecl" {
+		return readStructDeclBinary(d, o)
+	}
+	if t == "trap/tree/TypeAliasDecl" {
+		return readTypeAliasDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *StructDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *FieldDecl
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/StructDecl") {
+		return
+	}
+	if node.Fields == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Fields))
+		for _, x = range node.Fields {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *StructDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*FieldDecl
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*FieldDecl{}
+		for range n {
+			s = append(s, DecodeFieldDeclBinary(d))
+		}
+	}
+	node.Fields = s
+}
+
+func readStructDeclBinary(d *runtime.BinaryDecoder, o interface{}) *StructDecl {
+	var node *StructDecl
+	if o != nil {
+		return o.(*StructDecl)
+	}
+	node = &StructDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *StructDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "ff07796815443925")
+}
+
+func (node *StructDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "ff07796815443925", "trap/tree/StructDecl", node)
+}
+
+func DecodeStructDeclBinary(d *runtime.BinaryDecoder) *StructDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/StructDecl" {
+		return readStructDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeAliasDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/TypeAliasDecl") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *TypeAliasDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Type = DecodeTypeRefBinary(d)
+}
+
+func readTypeAliasDeclBinary(d *runtime.BinaryDecoder, o interface{}) *TypeAliasDecl {
+	var node *TypeAliasDecl
+	if o != nil {
+		return o.(*TypeAliasDecl)
+	}
+	node = &TypeAliasDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeAliasDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "795f513fa3fb3824")
+}
+
+func (node *TypeAliasDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "795f513fa3fb3824", "trap/tree/TypeAliasDecl", node)
+}
+
+func DecodeTypeAliasDeclBinary(d *runtime.BinaryDecoder) *TypeAliasDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/TypeAliasDecl" {
+		return readTypeAliasDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeDeclBinary(d *runtime.BinaryDecoder) Decl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/TypeDecl" {
+		return readTypeDeclBinary(d, o)
+	}
+	if t == "trap/tree/FuncDecl" {
+		return readFuncDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *TypeDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/TypeDecl") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Decl)
+}
+
+func (node *TypeDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeTokenBinary(d)
+	node.Decl = DecodeTypeImplBinary(d)
+}
+
+func readTypeDeclBinary(d *runtime.BinaryDecoder, o interface{}) *TypeDecl {
+	var node *TypeDecl
+	if o != nil {
+		return o.(*TypeDecl)
+	}
+	node = &TypeDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *TypeDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "37e89c34bd326421")
+}
+
+func (node *TypeDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "37e89c34bd326421", "trap/tree/TypeDecl", node)
+}
+
+func DecodeTypeDeclBinary(d *runtime.BinaryDecoder) *TypeDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/TypeDecl" {
+		return readTypeDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeStmtBinary(d *runtime.BinaryDecoder) Stmt {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/GetName" {
+		return readGetNameBinary(d, o)
+	}
+	if t == "trap/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	if t == "trap/tree/InfixOp" {
+		return readInfixOpBinary(d, o)
+	}
+	if t == "trap/tree/GetAttr" {
+		return readGetAttrBinary(d, o)
+	}
+	if t == "trap/tree/GetIndex" {
+		return readGetIndexBinary(d, o)
+	}
+	if t == "trap/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	if t == "trap/tree/AssignOp" {
+		return readAssignOpBinary(d, o)
+	}
+	if t == "trap/tree/CreateStruct" {
+		return readCreateStructBinary(d, o)
+	}
+	if t == "trap/tree/CreateList" {
+		return readCreateListBinary(d, o)
+	}
+	if t == "trap/tree/Return" {
+		return readReturnBinary(d, o)
+	}
+	if t == "trap/tree/Block" {
+		return readBlockBinary(d, o)
+	}
+	if t == "trap/tree/If" {
+		return readIfBinary(d, o)
+	}
+	if t == "trap/tree/While" {
+		return readWhileBinary(d, o)
+	}
+	if t == "trap/tree/For" {
+		return readForBinary(d, o)
+	}
+	if t == "trap/tree/Break" {
+		return readBreakBinary(d, o)
+	}
+	if t == "trap/tree/Continue" {
+		return readContinueBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func DecodeExprBinary(d *runtime.BinaryDecoder) Expr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/GetName" {
+		return readGetNameBinary(d, o)
+	}
+	if t == "trap/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	if t == "trap/tree/InfixOp" {
+		return readInfixOpBinary(d, o)
+	}
+	if t == "trap/tree/GetAttr" {
+		return readGetAttrBinary(d, o)
+	}
+	if t == "trap/tree/GetIndex" {
+		return readGetIndexBinary(d, o)
+	}
+	if t == "trap/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	if t == "trap/tree/AssignOp" {
+		return readAssignOpBinary(d, o)
+	}
+	if t == "trap/tree/CreateStruct" {
+		return readCreateStructBinary(d, o)
+	}
+	if t == "trap/tree/CreateList" {
+		return readCreateListBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetName) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/GetName") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+}
+
+func (node *GetName) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeTokenBinary(d)
+}
+
+func readGetNameBinary(d *runtime.BinaryDecoder, o interface{}) *GetName {
+	var node *GetName
+	if o != nil {
+		return o.(*GetName)
+	}
+	node = &GetName{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetName) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "4964cb72a25687e8")
+}
+
+func (node *GetName) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "4964cb72a25687e8", "trap/tree/GetName", node)
+}
+
+func DecodeGetNameBinary(d *runtime.BinaryDecoder) *GetName {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/GetName" {
+		return readGetNameBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *IntLiteral) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/IntLiteral") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	e.WriteString(node.Text)
+}
+
+func (node *IntLiteral) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Text = d.ReadString()
+}
+
+func readIntLiteralBinary(d *runtime.BinaryDecoder, o interface{}) *IntLiteral {
+	var node *IntLiteral
+	if o != nil {
+		return o.(*IntLiteral)
+	}
+	node = &IntLiteral{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *IntLiteral) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "cebbd90489f03813")
+}
+
+func (node *IntLiteral) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "cebbd90489f03813", "trap/tree/IntLiteral", node)
+}
+
+func DecodeIntLiteralBinary(d *runtime.BinaryDecoder) *IntLiteral {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/IntLiteral" {
+		return readIntLiteralBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *InfixOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/InfixOp") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Left)
+	node.Op.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Right)
+}
+
+func (node *InfixOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Left = DecodeExprBinary(d)
+	node.Op = DecodeTokenBinary(d)
+	node.Right = DecodeExprBinary(d)
+}
+
+func readInfixOpBinary(d *runtime.BinaryDecoder, o interface{}) *InfixOp {
+	var node *InfixOp
+	if o != nil {
+		return o.(*InfixOp)
+	}
+	node = &InfixOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *InfixOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *InfixOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/InfixOp", node)
+}
+
+func DecodeInfixOpBinary(d *runtime.BinaryDecoder) *InfixOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/InfixOp" {
+		return readInfixOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetAttr) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/GetAttr") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	node.Attr.EncodeBinary(e)
+}
+
+func (node *GetAttr) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeExprBinary(d)
+	node.Attr = DecodeTokenBinary(d)
+}
+
+func readGetAttrBinary(d *runtime.BinaryDecoder, o interface{}) *GetAttr {
+	var node *GetAttr
+	if o != nil {
+		return o.(*GetAttr)
+	}
+	node = &GetAttr{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetAttr) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *GetAttr) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/GetAttr", node)
+}
+
+func DecodeGetAttrBinary(d *runtime.BinaryDecoder) *GetAttr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/GetAttr" {
+		return readGetAttrBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *GetIndex) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/GetIndex") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	runtime.EncodeBinary(e, node.Index)
+}
+
+func (node *GetIndex) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Expr = DecodeExprBinary(d)
+	node.Index = DecodeExprBinary(d)
+}
+
+func readGetIndexBinary(d *runtime.BinaryDecoder, o interface{}) *GetIndex {
+	var node *GetIndex
+	if o != nil {
+		return o.(*GetIndex)
+	}
+	node = &GetIndex{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *GetIndex) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *GetIndex) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/GetIndex", node)
+}
+
+func DecodeGetIndexBinary(d *runtime.BinaryDecoder) *GetIndex {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/GetIndex" {
+		return readGetIndexBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Call) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Expr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Call") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Expr)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *Call) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Expr
+	node.Expr = DecodeExprBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Expr{}
+		for range n {
+			s = append(s, DecodeExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readCallBinary(d *runtime.BinaryDecoder, o interface{}) *Call {
+	var node *Call
+	if o != nil {
+		return o.(*Call)
+	}
+	node = &Call{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Call) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *Call) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/Call", node)
+}
+
+func DecodeCallBinary(d *runtime.BinaryDecoder) *Call {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Call" {
+		return readCallBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *AssignOp) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/AssignOp") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Target)
+	node.Op.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Value)
+}
+
+func (node *AssignOp) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Target = DecodeExprBinary(d)
+	node.Op = DecodeTokenBinary(d)
+	node.Value = DecodeExprBinary(d)
+}
+
+func readAssignOpBinary(d *runtime.BinaryDecoder, o interface{}) *AssignOp {
+	var node *AssignOp
+	if o != nil {
+		return o.(*AssignOp)
+	}
+	node = &AssignOp{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *AssignOp) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *AssignOp) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/AssignOp", node)
+}
+
+func DecodeAssignOpBinary(d *runtime.BinaryDecoder) *AssignOp {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/AssignOp" {
+		return readAssignOpBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *NamedExpr) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/NamedExpr") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Value)
+}
+
+func (node *NamedExpr) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeTokenBinary(d)
+	node.Value = DecodeExprBinary(d)
+}
+
+func readNamedExprBinary(d *runtime.BinaryDecoder, o interface{}) *NamedExpr {
+	var node *NamedExpr
+	if o != nil {
+		return o.(*NamedExpr)
+	}
+	node = &NamedExpr{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *NamedExpr) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *NamedExpr) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/NamedExpr", node)
+}
+
+func DecodeNamedExprBinary(d *runtime.BinaryDecoder) *NamedExpr {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/NamedExpr" {
+		return readNamedExprBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *CreateStruct) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x *NamedExpr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/CreateStruct") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			x.EncodeBinary(e)
+		}
+	}
+}
+
+func (node *CreateStruct) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []*NamedExpr
+	node.Type = DecodeTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []*NamedExpr{}
+		for range n {
+			s = append(s, DecodeNamedExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readCreateStructBinary(d *runtime.BinaryDecoder, o interface{}) *CreateStruct {
+	var node *CreateStruct
+	if o != nil {
+		return o.(*CreateStruct)
+	}
+	node = &CreateStruct{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *CreateStruct) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *CreateStruct) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/CreateStruct", node)
+}
+
+func DecodeCreateStructBinary(d *runtime.BinaryDecoder) *CreateStruct {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/CreateStruct" {
+		return readCreateStructBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *CreateList) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Expr
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/CreateList") {
+		return
+	}
+	runtime.EncodeBinary(e, node.Type)
+	if node.Args == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Args))
+		for _, x = range node.Args {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *CreateList) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Expr
+	node.Type = DecodeTypeRefBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Expr{}
+		for range n {
+			s = append(s, DecodeExprBinary(d))
+		}
+	}
+	node.Args = s
+}
+
+func readCreateListBinary(d *runtime.BinaryDecoder, o interface{}) *CreateList {
+	var node *CreateList
+	if o != nil {
+		return o.(*CreateList)
+	}
+	node = &CreateList{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *CreateList) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f527157ab68c0122")
+}
+
+func (node *CreateList) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f527157ab68c0122", "trap/tree/CreateList", node)
+}
+
+func DecodeCreateListBinary(d *runtime.BinaryDecoder) *CreateList {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/CreateList" {
+		return readCreateListBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Return) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Return") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	runtime.EncodeBinary(e, node.Expr)
+}
+
+func (node *Return) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+	node.Expr = DecodeExprBinary(d)
+}
+
+func readReturnBinary(d *runtime.BinaryDecoder, o interface{}) *Return {
+	var node *Return
+	if o != nil {
+		return o.(*Return)
+	}
+	node = &Return{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Return) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f9626c61e622b1ae")
+}
+
+func (node *Return) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f9626c61e622b1ae", "trap/tree/Return", node)
+}
+
+func DecodeReturnBinary(d *runtime.BinaryDecoder) *Return {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Return" {
+		return readReturnBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Block) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Stmt
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Block") {
+		return
+	}
+	if node.Body == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Body))
+		for _, x = range node.Body {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *Block) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Stmt
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Stmt{}
+		for range n {
+			s = append(s, DecodeStmtBinary(d))
+		}
+	}
+	node.Body = s
+}
+
+func readBlockBinary(d *runtime.BinaryDecoder, o interface{}) *Block {
+	var node *Block
+	if o != nil {
+		return o.(*Block)
+	}
+	node = &Block{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Block) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "08e52c10adc777a8")
+}
+
+func (node *Block) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "08e52c10adc777a8", "trap/tree/Block", node)
+}
+
+func DecodeBlockBinary(d *runtime.BinaryDecoder) *Block {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Block" {
+		return readBlockBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *If) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 Stmt
+	var x1 Stmt
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/If") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	runtime.EncodeBinary(e, node.Cond)
+	if node.Body == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Body))
+		for _, x0 = range node.Body {
+			runtime.EncodeBinary(e, x0)
+		}
+	}
+	if node.Else == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Else))
+		for _, x1 = range node.Else {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+}
+
+func (node *If) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []Stmt
+	var n1 int
+	var s1 []Stmt
+	node.Pos = d.ReadInt()
+	node.Cond = DecodeExprBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []Stmt{}
+		for range n0 {
+			s0 = append(s0, DecodeStmtBinary(d))
+		}
+	}
+	node.Body = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []Stmt{}
+		for range n1 {
+			s1 = append(s1, DecodeStmtBinary(d))
+		}
+	}
+	node.Else = s1
+}
+
+func readIfBinary(d *runtime.BinaryDecoder, o interface{}) *If {
+	var node *If
+	if o != nil {
+		return o.(*If)
+	}
+	node = &If{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *If) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "08e52c10adc777a8")
+}
+
+func (node *If) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "08e52c10adc777a8", "trap/tree/If", node)
+}
+
+func DecodeIfBinary(d *runtime.BinaryDecoder) *If {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/If" {
+		return readIfBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *While) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Stmt
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/While") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	runtime.EncodeBinary(e, node.Cond)
+	if node.Body == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Body))
+		for _, x = range node.Body {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *While) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Stmt
+	node.Pos = d.ReadInt()
+	node.Cond = DecodeExprBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Stmt{}
+		for range n {
+			s = append(s, DecodeStmtBinary(d))
+		}
+	}
+	node.Body = s
+}
+
+func readWhileBinary(d *runtime.BinaryDecoder, o interface{}) *While {
+	var node *While
+	if o != nil {
+		return o.(*While)
+	}
+	node = &While{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *While) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "08e52c10adc777a8")
+}
+
+func (node *While) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "08e52c10adc777a8", "trap/tree/While", node)
+}
+
+func DecodeWhileBinary(d *runtime.BinaryDecoder) *While {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/While" {
+		return readWhileBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *For) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Stmt
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/For") {
+		return
+	}
+	e.WriteInt(node.Pos)
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Expr)
+	if node.Body == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Body))
+		for _, x = range node.Body {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *For) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Stmt
+	node.Pos = d.ReadInt()
+	node.Name = DecodeTokenBinary(d)
+	node.Expr = DecodeExprBinary(d)
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Stmt{}
+		for range n {
+			s = append(s, DecodeStmtBinary(d))
+		}
+	}
+	node.Body = s
+}
+
+func readForBinary(d *runtime.BinaryDecoder, o interface{}) *For {
+	var node *For
+	if o != nil {
+		return o.(*For)
+	}
+	node = &For{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *For) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "08e52c10adc777a8")
+}
+
+func (node *For) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "08e52c10adc777a8", "trap/tree/For", node)
+}
+
+func DecodeForBinary(d *runtime.BinaryDecoder) *For {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/For" {
+		return readForBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Break) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Break") {
+		return
+	}
+	e.WriteInt(node.Pos)
+}
+
+func (node *Break) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+}
+
+func readBreakBinary(d *runtime.BinaryDecoder, o interface{}) *Break {
+	var node *Break
+	if o != nil {
+		return o.(*Break)
+	}
+	node = &Break{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Break) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "5c9200670c360c32")
+}
+
+func (node *Break) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "5c9200670c360c32", "trap/tree/Break", node)
+}
+
+func DecodeBreakBinary(d *runtime.BinaryDecoder) *Break {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Break" {
+		return readBreakBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Continue) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Continue") {
+		return
+	}
+	e.WriteInt(node.Pos)
+}
+
+func (node *Continue) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Pos = d.ReadInt()
+}
+
+func readContinueBinary(d *runtime.BinaryDecoder, o interface{}) *Continue {
+	var node *Continue
+	if o != nil {
+		return o.(*Continue)
+	}
+	node = &Continue{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Continue) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "2e3205d6c3f9a81c")
+}
+
+func (node *Continue) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "2e3205d6c3f9a81c", "trap/tree/Continue", node)
+}
+
+func DecodeContinueBinary(d *runtime.BinaryDecoder) *Continue {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Continue" {
+		return readContinueBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Parameter) EncodeBinary(e *runtime.BinaryEncoder) {
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Parameter") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	runtime.EncodeBinary(e, node.Type)
+}
+
+func (node *Parameter) DecodeBinary(d *runtime.BinaryDecoder) {
+	node.Name = DecodeTokenBinary(d)
+	node.Type = DecodeTypeRefBinary(d)
+}
+
+func readParameterBinary(d *runtime.BinaryDecoder, o interface{}) *Parameter {
+	var node *Parameter
+	if o != nil {
+		return o.(*Parameter)
+	}
+	node = &Parameter{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Parameter) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "9a2724c06c10bd7a")
+}
+
+func (node *Parameter) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "9a2724c06c10bd7a", "trap/tree/Parameter", node)
+}
+
+func DecodeParameterBinary(d *runtime.BinaryDecoder) *Parameter {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Parameter" {
+		return readParameterBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *FuncDecl) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 *Parameter
+	var x1 TypeRef
+	var x2 Stmt
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/FuncDecl") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	if node.Parameters == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Parameters))
+		for _, x0 = range node.Parameters {
+			x0.EncodeBinary(e)
+		}
+	}
+	if node.ReturnTypes == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.ReturnTypes))
+		for _, x1 = range node.ReturnTypes {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+	if node.Body == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Body))
+		for _, x2 = range node.Body {
+			runtime.EncodeBinary(e, x2)
+		}
+	}
+}
+
+func (node *FuncDecl) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []*Parameter
+	var n1 int
+	var s1 []TypeRef
+	var n2 int
+	var s2 []Stmt
+	node.Name = DecodeTokenBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []*Parameter{}
+		for range n0 {
+			s0 = append(s0, DecodeParameterBinary(d))
+		}
+	}
+	node.Parameters = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []TypeRef{}
+		for range n1 {
+			s1 = append(s1, DecodeTypeRefBinary(d))
+		}
+	}
+	node.ReturnTypes = s1
+	n2 = d.ReadLength()
+	s2 = nil
+	if n2 >= 0 {
+		s2 = []Stmt{}
+		for range n2 {
+			s2 = append(s2, DecodeStmtBinary(d))
+		}
+	}
+	node.Body = s2
+}
+
+func readFuncDeclBinary(d *runtime.BinaryDecoder, o interface{}) *FuncDecl {
+	var node *FuncDecl
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	node = &FuncDecl{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *FuncDecl) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "f3a41d82a12845c2")
+}
+
+func (node *FuncDecl) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "f3a41d82a12845c2", "trap/tree/FuncDecl", node)
+}
+
+func DecodeFuncDeclBinary(d *runtime.BinaryDecoder) *FuncDecl {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/FuncDecl" {
+		return readFuncDeclBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *Signature) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x0 TypeRef
+	var x1 TypeRef
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/Signature") {
+		return
+	}
+	node.Name.EncodeBinary(e)
+	if node.Params == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Params))
+		for _, x0 = range node.Params {
+			runtime.EncodeBinary(e, x0)
+		}
+	}
+	if node.ReturnTypes == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.ReturnTypes))
+		for _, x1 = range node.ReturnTypes {
+			runtime.EncodeBinary(e, x1)
+		}
+	}
+}
+
+func (node *Signature) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n0 int
+	var s0 []TypeRef
+	var n1 int
+	var s1 []TypeRef
+	node.Name = DecodeTokenBinary(d)
+	n0 = d.ReadLength()
+	s0 = nil
+	if n0 >= 0 {
+		s0 = []TypeRef{}
+		for range n0 {
+			s0 = append(s0, DecodeTypeRefBinary(d))
+		}
+	}
+	node.Params = s0
+	n1 = d.ReadLength()
+	s1 = nil
+	if n1 >= 0 {
+		s1 = []TypeRef{}
+		for range n1 {
+			s1 = append(s1, DecodeTypeRefBinary(d))
+		}
+	}
+	node.ReturnTypes = s1
+}
+
+func readSignatureBinary(d *runtime.BinaryDecoder, o interface{}) *Signature {
+	var node *Signature
+	if o != nil {
+		return o.(*Signature)
+	}
+	node = &Signature{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *Signature) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "2c6cf9baaa59eef3")
+}
+
+func (node *Signature) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "2c6cf9baaa59eef3", "trap/tree/Signature", node)
+}
+
+func DecodeSignatureBinary(d *runtime.BinaryDecoder) *Signature {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/Signature" {
+		return readSignatureBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
+
+func (node *File) EncodeBinary(e *runtime.BinaryEncoder) {
+	var x Decl
+	if node == nil {
+		e.WriteNil()
+		return
+	}
+	if !e.WriteObject(node, "trap/tree/File") {
+		return
+	}
+	e.WriteString(node.Name)
+	if node.Decls == nil {
+		e.WriteNil()
+	} else {
+		e.WriteLength(len(node.Decls))
+		for _, x = range node.Decls {
+			runtime.EncodeBinary(e, x)
+		}
+	}
+}
+
+func (node *File) DecodeBinary(d *runtime.BinaryDecoder) {
+	var n int
+	var s []Decl
+	node.Name = d.ReadString()
+	n = d.ReadLength()
+	s = nil
+	if n >= 0 {
+		s = []Decl{}
+		for range n {
+			s = append(s, DecodeDeclBinary(d))
+		}
+	}
+	node.Decls = s
+}
+
+func readFileBinary(d *runtime.BinaryDecoder, o interface{}) *File {
+	var node *File
+	if o != nil {
+		return o.(*File)
+	}
+	node = &File{}
+	d.Register(node)
+	node.DecodeBinary(d)
+	return node
+}
+
+func (node *File) MarshalBinary() ([]byte, error) {
+	return runtime.MarshalBinary(node, "dfdc1bcc505f9f9e")
+}
+
+func (node *File) UnmarshalBinary(data []byte) error {
+	return runtime.UnmarshalBinary(data, "dfdc1bcc505f9f9e", "trap/tree/File", node)
+}
+
+func DecodeFileBinary(d *runtime.BinaryDecoder) *File {
+	var o interface{}
+	var t string
+	o, t = d.ReadObject()
+	if t == "" {
+		return nil
+	}
+	if t == "trap/tree/File" {
+		return readFileBinary(d, o)
+	}
+	d.Fail("unexpected " + t)
+	return nil
+}
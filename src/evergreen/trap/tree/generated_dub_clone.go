@@ -0,0 +1,2217 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *Token) Clone() *Token {
+	var c *runtime.Cloner
+	var clone *Token
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Token{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Token) clone(c *runtime.Cloner) *Token {
+	var o interface{}
+	var clone *Token
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Token)
+	}
+	clone = &Token{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Token) cloneFields(c *runtime.Cloner, clone *Token) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *Token) Equal(other *Token) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Token) equal(c *runtime.Comparer, other *Token) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func cloneTypeRef(c *runtime.Cloner, node TypeRef) TypeRef {
+	switch node.(type) {
+	case *NamedTypeRef:
+		return node.(*NamedTypeRef).clone(c)
+	case *ListTypeRef:
+		return node.(*ListTypeRef).clone(c)
+	case *SumTypeRef:
+		return node.(*SumTypeRef).clone(c)
+	}
+	return node
+}
+
+func equalTypeRef(c *runtime.Comparer, a TypeRef, b TypeRef) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *NamedTypeRef:
+		switch b.(type) {
+		case *NamedTypeRef:
+			return a.(*NamedTypeRef).equal(c, b.(*NamedTypeRef))
+		}
+	case *ListTypeRef:
+		switch b.(type) {
+		case *ListTypeRef:
+			return a.(*ListTypeRef).equal(c, b.(*ListTypeRef))
+		}
+	case *SumTypeRef:
+		switch b.(type) {
+		case *SumTypeRef:
+			return a.(*SumTypeRef).equal(c, b.(*SumTypeRef))
+		}
+	}
+	return a == b
+}
+
+func (node *NamedTypeRef) Clone() *NamedTypeRef {
+	var c *runtime.Cloner
+	var clone *NamedTypeRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NamedTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedTypeRef) clone(c *runtime.Cloner) *NamedTypeRef {
+	var o interface{}
+	var clone *NamedTypeRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NamedTypeRef)
+	}
+	clone = &NamedTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedTypeRef) cloneFields(c *runtime.Cloner, clone *NamedTypeRef) {
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *NamedTypeRef) Equal(other *NamedTypeRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NamedTypeRef) equal(c *runtime.Comparer, other *NamedTypeRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *ListTypeRef) Clone() *ListTypeRef {
+	var c *runtime.Cloner
+	var clone *ListTypeRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &ListTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListTypeRef) clone(c *runtime.Cloner) *ListTypeRef {
+	var o interface{}
+	var clone *ListTypeRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*ListTypeRef)
+	}
+	clone = &ListTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *ListTypeRef) cloneFields(c *runtime.Cloner, clone *ListTypeRef) {
+	clone.Type = cloneTypeRef(c, node.Type)
+}
+
+func (node *ListTypeRef) Equal(other *ListTypeRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *ListTypeRef) equal(c *runtime.Comparer, other *ListTypeRef) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *SumTypeRef) Clone() *SumTypeRef {
+	var c *runtime.Cloner
+	var clone *SumTypeRef
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &SumTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SumTypeRef) clone(c *runtime.Cloner) *SumTypeRef {
+	var o interface{}
+	var clone *SumTypeRef
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*SumTypeRef)
+	}
+	clone = &SumTypeRef{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *SumTypeRef) cloneFields(c *runtime.Cloner, clone *SumTypeRef) {
+	var s []TypeRef
+	var e TypeRef
+	s = nil
+	if node.Types != nil {
+		s = []TypeRef{}
+		for _, e = range node.Types {
+			s = append(s, cloneTypeRef(c, e))
+		}
+	}
+	clone.Types = s
+}
+
+func (node *SumTypeRef) Equal(other *SumTypeRef) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *SumTypeRef) equal(c *runtime.Comparer, other *SumTypeRef) bool {
+	var i int
+	var e TypeRef
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Types) != len(other.Types) || node.Types == nil != (other.Types == nil) {
+		return false
+	}
+	for i, e = range node.Types {
+		if !equalTypeRef(c, e, other.Types[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *FieldDecl) Clone() *FieldDecl {
+	var c *runtime.Cloner
+	var clone *FieldDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FieldDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldDecl) clone(c *runtime.Cloner) *FieldDecl {
+	var o interface{}
+	var clone *FieldDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FieldDecl)
+	}
+	clone = &FieldDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FieldDecl) cloneFields(c *runtime.Cloner, clone *FieldDecl) {
+	clone.Name = node.Name.clone(c)
+	clone.Type = cloneTypeRef(c, node.Type)
+}
+
+func (node *FieldDecl) Equal(other *FieldDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FieldDecl) equal(c *runtime.Comparer, other *FieldDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func cloneTypeImpl(c *runtime.Cloner, node TypeImpl) TypeImpl {
+	switch node.(type) {
+	case *StructDecl:
+		return node.(*StructDecl).clone(c)
+	case *TypeAliasDecl:
+		return node.(*TypeAliasDecl).clone(c)
+	}
+	return node
+}
+
+func equalTypeImpl(c *runtime.Comparer, a TypeImpl, b TypeImpl) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *StructDecl:
+		switch b.(type) {
+		case *StructDecl:
+			return a.(*StructDecl).equal(c, b.(*StructDecl))
+		}
+	case *TypeAliasDecl:
+		switch b.(type) {
+		case *TypeAliasDecl:
+			return a.(*TypeAliasDecl).equal(c, b.(*TypeAliasDecl))
+		}
+	}
+	return a == b
+}
+
+func (node *StructDecl) Clone() *StructDecl {
+	var c *runtime.Cloner
+	var clone *StructDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &StructDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructDecl) clone(c *runtime.Cloner) *StructDecl {
+	var o interface{}
+	var clone *StructDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*StructDecl)
+	}
+	clone = &StructDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *StructDecl) cloneFields(c *runtime.Cloner, clone *StructDecl) {
+	var s []*FieldDecl
+	var e *FieldDecl
+	s = nil
+	if node.Fields != nil {
+		s = []*FieldDecl{}
+		for _, e = range node.Fields {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Fields = s
+}
+
+func (node *StructDecl) Equal(other *StructDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *StructDecl) equal(c *runtime.Comparer, other *StructDecl) bool {
+	var i int
+	var e *FieldDecl
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Fields) != len(other.Fields) || node.Fields == nil != (other.Fields == nil) {
+		return false
+	}
+	for i, e = range node.Fields {
+		if !e.equal(c, other.Fields[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *TypeAliasDecl) Clone() *TypeAliasDecl {
+	var c *runtime.Cloner
+	var clone *TypeAliasDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeAliasDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeAliasDecl) clone(c *runtime.Cloner) *TypeAliasDecl {
+	var o interface{}
+	var clone *TypeAliasDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeAliasDecl)
+	}
+	clone = &TypeAliasDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeAliasDecl) cloneFields(c *runtime.Cloner, clone *TypeAliasDecl) {
+	clone.Type = cloneTypeRef(c, node.Type)
+}
+
+func (node *TypeAliasDecl) Equal(other *TypeAliasDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeAliasDecl) equal(c *runtime.Comparer, other *TypeAliasDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func cloneDecl(c *runtime.Cloner, node Decl) Decl {
+	switch node.(type) {
+	case *TypeDecl:
+		return node.(*TypeDecl).clone(c)
+	case *FuncDecl:
+		return node.(*FuncDecl).clone(c)
+	}
+	return node
+}
+
+func equalDecl(c *runtime.Comparer, a Decl, b Decl) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *TypeDecl:
+		switch b.(type) {
+		case *TypeDecl:
+			return a.(*TypeDecl).equal(c, b.(*TypeDecl))
+		}
+	case *FuncDecl:
+		switch b.(type) {
+		case *FuncDecl:
+			return a.(*FuncDecl).equal(c, b.(*FuncDecl))
+		}
+	}
+	return a == b
+}
+
+func (node *TypeDecl) Clone() *TypeDecl {
+	var c *runtime.Cloner
+	var clone *TypeDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &TypeDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeDecl) clone(c *runtime.Cloner) *TypeDecl {
+	var o interface{}
+	var clone *TypeDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*TypeDecl)
+	}
+	clone = &TypeDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *TypeDecl) cloneFields(c *runtime.Cloner, clone *TypeDecl) {
+	clone.Name = node.Name.clone(c)
+	clone.Decl = cloneTypeImpl(c, node.Decl)
+}
+
+func (node *TypeDecl) Equal(other *TypeDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *TypeDecl) equal(c *runtime.Comparer, other *TypeDecl) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalTypeImpl(c, node.Decl, other.Decl) {
+		return false
+	}
+	return true
+}
+
+func cloneStmt(c *runtime.Cloner, node Stmt) Stmt {
+	switch node.(type) {
+	case *GetName:
+		return node.(*GetName).clone(c)
+	case *IntLiteral:
+		return node.(*IntLiteral).clone(c)
+	case *InfixOp:
+		return node.(*InfixOp).clone(c)
+	case *GetAttr:
+		return node.(*GetAttr).clone(c)
+	case *GetIndex:
+		return node.(*GetIndex).clone(c)
+	case *Call:
+		return node.(*Call).clone(c)
+	case *AssignOp:
+		return node.(*AssignOp).clone(c)
+	case *CreateStruct:
+		return node.(*CreateStruct).clone(c)
+	case *CreateList:
+		return node.(*CreateList).clone(c)
+	case *Return:
+		return node.(*Return).clone(c)
+	case *Block:
+		return node.(*Block).clone(c)
+	case *If:
+		return node.(*If).clone(c)
+	case *While:
+		return node.(*While).clone(c)
+	case *For:
+		return node.(*For).clone(c)
+	case *Break:
+		return node.(*Break).clone(c)
+	case *Continue:
+		return node.(*Continue).clone(c)
+	}
+	return node
+}
+
+func equalStmt(c *runtime.Comparer, a Stmt, b Stmt) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *GetName:
+		switch b.(type) {
+		case *GetName:
+			return a.(*GetName).equal(c, b.(*GetName))
+		}
+	case *IntLiteral:
+		switch b.(type) {
+		case *IntLiteral:
+			return a.(*IntLiteral).equal(c, b.(*IntLiteral))
+		}
+	case *InfixOp:
+		switch b.(type) {
+		case *InfixOp:
+			return a.(*InfixOp).equal(c, b.(*InfixOp))
+		}
+	case *GetAttr:
+		switch b.(type) {
+		case *GetAttr:
+			return a.(*GetAttr).equal(c, b.(*GetAttr))
+		}
+	case *GetIndex:
+		switch b.(type) {
+		case *GetIndex:
+			return a.(*GetIndex).equal(c, b.(*GetIndex))
+		}
+	case *Call:
+		switch b.(type) {
+		case *Call:
+			return a.(*Call).equal(c, b.(*Call))
+		}
+	case *AssignOp:
+		switch b.(type) {
+		case *AssignOp:
+			return a.(*AssignOp).equal(c, b.(*AssignOp))
+		}
+	case *CreateStruct:
+		switch b.(type) {
+		case *CreateStruct:
+			return a.(*CreateStruct).equal(c, b.(*CreateStruct))
+		}
+	case *CreateList:
+		switch b.(type) {
+		case *CreateList:
+			return a.(*CreateList).equal(c, b.(*CreateList))
+		}
+	case *Return:
+		switch b.(type) {
+		case *Return:
+			return a.(*Return).equal(c, b.(*Return))
+		}
+	case *Block:
+		switch b.(type) {
+		case *Block:
+			return a.(*Block).equal(c, b.(*Block))
+		}
+	case *If:
+		switch b.(type) {
+		case *If:
+			return a.(*If).equal(c, b.(*If))
+		}
+	case *While:
+		switch b.(type) {
+		case *While:
+			return a.(*While).equal(c, b.(*While))
+		}
+	case *For:
+		switch b.(type) {
+		case *For:
+			return a.(*For).equal(c, b.(*For))
+		}
+	case *Break:
+		switch b.(type) {
+		case *Break:
+			return a.(*Break).equal(c, b.(*Break))
+		}
+	case *Continue:
+		switch b.(type) {
+		case *Continue:
+			return a.(*Continue).equal(c, b.(*Continue))
+		}
+	}
+	return a == b
+}
+
+func cloneExpr(c *runtime.Cloner, node Expr) Expr {
+	switch node.(type) {
+	case *GetName:
+		return node.(*GetName).clone(c)
+	case *IntLiteral:
+		return node.(*IntLiteral).clone(c)
+	case *InfixOp:
+		return node.(*InfixOp).clone(c)
+	case *GetAttr:
+		return node.(*GetAttr).clone(c)
+	case *GetIndex:
+		return node.(*GetIndex).clone(c)
+	case *Call:
+		return node.(*Call).clone(c)
+	case *AssignOp:
+		return node.(*AssignOp).clone(c)
+	case *CreateStruct:
+		return node.(*CreateStruct).clone(c)
+	case *CreateList:
+		return node.(*CreateList).clone(c)
+	}
+	return node
+}
+
+func equalExpr(c *runtime.Comparer, a Expr, b Expr) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	switch a.(type) {
+	case *GetName:
+		switch b.(type) {
+		case *GetName:
+			return a.(*GetName).equal(c, b.(*GetName))
+		}
+	case *IntLiteral:
+		switch b.(type) {
+		case *IntLiteral:
+			return a.(*IntLiteral).equal(c, b.(*IntLiteral))
+		}
+	case *InfixOp:
+		switch b.(type) {
+		case *InfixOp:
+			return a.(*InfixOp).equal(c, b.(*InfixOp))
+		}
+	case *GetAttr:
+		switch b.(type) {
+		case *GetAttr:
+			return a.(*GetAttr).equal(c, b.(*GetAttr))
+		}
+	case *GetIndex:
+		switch b.(type) {
+		case *GetIndex:
+			return a.(*GetIndex).equal(c, b.(*GetIndex))
+		}
+	case *Call:
+		switch b.(type) {
+		case *Call:
+			return a.(*Call).equal(c, b.(*Call))
+		}
+	case *AssignOp:
+		switch b.(type) {
+		case *AssignOp:
+			return a.(*AssignOp).equal(c, b.(*AssignOp))
+		}
+	case *CreateStruct:
+		switch b.(type) {
+		case *CreateStruct:
+			return a.(*CreateStruct).equal(c, b.(*CreateStruct))
+		}
+	case *CreateList:
+		switch b.(type) {
+		case *CreateList:
+			return a.(*CreateList).equal(c, b.(*CreateList))
+		}
+	}
+	return a == b
+}
+
+func (node *GetName) Clone() *GetName {
+	var c *runtime.Cloner
+	var clone *GetName
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetName{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetName) clone(c *runtime.Cloner) *GetName {
+	var o interface{}
+	var clone *GetName
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetName)
+	}
+	clone = &GetName{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetName) cloneFields(c *runtime.Cloner, clone *GetName) {
+	clone.Name = node.Name.clone(c)
+}
+
+func (node *GetName) Equal(other *GetName) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetName) equal(c *runtime.Comparer, other *GetName) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	return true
+}
+
+func (node *IntLiteral) Clone() *IntLiteral {
+	var c *runtime.Cloner
+	var clone *IntLiteral
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &IntLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntLiteral) clone(c *runtime.Cloner) *IntLiteral {
+	var o interface{}
+	var clone *IntLiteral
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*IntLiteral)
+	}
+	clone = &IntLiteral{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *IntLiteral) cloneFields(c *runtime.Cloner, clone *IntLiteral) {
+	clone.Pos = node.Pos
+	clone.Text = node.Text
+}
+
+func (node *IntLiteral) Equal(other *IntLiteral) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *IntLiteral) equal(c *runtime.Comparer, other *IntLiteral) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if node.Text != other.Text {
+		return false
+	}
+	return true
+}
+
+func (node *InfixOp) Clone() *InfixOp {
+	var c *runtime.Cloner
+	var clone *InfixOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &InfixOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *InfixOp) clone(c *runtime.Cloner) *InfixOp {
+	var o interface{}
+	var clone *InfixOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*InfixOp)
+	}
+	clone = &InfixOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *InfixOp) cloneFields(c *runtime.Cloner, clone *InfixOp) {
+	clone.Left = cloneExpr(c, node.Left)
+	clone.Op = node.Op.clone(c)
+	clone.Right = cloneExpr(c, node.Right)
+}
+
+func (node *InfixOp) Equal(other *InfixOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *InfixOp) equal(c *runtime.Comparer, other *InfixOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Left, other.Left) {
+		return false
+	}
+	if !node.Op.equal(c, other.Op) {
+		return false
+	}
+	if !equalExpr(c, node.Right, other.Right) {
+		return false
+	}
+	return true
+}
+
+func (node *GetAttr) Clone() *GetAttr {
+	var c *runtime.Cloner
+	var clone *GetAttr
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetAttr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetAttr) clone(c *runtime.Cloner) *GetAttr {
+	var o interface{}
+	var clone *GetAttr
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetAttr)
+	}
+	clone = &GetAttr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetAttr) cloneFields(c *runtime.Cloner, clone *GetAttr) {
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Attr = node.Attr.clone(c)
+}
+
+func (node *GetAttr) Equal(other *GetAttr) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetAttr) equal(c *runtime.Comparer, other *GetAttr) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if !node.Attr.equal(c, other.Attr) {
+		return false
+	}
+	return true
+}
+
+func (node *GetIndex) Clone() *GetIndex {
+	var c *runtime.Cloner
+	var clone *GetIndex
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &GetIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetIndex) clone(c *runtime.Cloner) *GetIndex {
+	var o interface{}
+	var clone *GetIndex
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*GetIndex)
+	}
+	clone = &GetIndex{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *GetIndex) cloneFields(c *runtime.Cloner, clone *GetIndex) {
+	clone.Expr = cloneExpr(c, node.Expr)
+	clone.Index = cloneExpr(c, node.Index)
+}
+
+func (node *GetIndex) Equal(other *GetIndex) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *GetIndex) equal(c *runtime.Comparer, other *GetIndex) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if !equalExpr(c, node.Index, other.Index) {
+		return false
+	}
+	return true
+}
+
+func (node *Call) Clone() *Call {
+	var c *runtime.Cloner
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) clone(c *runtime.Cloner) *Call {
+	var o interface{}
+	var clone *Call
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Call)
+	}
+	clone = &Call{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Call) cloneFields(c *runtime.Cloner, clone *Call) {
+	var s []Expr
+	var e Expr
+	clone.Expr = cloneExpr(c, node.Expr)
+	s = nil
+	if node.Args != nil {
+		s = []Expr{}
+		for _, e = range node.Args {
+			s = append(s, cloneExpr(c, e))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *Call) Equal(other *Call) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Call) equal(c *runtime.Comparer, other *Call) bool {
+	var i int
+	var e Expr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalExpr(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *AssignOp) Clone() *AssignOp {
+	var c *runtime.Cloner
+	var clone *AssignOp
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &AssignOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *AssignOp) clone(c *runtime.Cloner) *AssignOp {
+	var o interface{}
+	var clone *AssignOp
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*AssignOp)
+	}
+	clone = &AssignOp{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *AssignOp) cloneFields(c *runtime.Cloner, clone *AssignOp) {
+	clone.Target = cloneExpr(c, node.Target)
+	clone.Op = node.Op.clone(c)
+	clone.Value = cloneExpr(c, node.Value)
+}
+
+func (node *AssignOp) Equal(other *AssignOp) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *AssignOp) equal(c *runtime.Comparer, other *AssignOp) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalExpr(c, node.Target, other.Target) {
+		return false
+	}
+	if !node.Op.equal(c, other.Op) {
+		return false
+	}
+	if !equalExpr(c, node.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+func (node *NamedExpr) Clone() *NamedExpr {
+	var c *runtime.Cloner
+	var clone *NamedExpr
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &NamedExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedExpr) clone(c *runtime.Cloner) *NamedExpr {
+	var o interface{}
+	var clone *NamedExpr
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*NamedExpr)
+	}
+	clone = &NamedExpr{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *NamedExpr) cloneFields(c *runtime.Cloner, clone *NamedExpr) {
+	clone.Name = node.Name.clone(c)
+	clone.Value = cloneExpr(c, node.Value)
+}
+
+func (node *NamedExpr) Equal(other *NamedExpr) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *NamedExpr) equal(c *runtime.Comparer, other *NamedExpr) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalExpr(c, node.Value, other.Value) {
+		return false
+	}
+	return true
+}
+
+func (node *CreateStruct) Clone() *CreateStruct {
+	var c *runtime.Cloner
+	var clone *CreateStruct
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &CreateStruct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CreateStruct) clone(c *runtime.Cloner) *CreateStruct {
+	var o interface{}
+	var clone *CreateStruct
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*CreateStruct)
+	}
+	clone = &CreateStruct{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CreateStruct) cloneFields(c *runtime.Cloner, clone *CreateStruct) {
+	var s []*NamedExpr
+	var e *NamedExpr
+	clone.Type = cloneTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []*NamedExpr{}
+		for _, e = range node.Args {
+			s = append(s, e.clone(c))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *CreateStruct) Equal(other *CreateStruct) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *CreateStruct) equal(c *runtime.Comparer, other *CreateStruct) bool {
+	var i int
+	var e *NamedExpr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !e.equal(c, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *CreateList) Clone() *CreateList {
+	var c *runtime.Cloner
+	var clone *CreateList
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &CreateList{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CreateList) clone(c *runtime.Cloner) *CreateList {
+	var o interface{}
+	var clone *CreateList
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*CreateList)
+	}
+	clone = &CreateList{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *CreateList) cloneFields(c *runtime.Cloner, clone *CreateList) {
+	var s []Expr
+	var e Expr
+	clone.Type = cloneTypeRef(c, node.Type)
+	s = nil
+	if node.Args != nil {
+		s = []Expr{}
+		for _, e = range node.Args {
+			s = append(s, cloneExpr(c, e))
+		}
+	}
+	clone.Args = s
+}
+
+func (node *CreateList) Equal(other *CreateList) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *CreateList) equal(c *runtime.Comparer, other *CreateList) bool {
+	var i int
+	var e Expr
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	if len(node.Args) != len(other.Args) || node.Args == nil != (other.Args == nil) {
+		return false
+	}
+	for i, e = range node.Args {
+		if !equalExpr(c, e, other.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Return) Clone() *Return {
+	var c *runtime.Cloner
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) clone(c *runtime.Cloner) *Return {
+	var o interface{}
+	var clone *Return
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Return)
+	}
+	clone = &Return{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Return) cloneFields(c *runtime.Cloner, clone *Return) {
+	clone.Pos = node.Pos
+	clone.Expr = cloneExpr(c, node.Expr)
+}
+
+func (node *Return) Equal(other *Return) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Return) equal(c *runtime.Comparer, other *Return) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	return true
+}
+
+func (node *Block) Clone() *Block {
+	var c *runtime.Cloner
+	var clone *Block
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Block{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Block) clone(c *runtime.Cloner) *Block {
+	var o interface{}
+	var clone *Block
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Block)
+	}
+	clone = &Block{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Block) cloneFields(c *runtime.Cloner, clone *Block) {
+	var s []Stmt
+	var e Stmt
+	s = nil
+	if node.Body != nil {
+		s = []Stmt{}
+		for _, e = range node.Body {
+			s = append(s, cloneStmt(c, e))
+		}
+	}
+	clone.Body = s
+}
+
+func (node *Block) Equal(other *Block) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Block) equal(c *runtime.Comparer, other *Block) bool {
+	var i int
+	var e Stmt
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if len(node.Body) != len(other.Body) || node.Body == nil != (other.Body == nil) {
+		return false
+	}
+	for i, e = range node.Body {
+		if !equalStmt(c, e, other.Body[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *If) Clone() *If {
+	var c *runtime.Cloner
+	var clone *If
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &If{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *If) clone(c *runtime.Cloner) *If {
+	var o interface{}
+	var clone *If
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*If)
+	}
+	clone = &If{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *If) cloneFields(c *runtime.Cloner, clone *If) {
+	var s0 []Stmt
+	var e0 Stmt
+	var s1 []Stmt
+	var e1 Stmt
+	clone.Pos = node.Pos
+	clone.Cond = cloneExpr(c, node.Cond)
+	s0 = nil
+	if node.Body != nil {
+		s0 = []Stmt{}
+		for _, e0 = range node.Body {
+			s0 = append(s0, cloneStmt(c, e0))
+		}
+	}
+	clone.Body = s0
+	s1 = nil
+	if node.Else != nil {
+		s1 = []Stmt{}
+		for _, e1 = range node.Else {
+			s1 = append(s1, cloneStmt(c, e1))
+		}
+	}
+	clone.Else = s1
+}
+
+func (node *If) Equal(other *If) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *If) equal(c *runtime.Comparer, other *If) bool {
+	var i0 int
+	var e0 Stmt
+	var i1 int
+	var e1 Stmt
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if !equalExpr(c, node.Cond, other.Cond) {
+		return false
+	}
+	if len(node.Body) != len(other.Body) || node.Body == nil != (other.Body == nil) {
+		return false
+	}
+	for i0, e0 = range node.Body {
+		if !equalStmt(c, e0, other.Body[i0]) {
+			return false
+		}
+	}
+	if len(node.Else) != len(other.Else) || node.Else == nil != (other.Else == nil) {
+		return false
+	}
+	for i1, e1 = range node.Else {
+		if !equalStmt(c, e1, other.Else[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *While) Clone() *While {
+	var c *runtime.Cloner
+	var clone *While
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &While{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *While) clone(c *runtime.Cloner) *While {
+	var o interface{}
+	var clone *While
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*While)
+	}
+	clone = &While{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *While) cloneFields(c *runtime.Cloner, clone *While) {
+	var s []Stmt
+	var e Stmt
+	clone.Pos = node.Pos
+	clone.Cond = cloneExpr(c, node.Cond)
+	s = nil
+	if node.Body != nil {
+		s = []Stmt{}
+		for _, e = range node.Body {
+			s = append(s, cloneStmt(c, e))
+		}
+	}
+	clone.Body = s
+}
+
+func (node *While) Equal(other *While) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *While) equal(c *runtime.Comparer, other *While) bool {
+	var i int
+	var e Stmt
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if !equalExpr(c, node.Cond, other.Cond) {
+		return false
+	}
+	if len(node.Body) != len(other.Body) || node.Body == nil != (other.Body == nil) {
+		return false
+	}
+	for i, e = range node.Body {
+		if !equalStmt(c, e, other.Body[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *For) Clone() *For {
+	var c *runtime.Cloner
+	var clone *For
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &For{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *For) clone(c *runtime.Cloner) *For {
+	var o interface{}
+	var clone *For
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*For)
+	}
+	clone = &For{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *For) cloneFields(c *runtime.Cloner, clone *For) {
+	var s []Stmt
+	var e Stmt
+	clone.Pos = node.Pos
+	clone.Name = node.Name.clone(c)
+	clone.Expr = cloneExpr(c, node.Expr)
+	s = nil
+	if node.Body != nil {
+		s = []Stmt{}
+		for _, e = range node.Body {
+			s = append(s, cloneStmt(c, e))
+		}
+	}
+	clone.Body = s
+}
+
+func (node *For) Equal(other *For) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *For) equal(c *runtime.Comparer, other *For) bool {
+	var i int
+	var e Stmt
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalExpr(c, node.Expr, other.Expr) {
+		return false
+	}
+	if len(node.Body) != len(other.Body) || node.Body == nil != (other.Body == nil) {
+		return false
+	}
+	for i, e = range node.Body {
+		if !equalStmt(c, e, other.Body[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Break) Clone() *Break {
+	var c *runtime.Cloner
+	var clone *Break
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Break{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Break) clone(c *runtime.Cloner) *Break {
+	var o interface{}
+	var clone *Break
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Break)
+	}
+	clone = &Break{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Break) cloneFields(c *runtime.Cloner, clone *Break) {
+	clone.Pos = node.Pos
+}
+
+func (node *Break) Equal(other *Break) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Break) equal(c *runtime.Comparer, other *Break) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	return true
+}
+
+func (node *Continue) Clone() *Continue {
+	var c *runtime.Cloner
+	var clone *Continue
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Continue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Continue) clone(c *runtime.Cloner) *Continue {
+	var o interface{}
+	var clone *Continue
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Continue)
+	}
+	clone = &Continue{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Continue) cloneFields(c *runtime.Cloner, clone *Continue) {
+	clone.Pos = node.Pos
+}
+
+func (node *Continue) Equal(other *Continue) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Continue) equal(c *runtime.Comparer, other *Continue) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Pos != other.Pos {
+		return false
+	}
+	return true
+}
+
+func (node *Parameter) Clone() *Parameter {
+	var c *runtime.Cloner
+	var clone *Parameter
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Parameter{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Parameter) clone(c *runtime.Cloner) *Parameter {
+	var o interface{}
+	var clone *Parameter
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Parameter)
+	}
+	clone = &Parameter{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Parameter) cloneFields(c *runtime.Cloner, clone *Parameter) {
+	clone.Name = node.Name.clone(c)
+	clone.Type = cloneTypeRef(c, node.Type)
+}
+
+func (node *Parameter) Equal(other *Parameter) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Parameter) equal(c *runtime.Comparer, other *Parameter) bool {
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if !equalTypeRef(c, node.Type, other.Type) {
+		return false
+	}
+	return true
+}
+
+func (node *FuncDecl) Clone() *FuncDecl {
+	var c *runtime.Cloner
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) clone(c *runtime.Cloner) *FuncDecl {
+	var o interface{}
+	var clone *FuncDecl
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*FuncDecl)
+	}
+	clone = &FuncDecl{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *FuncDecl) cloneFields(c *runtime.Cloner, clone *FuncDecl) {
+	var s0 []*Parameter
+	var e0 *Parameter
+	var s1 []TypeRef
+	var e1 TypeRef
+	var s2 []Stmt
+	var e2 Stmt
+	clone.Name = node.Name.clone(c)
+	s0 = nil
+	if node.Parameters != nil {
+		s0 = []*Parameter{}
+		for _, e0 = range node.Parameters {
+			s0 = append(s0, e0.clone(c))
+		}
+	}
+	clone.Parameters = s0
+	s1 = nil
+	if node.ReturnTypes != nil {
+		s1 = []TypeRef{}
+		for _, e1 = range node.ReturnTypes {
+			s1 = append(s1, cloneTypeRef(c, e1))
+		}
+	}
+	clone.ReturnTypes = s1
+	s2 = nil
+	if node.Body != nil {
+		s2 = []Stmt{}
+		for _, e2 = range node.Body {
+			s2 = append(s2, cloneStmt(c, e2))
+		}
+	}
+	clone.Body = s2
+}
+
+func (node *FuncDecl) Equal(other *FuncDecl) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *FuncDecl) equal(c *runtime.Comparer, other *FuncDecl) bool {
+	var i0 int
+	var e0 *Parameter
+	var i1 int
+	var e1 TypeRef
+	var i2 int
+	var e2 Stmt
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if len(node.Parameters) != len(other.Parameters) || node.Parameters == nil != (other.Parameters == nil) {
+		return false
+	}
+	for i0, e0 = range node.Parameters {
+		if !e0.equal(c, other.Parameters[i0]) {
+			return false
+		}
+	}
+	if len(node.ReturnTypes) != len(other.ReturnTypes) || node.ReturnTypes == nil != (other.ReturnTypes == nil) {
+		return false
+	}
+	for i1, e1 = range node.ReturnTypes {
+		if !equalTypeRef(c, e1, other.ReturnTypes[i1]) {
+			return false
+		}
+	}
+	if len(node.Body) != len(other.Body) || node.Body == nil != (other.Body == nil) {
+		return false
+	}
+	for i2, e2 = range node.Body {
+		if !equalStmt(c, e2, other.Body[i2]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *Signature) Clone() *Signature {
+	var c *runtime.Cloner
+	var clone *Signature
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &Signature{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Signature) clone(c *runtime.Cloner) *Signature {
+	var o interface{}
+	var clone *Signature
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*Signature)
+	}
+	clone = &Signature{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *Signature) cloneFields(c *runtime.Cloner, clone *Signature) {
+	var s0 []TypeRef
+	var e0 TypeRef
+	var s1 []TypeRef
+	var e1 TypeRef
+	clone.Name = node.Name.clone(c)
+	s0 = nil
+	if node.Params != nil {
+		s0 = []TypeRef{}
+		for _, e0 = range node.Params {
+			s0 = append(s0, cloneTypeRef(c, e0))
+		}
+	}
+	clone.Params = s0
+	s1 = nil
+	if node.ReturnTypes != nil {
+		s1 = []TypeRef{}
+		for _, e1 = range node.ReturnTypes {
+			s1 = append(s1, cloneTypeRef(c, e1))
+		}
+	}
+	clone.ReturnTypes = s1
+}
+
+func (node *Signature) Equal(other *Signature) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *Signature) equal(c *runtime.Comparer, other *Signature) bool {
+	var i0 int
+	var e0 TypeRef
+	var i1 int
+	var e1 TypeRef
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if !node.Name.equal(c, other.Name) {
+		return false
+	}
+	if len(node.Params) != len(other.Params) || node.Params == nil != (other.Params == nil) {
+		return false
+	}
+	for i0, e0 = range node.Params {
+		if !equalTypeRef(c, e0, other.Params[i0]) {
+			return false
+		}
+	}
+	if len(node.ReturnTypes) != len(other.ReturnTypes) || node.ReturnTypes == nil != (other.ReturnTypes == nil) {
+		return false
+	}
+	for i1, e1 = range node.ReturnTypes {
+		if !equalTypeRef(c, e1, other.ReturnTypes[i1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (node *File) Clone() *File {
+	var c *runtime.Cloner
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	c = runtime.MakeCloner()
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) clone(c *runtime.Cloner) *File {
+	var o interface{}
+	var clone *File
+	if node == nil {
+		return nil
+	}
+	o = c.Lookup(node)
+	if o != nil {
+		return o.(*File)
+	}
+	clone = &File{}
+	c.Map(node, clone)
+	node.cloneFields(c, clone)
+	return clone
+}
+
+func (node *File) cloneFields(c *runtime.Cloner, clone *File) {
+	var s []Decl
+	var e Decl
+	clone.Name = node.Name
+	s = nil
+	if node.Decls != nil {
+		s = []Decl{}
+		for _, e = range node.Decls {
+			s = append(s, cloneDecl(c, e))
+		}
+	}
+	clone.Decls = s
+}
+
+func (node *File) Equal(other *File) bool {
+	return node.equal(runtime.MakeComparer(), other)
+}
+
+func (node *File) equal(c *runtime.Comparer, other *File) bool {
+	var i int
+	var e Decl
+	if node == other {
+		return true
+	}
+	if node == nil || other == nil {
+		return false
+	}
+	if c.Assume(node, other) {
+		return true
+	}
+	if node.Name != other.Name {
+		return false
+	}
+	if len(node.Decls) != len(other.Decls) || node.Decls == nil != (other.Decls == nil) {
+		return false
+	}
+	for i, e = range node.Decls {
+		if !equalDecl(c, e, other.Decls[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,537 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func (node *Token) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Token")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *Token) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NamedTypeRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NamedTypeRef")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *NamedTypeRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *ListTypeRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("ListTypeRef")
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *ListTypeRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *SumTypeRef) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e TypeRef
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("SumTypeRef")
+	l = runtime.MakeList("[]TypeRef")
+	for _, e = range node.Types {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Types", l)
+	return d
+}
+
+func (node *SumTypeRef) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FieldDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FieldDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *FieldDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *StructDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *FieldDecl
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("StructDecl")
+	l = runtime.MakeList("[]FieldDecl")
+	for _, e = range node.Fields {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Fields", l)
+	return d
+}
+
+func (node *StructDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeAliasDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeAliasDecl")
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *TypeAliasDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *TypeDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("TypeDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Decl", runtime.Describe(node.Decl))
+	return d
+}
+
+func (node *TypeDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetName) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetName")
+	d.AddField("Name", runtime.Describe(node.Name))
+	return d
+}
+
+func (node *GetName) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *IntLiteral) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("IntLiteral")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Text", runtime.Describe(node.Text))
+	return d
+}
+
+func (node *IntLiteral) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *InfixOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("InfixOp")
+	d.AddField("Left", runtime.Describe(node.Left))
+	d.AddField("Op", runtime.Describe(node.Op))
+	d.AddField("Right", runtime.Describe(node.Right))
+	return d
+}
+
+func (node *InfixOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetAttr) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetAttr")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Attr", runtime.Describe(node.Attr))
+	return d
+}
+
+func (node *GetAttr) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *GetIndex) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("GetIndex")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	d.AddField("Index", runtime.Describe(node.Index))
+	return d
+}
+
+func (node *GetIndex) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Call) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Expr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Call")
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	l = runtime.MakeList("[]Expr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *Call) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *AssignOp) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("AssignOp")
+	d.AddField("Target", runtime.Describe(node.Target))
+	d.AddField("Op", runtime.Describe(node.Op))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *AssignOp) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *NamedExpr) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("NamedExpr")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Value", runtime.Describe(node.Value))
+	return d
+}
+
+func (node *NamedExpr) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *CreateStruct) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e *NamedExpr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("CreateStruct")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]NamedExpr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *CreateStruct) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *CreateList) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Expr
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("CreateList")
+	d.AddField("Type", runtime.Describe(node.Type))
+	l = runtime.MakeList("[]Expr")
+	for _, e = range node.Args {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Args", l)
+	return d
+}
+
+func (node *CreateList) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Return) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Return")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	return d
+}
+
+func (node *Return) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Block) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Stmt
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Block")
+	l = runtime.MakeList("[]Stmt")
+	for _, e = range node.Body {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Body", l)
+	return d
+}
+
+func (node *Block) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *If) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 Stmt
+	var l1 *runtime.List
+	var e1 Stmt
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("If")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Cond", runtime.Describe(node.Cond))
+	l0 = runtime.MakeList("[]Stmt")
+	for _, e0 = range node.Body {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Body", l0)
+	l1 = runtime.MakeList("[]Stmt")
+	for _, e1 = range node.Else {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("Else", l1)
+	return d
+}
+
+func (node *If) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *While) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Stmt
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("While")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Cond", runtime.Describe(node.Cond))
+	l = runtime.MakeList("[]Stmt")
+	for _, e = range node.Body {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Body", l)
+	return d
+}
+
+func (node *While) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *For) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Stmt
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("For")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Expr", runtime.Describe(node.Expr))
+	l = runtime.MakeList("[]Stmt")
+	for _, e = range node.Body {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Body", l)
+	return d
+}
+
+func (node *For) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Break) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Break")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	return d
+}
+
+func (node *Break) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Continue) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Continue")
+	d.AddField("Pos", runtime.Describe(node.Pos))
+	return d
+}
+
+func (node *Continue) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Parameter) Describe() runtime.Value {
+	var d *runtime.Struct
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Parameter")
+	d.AddField("Name", runtime.Describe(node.Name))
+	d.AddField("Type", runtime.Describe(node.Type))
+	return d
+}
+
+func (node *Parameter) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *FuncDecl) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 *Parameter
+	var l1 *runtime.List
+	var e1 TypeRef
+	var l2 *runtime.List
+	var e2 Stmt
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("FuncDecl")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l0 = runtime.MakeList("[]Parameter")
+	for _, e0 = range node.Parameters {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Parameters", l0)
+	l1 = runtime.MakeList("[]TypeRef")
+	for _, e1 = range node.ReturnTypes {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("ReturnTypes", l1)
+	l2 = runtime.MakeList("[]Stmt")
+	for _, e2 = range node.Body {
+		l2.Append(runtime.Describe(e2))
+	}
+	d.AddField("Body", l2)
+	return d
+}
+
+func (node *FuncDecl) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *Signature) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l0 *runtime.List
+	var e0 TypeRef
+	var l1 *runtime.List
+	var e1 TypeRef
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("Signature")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l0 = runtime.MakeList("[]TypeRef")
+	for _, e0 = range node.Params {
+		l0.Append(runtime.Describe(e0))
+	}
+	d.AddField("Params", l0)
+	l1 = runtime.MakeList("[]TypeRef")
+	for _, e1 = range node.ReturnTypes {
+		l1.Append(runtime.Describe(e1))
+	}
+	d.AddField("ReturnTypes", l1)
+	return d
+}
+
+func (node *Signature) String() string {
+	return runtime.Format(node.Describe())
+}
+
+func (node *File) Describe() runtime.Value {
+	var d *runtime.Struct
+	var l *runtime.List
+	var e Decl
+	if node == nil {
+		return runtime.Describe(nil)
+	}
+	d = runtime.MakeStruct("File")
+	d.AddField("Name", runtime.Describe(node.Name))
+	l = runtime.MakeList("[]Decl")
+	for _, e = range node.Decls {
+		l.Append(runtime.Describe(e))
+	}
+	d.AddField("Decls", l)
+	return d
+}
+
+func (node *File) String() string {
+	return runtime.Format(node.Describe())
+}
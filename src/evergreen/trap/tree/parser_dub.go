@@ -0,0 +1,2737 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+)
+
+func EOL(frame *runtime.State) {
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '\n' {
+			frame.Consume()
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint)
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		if c1 == '\r' {
+			frame.Consume()
+			c2 = frame.Peek()
+			if frame.Flow == 0 {
+				if c2 == '\n' {
+					frame.Consume()
+					return
+				}
+				frame.Fail()
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint)
+	c3 = frame.Peek()
+	if frame.Flow == 0 {
+		if c3 == '\r' {
+			frame.Consume()
+		} else {
+			frame.Fail()
+		}
+	}
+}
+
+func SingleLineComment(frame *runtime.State) {
+	var c0 rune
+	var c1 rune
+	var checkpoint int
+	var c2 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '/' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == '/' {
+					frame.Consume()
+				loop0:
+					for {
+						checkpoint = frame.Checkpoint()
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							switch c2 {
+							case '\n', '\r':
+								frame.Fail()
+							default:
+								frame.Consume()
+								continue loop0
+							}
+						}
+						frame.Recover(checkpoint)
+						return
+					}
+				}
+				frame.Fail()
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+}
+
+func S(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var c rune
+loop0:
+	for {
+		checkpoint0 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			switch c {
+			case ' ', '\t':
+				frame.Consume()
+				continue loop0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint1)
+		EOL(frame)
+		if frame.Flow != 0 {
+			frame.Recover(checkpoint1)
+			SingleLineComment(frame)
+			if frame.Flow != 0 {
+				frame.Recover(checkpoint0)
+				return
+			}
+		}
+	}
+}
+
+func EOSInsertionPoint(frame *runtime.State) {
+	var checkpoint int
+	var c rune
+loop0:
+	for {
+		checkpoint = frame.Checkpoint()
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			switch c {
+			case ' ', '\t':
+				frame.Consume()
+				continue loop0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint)
+		return
+	}
+}
+
+func EOS(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var c0 rune
+	var checkpoint2 int
+	var c1 rune
+	var checkpoint3 int
+	var checkpoint4 int
+	checkpoint0 = frame.Checkpoint()
+	S(frame)
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == ';' {
+			frame.Consume()
+			return
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint1)
+	checkpoint2 = frame.LookaheadBegin()
+	c1 = frame.Peek()
+	if frame.Flow == 0 {
+		switch c1 {
+		case ')', '}':
+			frame.Consume()
+			frame.LookaheadNormal(checkpoint2)
+			return
+		default:
+			frame.Fail()
+		}
+	}
+	frame.LookaheadFail(checkpoint2)
+	frame.Recover(checkpoint1)
+	checkpoint3 = frame.LookaheadBegin()
+	frame.Peek()
+	if frame.Flow == 0 {
+		frame.Consume()
+		frame.LookaheadFail(checkpoint3)
+		frame.Recover(checkpoint0)
+		checkpoint4 = frame.Checkpoint()
+		SingleLineComment(frame)
+		if frame.Flow != 0 {
+			frame.Recover(checkpoint4)
+		}
+		EOL(frame)
+	} else {
+		frame.LookaheadNormal(checkpoint3)
+	}
+}
+
+func EndKeyword(frame *runtime.State) {
+	var checkpoint int
+	var c rune
+	var cond0 bool
+	var cond1 bool
+	checkpoint = frame.LookaheadBegin()
+	c = frame.Peek()
+	cond1 = frame.Flow == 0
+block1:
+	for {
+		if cond1 {
+			cond0 = c >= 'a'
+		block0:
+			for {
+				if cond0 {
+					if c <= 'z' {
+						break block0
+					}
+				}
+				if c >= 'A' {
+					if c <= 'Z' {
+						break block0
+					}
+				}
+				if c != '_' {
+					if c >= '0' {
+						if c <= '9' {
+							break block0
+						}
+					}
+					frame.Fail()
+					break block1
+				}
+				break
+			}
+			frame.Consume()
+			frame.LookaheadFail(checkpoint)
+			return
+		}
+		break
+	}
+	frame.LookaheadNormal(checkpoint)
+}
+
+func NotReserved(frame *runtime.State) {
+	var checkpoint0 int
+	var checkpoint1 int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var c8 rune
+	var c9 rune
+	var c10 rune
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var c14 rune
+	var c15 rune
+	var c16 rune
+	var c17 rune
+	var c18 rune
+	var c19 rune
+	var c20 rune
+	var c21 rune
+	var c22 rune
+	var c23 rune
+	var c24 rune
+	var c25 rune
+	var c26 rune
+	var c27 rune
+	var c28 rune
+	var c29 rune
+	var c30 rune
+	var c31 rune
+	var c32 rune
+	var c33 rune
+	var c34 rune
+	var c35 rune
+	var c36 rune
+	var c37 rune
+	var c38 rune
+	var c39 rune
+	var c40 rune
+	var c41 rune
+	var c42 rune
+	var c43 rune
+	var c44 rune
+	var c45 rune
+	var c46 rune
+	var c47 rune
+	var c48 rune
+	var checkpoint2 int
+	var c49 rune
+	var cond0 bool
+	var cond1 bool
+	var cond2 bool
+	checkpoint0 = frame.LookaheadBegin()
+	checkpoint1 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond1 = frame.Flow == 0
+block1:
+	for {
+	block0:
+		for {
+			if cond1 {
+				if c0 == 't' {
+					frame.Consume()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 == 'y' {
+							frame.Consume()
+							c2 = frame.Peek()
+							if frame.Flow == 0 {
+								if c2 == 'p' {
+									frame.Consume()
+									c3 = frame.Peek()
+									if frame.Flow == 0 {
+										if c3 == 'e' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c4 = frame.Peek()
+			if frame.Flow == 0 {
+				if c4 == 's' {
+					frame.Consume()
+					c5 = frame.Peek()
+					if frame.Flow == 0 {
+						if c5 == 't' {
+							frame.Consume()
+							c6 = frame.Peek()
+							if frame.Flow == 0 {
+								if c6 == 'r' {
+									frame.Consume()
+									c7 = frame.Peek()
+									if frame.Flow == 0 {
+										if c7 == 'u' {
+											frame.Consume()
+											c8 = frame.Peek()
+											if frame.Flow == 0 {
+												if c8 == 'c' {
+													frame.Consume()
+													c9 = frame.Peek()
+													if frame.Flow == 0 {
+														if c9 == 't' {
+															frame.Consume()
+															break block0
+														}
+														frame.Fail()
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c10 = frame.Peek()
+			if frame.Flow == 0 {
+				if c10 == 'f' {
+					frame.Consume()
+					c11 = frame.Peek()
+					if frame.Flow == 0 {
+						if c11 == 'u' {
+							frame.Consume()
+							c12 = frame.Peek()
+							if frame.Flow == 0 {
+								if c12 == 'n' {
+									frame.Consume()
+									c13 = frame.Peek()
+									if frame.Flow == 0 {
+										if c13 == 'c' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c14 = frame.Peek()
+			if frame.Flow == 0 {
+				if c14 == 'r' {
+					frame.Consume()
+					c15 = frame.Peek()
+					if frame.Flow == 0 {
+						if c15 == 'e' {
+							frame.Consume()
+							c16 = frame.Peek()
+							if frame.Flow == 0 {
+								if c16 == 't' {
+									frame.Consume()
+									c17 = frame.Peek()
+									if frame.Flow == 0 {
+										if c17 == 'u' {
+											frame.Consume()
+											c18 = frame.Peek()
+											if frame.Flow == 0 {
+												if c18 == 'r' {
+													frame.Consume()
+													c19 = frame.Peek()
+													if frame.Flow == 0 {
+														if c19 == 'n' {
+															frame.Consume()
+															break block0
+														}
+														frame.Fail()
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c20 = frame.Peek()
+			if frame.Flow == 0 {
+				if c20 == 'i' {
+					frame.Consume()
+					c21 = frame.Peek()
+					if frame.Flow == 0 {
+						if c21 == 'f' {
+							frame.Consume()
+							break block0
+						}
+						frame.Fail()
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c22 = frame.Peek()
+			if frame.Flow == 0 {
+				if c22 == 'e' {
+					frame.Consume()
+					c23 = frame.Peek()
+					if frame.Flow == 0 {
+						if c23 == 'l' {
+							frame.Consume()
+							c24 = frame.Peek()
+							if frame.Flow == 0 {
+								if c24 == 's' {
+									frame.Consume()
+									c25 = frame.Peek()
+									if frame.Flow == 0 {
+										if c25 == 'e' {
+											frame.Consume()
+											break block0
+										}
+										frame.Fail()
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c26 = frame.Peek()
+			if frame.Flow == 0 {
+				if c26 == 'w' {
+					frame.Consume()
+					c27 = frame.Peek()
+					if frame.Flow == 0 {
+						if c27 == 'h' {
+							frame.Consume()
+							c28 = frame.Peek()
+							if frame.Flow == 0 {
+								if c28 == 'i' {
+									frame.Consume()
+									c29 = frame.Peek()
+									if frame.Flow == 0 {
+										if c29 == 'l' {
+											frame.Consume()
+											c30 = frame.Peek()
+											if frame.Flow == 0 {
+												if c30 == 'e' {
+													frame.Consume()
+													break block0
+												}
+												frame.Fail()
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c31 = frame.Peek()
+			if frame.Flow == 0 {
+				if c31 == 'f' {
+					frame.Consume()
+					c32 = frame.Peek()
+					if frame.Flow == 0 {
+						if c32 == 'o' {
+							frame.Consume()
+							c33 = frame.Peek()
+							if frame.Flow == 0 {
+								if c33 == 'r' {
+									frame.Consume()
+									break block0
+								}
+								frame.Fail()
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c34 = frame.Peek()
+			if frame.Flow == 0 {
+				if c34 == 'i' {
+					frame.Consume()
+					c35 = frame.Peek()
+					if frame.Flow == 0 {
+						if c35 == 'n' {
+							frame.Consume()
+							break block0
+						}
+						frame.Fail()
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c36 = frame.Peek()
+			if frame.Flow == 0 {
+				if c36 == 'b' {
+					frame.Consume()
+					c37 = frame.Peek()
+					if frame.Flow == 0 {
+						if c37 == 'r' {
+							frame.Consume()
+							c38 = frame.Peek()
+							if frame.Flow == 0 {
+								if c38 == 'e' {
+									frame.Consume()
+									c39 = frame.Peek()
+									if frame.Flow == 0 {
+										if c39 == 'a' {
+											frame.Consume()
+											c40 = frame.Peek()
+											if frame.Flow == 0 {
+												if c40 == 'k' {
+													frame.Consume()
+													break block0
+												}
+												frame.Fail()
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c41 = frame.Peek()
+			if frame.Flow == 0 {
+				if c41 == 'c' {
+					frame.Consume()
+					c42 = frame.Peek()
+					if frame.Flow == 0 {
+						if c42 == 'o' {
+							frame.Consume()
+							c43 = frame.Peek()
+							if frame.Flow == 0 {
+								if c43 == 'n' {
+									frame.Consume()
+									c44 = frame.Peek()
+									if frame.Flow == 0 {
+										if c44 == 't' {
+											frame.Consume()
+											c45 = frame.Peek()
+											if frame.Flow == 0 {
+												if c45 == 'i' {
+													frame.Consume()
+													c46 = frame.Peek()
+													if frame.Flow == 0 {
+														if c46 == 'n' {
+															frame.Consume()
+															c47 = frame.Peek()
+															if frame.Flow == 0 {
+																if c47 == 'u' {
+																	frame.Consume()
+																	c48 = frame.Peek()
+																	if frame.Flow == 0 {
+																		if c48 == 'e' {
+																			frame.Consume()
+																		} else {
+																			frame.Fail()
+																			break block1
+																		}
+																	} else {
+																		break block1
+																	}
+																} else {
+																	frame.Fail()
+																	break block1
+																}
+															} else {
+																break block1
+															}
+														} else {
+															frame.Fail()
+															break block1
+														}
+													} else {
+														break block1
+													}
+												} else {
+													frame.Fail()
+													break block1
+												}
+											} else {
+												break block1
+											}
+										} else {
+											frame.Fail()
+											break block1
+										}
+									} else {
+										break block1
+									}
+								} else {
+									frame.Fail()
+									break block1
+								}
+							} else {
+								break block1
+							}
+						} else {
+							frame.Fail()
+							break block1
+						}
+					} else {
+						break block1
+					}
+				} else {
+					frame.Fail()
+					break block1
+				}
+			} else {
+				break block1
+			}
+			break
+		}
+		checkpoint2 = frame.LookaheadBegin()
+		c49 = frame.Peek()
+		cond2 = frame.Flow == 0
+	block3:
+		for {
+			if cond2 {
+				cond0 = c49 >= 'a'
+			block2:
+				for {
+					if cond0 {
+						if c49 <= 'z' {
+							break block2
+						}
+					}
+					if c49 >= 'A' {
+						if c49 <= 'Z' {
+							break block2
+						}
+					}
+					if c49 != '_' {
+						if c49 >= '0' {
+							if c49 <= '9' {
+								break block2
+							}
+						}
+						frame.Fail()
+						break block3
+					}
+					break
+				}
+				frame.Consume()
+				frame.LookaheadFail(checkpoint2)
+				break block1
+			}
+			break
+		}
+		frame.LookaheadNormal(checkpoint2)
+		frame.LookaheadFail(checkpoint0)
+		return
+	}
+	frame.LookaheadNormal(checkpoint0)
+}
+
+func Id(frame *runtime.State) (ret *Token) {
+	var p int
+	var begin int
+	var c0 rune
+	var cond0 bool
+	var checkpoint int
+	var c1 rune
+	var cond1 bool
+	var cond2 bool
+	p = frame.Checkpoint()
+	NotReserved(frame)
+	if frame.Flow == 0 {
+		begin = frame.Checkpoint()
+		c0 = frame.Peek()
+		if frame.Flow == 0 {
+			cond0 = c0 >= 'a'
+		block0:
+			for {
+				if cond0 {
+					if c0 <= 'z' {
+						break block0
+					}
+				}
+				if c0 >= 'A' {
+					if c0 <= 'Z' {
+						break block0
+					}
+				}
+				if c0 != '_' {
+					frame.Fail()
+					return
+				}
+				break
+			}
+			frame.Consume()
+		loop3:
+			for {
+				checkpoint = frame.Checkpoint()
+				c1 = frame.Peek()
+				cond2 = frame.Flow == 0
+			block2:
+				for {
+					if cond2 {
+						cond1 = c1 >= 'a'
+					block1:
+						for {
+							if cond1 {
+								if c1 <= 'z' {
+									break block1
+								}
+							}
+							if c1 >= 'A' {
+								if c1 <= 'Z' {
+									break block1
+								}
+							}
+							if c1 != '_' {
+								if c1 >= '0' {
+									if c1 <= '9' {
+										break block1
+									}
+								}
+								frame.Fail()
+								break block2
+							}
+							break
+						}
+						frame.Consume()
+						continue loop3
+					}
+					break
+				}
+				frame.Recover(checkpoint)
+				ret = &Token{Pos: p, Text: frame.Slice(begin, frame.Checkpoint())}
+				return
+			}
+		}
+		return
+	}
+	return
+}
+
+func ParseNamedTypeRef(frame *runtime.State) (ret TypeRef) {
+	var name *Token
+	name = Id(frame)
+	if frame.Flow == 0 {
+		ret = &NamedTypeRef{Name: name}
+		return
+	}
+	return
+}
+
+func ParseListTypeRef(frame *runtime.State) (ret TypeRef) {
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var r TypeRef
+	var name *Token
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '[' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == ']' {
+					frame.Consume()
+					S(frame)
+					r = ParseListTypeRef(frame)
+					if frame.Flow == 0 {
+						ret = &ListTypeRef{Type: r}
+						return
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint)
+	name = Id(frame)
+	if frame.Flow == 0 {
+		ret = &NamedTypeRef{Name: name}
+		return
+	}
+	return
+}
+
+func ParseSumTypeRef(frame *runtime.State) (ret TypeRef) {
+	var t TypeRef
+	var checkpoint0 int
+	var types0 []TypeRef
+	var c0 rune
+	var r0 TypeRef
+	var types1 []TypeRef
+	var checkpoint1 int
+	var c1 rune
+	var r1 TypeRef
+	t = ParseListTypeRef(frame)
+	if frame.Flow == 0 {
+		checkpoint0 = frame.Checkpoint()
+		types0 = []TypeRef{t}
+		S(frame)
+		c0 = frame.Peek()
+		if frame.Flow == 0 {
+			if c0 == '|' {
+				frame.Consume()
+				S(frame)
+				r0 = ParseListTypeRef(frame)
+				if frame.Flow == 0 {
+					types1 = append(types0, r0)
+				loop0:
+					for {
+						checkpoint1 = frame.Checkpoint()
+						S(frame)
+						c1 = frame.Peek()
+						if frame.Flow == 0 {
+							if c1 == '|' {
+								frame.Consume()
+								S(frame)
+								r1 = ParseListTypeRef(frame)
+								if frame.Flow == 0 {
+									types1 = append(types1, r1)
+									continue loop0
+								}
+							} else {
+								frame.Fail()
+							}
+						}
+						frame.Recover(checkpoint1)
+						ret = &SumTypeRef{Types: types1}
+						return
+					}
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint0)
+		ret = t
+		return
+	}
+	return
+}
+
+func ParseTypeRef(frame *runtime.State) (ret TypeRef) {
+	var r TypeRef
+	r = ParseSumTypeRef(frame)
+	if frame.Flow == 0 {
+		ret = r
+		return
+	}
+	return
+}
+
+func ParseField(frame *runtime.State) (ret *FieldDecl) {
+	var name *Token
+	var r TypeRef
+	name = Id(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		r = ParseSumTypeRef(frame)
+		if frame.Flow == 0 {
+			EOS(frame)
+			if frame.Flow == 0 {
+				ret = &FieldDecl{Name: name, Type: r}
+				return
+			}
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseFields(frame *runtime.State) (ret []*FieldDecl) {
+	var fields0 []*FieldDecl
+	var checkpoint int
+	var r *FieldDecl
+	var fields1 []*FieldDecl
+	var fields2 []*FieldDecl
+	fields0 = []*FieldDecl{}
+	for {
+		checkpoint = frame.Checkpoint()
+		r = ParseField(frame)
+		if frame.Flow == 0 {
+			fields1 = append(fields0, r)
+			S(frame)
+			fields0 = fields1
+		} else {
+			fields2 = fields0
+			frame.Recover(checkpoint)
+			ret = fields2
+			return
+		}
+	}
+}
+
+func ParseStructDecl(frame *runtime.State) (ret *StructDecl) {
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var c6 rune
+	var fields []*FieldDecl
+	var c7 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 's' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 't' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'r' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'u' {
+									frame.Consume()
+									c4 = frame.Peek()
+									if frame.Flow == 0 {
+										if c4 == 'c' {
+											frame.Consume()
+											c5 = frame.Peek()
+											if frame.Flow == 0 {
+												if c5 == 't' {
+													frame.Consume()
+													EndKeyword(frame)
+													if frame.Flow == 0 {
+														S(frame)
+														c6 = frame.Peek()
+														if frame.Flow == 0 {
+															if c6 == '{' {
+																frame.Consume()
+																S(frame)
+																fields = ParseFields(frame)
+																S(frame)
+																c7 = frame.Peek()
+																if frame.Flow == 0 {
+																	if c7 == '}' {
+																		frame.Consume()
+																		ret = &StructDecl{Fields: fields}
+																		return
+																	}
+																	frame.Fail()
+																	return
+																}
+																return
+															}
+															frame.Fail()
+															return
+														}
+														return
+													}
+													return
+												}
+												frame.Fail()
+												return
+											}
+											return
+										}
+										frame.Fail()
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseTypeAliasDecl(frame *runtime.State) (ret *TypeAliasDecl) {
+	var c0 rune
+	var r TypeRef
+	var c1 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '=' {
+			frame.Consume()
+			S(frame)
+			r = ParseSumTypeRef(frame)
+			if frame.Flow == 0 {
+				S(frame)
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == ';' {
+						frame.Consume()
+						ret = &TypeAliasDecl{Type: r}
+						return
+					}
+					frame.Fail()
+					return
+				}
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseTypeDecl(frame *runtime.State) (ret *TypeDecl) {
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var name *Token
+	var checkpoint int
+	var impl0 *StructDecl
+	var impl1 TypeImpl
+	var impl2 *TypeAliasDecl
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 't' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'y' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'p' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'e' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										name = Id(frame)
+										if frame.Flow == 0 {
+											S(frame)
+											checkpoint = frame.Checkpoint()
+											impl0 = ParseStructDecl(frame)
+											if frame.Flow == 0 {
+												impl1 = impl0
+											} else {
+												frame.Recover(checkpoint)
+												impl2 = ParseTypeAliasDecl(frame)
+												if frame.Flow == 0 {
+													impl1 = impl2
+												} else {
+													return
+												}
+											}
+											ret = &TypeDecl{Name: name, Decl: impl1}
+											return
+										}
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseNamedExpr(frame *runtime.State) (ret *NamedExpr) {
+	var name *Token
+	var c rune
+	var r Expr
+	name = Id(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		c = frame.Peek()
+		if frame.Flow == 0 {
+			if c == ':' {
+				frame.Consume()
+				S(frame)
+				r = ParseAssignExpr(frame)
+				if frame.Flow == 0 {
+					ret = &NamedExpr{Name: name, Value: r}
+					return
+				}
+				return
+			}
+			frame.Fail()
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseExprList(frame *runtime.State) (ret []Expr) {
+	var exprs0 []Expr
+	var checkpoint0 int
+	var r0 Expr
+	var exprs1 []Expr
+	var checkpoint1 int
+	var c0 rune
+	var r1 Expr
+	var checkpoint2 int
+	var c1 rune
+	var exprs2 []Expr
+	var cond bool
+	exprs0 = []Expr{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseExpr(frame)
+	cond = frame.Flow == 0
+block1:
+	for {
+		if cond {
+			exprs1 = append(exprs0, r0)
+		loop0:
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				c0 = frame.Peek()
+				if frame.Flow == 0 {
+					if c0 == ',' {
+						frame.Consume()
+						S(frame)
+						r1 = ParseExpr(frame)
+						if frame.Flow == 0 {
+							exprs1 = append(exprs1, r1)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				checkpoint2 = frame.Checkpoint()
+				S(frame)
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == ',' {
+						frame.Consume()
+						exprs2 = exprs1
+						break block1
+					}
+					frame.Fail()
+				}
+				frame.Recover(checkpoint2)
+				exprs2 = exprs1
+				break block1
+			}
+		}
+		frame.Recover(checkpoint0)
+		exprs2 = exprs0
+		break
+	}
+	ret = exprs2
+	return
+}
+
+func ParseNamedExprList(frame *runtime.State) (ret []*NamedExpr) {
+	var exprs0 []*NamedExpr
+	var checkpoint0 int
+	var r0 *NamedExpr
+	var exprs1 []*NamedExpr
+	var checkpoint1 int
+	var c0 rune
+	var r1 *NamedExpr
+	var checkpoint2 int
+	var c1 rune
+	var exprs2 []*NamedExpr
+	var cond bool
+	exprs0 = []*NamedExpr{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseNamedExpr(frame)
+	cond = frame.Flow == 0
+block1:
+	for {
+		if cond {
+			exprs1 = append(exprs0, r0)
+		loop0:
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				c0 = frame.Peek()
+				if frame.Flow == 0 {
+					if c0 == ',' {
+						frame.Consume()
+						S(frame)
+						r1 = ParseNamedExpr(frame)
+						if frame.Flow == 0 {
+							exprs1 = append(exprs1, r1)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				checkpoint2 = frame.Checkpoint()
+				S(frame)
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == ',' {
+						frame.Consume()
+						exprs2 = exprs1
+						break block1
+					}
+					frame.Fail()
+				}
+				frame.Recover(checkpoint2)
+				exprs2 = exprs1
+				break block1
+			}
+		}
+		frame.Recover(checkpoint0)
+		exprs2 = exprs0
+		break
+	}
+	ret = exprs2
+	return
+}
+
+func ParseCompositeLiteral(frame *runtime.State) (ret Expr) {
+	var r TypeRef
+	var c0 rune
+	var checkpoint int
+	var args0 []*NamedExpr
+	var c1 rune
+	var args1 []Expr
+	var c2 rune
+	r = ParseSumTypeRef(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		c0 = frame.Peek()
+		if frame.Flow == 0 {
+			if c0 == '{' {
+				frame.Consume()
+				S(frame)
+				checkpoint = frame.Checkpoint()
+				args0 = ParseNamedExprList(frame)
+				S(frame)
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == '}' {
+						frame.Consume()
+						ret = &CreateStruct{Type: r, Args: args0}
+						return
+					}
+					frame.Fail()
+				}
+				frame.Recover(checkpoint)
+				args1 = ParseExprList(frame)
+				S(frame)
+				c2 = frame.Peek()
+				if frame.Flow == 0 {
+					if c2 == '}' {
+						frame.Consume()
+						ret = &CreateList{Type: r, Args: args1}
+						return
+					}
+					frame.Fail()
+					return
+				}
+				return
+			}
+			frame.Fail()
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseExprAtom(frame *runtime.State, literals bool) (ret Expr) {
+	var p int
+	var checkpoint0 int
+	var begin int
+	var c0 rune
+	var checkpoint1 int
+	var c1 rune
+	var r Expr
+	var name *Token
+	var c2 rune
+	var expr Expr
+	var c3 rune
+	p = frame.Checkpoint()
+	checkpoint0 = frame.Checkpoint()
+	begin = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 >= '0' {
+			if c0 <= '9' {
+				frame.Consume()
+			loop0:
+				for {
+					checkpoint1 = frame.Checkpoint()
+					c1 = frame.Peek()
+					if frame.Flow == 0 {
+						if c1 >= '0' {
+							if c1 <= '9' {
+								frame.Consume()
+								continue loop0
+							}
+						}
+						frame.Fail()
+					}
+					frame.Recover(checkpoint1)
+					ret = &IntLiteral{Pos: p, Text: frame.Slice(begin, frame.Checkpoint())}
+					return
+				}
+			}
+		}
+		frame.Fail()
+	}
+	frame.Recover(checkpoint0)
+	if literals {
+		r = ParseCompositeLiteral(frame)
+		if frame.Flow == 0 {
+			ret = r
+			return
+		}
+	} else {
+		frame.Fail()
+	}
+	frame.Recover(checkpoint0)
+	name = Id(frame)
+	if frame.Flow == 0 {
+		ret = &GetName{Name: name}
+		return
+	}
+	frame.Recover(checkpoint0)
+	c2 = frame.Peek()
+	if frame.Flow == 0 {
+		if c2 == '(' {
+			frame.Consume()
+			S(frame)
+			expr = ParseExpr(frame)
+			if frame.Flow == 0 {
+				S(frame)
+				c3 = frame.Peek()
+				if frame.Flow == 0 {
+					if c3 == ')' {
+						frame.Consume()
+						ret = expr
+						return
+					}
+					frame.Fail()
+					return
+				}
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseExprPostfix(frame *runtime.State, literals bool) (ret Expr) {
+	var expr0 Expr
+	var expr1 Expr
+	var checkpoint0 int
+	var checkpoint1 int
+	var c0 rune
+	var attr *Token
+	var c1 rune
+	var index Expr
+	var c2 rune
+	var c3 rune
+	var args []Expr
+	var c4 rune
+	expr0 = ParseExprAtom(frame, literals)
+	if frame.Flow == 0 {
+		expr1 = expr0
+	loop0:
+		for {
+			checkpoint0 = frame.Checkpoint()
+			S(frame)
+			checkpoint1 = frame.Checkpoint()
+			c0 = frame.Peek()
+			if frame.Flow == 0 {
+				if c0 == '.' {
+					frame.Consume()
+					S(frame)
+					attr = Id(frame)
+					if frame.Flow == 0 {
+						expr1 = &GetAttr{Expr: expr1, Attr: attr}
+						continue loop0
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == '[' {
+					frame.Consume()
+					S(frame)
+					index = ParseExpr(frame)
+					if frame.Flow == 0 {
+						S(frame)
+						c2 = frame.Peek()
+						if frame.Flow == 0 {
+							if c2 == ']' {
+								frame.Consume()
+								expr1 = &GetIndex{Expr: expr1, Index: index}
+								continue loop0
+							}
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c3 = frame.Peek()
+			if frame.Flow == 0 {
+				if c3 == '(' {
+					frame.Consume()
+					S(frame)
+					args = ParseExprList(frame)
+					S(frame)
+					c4 = frame.Peek()
+					if frame.Flow == 0 {
+						if c4 == ')' {
+							frame.Consume()
+							expr1 = &Call{Expr: expr1, Args: args}
+							continue loop0
+						}
+						frame.Fail()
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint0)
+			ret = expr1
+			return
+		}
+	}
+	return
+}
+
+func InfixOperator(frame *runtime.State) (ret0 *Token, ret1 int) {
+	var p int
+	var checkpoint0 int
+	var begin0 int
+	var c0 rune
+	var text string
+	var prec int
+	var begin1 int
+	var c1 rune
+	var begin2 int
+	var checkpoint1 int
+	var c2 rune
+	var checkpoint2 int
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var cond0 bool
+	var cond1 bool
+	p = frame.Checkpoint()
+	checkpoint0 = frame.Checkpoint()
+	begin0 = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond0 = frame.Flow == 0
+block0:
+	for {
+		if cond0 {
+			switch c0 {
+			case '*', '/', '%':
+				frame.Consume()
+				text, prec = frame.Slice(begin0, frame.Checkpoint()), 5
+				break block0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint0)
+		begin1 = frame.Checkpoint()
+		c1 = frame.Peek()
+		if frame.Flow == 0 {
+			switch c1 {
+			case '+', '-':
+				frame.Consume()
+				text, prec = frame.Slice(begin1, frame.Checkpoint()), 4
+				break block0
+			default:
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint0)
+		begin2 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		c2 = frame.Peek()
+		cond1 = frame.Flow == 0
+	block1:
+		for {
+			if cond1 {
+				switch c2 {
+				case '<', '>':
+					frame.Consume()
+					checkpoint2 = frame.Checkpoint()
+					c3 = frame.Peek()
+					if frame.Flow == 0 {
+						if c3 == '=' {
+							frame.Consume()
+							break block1
+						}
+						frame.Fail()
+					}
+					frame.Recover(checkpoint2)
+					break block1
+				default:
+					frame.Fail()
+				}
+			}
+			frame.Recover(checkpoint1)
+			c4 = frame.Peek()
+			if frame.Flow == 0 {
+				switch c4 {
+				case '!', '=':
+					frame.Consume()
+					c5 = frame.Peek()
+					if frame.Flow == 0 {
+						if c5 == '=' {
+							frame.Consume()
+						} else {
+							frame.Fail()
+							return
+						}
+					} else {
+						return
+					}
+				default:
+					frame.Fail()
+					return
+				}
+			} else {
+				return
+			}
+			break
+		}
+		text, prec = frame.Slice(begin2, frame.Checkpoint()), 3
+		break
+	}
+	ret0, ret1 = &Token{Pos: p, Text: text}, prec
+	return
+}
+
+func ParseBinaryOp(frame *runtime.State, min_prec int, literals bool) (ret Expr) {
+	var e0 Expr
+	var e1 Expr
+	var checkpoint int
+	var op *Token
+	var prec int
+	var r Expr
+	e0 = ParseExprPostfix(frame, literals)
+	if frame.Flow == 0 {
+		e1 = e0
+	loop0:
+		for {
+			checkpoint = frame.Checkpoint()
+			S(frame)
+			op, prec = InfixOperator(frame)
+			if frame.Flow == 0 {
+				if prec < min_prec {
+					frame.Fail()
+				} else {
+					S(frame)
+					r = ParseBinaryOp(frame, prec+1, literals)
+					if frame.Flow == 0 {
+						e1 = &InfixOp{Left: e1, Op: op, Right: r}
+						continue loop0
+					}
+				}
+			}
+			frame.Recover(checkpoint)
+			ret = e1
+			return
+		}
+	}
+	return
+}
+
+func ParseAssignOp(frame *runtime.State) (ret *Token) {
+	var p int
+	var begin int
+	var checkpoint int
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var cond bool
+	p = frame.Checkpoint()
+	begin = frame.Checkpoint()
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	cond = frame.Flow == 0
+block0:
+	for {
+		if cond {
+			if c0 == ':' {
+				frame.Consume()
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == '=' {
+						frame.Consume()
+						break block0
+					}
+					frame.Fail()
+				}
+			} else {
+				frame.Fail()
+			}
+		}
+		frame.Recover(checkpoint)
+		c2 = frame.Peek()
+		if frame.Flow == 0 {
+			if c2 == '=' {
+				frame.Consume()
+			} else {
+				frame.Fail()
+				return
+			}
+		} else {
+			return
+		}
+		break
+	}
+	ret = &Token{Pos: p, Text: frame.Slice(begin, frame.Checkpoint())}
+	return
+}
+
+func ParseAssignExpr(frame *runtime.State) (ret Expr) {
+	var e0 Expr
+	var checkpoint int
+	var op *Token
+	var other Expr
+	var e1 Expr
+	var cond bool
+	e0 = ParseBinaryOp(frame, 1, true)
+	if frame.Flow == 0 {
+		checkpoint = frame.Checkpoint()
+		S(frame)
+		op = ParseAssignOp(frame)
+		cond = frame.Flow == 0
+	block0:
+		for {
+			if cond {
+				S(frame)
+				other = ParseBinaryOp(frame, 1, true)
+				if frame.Flow == 0 {
+					e1 = &AssignOp{Target: e0, Op: op, Value: other}
+					break block0
+				}
+			}
+			frame.Recover(checkpoint)
+			e1 = e0
+			break
+		}
+		ret = e1
+		return
+	}
+	return
+}
+
+func ParseExpr(frame *runtime.State) (ret Expr) {
+	var r Expr
+	r = ParseAssignExpr(frame)
+	if frame.Flow == 0 {
+		ret = r
+		return
+	}
+	return
+}
+
+func ParseCondition(frame *runtime.State) (ret Expr) {
+	var r Expr
+	r = ParseBinaryOp(frame, 1, false)
+	if frame.Flow == 0 {
+		ret = r
+		return
+	}
+	return
+}
+
+func ParseBlock(frame *runtime.State) (ret []Stmt) {
+	var c0 rune
+	var body []Stmt
+	var c1 rune
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '{' {
+			frame.Consume()
+			S(frame)
+			body = ParseStatementList(frame)
+			S(frame)
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == '}' {
+					frame.Consume()
+					ret = body
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseIf(frame *runtime.State) (ret *If) {
+	var p int
+	var c0 rune
+	var c1 rune
+	var r0 Expr
+	var body []Stmt
+	var else_0 []Stmt
+	var checkpoint0 int
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var c5 rune
+	var checkpoint1 int
+	var r1 *If
+	var else_1 []Stmt
+	var else_2 []Stmt
+	var cond bool
+	p = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'i' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'f' {
+					frame.Consume()
+					EndKeyword(frame)
+					if frame.Flow == 0 {
+						S(frame)
+						r0 = ParseBinaryOp(frame, 1, false)
+						if frame.Flow == 0 {
+							S(frame)
+							body = ParseBlock(frame)
+							if frame.Flow == 0 {
+								else_0 = []Stmt{}
+								checkpoint0 = frame.Checkpoint()
+								S(frame)
+								c2 = frame.Peek()
+								cond = frame.Flow == 0
+							block0:
+								for {
+									if cond {
+										if c2 == 'e' {
+											frame.Consume()
+											c3 = frame.Peek()
+											if frame.Flow == 0 {
+												if c3 == 'l' {
+													frame.Consume()
+													c4 = frame.Peek()
+													if frame.Flow == 0 {
+														if c4 == 's' {
+															frame.Consume()
+															c5 = frame.Peek()
+															if frame.Flow == 0 {
+																if c5 == 'e' {
+																	frame.Consume()
+																	EndKeyword(frame)
+																	if frame.Flow == 0 {
+																		S(frame)
+																		checkpoint1 = frame.Checkpoint()
+																		r1 = ParseIf(frame)
+																		if frame.Flow == 0 {
+																			else_1 = []Stmt{r1}
+																			break block0
+																		}
+																		frame.Recover(checkpoint1)
+																		else_2 = ParseBlock(frame)
+																		if frame.Flow == 0 {
+																			else_1 = else_2
+																			break block0
+																		}
+																	}
+																} else {
+																	frame.Fail()
+																}
+															}
+														} else {
+															frame.Fail()
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+									frame.Recover(checkpoint0)
+									else_1 = else_0
+									break
+								}
+								ret = &If{Pos: p, Cond: r0, Body: body, Else: else_1}
+								return
+							}
+							return
+						}
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseStatement(frame *runtime.State) (ret Stmt) {
+	var p int
+	var checkpoint0 int
+	var body0 []Stmt
+	var stmt *If
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var c4 rune
+	var r0 Expr
+	var body1 []Stmt
+	var c5 rune
+	var c6 rune
+	var c7 rune
+	var name *Token
+	var c8 rune
+	var c9 rune
+	var r1 Expr
+	var body2 []Stmt
+	var c10 rune
+	var c11 rune
+	var c12 rune
+	var c13 rune
+	var c14 rune
+	var c15 rune
+	var c16 rune
+	var c17 rune
+	var c18 rune
+	var c19 rune
+	var c20 rune
+	var c21 rune
+	var c22 rune
+	var c23 rune
+	var c24 rune
+	var c25 rune
+	var c26 rune
+	var c27 rune
+	var c28 rune
+	var expr0 Expr
+	var checkpoint1 int
+	var r2 Expr
+	var expr1 Expr
+	var expr2 Expr
+	var r3 Expr
+	p = frame.Checkpoint()
+	checkpoint0 = frame.Checkpoint()
+	body0 = ParseBlock(frame)
+	if frame.Flow == 0 {
+		EOSInsertionPoint(frame)
+		EOS(frame)
+		if frame.Flow == 0 {
+			ret = &Block{Body: body0}
+			return
+		}
+	}
+	frame.Recover(checkpoint0)
+	stmt = ParseIf(frame)
+	if frame.Flow == 0 {
+		EOSInsertionPoint(frame)
+		EOS(frame)
+		if frame.Flow == 0 {
+			ret = stmt
+			return
+		}
+	}
+	frame.Recover(checkpoint0)
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'w' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'h' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'i' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'l' {
+									frame.Consume()
+									c4 = frame.Peek()
+									if frame.Flow == 0 {
+										if c4 == 'e' {
+											frame.Consume()
+											EndKeyword(frame)
+											if frame.Flow == 0 {
+												S(frame)
+												r0 = ParseBinaryOp(frame, 1, false)
+												if frame.Flow == 0 {
+													S(frame)
+													body1 = ParseBlock(frame)
+													if frame.Flow == 0 {
+														EOSInsertionPoint(frame)
+														EOS(frame)
+														if frame.Flow == 0 {
+															ret = &While{Pos: p, Cond: r0, Body: body1}
+															return
+														}
+													}
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c5 = frame.Peek()
+	if frame.Flow == 0 {
+		if c5 == 'f' {
+			frame.Consume()
+			c6 = frame.Peek()
+			if frame.Flow == 0 {
+				if c6 == 'o' {
+					frame.Consume()
+					c7 = frame.Peek()
+					if frame.Flow == 0 {
+						if c7 == 'r' {
+							frame.Consume()
+							EndKeyword(frame)
+							if frame.Flow == 0 {
+								S(frame)
+								name = Id(frame)
+								if frame.Flow == 0 {
+									S(frame)
+									c8 = frame.Peek()
+									if frame.Flow == 0 {
+										if c8 == 'i' {
+											frame.Consume()
+											c9 = frame.Peek()
+											if frame.Flow == 0 {
+												if c9 == 'n' {
+													frame.Consume()
+													EndKeyword(frame)
+													if frame.Flow == 0 {
+														S(frame)
+														r1 = ParseBinaryOp(frame, 1, false)
+														if frame.Flow == 0 {
+															S(frame)
+															body2 = ParseBlock(frame)
+															if frame.Flow == 0 {
+																EOSInsertionPoint(frame)
+																EOS(frame)
+																if frame.Flow == 0 {
+																	ret = &For{Pos: p, Name: name, Expr: r1, Body: body2}
+																	return
+																}
+															}
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c10 = frame.Peek()
+	if frame.Flow == 0 {
+		if c10 == 'b' {
+			frame.Consume()
+			c11 = frame.Peek()
+			if frame.Flow == 0 {
+				if c11 == 'r' {
+					frame.Consume()
+					c12 = frame.Peek()
+					if frame.Flow == 0 {
+						if c12 == 'e' {
+							frame.Consume()
+							c13 = frame.Peek()
+							if frame.Flow == 0 {
+								if c13 == 'a' {
+									frame.Consume()
+									c14 = frame.Peek()
+									if frame.Flow == 0 {
+										if c14 == 'k' {
+											frame.Consume()
+											EndKeyword(frame)
+											if frame.Flow == 0 {
+												EOSInsertionPoint(frame)
+												EOS(frame)
+												if frame.Flow == 0 {
+													ret = &Break{Pos: p}
+													return
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c15 = frame.Peek()
+	if frame.Flow == 0 {
+		if c15 == 'c' {
+			frame.Consume()
+			c16 = frame.Peek()
+			if frame.Flow == 0 {
+				if c16 == 'o' {
+					frame.Consume()
+					c17 = frame.Peek()
+					if frame.Flow == 0 {
+						if c17 == 'n' {
+							frame.Consume()
+							c18 = frame.Peek()
+							if frame.Flow == 0 {
+								if c18 == 't' {
+									frame.Consume()
+									c19 = frame.Peek()
+									if frame.Flow == 0 {
+										if c19 == 'i' {
+											frame.Consume()
+											c20 = frame.Peek()
+											if frame.Flow == 0 {
+												if c20 == 'n' {
+													frame.Consume()
+													c21 = frame.Peek()
+													if frame.Flow == 0 {
+														if c21 == 'u' {
+															frame.Consume()
+															c22 = frame.Peek()
+															if frame.Flow == 0 {
+																if c22 == 'e' {
+																	frame.Consume()
+																	EndKeyword(frame)
+																	if frame.Flow == 0 {
+																		EOSInsertionPoint(frame)
+																		EOS(frame)
+																		if frame.Flow == 0 {
+																			ret = &Continue{Pos: p}
+																			return
+																		}
+																	}
+																} else {
+																	frame.Fail()
+																}
+															}
+														} else {
+															frame.Fail()
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	c23 = frame.Peek()
+	if frame.Flow == 0 {
+		if c23 == 'r' {
+			frame.Consume()
+			c24 = frame.Peek()
+			if frame.Flow == 0 {
+				if c24 == 'e' {
+					frame.Consume()
+					c25 = frame.Peek()
+					if frame.Flow == 0 {
+						if c25 == 't' {
+							frame.Consume()
+							c26 = frame.Peek()
+							if frame.Flow == 0 {
+								if c26 == 'u' {
+									frame.Consume()
+									c27 = frame.Peek()
+									if frame.Flow == 0 {
+										if c27 == 'r' {
+											frame.Consume()
+											c28 = frame.Peek()
+											if frame.Flow == 0 {
+												if c28 == 'n' {
+													frame.Consume()
+													EndKeyword(frame)
+													if frame.Flow == 0 {
+														EOSInsertionPoint(frame)
+														expr0 = nil
+														checkpoint1 = frame.Checkpoint()
+														r2 = ParseAssignExpr(frame)
+														if frame.Flow == 0 {
+															EOSInsertionPoint(frame)
+															expr1 = r2
+														} else {
+															expr2 = expr0
+															frame.Recover(checkpoint1)
+															expr1 = expr2
+														}
+														EOS(frame)
+														if frame.Flow == 0 {
+															ret = &Return{Pos: p, Expr: expr1}
+															return
+														}
+													}
+												} else {
+													frame.Fail()
+												}
+											}
+										} else {
+											frame.Fail()
+										}
+									}
+								} else {
+									frame.Fail()
+								}
+							}
+						} else {
+							frame.Fail()
+						}
+					}
+				} else {
+					frame.Fail()
+				}
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint0)
+	r3 = ParseAssignExpr(frame)
+	if frame.Flow == 0 {
+		EOSInsertionPoint(frame)
+		EOS(frame)
+		if frame.Flow == 0 {
+			ret = r3
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseStatementList(frame *runtime.State) (ret []Stmt) {
+	var stmts0 []Stmt
+	var checkpoint0 int
+	var r0 Stmt
+	var stmts1 []Stmt
+	var checkpoint1 int
+	var r1 Stmt
+	var stmts2 []Stmt
+	var cond bool
+	stmts0 = []Stmt{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseStatement(frame)
+	cond = frame.Flow == 0
+block0:
+	for {
+		if cond {
+			stmts1 = append(stmts0, r0)
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				r1 = ParseStatement(frame)
+				if frame.Flow == 0 {
+					stmts1 = append(stmts1, r1)
+				} else {
+					frame.Recover(checkpoint1)
+					stmts2 = stmts1
+					break block0
+				}
+			}
+		}
+		frame.Recover(checkpoint0)
+		stmts2 = stmts0
+		break
+	}
+	ret = stmts2
+	return
+}
+
+func ParseTypeList(frame *runtime.State) (ret []TypeRef) {
+	var types0 []TypeRef
+	var checkpoint0 int
+	var r0 TypeRef
+	var types1 []TypeRef
+	var checkpoint1 int
+	var c rune
+	var r1 TypeRef
+	var types2 []TypeRef
+	var cond bool
+	types0 = []TypeRef{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseSumTypeRef(frame)
+	cond = frame.Flow == 0
+block1:
+	for {
+		if cond {
+			types1 = append(types0, r0)
+		loop0:
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				c = frame.Peek()
+				if frame.Flow == 0 {
+					if c == ',' {
+						frame.Consume()
+						S(frame)
+						r1 = ParseSumTypeRef(frame)
+						if frame.Flow == 0 {
+							types1 = append(types1, r1)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				types2 = types1
+				break block1
+			}
+		}
+		frame.Recover(checkpoint0)
+		types2 = types0
+		break
+	}
+	ret = types2
+	return
+}
+
+func ParseReturnTypeList(frame *runtime.State) (ret []TypeRef) {
+	var checkpoint int
+	var c0 rune
+	var types []TypeRef
+	var c1 rune
+	var r TypeRef
+	checkpoint = frame.Checkpoint()
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == '(' {
+			frame.Consume()
+			S(frame)
+			types = ParseTypeList(frame)
+			S(frame)
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == ')' {
+					frame.Consume()
+					ret = types
+					return
+				}
+				frame.Fail()
+			}
+		} else {
+			frame.Fail()
+		}
+	}
+	frame.Recover(checkpoint)
+	r = ParseSumTypeRef(frame)
+	if frame.Flow == 0 {
+		ret = []TypeRef{r}
+		return
+	}
+	frame.Recover(checkpoint)
+	ret = []TypeRef{}
+	return
+}
+
+func ParseParameter(frame *runtime.State) (ret *Parameter) {
+	var name *Token
+	var r TypeRef
+	name = Id(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		r = ParseSumTypeRef(frame)
+		if frame.Flow == 0 {
+			ret = &Parameter{Name: name, Type: r}
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseParameterList(frame *runtime.State) (ret []*Parameter) {
+	var params0 []*Parameter
+	var checkpoint0 int
+	var r0 *Parameter
+	var params1 []*Parameter
+	var checkpoint1 int
+	var c rune
+	var r1 *Parameter
+	var params2 []*Parameter
+	var cond bool
+	params0 = []*Parameter{}
+	checkpoint0 = frame.Checkpoint()
+	r0 = ParseParameter(frame)
+	cond = frame.Flow == 0
+block1:
+	for {
+		if cond {
+			params1 = append(params0, r0)
+		loop0:
+			for {
+				checkpoint1 = frame.Checkpoint()
+				S(frame)
+				c = frame.Peek()
+				if frame.Flow == 0 {
+					if c == ',' {
+						frame.Consume()
+						S(frame)
+						r1 = ParseParameter(frame)
+						if frame.Flow == 0 {
+							params1 = append(params1, r1)
+							continue loop0
+						}
+					} else {
+						frame.Fail()
+					}
+				}
+				frame.Recover(checkpoint1)
+				params2 = params1
+				break block1
+			}
+		}
+		frame.Recover(checkpoint0)
+		params2 = params0
+		break
+	}
+	ret = params2
+	return
+}
+
+func ParseFuncDecl(frame *runtime.State) (ret *FuncDecl) {
+	var c0 rune
+	var c1 rune
+	var c2 rune
+	var c3 rune
+	var name *Token
+	var c4 rune
+	var params []*Parameter
+	var c5 rune
+	var retTypes []TypeRef
+	var body []Stmt
+	c0 = frame.Peek()
+	if frame.Flow == 0 {
+		if c0 == 'f' {
+			frame.Consume()
+			c1 = frame.Peek()
+			if frame.Flow == 0 {
+				if c1 == 'u' {
+					frame.Consume()
+					c2 = frame.Peek()
+					if frame.Flow == 0 {
+						if c2 == 'n' {
+							frame.Consume()
+							c3 = frame.Peek()
+							if frame.Flow == 0 {
+								if c3 == 'c' {
+									frame.Consume()
+									EndKeyword(frame)
+									if frame.Flow == 0 {
+										S(frame)
+										name = Id(frame)
+										if frame.Flow == 0 {
+											S(frame)
+											c4 = frame.Peek()
+											if frame.Flow == 0 {
+												if c4 == '(' {
+													frame.Consume()
+													S(frame)
+													params = ParseParameterList(frame)
+													S(frame)
+													c5 = frame.Peek()
+													if frame.Flow == 0 {
+														if c5 == ')' {
+															frame.Consume()
+															S(frame)
+															retTypes = ParseReturnTypeList(frame)
+															S(frame)
+															body = ParseBlock(frame)
+															if frame.Flow == 0 {
+																ret = &FuncDecl{Name: name, Parameters: params, ReturnTypes: retTypes, Body: body}
+																return
+															}
+															return
+														}
+														frame.Fail()
+														return
+													}
+													return
+												}
+												frame.Fail()
+												return
+											}
+											return
+										}
+										return
+									}
+									return
+								}
+								frame.Fail()
+								return
+							}
+							return
+						}
+						frame.Fail()
+						return
+					}
+					return
+				}
+				frame.Fail()
+				return
+			}
+			return
+		}
+		frame.Fail()
+		return
+	}
+	return
+}
+
+func ParseSignature(frame *runtime.State) (ret *Signature) {
+	var name *Token
+	var c0 rune
+	var params []TypeRef
+	var c1 rune
+	var retTypes []TypeRef
+	var checkpoint int
+	S(frame)
+	name = Id(frame)
+	if frame.Flow == 0 {
+		S(frame)
+		c0 = frame.Peek()
+		if frame.Flow == 0 {
+			if c0 == '(' {
+				frame.Consume()
+				S(frame)
+				params = ParseTypeList(frame)
+				S(frame)
+				c1 = frame.Peek()
+				if frame.Flow == 0 {
+					if c1 == ')' {
+						frame.Consume()
+						S(frame)
+						retTypes = ParseReturnTypeList(frame)
+						S(frame)
+						checkpoint = frame.LookaheadBegin()
+						frame.Peek()
+						if frame.Flow == 0 {
+							frame.Consume()
+							frame.LookaheadFail(checkpoint)
+							return
+						}
+						frame.LookaheadNormal(checkpoint)
+						ret = &Signature{Name: name, Params: params, ReturnTypes: retTypes}
+						return
+					}
+					frame.Fail()
+					return
+				}
+				return
+			}
+			frame.Fail()
+			return
+		}
+		return
+	}
+	return
+}
+
+func ParseFile(frame *runtime.State) (ret *File) {
+	var decls0 []Decl
+	var decls1 []Decl
+	var checkpoint0 int
+	var checkpoint1 int
+	var r0 *TypeDecl
+	var decls2 []Decl
+	var r1 *FuncDecl
+	var decls3 []Decl
+	var checkpoint2 int
+	decls0 = []Decl{}
+	S(frame)
+	decls1 = decls0
+	for {
+		checkpoint0 = frame.Checkpoint()
+		checkpoint1 = frame.Checkpoint()
+		r0 = ParseTypeDecl(frame)
+		if frame.Flow == 0 {
+			decls2 = append(decls1, r0)
+		} else {
+			frame.Recover(checkpoint1)
+			r1 = ParseFuncDecl(frame)
+			if frame.Flow == 0 {
+				decls2 = append(decls1, r1)
+			} else {
+				decls3 = decls1
+				frame.Recover(checkpoint0)
+				checkpoint2 = frame.LookaheadBegin()
+				frame.Peek()
+				if frame.Flow == 0 {
+					frame.Consume()
+					frame.LookaheadFail(checkpoint2)
+					return
+				}
+				frame.LookaheadNormal(checkpoint2)
+				ret = &File{Decls: decls3}
+				return
+			}
+		}
+		S(frame)
+		decls1 = decls2
+	}
+}
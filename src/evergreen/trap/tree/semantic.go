@@ -0,0 +1,772 @@
+package tree
+
+import (
+	"evergreen/compiler"
+	"fmt"
+)
+
+type LocalInfo struct {
+	Name string
+	Type Type
+	// Parameters come first, followed by locals in the order they are defined.
+	Index int
+}
+
+type FunctionInfo struct {
+	Name string
+	// Nil for native functions, which are implemented by the host.
+	Decl    *FuncDecl
+	Params  []Type
+	Results []Type
+	Locals  []*LocalInfo
+}
+
+// The result of the semantic pass.  The AST is not modified, instead what the
+// pass learned about each node is recorded here.
+type Program struct {
+	Structs   []*StructType
+	Funcs     []*FunctionInfo
+	ExprTypes map[Expr]Type
+	Names     map[*GetName]*LocalInfo
+	Fields    map[*GetAttr]*FieldInfo
+	Calls     map[*Call]*FunctionInfo
+	// The variable each for loop defines.
+	ForLocals map[*For]*LocalInfo
+}
+
+type typeEntry struct {
+	Decl      *TypeDecl
+	Type      Type
+	Resolving bool
+	Resolved  bool
+}
+
+type semanticScope struct {
+	parent *semanticScope
+	locals map[string]*LocalInfo
+}
+
+func (scope *semanticScope) localInfo(name string) (*LocalInfo, bool) {
+	for scope != nil {
+		info, ok := scope.locals[name]
+		if ok {
+			return info, true
+		}
+		scope = scope.parent
+	}
+	return nil, false
+}
+
+func childScope(scope *semanticScope) *semanticScope {
+	return &semanticScope{
+		parent: scope,
+		locals: map[string]*LocalInfo{},
+	}
+}
+
+type semanticPassContext struct {
+	Program  *Program
+	Status   compiler.PassStatus
+	Builtins map[string]Type
+	Types    map[string]*typeEntry
+	Funcs    map[string]*FunctionInfo
+
+	Func  *FunctionInfo
+	Scope *semanticScope
+	// How many loops enclose the statement being checked.
+	Loops int
+}
+
+func typeRefPos(ref TypeRef) int {
+	switch ref := ref.(type) {
+	case *NamedTypeRef:
+		return ref.Name.Pos
+	case *ListTypeRef:
+		return typeRefPos(ref.Type)
+	case *SumTypeRef:
+		return typeRefPos(ref.Types[0])
+	default:
+		panic(ref)
+	}
+}
+
+func ExprPos(expr Expr) int {
+	switch expr := expr.(type) {
+	case *GetName:
+		return expr.Name.Pos
+	case *IntLiteral:
+		return expr.Pos
+	case *InfixOp:
+		return expr.Op.Pos
+	case *GetAttr:
+		return expr.Attr.Pos
+	case *GetIndex:
+		return ExprPos(expr.Expr)
+	case *Call:
+		return ExprPos(expr.Expr)
+	case *AssignOp:
+		return expr.Op.Pos
+	case *CreateStruct:
+		return typeRefPos(expr.Type)
+	case *CreateList:
+		return typeRefPos(expr.Type)
+	default:
+		panic(expr)
+	}
+}
+
+func (ctx *semanticPassContext) resolveNamedType(name *Token) Type {
+	if t, ok := ctx.Builtins[name.Text]; ok {
+		return t
+	}
+	entry, ok := ctx.Types[name.Text]
+	if !ok {
+		ctx.Status.LocationError(name.Pos, fmt.Sprintf("Could not resolve type %#v", name.Text))
+		return nil
+	}
+	if !entry.Resolved {
+		if entry.Resolving {
+			ctx.Status.LocationError(name.Pos, fmt.Sprintf("Recursive type alias %#v", name.Text))
+			return nil
+		}
+		entry.Resolving = true
+		entry.Type = ctx.resolveType(entry.Decl.Decl.(*TypeAliasDecl).Type)
+		entry.Resolving = false
+		entry.Resolved = true
+	}
+	return entry.Type
+}
+
+func (ctx *semanticPassContext) resolveType(ref TypeRef) Type {
+	switch ref := ref.(type) {
+	case *NamedTypeRef:
+		return ctx.resolveNamedType(ref.Name)
+	case *ListTypeRef:
+		t := ctx.resolveType(ref.Type)
+		if t == nil {
+			return nil
+		}
+		return &ListType{Type: t}
+	case *SumTypeRef:
+		types := make([]Type, len(ref.Types))
+		ok := true
+		for i, inner := range ref.Types {
+			types[i] = ctx.resolveType(inner)
+			ok = ok && types[i] != nil
+		}
+		if !ok {
+			return nil
+		}
+		return makeSumType(types)
+	default:
+		panic(ref)
+	}
+}
+
+func (ctx *semanticPassContext) defineLocal(name *Token, t Type) *LocalInfo {
+	if _, ok := ctx.Scope.locals[name.Text]; ok {
+		ctx.Status.LocationError(name.Pos, fmt.Sprintf("Tried to redefine %#v", name.Text))
+	}
+	info := &LocalInfo{Name: name.Text, Type: t, Index: len(ctx.Func.Locals)}
+	ctx.Func.Locals = append(ctx.Func.Locals, info)
+	ctx.Scope.locals[name.Text] = info
+	return info
+}
+
+// The integer type an untyped integer should become when the expected type is
+// needed.
+func integerTarget(expected Type) Type {
+	switch expected := expected.(type) {
+	case *BuiltinType:
+		if expected.Integer && expected != untypedIntType {
+			return expected
+		}
+	case *SumType:
+		for _, t := range expected.Types {
+			if b, ok := t.(*BuiltinType); ok && b.Integer {
+				return b
+			}
+		}
+	}
+	return I32Type
+}
+
+// Gives untyped integer expressions a concrete type.
+func (ctx *semanticPassContext) coerce(expr Expr, expected Type) {
+	if ctx.Program.ExprTypes[expr] != untypedIntType {
+		return
+	}
+	ctx.Program.ExprTypes[expr] = integerTarget(expected)
+	if op, ok := expr.(*InfixOp); ok {
+		ctx.coerce(op.Left, expected)
+		ctx.coerce(op.Right, expected)
+	}
+}
+
+// Checks an expression that must produce a value, which may still be untyped.
+func (ctx *semanticPassContext) checkOperand(expr Expr) Type {
+	t := ctx.checkExpr(expr)
+	if t == voidType {
+		ctx.Status.LocationError(ExprPos(expr), "Expected a single value")
+		ctx.Program.ExprTypes[expr] = nil
+		return nil
+	}
+	return t
+}
+
+func (ctx *semanticPassContext) checkValue(expr Expr, expected Type) Type {
+	ctx.checkOperand(expr)
+	ctx.coerce(expr, expected)
+	return ctx.Program.ExprTypes[expr]
+}
+
+func (ctx *semanticPassContext) checkExpr(expr Expr) Type {
+	t := ctx.exprType(expr)
+	ctx.Program.ExprTypes[expr] = t
+	return t
+}
+
+func isOrdering(op string) bool {
+	switch op {
+	case "<", "<=", ">", ">=":
+		return true
+	default:
+		return false
+	}
+}
+
+func (ctx *semanticPassContext) checkInfixOp(expr *InfixOp) Type {
+	l := ctx.checkOperand(expr.Left)
+	r := ctx.checkOperand(expr.Right)
+	if l == nil || r == nil {
+		return nil
+	}
+
+	// An untyped operand takes the type of the other operand.
+	if l == untypedIntType && r != untypedIntType {
+		ctx.coerce(expr.Left, r)
+		l = ctx.Program.ExprTypes[expr.Left]
+	} else if r == untypedIntType && l != untypedIntType {
+		ctx.coerce(expr.Right, l)
+		r = ctx.Program.ExprTypes[expr.Right]
+	}
+
+	op := expr.Op.Text
+	arithmetic := op == "+" || op == "-" || op == "*" || op == "/" || op == "%"
+	if !arithmetic && l == untypedIntType {
+		ctx.coerce(expr.Left, nil)
+		ctx.coerce(expr.Right, nil)
+		l, r = I32Type, I32Type
+	}
+	if !SameType(l, r) {
+		ctx.Status.LocationError(expr.Op.Pos, fmt.Sprintf("Mismatched types %s and %s for %s", TypeName(l), TypeName(r), op))
+		return nil
+	}
+
+	b, _ := l.(*BuiltinType)
+	var valid bool
+	switch {
+	case op == "%":
+		valid = b != nil && b.Integer
+	case arithmetic:
+		valid = b != nil && (b.Numeric || op == "+" && b == StringType)
+	case isOrdering(op):
+		valid = b != nil && (b.Numeric || b == StringType)
+	default:
+		valid = true
+	}
+	if !valid {
+		ctx.Status.LocationError(expr.Op.Pos, fmt.Sprintf("Operator %s is not defined for %s", op, TypeName(l)))
+		return nil
+	}
+	if arithmetic {
+		return l
+	}
+	return BoolType
+}
+
+func (ctx *semanticPassContext) checkAssignOp(expr *AssignOp) Type {
+	switch expr.Op.Text {
+	case ":=":
+		t := ctx.checkValue(expr.Value, nil)
+		name, ok := expr.Target.(*GetName)
+		if !ok {
+			ctx.Status.LocationError(expr.Op.Pos, "Can only define names")
+			return nil
+		}
+		ctx.Program.Names[name] = ctx.defineLocal(name.Name, t)
+		ctx.Program.ExprTypes[name] = t
+		return t
+	case "=":
+		switch expr.Target.(type) {
+		case *GetName, *GetAttr, *GetIndex:
+		default:
+			ctx.checkValue(expr.Value, nil)
+			ctx.Status.LocationError(expr.Op.Pos, "Cannot assign to expression")
+			return nil
+		}
+		t := ctx.checkExpr(expr.Target)
+		v := ctx.checkValue(expr.Value, t)
+		if !IsAssignable(v, t) {
+			ctx.Status.LocationError(expr.Op.Pos, fmt.Sprintf("Cannot assign %s to %s", TypeName(v), TypeName(t)))
+		}
+		return t
+	default:
+		panic(expr.Op.Text)
+	}
+}
+
+func (ctx *semanticPassContext) checkCreateStruct(expr *CreateStruct) Type {
+	t := ctx.resolveType(expr.Type)
+	if lt, ok := t.(*ListType); ok && len(expr.Args) == 0 {
+		// An empty list literal looks like an empty struct literal.
+		return lt
+	}
+	st, ok := t.(*StructType)
+	if !ok {
+		if t != nil {
+			ctx.Status.LocationError(typeRefPos(expr.Type), fmt.Sprintf("%s is not a struct type", TypeName(t)))
+		}
+		for _, arg := range expr.Args {
+			ctx.checkValue(arg.Value, nil)
+		}
+		return nil
+	}
+
+	seen := map[string]bool{}
+	for _, arg := range expr.Args {
+		f := st.Field(arg.Name.Text)
+		if f == nil {
+			ctx.Status.LocationError(arg.Name.Pos, fmt.Sprintf("%s does not have field %s", TypeName(st), arg.Name.Text))
+			ctx.checkValue(arg.Value, nil)
+			continue
+		}
+		if seen[f.Name] {
+			ctx.Status.LocationError(arg.Name.Pos, fmt.Sprintf("Field %s is set more than once", f.Name))
+		}
+		seen[f.Name] = true
+		v := ctx.checkValue(arg.Value, f.Type)
+		if !IsAssignable(v, f.Type) {
+			ctx.Status.LocationError(arg.Name.Pos, fmt.Sprintf("Expected type %s, but got %s", TypeName(f.Type), TypeName(v)))
+		}
+	}
+	for _, f := range st.Fields {
+		if !seen[f.Name] {
+			ctx.Status.LocationError(typeRefPos(expr.Type), fmt.Sprintf("Missing field %s", f.Name))
+		}
+	}
+	return st
+}
+
+func (ctx *semanticPassContext) checkCreateList(expr *CreateList) Type {
+	t := ctx.resolveType(expr.Type)
+	lt, ok := t.(*ListType)
+	if !ok {
+		if t != nil {
+			ctx.Status.LocationError(typeRefPos(expr.Type), fmt.Sprintf("%s is not a list type", TypeName(t)))
+		}
+		for _, arg := range expr.Args {
+			ctx.checkValue(arg, nil)
+		}
+		return nil
+	}
+	for _, arg := range expr.Args {
+		v := ctx.checkValue(arg, lt.Type)
+		if !IsAssignable(v, lt.Type) {
+			ctx.Status.LocationError(ExprPos(arg), fmt.Sprintf("Expected type %s, but got %s", TypeName(lt.Type), TypeName(v)))
+		}
+	}
+	return lt
+}
+
+// Replaces the bound type variables in a type.
+func substitute(t Type, bindings map[*TypeVar]Type) Type {
+	switch t := t.(type) {
+	case *TypeVar:
+		if bound, ok := bindings[t]; ok {
+			return bound
+		}
+		return t
+	case *ListType:
+		return &ListType{Type: substitute(t.Type, bindings)}
+	case *SumType:
+		types := make([]Type, len(t.Types))
+		for i, inner := range t.Types {
+			types[i] = substitute(inner, bindings)
+		}
+		return makeSumType(types)
+	default:
+		return t
+	}
+}
+
+func hasTypeVars(t Type) bool {
+	switch t := t.(type) {
+	case *TypeVar:
+		return true
+	case *ListType:
+		return hasTypeVars(t.Type)
+	case *SumType:
+		for _, inner := range t.Types {
+			if hasTypeVars(inner) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Checks an argument against a parameter that may contain type variables.
+// Each variable is bound to the first type it is matched with.
+func matchParam(param Type, actual Type, bindings map[*TypeVar]Type) bool {
+	if actual == nil || param == nil {
+		// An error has already been reported.
+		return true
+	}
+	switch param := param.(type) {
+	case *TypeVar:
+		bound, ok := bindings[param]
+		if !ok {
+			bindings[param] = actual
+			return true
+		}
+		return IsAssignable(actual, bound)
+	case *ListType:
+		lt, ok := actual.(*ListType)
+		if ok && hasTypeVars(param.Type) {
+			return matchParam(param.Type, lt.Type, bindings)
+		}
+	}
+	return IsAssignable(actual, substitute(param, bindings))
+}
+
+func (ctx *semanticPassContext) checkCall(expr *Call) Type {
+	var f *FunctionInfo
+	if name, ok := expr.Expr.(*GetName); ok {
+		if _, shadowed := ctx.Scope.localInfo(name.Name.Text); !shadowed {
+			f = ctx.Funcs[name.Name.Text]
+		}
+	}
+	if f == nil {
+		ctx.Status.LocationError(ExprPos(expr), "Can only call functions by name")
+		for _, arg := range expr.Args {
+			ctx.checkValue(arg, nil)
+		}
+		return nil
+	}
+	ctx.Program.Calls[expr] = f
+
+	if len(expr.Args) != len(f.Params) {
+		ctx.Status.LocationError(ExprPos(expr), fmt.Sprintf("expected %d arguments, got %d", len(f.Params), len(expr.Args)))
+	}
+	bindings := map[*TypeVar]Type{}
+	for i, arg := range expr.Args {
+		var expected Type
+		if i < len(f.Params) {
+			expected = f.Params[i]
+		}
+		v := ctx.checkValue(arg, substitute(expected, bindings))
+		if !matchParam(expected, v, bindings) {
+			ctx.Status.LocationError(ExprPos(arg), fmt.Sprintf("argument %d - got %s, expected %s", i, TypeName(v), TypeName(substitute(expected, bindings))))
+		}
+	}
+	if len(f.Results) != 1 {
+		return voidType
+	}
+	result := substitute(f.Results[0], bindings)
+	if hasTypeVars(result) {
+		// An argument that would have bound it is missing.
+		return nil
+	}
+	return result
+}
+
+func (ctx *semanticPassContext) exprType(expr Expr) Type {
+	switch expr := expr.(type) {
+	case *IntLiteral:
+		return untypedIntType
+	case *GetName:
+		info, ok := ctx.Scope.localInfo(expr.Name.Text)
+		if !ok {
+			ctx.Status.LocationError(expr.Name.Pos, fmt.Sprintf("Could not resolve name %#v", expr.Name.Text))
+			return nil
+		}
+		ctx.Program.Names[expr] = info
+		return info.Type
+	case *InfixOp:
+		return ctx.checkInfixOp(expr)
+	case *GetAttr:
+		t := ctx.checkValue(expr.Expr, nil)
+		if t == nil {
+			return nil
+		}
+		st, ok := t.(*StructType)
+		if !ok {
+			ctx.Status.LocationError(expr.Attr.Pos, fmt.Sprintf("%s is not a struct type", TypeName(t)))
+			return nil
+		}
+		f := st.Field(expr.Attr.Text)
+		if f == nil {
+			ctx.Status.LocationError(expr.Attr.Pos, fmt.Sprintf("%s does not have field %s", TypeName(st), expr.Attr.Text))
+			return nil
+		}
+		ctx.Program.Fields[expr] = f
+		return f.Type
+	case *GetIndex:
+		t := ctx.checkValue(expr.Expr, nil)
+		it := ctx.checkValue(expr.Index, nil)
+		if b, ok := it.(*BuiltinType); it != nil && !(ok && b.Integer) {
+			ctx.Status.LocationError(ExprPos(expr.Index), fmt.Sprintf("Cannot index with %s", TypeName(it)))
+		}
+		if t == nil {
+			return nil
+		}
+		lt, ok := t.(*ListType)
+		if !ok {
+			ctx.Status.LocationError(ExprPos(expr), fmt.Sprintf("Cannot index %s", TypeName(t)))
+			return nil
+		}
+		return lt.Type
+	case *Call:
+		return ctx.checkCall(expr)
+	case *AssignOp:
+		return ctx.checkAssignOp(expr)
+	case *CreateStruct:
+		return ctx.checkCreateStruct(expr)
+	case *CreateList:
+		return ctx.checkCreateList(expr)
+	default:
+		panic(expr)
+	}
+}
+
+func (ctx *semanticPassContext) checkReturn(stmt *Return) {
+	results := ctx.Func.Results
+	if stmt.Expr == nil {
+		if len(results) != 0 {
+			ctx.Status.LocationError(stmt.Pos, fmt.Sprintf("expected %d return values, got 0", len(results)))
+		}
+		return
+	}
+	var expected Type
+	if len(results) == 1 {
+		expected = results[0]
+	}
+	t := ctx.checkValue(stmt.Expr, expected)
+	if len(results) != 1 {
+		ctx.Status.LocationError(stmt.Pos, fmt.Sprintf("expected %d return values, got 1", len(results)))
+	} else if !IsAssignable(t, expected) {
+		ctx.Status.LocationError(ExprPos(stmt.Expr), fmt.Sprintf("return: %s vs. %s", TypeName(t), TypeName(expected)))
+	}
+}
+
+func (ctx *semanticPassContext) checkCondition(cond Expr) {
+	t := ctx.checkValue(cond, BoolType)
+	if t != nil && !IsAssignable(t, BoolType) {
+		ctx.Status.LocationError(ExprPos(cond), fmt.Sprintf("condition: %s vs. %s", TypeName(t), TypeName(BoolType)))
+	}
+}
+
+// Checks the statements of a block in their own scope.
+func (ctx *semanticPassContext) checkBlock(body []Stmt) {
+	ctx.Scope = childScope(ctx.Scope)
+	for _, stmt := range body {
+		ctx.checkStmt(stmt)
+	}
+	ctx.Scope = ctx.Scope.parent
+}
+
+func (ctx *semanticPassContext) checkLoopBody(body []Stmt) {
+	ctx.Loops++
+	ctx.checkBlock(body)
+	ctx.Loops--
+}
+
+func (ctx *semanticPassContext) checkFor(stmt *For) {
+	t := ctx.checkOperand(stmt.Expr)
+	var element Type
+	if lt, ok := t.(*ListType); ok {
+		element = lt.Type
+	} else if t != nil {
+		ctx.Status.LocationError(ExprPos(stmt.Expr), fmt.Sprintf("cannot iterate over %s", TypeName(t)))
+	}
+	// The variable is only visible inside the loop.
+	ctx.Scope = childScope(ctx.Scope)
+	ctx.Program.ForLocals[stmt] = ctx.defineLocal(stmt.Name, element)
+	ctx.checkLoopBody(stmt.Body)
+	ctx.Scope = ctx.Scope.parent
+}
+
+func (ctx *semanticPassContext) checkStmt(stmt Stmt) {
+	switch stmt := stmt.(type) {
+	case *Return:
+		ctx.checkReturn(stmt)
+	case *Block:
+		ctx.checkBlock(stmt.Body)
+	case *If:
+		ctx.checkCondition(stmt.Cond)
+		ctx.checkBlock(stmt.Body)
+		ctx.checkBlock(stmt.Else)
+	case *While:
+		ctx.checkCondition(stmt.Cond)
+		ctx.checkLoopBody(stmt.Body)
+	case *For:
+		ctx.checkFor(stmt)
+	case *Break:
+		if ctx.Loops == 0 {
+			ctx.Status.LocationError(stmt.Pos, "break outside of a loop")
+		}
+	case *Continue:
+		if ctx.Loops == 0 {
+			ctx.Status.LocationError(stmt.Pos, "continue outside of a loop")
+		}
+	case Expr:
+		// Expression statements may discard any number of values.
+		if ctx.checkExpr(stmt) != voidType {
+			ctx.coerce(stmt, nil)
+		}
+	default:
+		panic(stmt)
+	}
+}
+
+func (ctx *semanticPassContext) checkFunction(f *FunctionInfo) {
+	ctx.Func = f
+	ctx.Scope = childScope(nil)
+	for i, p := range f.Decl.Parameters {
+		ctx.defineLocal(p.Name, f.Params[i])
+	}
+	for _, stmt := range f.Decl.Body {
+		ctx.checkStmt(stmt)
+	}
+	if len(f.Results) > 0 && !Terminates(f.Decl.Body) {
+		ctx.Status.LocationError(f.Decl.Name.Pos, "missing return")
+	}
+	ctx.Func = nil
+	ctx.Scope = nil
+}
+
+// Reports whether control cannot reach the end of a block.  Loops are assumed
+// to exit, since their conditions are not evaluated.
+func Terminates(body []Stmt) bool {
+	if len(body) == 0 {
+		return false
+	}
+	switch stmt := body[len(body)-1].(type) {
+	case *Return:
+		return true
+	case *Block:
+		return Terminates(stmt.Body)
+	case *If:
+		return Terminates(stmt.Body) && Terminates(stmt.Else)
+	default:
+		return false
+	}
+}
+
+// Resolves types and names, and checks the types of a trap file.  Natives are
+// the functions the host provides, and come before the file's functions.
+func SemanticPass(file *File, natives []*FunctionInfo, status compiler.PassStatus) *Program {
+	status.Begin()
+	defer status.End()
+
+	ctx := &semanticPassContext{
+		Program: &Program{
+			ExprTypes: map[Expr]Type{},
+			Names:     map[*GetName]*LocalInfo{},
+			Fields:    map[*GetAttr]*FieldInfo{},
+			Calls:     map[*Call]*FunctionInfo{},
+			ForLocals: map[*For]*LocalInfo{},
+		},
+		Status:   status,
+		Builtins: map[string]Type{},
+		Types:    map[string]*typeEntry{},
+		Funcs:    map[string]*FunctionInfo{},
+	}
+	for _, t := range builtinTypes {
+		ctx.Builtins[t.Name] = t
+	}
+
+	// Declare every type before resolving any of them, so types can refer to
+	// types declared later in the file.
+	types := []*TypeDecl{}
+	funcs := []*FuncDecl{}
+	for _, decl := range file.Decls {
+		switch decl := decl.(type) {
+		case *TypeDecl:
+			name := decl.Name.Text
+			_, builtin := ctx.Builtins[name]
+			if _, exists := ctx.Types[name]; exists || builtin {
+				status.LocationError(decl.Name.Pos, fmt.Sprintf("Tried to redefine %#v", name))
+				continue
+			}
+			entry := &typeEntry{Decl: decl}
+			if _, ok := decl.Decl.(*StructDecl); ok {
+				st := &StructType{Name: name}
+				ctx.Program.Structs = append(ctx.Program.Structs, st)
+				entry.Type = st
+				entry.Resolved = true
+			}
+			ctx.Types[name] = entry
+			types = append(types, decl)
+		case *FuncDecl:
+			funcs = append(funcs, decl)
+		default:
+			panic(decl)
+		}
+	}
+
+	for _, decl := range types {
+		entry := ctx.Types[decl.Name.Text]
+		switch impl := decl.Decl.(type) {
+		case *StructDecl:
+			st := entry.Type.(*StructType)
+			for _, field := range impl.Fields {
+				if st.Field(field.Name.Text) != nil {
+					status.LocationError(field.Name.Pos, fmt.Sprintf("Tried to redefine %#v", field.Name.Text))
+					continue
+				}
+				st.Fields = append(st.Fields, &FieldInfo{
+					Name: field.Name.Text,
+					Type: ctx.resolveType(field.Type),
+					Slot: len(st.Fields),
+				})
+			}
+		case *TypeAliasDecl:
+			ctx.resolveNamedType(decl.Name)
+		default:
+			panic(impl)
+		}
+	}
+
+	for _, f := range natives {
+		ctx.Funcs[f.Name] = f
+		ctx.Program.Funcs = append(ctx.Program.Funcs, f)
+	}
+	for _, decl := range funcs {
+		name := decl.Name.Text
+		if _, exists := ctx.Funcs[name]; exists {
+			status.LocationError(decl.Name.Pos, fmt.Sprintf("Tried to redefine %#v", name))
+			continue
+		}
+		f := &FunctionInfo{
+			Name: name,
+			Decl: decl,
+		}
+		for _, p := range decl.Parameters {
+			f.Params = append(f.Params, ctx.resolveType(p.Type))
+		}
+		for _, r := range decl.ReturnTypes {
+			f.Results = append(f.Results, ctx.resolveType(r))
+		}
+		ctx.Funcs[name] = f
+		ctx.Program.Funcs = append(ctx.Program.Funcs, f)
+	}
+
+	for _, f := range ctx.Program.Funcs {
+		if f.Decl != nil {
+			ctx.checkFunction(f)
+		}
+	}
+	return ctx.Program
+}
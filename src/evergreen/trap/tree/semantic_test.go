@@ -0,0 +1,295 @@
+package tree
+
+import (
+	"evergreen/assert"
+	"evergreen/compiler"
+	"testing"
+)
+
+func checkSource(src string, t *testing.T) (*Program, compiler.CompileStatus) {
+	return checkSourceWithNatives(src, nil, t)
+}
+
+func checkSourceWithNatives(src string, natives []*FunctionInfo, t *testing.T) (*Program, compiler.CompileStatus) {
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	offset := p.AddFile("test.trap", []rune(src))
+
+	pass := status.Pass("trap")
+	pass.Begin()
+	defer pass.End()
+	file := ParseTrap([]byte(src), offset, pass.Task("parse"))
+	if file == nil {
+		t.Fatalf("could not parse %#v", src)
+	}
+	return SemanticPass(file, natives, pass.Pass("semantic")), status
+}
+
+func TestSemanticTypes(t *testing.T) {
+	src := `
+type Number = i64;
+type Value = Number | []Point;
+
+type Point struct {
+	x Number
+	y Number
+}
+
+func Manhattan(p Point) Number {
+	d := p.x + 1
+	d = d + p.y
+	return d
+}
+
+func Wrap(p Point) Value {
+	points := []Point{p, Point{x: 2, y: 3}}
+	return points
+}
+
+func Set(p Point, v i64) {
+	p.y = v
+	return
+}
+
+func Reset(p Point) {
+	Set(p, Manhattan(p) - 1)
+}
+`
+	program, status := checkSource(src, t)
+	assert.IntEquals(t, status.ErrorCount(), 0)
+
+	assert.IntEquals(t, len(program.Structs), 1)
+	point := program.Structs[0]
+	assert.StringEquals(t, point.Name, "Point")
+	assert.IntEquals(t, len(point.Fields), 2)
+	assert.IntEquals(t, point.Field("y").Slot, 1)
+	if point.Field("x").Type != I64Type {
+		t.Errorf("alias was not expanded: %s", TypeName(point.Field("x").Type))
+	}
+
+	assert.IntEquals(t, len(program.Funcs), 4)
+	manhattan := program.Funcs[0]
+	assert.IntEquals(t, len(manhattan.Locals), 2)
+	assert.StringEquals(t, manhattan.Locals[1].Name, "d")
+	assert.IntEquals(t, manhattan.Locals[1].Index, 1)
+	if manhattan.Locals[1].Type != I64Type {
+		t.Errorf("bad local type: %s", TypeName(manhattan.Locals[1].Type))
+	}
+
+	// The literal takes the type of the other operand.
+	define := manhattan.Decl.Body[0].(*AssignOp)
+	one := define.Value.(*InfixOp).Right
+	if program.ExprTypes[one] != I64Type {
+		t.Errorf("bad literal type: %s", TypeName(program.ExprTypes[one]))
+	}
+	if program.Fields[define.Value.(*InfixOp).Left.(*GetAttr)] != point.Fields[0] {
+		t.Error("field was not resolved")
+	}
+
+	wrap := program.Funcs[1]
+	assert.StringEquals(t, TypeName(wrap.Results[0]), "i64 | []Point")
+	assert.StringEquals(t, TypeName(wrap.Locals[1].Type), "[]Point")
+
+	call := program.Funcs[3].Decl.Body[0].(*Call)
+	if program.Calls[call] != program.Funcs[2] {
+		t.Error("call was not resolved")
+	}
+	if program.ExprTypes[call.Args[1].(*InfixOp).Right] != I64Type {
+		t.Error("argument was not coerced")
+	}
+}
+
+func TestSemanticControlFlow(t *testing.T) {
+	program, status := checkSource(`
+func Sum(items []i32, limit i32) i32 {
+	total := 0
+	for item in items {
+		if item > limit {
+			break
+		} else if item == 0 {
+			continue
+		}
+		total = total + item
+	}
+	return total
+}
+
+func Sign(x i32) i32 {
+	if x < 0 {
+		return 0 - 1
+	} else {
+		{
+			x := x == 0
+			if x {
+				return 0
+			}
+		}
+	}
+	return 1
+}
+
+func Max(a i32, b i32) i32 {
+	if a < b {
+		return b
+	} else {
+		return a
+	}
+}
+`, t)
+	assert.IntEquals(t, status.ErrorCount(), 0)
+
+	sum := program.Funcs[0]
+	assert.IntEquals(t, len(sum.Locals), 4)
+	assert.StringEquals(t, TypeName(sum.Locals[2].Type), "i32")
+	assert.StringEquals(t, TypeName(sum.Locals[3].Type), "i32")
+	for stmt, info := range program.ForLocals {
+		assert.StringEquals(t, info.Name, stmt.Name.Text)
+		assert.IntEquals(t, info.Index, 3)
+	}
+
+	// The inner x shadows the parameter.
+	sign := program.Funcs[1]
+	assert.IntEquals(t, len(sign.Locals), 2)
+	assert.StringEquals(t, TypeName(sign.Locals[1].Type), "bool")
+}
+
+func TestSemanticErrors(t *testing.T) {
+	sources := []string{
+		"type A = B;",
+		"type A = B; type B = A;",
+		"type A struct {x i32; x i32}",
+		"type i32 struct {}",
+		"func F() i32 {return x}",
+		"func F() i32 {return 1 + 2 == 3}",
+		"func F(a i32, b i64) i32 {return a + b}",
+		"func F(a string, b string) string {return a % b}",
+		"func F(a bool) bool {return a < a}",
+		"func F(a i32) i32 {return a.x}",
+		"type P struct {x i32} func F(p P) i32 {return p.y}",
+		"type P struct {x i32} func F(p P) {p.x = p}",
+		"type P struct {x i32; y i32} func F() P {return P{x: 1}}",
+		"type P struct {x i32} func F() P {return P{x: 1, z: 2}}",
+		"type P struct {x i32} func F(p P) P {return P{x: p}}",
+		"func F() []i32 {return []i32{1, []i32{}}}",
+		"func F() i32 {return i32{}}",
+		"func F() i32 {return}",
+		"func F() {return 1}",
+		"func F() (i32, i32) {return 1}",
+		"func F() i32 {x := 1}",
+		"func F(a i32) {a := 2}",
+		"func F(a i32) {1 = a}",
+		"func F(a []i32, b bool) i32 {return a[b]}",
+		"func F() {} func F() {}",
+		"func G(a i32) i32 {return a} func F() i32 {return G()}",
+		"func G(a i32) i32 {return a} func F(b bool) i32 {return G(b)}",
+		"func G() {} func F() i32 {return G()}",
+		"func F(a i32) i32 {return a(1)}",
+		"func F(a i32) {if a {}}",
+		"func F(a i32) {while a + 1 {}}",
+		"func F(a i32) {for x in a {}}",
+		"func F() {break}",
+		"func F(a bool) {if a {continue}}",
+		"func F(a bool) i32 {if a {return 1}}",
+		"func F(a bool) i32 {while a {return 1}}",
+		"func F(a bool) {if a {x := 1} else {x = 2}}",
+		"func F(a []i32) i32 {for x in a {}; return x}",
+	}
+	for _, src := range sources {
+		_, status := checkSource(src, t)
+		if status.ErrorCount() != 1 {
+			t.Errorf("expected 1 error, got %d for %#v", status.ErrorCount(), src)
+		}
+	}
+}
+
+func makeNatives(t *testing.T, signatures ...string) []*FunctionInfo {
+	natives := []*FunctionInfo{}
+	for _, sig := range signatures {
+		f, err := ParseNativeSignature(sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		natives = append(natives, f)
+	}
+	return natives
+}
+
+func TestNativeSignatures(t *testing.T) {
+	f, err := ParseNativeSignature("pick([]T, i32) (T, bool)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.StringEquals(t, f.Name, "pick")
+	assert.IntEquals(t, len(f.Params), 2)
+	assert.StringEquals(t, TypeName(f.Params[0]), "[]T")
+	if f.Params[0].(*ListType).Type != f.Results[0] {
+		t.Error("Expected T to be the same variable in the params and results")
+	}
+	assert.StringEquals(t, TypeName(f.Results[1]), "bool")
+
+	for _, sig := range []string{"f(T) U", "f(", "func f()"} {
+		if _, err := ParseNativeSignature(sig); err == nil {
+			t.Errorf("Expected %#v to be rejected", sig)
+		}
+	}
+}
+
+func TestSemanticNatives(t *testing.T) {
+	src := `
+type Point struct {
+	x i32
+}
+
+func Count(p []Point) i32 {
+	print(len(p))
+	return len([]i64{1, 2})
+}
+
+func First(p []Point) i32 {
+	return first(p).x
+}
+`
+	natives := makeNatives(t, "print(i32)", "len([]T) i32", "first([]T) T")
+	program, status := checkSourceWithNatives(src, natives, t)
+	assert.IntEquals(t, status.ErrorCount(), 0)
+	// Natives come first.
+	assert.IntEquals(t, len(program.Funcs), 5)
+	if program.Funcs[1] != natives[1] {
+		t.Error("Expected the natives to be the first functions")
+	}
+
+	first := program.Funcs[4].Decl.Body[0].(*Return).Expr.(*GetAttr).Expr
+	assert.StringEquals(t, TypeName(program.ExprTypes[first]), "Point")
+
+	sources := []string{
+		"func F() {print(len(1))}",
+		"func F() {print(true)}",
+		"func F() {same(1, true)}",
+		"func F(a []i32, b []i64) {same(a, b)}",
+		"func print(x i32) {}",
+		"func F() bool {return first([]i32{})}",
+	}
+	natives = makeNatives(t, "print(i32)", "len([]T) i32", "first([]T) T", "same(T, T)")
+	for _, src := range sources {
+		_, status := checkSourceWithNatives(src, natives, t)
+		if status.ErrorCount() != 1 {
+			t.Errorf("expected 1 error, got %d for %#v", status.ErrorCount(), src)
+		}
+	}
+}
+
+// Errors at the end of the file are reported on the last line.
+func TestParseTruncated(t *testing.T) {
+	src := "func F() i32 {"
+	p := compiler.MakeProvider()
+	status := compiler.MakeStatus(p)
+	offset := p.AddFile("test.trap", []rune(src))
+	pass := status.Pass("trap")
+	pass.Begin()
+	file := ParseTrap([]byte(src), offset, pass.Task("parse"))
+	pass.End()
+	if file != nil {
+		t.Fatalf("expected %#v not to parse", src)
+	}
+	assert.IntEquals(t, status.ErrorCount(), 1)
+}
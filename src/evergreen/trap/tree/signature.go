@@ -0,0 +1,88 @@
+package tree
+
+import (
+	"evergreen/dub/runtime"
+	"fmt"
+)
+
+type signatureResolver struct {
+	builtins map[string]Type
+	vars     map[string]*TypeVar
+}
+
+// Names that are not builtin types are type variables, since natives cannot
+// refer to the types a trap file declares.
+func (r *signatureResolver) resolve(ref TypeRef) Type {
+	switch ref := ref.(type) {
+	case *NamedTypeRef:
+		name := ref.Name.Text
+		if t, ok := r.builtins[name]; ok {
+			return t
+		}
+		v, ok := r.vars[name]
+		if !ok {
+			v = &TypeVar{Name: name}
+			r.vars[name] = v
+		}
+		return v
+	case *ListTypeRef:
+		return &ListType{Type: r.resolve(ref.Type)}
+	case *SumTypeRef:
+		types := make([]Type, len(ref.Types))
+		for i, inner := range ref.Types {
+			types[i] = r.resolve(inner)
+		}
+		return makeSumType(types)
+	default:
+		panic(ref)
+	}
+}
+
+func collectTypeVars(t Type, vars map[*TypeVar]bool) {
+	switch t := t.(type) {
+	case *TypeVar:
+		vars[t] = true
+	case *ListType:
+		collectTypeVars(t.Type, vars)
+	case *SumType:
+		for _, inner := range t.Types {
+			collectTypeVars(inner, vars)
+		}
+	}
+}
+
+// Parses the signature of a native function, like "len([]T) i32".  Every
+// type variable in the results must also appear in the parameters, so calls
+// can bind it.
+func ParseNativeSignature(text string) (*FunctionInfo, error) {
+	state := &runtime.State{Stream: []rune(text)}
+	sig := ParseSignature(state)
+	if state.Flow != 0 {
+		pos := state.Deepest()
+		return nil, fmt.Errorf("unexpected %s in signature %#v", state.RuneName(pos), text)
+	}
+
+	r := &signatureResolver{builtins: map[string]Type{}, vars: map[string]*TypeVar{}}
+	for _, t := range builtinTypes {
+		r.builtins[t.Name] = t
+	}
+	f := &FunctionInfo{Name: sig.Name.Text}
+	bound := map[*TypeVar]bool{}
+	for _, ref := range sig.Params {
+		t := r.resolve(ref)
+		collectTypeVars(t, bound)
+		f.Params = append(f.Params, t)
+	}
+	for _, ref := range sig.ReturnTypes {
+		t := r.resolve(ref)
+		used := map[*TypeVar]bool{}
+		collectTypeVars(t, used)
+		for v := range used {
+			if !bound[v] {
+				return nil, fmt.Errorf("%s is only used in the results of %#v", v.Name, text)
+			}
+		}
+		f.Results = append(f.Results, t)
+	}
+	return f, nil
+}
@@ -0,0 +1,187 @@
+package tree
+
+import (
+	"strings"
+)
+
+// A resolved trap type.  Named types are resolved and aliases are expanded, so
+// types can be compared without knowing how they were written.
+type Type interface {
+	isType()
+}
+
+type BuiltinType struct {
+	Name    string
+	Integer bool
+	Numeric bool
+}
+
+func (t *BuiltinType) isType() {
+}
+
+type FieldInfo struct {
+	Name string
+	Type Type
+	// Fields are numbered in the order they are declared.
+	Slot int
+}
+
+type StructType struct {
+	Name   string
+	Fields []*FieldInfo
+}
+
+func (t *StructType) isType() {
+}
+
+func (t *StructType) Field(name string) *FieldInfo {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+type ListType struct {
+	Type Type
+}
+
+func (t *ListType) isType() {
+}
+
+// Sum types are flattened, so they never directly contain another sum type.
+type SumType struct {
+	Types []Type
+}
+
+func (t *SumType) isType() {
+}
+
+// A placeholder in the signature of a native function, like T in len([]T).
+// Each call binds it to the type of an argument.
+type TypeVar struct {
+	Name string
+}
+
+func (t *TypeVar) isType() {
+}
+
+var I32Type = &BuiltinType{Name: "i32", Integer: true, Numeric: true}
+var I64Type = &BuiltinType{Name: "i64", Integer: true, Numeric: true}
+var U32Type = &BuiltinType{Name: "u32", Integer: true, Numeric: true}
+var F32Type = &BuiltinType{Name: "f32", Numeric: true}
+var F64Type = &BuiltinType{Name: "f64", Numeric: true}
+var BoolType = &BuiltinType{Name: "bool"}
+var StringType = &BuiltinType{Name: "string"}
+
+// The type of an integer literal that has not been given a type by the
+// expression around it.  It defaults to i32.
+var untypedIntType = &BuiltinType{Name: "untyped int", Integer: true, Numeric: true}
+
+// The type of a call that does not return exactly one value.
+var voidType = &BuiltinType{Name: "void"}
+
+var builtinTypes = []*BuiltinType{I32Type, I64Type, U32Type, F32Type, F64Type, BoolType, StringType}
+
+func SameType(a Type, b Type) bool {
+	switch a := a.(type) {
+	case *ListType:
+		b, ok := b.(*ListType)
+		return ok && SameType(a.Type, b.Type)
+	case *SumType:
+		b, ok := b.(*SumType)
+		if !ok || len(a.Types) != len(b.Types) {
+			return false
+		}
+		for _, t := range a.Types {
+			if !sumContains(b, t) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+func sumContains(s *SumType, t Type) bool {
+	for _, other := range s.Types {
+		if SameType(other, t) {
+			return true
+		}
+	}
+	return false
+}
+
+// A value of the actual type can be stored where the expected type is needed.
+func IsAssignable(actual Type, expected Type) bool {
+	if actual == nil || expected == nil {
+		// An error has already been reported.
+		return true
+	}
+	if actual == untypedIntType {
+		if b, ok := expected.(*BuiltinType); ok && b.Integer {
+			return true
+		}
+		actual = I32Type
+	}
+	if SameType(actual, expected) {
+		return true
+	}
+	s, ok := expected.(*SumType)
+	if !ok {
+		return false
+	}
+	if as, ok := actual.(*SumType); ok {
+		for _, t := range as.Types {
+			if !sumContains(s, t) {
+				return false
+			}
+		}
+		return true
+	}
+	return sumContains(s, actual)
+}
+
+func makeSumType(types []Type) Type {
+	flat := &SumType{}
+	for _, t := range types {
+		if s, ok := t.(*SumType); ok {
+			for _, inner := range s.Types {
+				if !sumContains(flat, inner) {
+					flat.Types = append(flat.Types, inner)
+				}
+			}
+		} else if !sumContains(flat, t) {
+			flat.Types = append(flat.Types, t)
+		}
+	}
+	if len(flat.Types) == 1 {
+		return flat.Types[0]
+	}
+	return flat
+}
+
+func TypeName(t Type) string {
+	switch t := t.(type) {
+	case *BuiltinType:
+		return t.Name
+	case *StructType:
+		return t.Name
+	case *TypeVar:
+		return t.Name
+	case *ListType:
+		return "[]" + TypeName(t.Type)
+	case *SumType:
+		names := make([]string, len(t.Types))
+		for i, inner := range t.Types {
+			names[i] = TypeName(inner)
+		}
+		return strings.Join(names, " | ")
+	case nil:
+		return "<unresolved>"
+	default:
+		panic(t)
+	}
+}